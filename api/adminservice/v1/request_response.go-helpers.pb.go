@@ -3297,3 +3297,965 @@ func (this *ForceUnloadTaskQueuePartitionResponse) Equal(that interface{}) bool
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type ModifyWorkflowPropertiesRequest to the protobuf v3 wire format
+func (val *ModifyWorkflowPropertiesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ModifyWorkflowPropertiesRequest from the protobuf v3 wire format
+func (val *ModifyWorkflowPropertiesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ModifyWorkflowPropertiesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ModifyWorkflowPropertiesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ModifyWorkflowPropertiesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ModifyWorkflowPropertiesRequest
+	switch t := that.(type) {
+	case *ModifyWorkflowPropertiesRequest:
+		that1 = t
+	case ModifyWorkflowPropertiesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ModifyWorkflowPropertiesResponse to the protobuf v3 wire format
+func (val *ModifyWorkflowPropertiesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ModifyWorkflowPropertiesResponse from the protobuf v3 wire format
+func (val *ModifyWorkflowPropertiesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ModifyWorkflowPropertiesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ModifyWorkflowPropertiesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ModifyWorkflowPropertiesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ModifyWorkflowPropertiesResponse
+	switch t := that.(type) {
+	case *ModifyWorkflowPropertiesResponse:
+		that1 = t
+	case ModifyWorkflowPropertiesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ModifyActivityPropertiesRequest to the protobuf v3 wire format
+func (val *ModifyActivityPropertiesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ModifyActivityPropertiesRequest from the protobuf v3 wire format
+func (val *ModifyActivityPropertiesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ModifyActivityPropertiesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ModifyActivityPropertiesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ModifyActivityPropertiesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ModifyActivityPropertiesRequest
+	switch t := that.(type) {
+	case *ModifyActivityPropertiesRequest:
+		that1 = t
+	case ModifyActivityPropertiesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ModifyActivityPropertiesResponse to the protobuf v3 wire format
+func (val *ModifyActivityPropertiesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ModifyActivityPropertiesResponse from the protobuf v3 wire format
+func (val *ModifyActivityPropertiesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ModifyActivityPropertiesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ModifyActivityPropertiesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ModifyActivityPropertiesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ModifyActivityPropertiesResponse
+	switch t := that.(type) {
+	case *ModifyActivityPropertiesResponse:
+		that1 = t
+	case ModifyActivityPropertiesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetDynamicConfigRequest to the protobuf v3 wire format
+func (val *GetDynamicConfigRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetDynamicConfigRequest from the protobuf v3 wire format
+func (val *GetDynamicConfigRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetDynamicConfigRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetDynamicConfigRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetDynamicConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetDynamicConfigRequest
+	switch t := that.(type) {
+	case *GetDynamicConfigRequest:
+		that1 = t
+	case GetDynamicConfigRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetDynamicConfigResponse to the protobuf v3 wire format
+func (val *GetDynamicConfigResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetDynamicConfigResponse from the protobuf v3 wire format
+func (val *GetDynamicConfigResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetDynamicConfigResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetDynamicConfigResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetDynamicConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetDynamicConfigResponse
+	switch t := that.(type) {
+	case *GetDynamicConfigResponse:
+		that1 = t
+	case GetDynamicConfigResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetDynamicConfigRequest to the protobuf v3 wire format
+func (val *SetDynamicConfigRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetDynamicConfigRequest from the protobuf v3 wire format
+func (val *SetDynamicConfigRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetDynamicConfigRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetDynamicConfigRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetDynamicConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetDynamicConfigRequest
+	switch t := that.(type) {
+	case *SetDynamicConfigRequest:
+		that1 = t
+	case SetDynamicConfigRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SetDynamicConfigResponse to the protobuf v3 wire format
+func (val *SetDynamicConfigResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SetDynamicConfigResponse from the protobuf v3 wire format
+func (val *SetDynamicConfigResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SetDynamicConfigResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SetDynamicConfigResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SetDynamicConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SetDynamicConfigResponse
+	switch t := that.(type) {
+	case *SetDynamicConfigResponse:
+		that1 = t
+	case SetDynamicConfigResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListDynamicConfigRequest to the protobuf v3 wire format
+func (val *ListDynamicConfigRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListDynamicConfigRequest from the protobuf v3 wire format
+func (val *ListDynamicConfigRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListDynamicConfigRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListDynamicConfigRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListDynamicConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListDynamicConfigRequest
+	switch t := that.(type) {
+	case *ListDynamicConfigRequest:
+		that1 = t
+	case ListDynamicConfigRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListDynamicConfigResponse to the protobuf v3 wire format
+func (val *ListDynamicConfigResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListDynamicConfigResponse from the protobuf v3 wire format
+func (val *ListDynamicConfigResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListDynamicConfigResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListDynamicConfigResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListDynamicConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListDynamicConfigResponse
+	switch t := that.(type) {
+	case *ListDynamicConfigResponse:
+		that1 = t
+	case ListDynamicConfigResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExplainDynamicConfigRequest to the protobuf v3 wire format
+func (val *ExplainDynamicConfigRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExplainDynamicConfigRequest from the protobuf v3 wire format
+func (val *ExplainDynamicConfigRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExplainDynamicConfigRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExplainDynamicConfigRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExplainDynamicConfigRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExplainDynamicConfigRequest
+	switch t := that.(type) {
+	case *ExplainDynamicConfigRequest:
+		that1 = t
+	case ExplainDynamicConfigRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExplainDynamicConfigResponse to the protobuf v3 wire format
+func (val *ExplainDynamicConfigResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExplainDynamicConfigResponse from the protobuf v3 wire format
+func (val *ExplainDynamicConfigResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExplainDynamicConfigResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExplainDynamicConfigResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExplainDynamicConfigResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExplainDynamicConfigResponse
+	switch t := that.(type) {
+	case *ExplainDynamicConfigResponse:
+		that1 = t
+	case ExplainDynamicConfigResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListDynamicConfigDeviationsRequest to the protobuf v3 wire format
+func (val *ListDynamicConfigDeviationsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListDynamicConfigDeviationsRequest from the protobuf v3 wire format
+func (val *ListDynamicConfigDeviationsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListDynamicConfigDeviationsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListDynamicConfigDeviationsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListDynamicConfigDeviationsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListDynamicConfigDeviationsRequest
+	switch t := that.(type) {
+	case *ListDynamicConfigDeviationsRequest:
+		that1 = t
+	case ListDynamicConfigDeviationsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListDynamicConfigDeviationsResponse to the protobuf v3 wire format
+func (val *ListDynamicConfigDeviationsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListDynamicConfigDeviationsResponse from the protobuf v3 wire format
+func (val *ListDynamicConfigDeviationsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListDynamicConfigDeviationsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListDynamicConfigDeviationsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListDynamicConfigDeviationsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListDynamicConfigDeviationsResponse
+	switch t := that.(type) {
+	case *ListDynamicConfigDeviationsResponse:
+		that1 = t
+	case ListDynamicConfigDeviationsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CreateScheduleExclusionCalendarRequest to the protobuf v3 wire format
+func (val *CreateScheduleExclusionCalendarRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CreateScheduleExclusionCalendarRequest from the protobuf v3 wire format
+func (val *CreateScheduleExclusionCalendarRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CreateScheduleExclusionCalendarRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CreateScheduleExclusionCalendarRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CreateScheduleExclusionCalendarRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CreateScheduleExclusionCalendarRequest
+	switch t := that.(type) {
+	case *CreateScheduleExclusionCalendarRequest:
+		that1 = t
+	case CreateScheduleExclusionCalendarRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type CreateScheduleExclusionCalendarResponse to the protobuf v3 wire format
+func (val *CreateScheduleExclusionCalendarResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type CreateScheduleExclusionCalendarResponse from the protobuf v3 wire format
+func (val *CreateScheduleExclusionCalendarResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *CreateScheduleExclusionCalendarResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two CreateScheduleExclusionCalendarResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *CreateScheduleExclusionCalendarResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *CreateScheduleExclusionCalendarResponse
+	switch t := that.(type) {
+	case *CreateScheduleExclusionCalendarResponse:
+		that1 = t
+	case CreateScheduleExclusionCalendarResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateScheduleExclusionCalendarRequest to the protobuf v3 wire format
+func (val *UpdateScheduleExclusionCalendarRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateScheduleExclusionCalendarRequest from the protobuf v3 wire format
+func (val *UpdateScheduleExclusionCalendarRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateScheduleExclusionCalendarRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateScheduleExclusionCalendarRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateScheduleExclusionCalendarRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateScheduleExclusionCalendarRequest
+	switch t := that.(type) {
+	case *UpdateScheduleExclusionCalendarRequest:
+		that1 = t
+	case UpdateScheduleExclusionCalendarRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type UpdateScheduleExclusionCalendarResponse to the protobuf v3 wire format
+func (val *UpdateScheduleExclusionCalendarResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type UpdateScheduleExclusionCalendarResponse from the protobuf v3 wire format
+func (val *UpdateScheduleExclusionCalendarResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *UpdateScheduleExclusionCalendarResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two UpdateScheduleExclusionCalendarResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *UpdateScheduleExclusionCalendarResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *UpdateScheduleExclusionCalendarResponse
+	switch t := that.(type) {
+	case *UpdateScheduleExclusionCalendarResponse:
+		that1 = t
+	case UpdateScheduleExclusionCalendarResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeScheduleExclusionCalendarRequest to the protobuf v3 wire format
+func (val *DescribeScheduleExclusionCalendarRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeScheduleExclusionCalendarRequest from the protobuf v3 wire format
+func (val *DescribeScheduleExclusionCalendarRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeScheduleExclusionCalendarRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeScheduleExclusionCalendarRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeScheduleExclusionCalendarRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeScheduleExclusionCalendarRequest
+	switch t := that.(type) {
+	case *DescribeScheduleExclusionCalendarRequest:
+		that1 = t
+	case DescribeScheduleExclusionCalendarRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DescribeScheduleExclusionCalendarResponse to the protobuf v3 wire format
+func (val *DescribeScheduleExclusionCalendarResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DescribeScheduleExclusionCalendarResponse from the protobuf v3 wire format
+func (val *DescribeScheduleExclusionCalendarResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DescribeScheduleExclusionCalendarResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DescribeScheduleExclusionCalendarResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DescribeScheduleExclusionCalendarResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DescribeScheduleExclusionCalendarResponse
+	switch t := that.(type) {
+	case *DescribeScheduleExclusionCalendarResponse:
+		that1 = t
+	case DescribeScheduleExclusionCalendarResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteScheduleExclusionCalendarRequest to the protobuf v3 wire format
+func (val *DeleteScheduleExclusionCalendarRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteScheduleExclusionCalendarRequest from the protobuf v3 wire format
+func (val *DeleteScheduleExclusionCalendarRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteScheduleExclusionCalendarRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteScheduleExclusionCalendarRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteScheduleExclusionCalendarRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteScheduleExclusionCalendarRequest
+	switch t := that.(type) {
+	case *DeleteScheduleExclusionCalendarRequest:
+		that1 = t
+	case DeleteScheduleExclusionCalendarRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DeleteScheduleExclusionCalendarResponse to the protobuf v3 wire format
+func (val *DeleteScheduleExclusionCalendarResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DeleteScheduleExclusionCalendarResponse from the protobuf v3 wire format
+func (val *DeleteScheduleExclusionCalendarResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DeleteScheduleExclusionCalendarResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DeleteScheduleExclusionCalendarResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DeleteScheduleExclusionCalendarResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DeleteScheduleExclusionCalendarResponse
+	switch t := that.(type) {
+	case *DeleteScheduleExclusionCalendarResponse:
+		that1 = t
+	case DeleteScheduleExclusionCalendarResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListScheduleExclusionCalendarsRequest to the protobuf v3 wire format
+func (val *ListScheduleExclusionCalendarsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListScheduleExclusionCalendarsRequest from the protobuf v3 wire format
+func (val *ListScheduleExclusionCalendarsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListScheduleExclusionCalendarsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListScheduleExclusionCalendarsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListScheduleExclusionCalendarsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListScheduleExclusionCalendarsRequest
+	switch t := that.(type) {
+	case *ListScheduleExclusionCalendarsRequest:
+		that1 = t
+	case ListScheduleExclusionCalendarsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListScheduleExclusionCalendarsResponse to the protobuf v3 wire format
+func (val *ListScheduleExclusionCalendarsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListScheduleExclusionCalendarsResponse from the protobuf v3 wire format
+func (val *ListScheduleExclusionCalendarsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListScheduleExclusionCalendarsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListScheduleExclusionCalendarsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListScheduleExclusionCalendarsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListScheduleExclusionCalendarsResponse
+	switch t := that.(type) {
+	case *ListScheduleExclusionCalendarsResponse:
+		that1 = t
+	case ListScheduleExclusionCalendarsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListBatchOperationResultsRequest to the protobuf v3 wire format
+func (val *ListBatchOperationResultsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListBatchOperationResultsRequest from the protobuf v3 wire format
+func (val *ListBatchOperationResultsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListBatchOperationResultsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListBatchOperationResultsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListBatchOperationResultsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListBatchOperationResultsRequest
+	switch t := that.(type) {
+	case *ListBatchOperationResultsRequest:
+		that1 = t
+	case ListBatchOperationResultsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ListBatchOperationResultsResponse to the protobuf v3 wire format
+func (val *ListBatchOperationResultsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ListBatchOperationResultsResponse from the protobuf v3 wire format
+func (val *ListBatchOperationResultsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ListBatchOperationResultsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ListBatchOperationResultsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ListBatchOperationResultsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ListBatchOperationResultsResponse
+	switch t := that.(type) {
+	case *ListBatchOperationResultsResponse:
+		that1 = t
+	case ListBatchOperationResultsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
@@ -63,6 +63,19 @@ const (
 	AdminService_GenerateLastHistoryReplicationTasks_FullMethodName = "/temporal.server.api.adminservice.v1.AdminService/GenerateLastHistoryReplicationTasks"
 	AdminService_DescribeTaskQueuePartition_FullMethodName          = "/temporal.server.api.adminservice.v1.AdminService/DescribeTaskQueuePartition"
 	AdminService_ForceUnloadTaskQueuePartition_FullMethodName       = "/temporal.server.api.adminservice.v1.AdminService/ForceUnloadTaskQueuePartition"
+	AdminService_ModifyWorkflowProperties_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/ModifyWorkflowProperties"
+	AdminService_ModifyActivityProperties_FullMethodName            = "/temporal.server.api.adminservice.v1.AdminService/ModifyActivityProperties"
+	AdminService_GetDynamicConfig_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/GetDynamicConfig"
+	AdminService_SetDynamicConfig_FullMethodName                    = "/temporal.server.api.adminservice.v1.AdminService/SetDynamicConfig"
+	AdminService_ListDynamicConfig_FullMethodName                   = "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfig"
+	AdminService_ExplainDynamicConfig_FullMethodName                = "/temporal.server.api.adminservice.v1.AdminService/ExplainDynamicConfig"
+	AdminService_ListDynamicConfigDeviations_FullMethodName         = "/temporal.server.api.adminservice.v1.AdminService/ListDynamicConfigDeviations"
+	AdminService_CreateScheduleExclusionCalendar_FullMethodName     = "/temporal.server.api.adminservice.v1.AdminService/CreateScheduleExclusionCalendar"
+	AdminService_UpdateScheduleExclusionCalendar_FullMethodName     = "/temporal.server.api.adminservice.v1.AdminService/UpdateScheduleExclusionCalendar"
+	AdminService_DescribeScheduleExclusionCalendar_FullMethodName   = "/temporal.server.api.adminservice.v1.AdminService/DescribeScheduleExclusionCalendar"
+	AdminService_DeleteScheduleExclusionCalendar_FullMethodName     = "/temporal.server.api.adminservice.v1.AdminService/DeleteScheduleExclusionCalendar"
+	AdminService_ListScheduleExclusionCalendars_FullMethodName      = "/temporal.server.api.adminservice.v1.AdminService/ListScheduleExclusionCalendars"
+	AdminService_ListBatchOperationResults_FullMethodName           = "/temporal.server.api.adminservice.v1.AdminService/ListBatchOperationResults"
 )
 
 // AdminServiceClient is the client API for AdminService service.
@@ -153,6 +166,38 @@ type AdminServiceClient interface {
 	GenerateLastHistoryReplicationTasks(ctx context.Context, in *GenerateLastHistoryReplicationTasksRequest, opts ...grpc.CallOption) (*GenerateLastHistoryReplicationTasksResponse, error)
 	DescribeTaskQueuePartition(ctx context.Context, in *DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*DescribeTaskQueuePartitionResponse, error)
 	ForceUnloadTaskQueuePartition(ctx context.Context, in *ForceUnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// ModifyWorkflowProperties changes the task queue, timeouts or memo of a running workflow.
+	// The change is recorded as a WorkflowPropertiesModifiedExternally event.
+	ModifyWorkflowProperties(ctx context.Context, in *ModifyWorkflowPropertiesRequest, opts ...grpc.CallOption) (*ModifyWorkflowPropertiesResponse, error)
+	// ModifyActivityProperties replaces the retry policy of a pending activity. The change is
+	// recorded as an ActivityPropertiesModifiedExternally event and applies from the next attempt.
+	ModifyActivityProperties(ctx context.Context, in *ModifyActivityPropertiesRequest, opts ...grpc.CallOption) (*ModifyActivityPropertiesResponse, error)
+	// GetDynamicConfig returns the values of a dynamic config key stored in persistence.
+	GetDynamicConfig(ctx context.Context, in *GetDynamicConfigRequest, opts ...grpc.CallOption) (*GetDynamicConfigResponse, error)
+	// SetDynamicConfig replaces the values of a dynamic config key stored in persistence.
+	SetDynamicConfig(ctx context.Context, in *SetDynamicConfigRequest, opts ...grpc.CallOption) (*SetDynamicConfigResponse, error)
+	// ListDynamicConfig returns all dynamic config values stored in persistence.
+	ListDynamicConfig(ctx context.Context, in *ListDynamicConfigRequest, opts ...grpc.CallOption) (*ListDynamicConfigResponse, error)
+	// ExplainDynamicConfig returns how the value of a dynamic config key is determined for a
+	// set of constraints: the default, the values of the key, and the value that is chosen.
+	ExplainDynamicConfig(ctx context.Context, in *ExplainDynamicConfigRequest, opts ...grpc.CallOption) (*ExplainDynamicConfigResponse, error)
+	// ListDynamicConfigDeviations returns the dynamic config keys with values that differ from
+	// their defaults.
+	ListDynamicConfigDeviations(ctx context.Context, in *ListDynamicConfigDeviationsRequest, opts ...grpc.CallOption) (*ListDynamicConfigDeviationsResponse, error)
+	// CreateScheduleExclusionCalendar registers a named exclusion calendar on a namespace.
+	// Schedules in the namespace can reference it from the exclude calendars of their spec.
+	CreateScheduleExclusionCalendar(ctx context.Context, in *CreateScheduleExclusionCalendarRequest, opts ...grpc.CallOption) (*CreateScheduleExclusionCalendarResponse, error)
+	// UpdateScheduleExclusionCalendar replaces an existing exclusion calendar. Schedules pick
+	// up the change the next time they refresh their calendars.
+	UpdateScheduleExclusionCalendar(ctx context.Context, in *UpdateScheduleExclusionCalendarRequest, opts ...grpc.CallOption) (*UpdateScheduleExclusionCalendarResponse, error)
+	DescribeScheduleExclusionCalendar(ctx context.Context, in *DescribeScheduleExclusionCalendarRequest, opts ...grpc.CallOption) (*DescribeScheduleExclusionCalendarResponse, error)
+	DeleteScheduleExclusionCalendar(ctx context.Context, in *DeleteScheduleExclusionCalendarRequest, opts ...grpc.CallOption) (*DeleteScheduleExclusionCalendarResponse, error)
+	ListScheduleExclusionCalendars(ctx context.Context, in *ListScheduleExclusionCalendarsRequest, opts ...grpc.CallOption) (*ListScheduleExclusionCalendarsResponse, error)
+	// ListBatchOperationResults returns the per-workflow results recorded by a batch
+	// operation. Results are recorded by update and signal-with-start batch operations.
+	// Unlike other admin APIs, it only requires read access to the namespace of the batch
+	// operation, like DescribeBatchOperation.
+	ListBatchOperationResults(ctx context.Context, in *ListBatchOperationResultsRequest, opts ...grpc.CallOption) (*ListBatchOperationResultsResponse, error)
 }
 
 type adminServiceClient struct {
@@ -572,6 +617,123 @@ func (c *adminServiceClient) ForceUnloadTaskQueuePartition(ctx context.Context,
 	return out, nil
 }
 
+func (c *adminServiceClient) ModifyWorkflowProperties(ctx context.Context, in *ModifyWorkflowPropertiesRequest, opts ...grpc.CallOption) (*ModifyWorkflowPropertiesResponse, error) {
+	out := new(ModifyWorkflowPropertiesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ModifyWorkflowProperties_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ModifyActivityProperties(ctx context.Context, in *ModifyActivityPropertiesRequest, opts ...grpc.CallOption) (*ModifyActivityPropertiesResponse, error) {
+	out := new(ModifyActivityPropertiesResponse)
+	err := c.cc.Invoke(ctx, AdminService_ModifyActivityProperties_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) GetDynamicConfig(ctx context.Context, in *GetDynamicConfigRequest, opts ...grpc.CallOption) (*GetDynamicConfigResponse, error) {
+	out := new(GetDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, AdminService_GetDynamicConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) SetDynamicConfig(ctx context.Context, in *SetDynamicConfigRequest, opts ...grpc.CallOption) (*SetDynamicConfigResponse, error) {
+	out := new(SetDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, AdminService_SetDynamicConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListDynamicConfig(ctx context.Context, in *ListDynamicConfigRequest, opts ...grpc.CallOption) (*ListDynamicConfigResponse, error) {
+	out := new(ListDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListDynamicConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ExplainDynamicConfig(ctx context.Context, in *ExplainDynamicConfigRequest, opts ...grpc.CallOption) (*ExplainDynamicConfigResponse, error) {
+	out := new(ExplainDynamicConfigResponse)
+	err := c.cc.Invoke(ctx, AdminService_ExplainDynamicConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListDynamicConfigDeviations(ctx context.Context, in *ListDynamicConfigDeviationsRequest, opts ...grpc.CallOption) (*ListDynamicConfigDeviationsResponse, error) {
+	out := new(ListDynamicConfigDeviationsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListDynamicConfigDeviations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) CreateScheduleExclusionCalendar(ctx context.Context, in *CreateScheduleExclusionCalendarRequest, opts ...grpc.CallOption) (*CreateScheduleExclusionCalendarResponse, error) {
+	out := new(CreateScheduleExclusionCalendarResponse)
+	err := c.cc.Invoke(ctx, AdminService_CreateScheduleExclusionCalendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) UpdateScheduleExclusionCalendar(ctx context.Context, in *UpdateScheduleExclusionCalendarRequest, opts ...grpc.CallOption) (*UpdateScheduleExclusionCalendarResponse, error) {
+	out := new(UpdateScheduleExclusionCalendarResponse)
+	err := c.cc.Invoke(ctx, AdminService_UpdateScheduleExclusionCalendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DescribeScheduleExclusionCalendar(ctx context.Context, in *DescribeScheduleExclusionCalendarRequest, opts ...grpc.CallOption) (*DescribeScheduleExclusionCalendarResponse, error) {
+	out := new(DescribeScheduleExclusionCalendarResponse)
+	err := c.cc.Invoke(ctx, AdminService_DescribeScheduleExclusionCalendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) DeleteScheduleExclusionCalendar(ctx context.Context, in *DeleteScheduleExclusionCalendarRequest, opts ...grpc.CallOption) (*DeleteScheduleExclusionCalendarResponse, error) {
+	out := new(DeleteScheduleExclusionCalendarResponse)
+	err := c.cc.Invoke(ctx, AdminService_DeleteScheduleExclusionCalendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListScheduleExclusionCalendars(ctx context.Context, in *ListScheduleExclusionCalendarsRequest, opts ...grpc.CallOption) (*ListScheduleExclusionCalendarsResponse, error) {
+	out := new(ListScheduleExclusionCalendarsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListScheduleExclusionCalendars_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) ListBatchOperationResults(ctx context.Context, in *ListBatchOperationResultsRequest, opts ...grpc.CallOption) (*ListBatchOperationResultsResponse, error) {
+	out := new(ListBatchOperationResultsResponse)
+	err := c.cc.Invoke(ctx, AdminService_ListBatchOperationResults_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AdminServiceServer is the server API for AdminService service.
 // All implementations must embed UnimplementedAdminServiceServer
 // for forward compatibility
@@ -660,6 +822,38 @@ type AdminServiceServer interface {
 	GenerateLastHistoryReplicationTasks(context.Context, *GenerateLastHistoryReplicationTasksRequest) (*GenerateLastHistoryReplicationTasksResponse, error)
 	DescribeTaskQueuePartition(context.Context, *DescribeTaskQueuePartitionRequest) (*DescribeTaskQueuePartitionResponse, error)
 	ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error)
+	// ModifyWorkflowProperties changes the task queue, timeouts or memo of a running workflow.
+	// The change is recorded as a WorkflowPropertiesModifiedExternally event.
+	ModifyWorkflowProperties(context.Context, *ModifyWorkflowPropertiesRequest) (*ModifyWorkflowPropertiesResponse, error)
+	// ModifyActivityProperties replaces the retry policy of a pending activity. The change is
+	// recorded as an ActivityPropertiesModifiedExternally event and applies from the next attempt.
+	ModifyActivityProperties(context.Context, *ModifyActivityPropertiesRequest) (*ModifyActivityPropertiesResponse, error)
+	// GetDynamicConfig returns the values of a dynamic config key stored in persistence.
+	GetDynamicConfig(context.Context, *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error)
+	// SetDynamicConfig replaces the values of a dynamic config key stored in persistence.
+	SetDynamicConfig(context.Context, *SetDynamicConfigRequest) (*SetDynamicConfigResponse, error)
+	// ListDynamicConfig returns all dynamic config values stored in persistence.
+	ListDynamicConfig(context.Context, *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error)
+	// ExplainDynamicConfig returns how the value of a dynamic config key is determined for a
+	// set of constraints: the default, the values of the key, and the value that is chosen.
+	ExplainDynamicConfig(context.Context, *ExplainDynamicConfigRequest) (*ExplainDynamicConfigResponse, error)
+	// ListDynamicConfigDeviations returns the dynamic config keys with values that differ from
+	// their defaults.
+	ListDynamicConfigDeviations(context.Context, *ListDynamicConfigDeviationsRequest) (*ListDynamicConfigDeviationsResponse, error)
+	// CreateScheduleExclusionCalendar registers a named exclusion calendar on a namespace.
+	// Schedules in the namespace can reference it from the exclude calendars of their spec.
+	CreateScheduleExclusionCalendar(context.Context, *CreateScheduleExclusionCalendarRequest) (*CreateScheduleExclusionCalendarResponse, error)
+	// UpdateScheduleExclusionCalendar replaces an existing exclusion calendar. Schedules pick
+	// up the change the next time they refresh their calendars.
+	UpdateScheduleExclusionCalendar(context.Context, *UpdateScheduleExclusionCalendarRequest) (*UpdateScheduleExclusionCalendarResponse, error)
+	DescribeScheduleExclusionCalendar(context.Context, *DescribeScheduleExclusionCalendarRequest) (*DescribeScheduleExclusionCalendarResponse, error)
+	DeleteScheduleExclusionCalendar(context.Context, *DeleteScheduleExclusionCalendarRequest) (*DeleteScheduleExclusionCalendarResponse, error)
+	ListScheduleExclusionCalendars(context.Context, *ListScheduleExclusionCalendarsRequest) (*ListScheduleExclusionCalendarsResponse, error)
+	// ListBatchOperationResults returns the per-workflow results recorded by a batch
+	// operation. Results are recorded by update and signal-with-start batch operations.
+	// Unlike other admin APIs, it only requires read access to the namespace of the batch
+	// operation, like DescribeBatchOperation.
+	ListBatchOperationResults(context.Context, *ListBatchOperationResultsRequest) (*ListBatchOperationResultsResponse, error)
 	mustEmbedUnimplementedAdminServiceServer()
 }
 
@@ -796,6 +990,45 @@ func (UnimplementedAdminServiceServer) DescribeTaskQueuePartition(context.Contex
 func (UnimplementedAdminServiceServer) ForceUnloadTaskQueuePartition(context.Context, *ForceUnloadTaskQueuePartitionRequest) (*ForceUnloadTaskQueuePartitionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ForceUnloadTaskQueuePartition not implemented")
 }
+func (UnimplementedAdminServiceServer) ModifyWorkflowProperties(context.Context, *ModifyWorkflowPropertiesRequest) (*ModifyWorkflowPropertiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyWorkflowProperties not implemented")
+}
+func (UnimplementedAdminServiceServer) ModifyActivityProperties(context.Context, *ModifyActivityPropertiesRequest) (*ModifyActivityPropertiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyActivityProperties not implemented")
+}
+func (UnimplementedAdminServiceServer) GetDynamicConfig(context.Context, *GetDynamicConfigRequest) (*GetDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDynamicConfig not implemented")
+}
+func (UnimplementedAdminServiceServer) SetDynamicConfig(context.Context, *SetDynamicConfigRequest) (*SetDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDynamicConfig not implemented")
+}
+func (UnimplementedAdminServiceServer) ListDynamicConfig(context.Context, *ListDynamicConfigRequest) (*ListDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDynamicConfig not implemented")
+}
+func (UnimplementedAdminServiceServer) ExplainDynamicConfig(context.Context, *ExplainDynamicConfigRequest) (*ExplainDynamicConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainDynamicConfig not implemented")
+}
+func (UnimplementedAdminServiceServer) ListDynamicConfigDeviations(context.Context, *ListDynamicConfigDeviationsRequest) (*ListDynamicConfigDeviationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDynamicConfigDeviations not implemented")
+}
+func (UnimplementedAdminServiceServer) CreateScheduleExclusionCalendar(context.Context, *CreateScheduleExclusionCalendarRequest) (*CreateScheduleExclusionCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateScheduleExclusionCalendar not implemented")
+}
+func (UnimplementedAdminServiceServer) UpdateScheduleExclusionCalendar(context.Context, *UpdateScheduleExclusionCalendarRequest) (*UpdateScheduleExclusionCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateScheduleExclusionCalendar not implemented")
+}
+func (UnimplementedAdminServiceServer) DescribeScheduleExclusionCalendar(context.Context, *DescribeScheduleExclusionCalendarRequest) (*DescribeScheduleExclusionCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeScheduleExclusionCalendar not implemented")
+}
+func (UnimplementedAdminServiceServer) DeleteScheduleExclusionCalendar(context.Context, *DeleteScheduleExclusionCalendarRequest) (*DeleteScheduleExclusionCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteScheduleExclusionCalendar not implemented")
+}
+func (UnimplementedAdminServiceServer) ListScheduleExclusionCalendars(context.Context, *ListScheduleExclusionCalendarsRequest) (*ListScheduleExclusionCalendarsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduleExclusionCalendars not implemented")
+}
+func (UnimplementedAdminServiceServer) ListBatchOperationResults(context.Context, *ListBatchOperationResultsRequest) (*ListBatchOperationResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListBatchOperationResults not implemented")
+}
 func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
 
 // UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1591,6 +1824,240 @@ func _AdminService_ForceUnloadTaskQueuePartition_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AdminService_ModifyWorkflowProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyWorkflowPropertiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ModifyWorkflowProperties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ModifyWorkflowProperties_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ModifyWorkflowProperties(ctx, req.(*ModifyWorkflowPropertiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ModifyActivityProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyActivityPropertiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ModifyActivityProperties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ModifyActivityProperties_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ModifyActivityProperties(ctx, req.(*ModifyActivityPropertiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_GetDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).GetDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_GetDynamicConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).GetDynamicConfig(ctx, req.(*GetDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_SetDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).SetDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_SetDynamicConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).SetDynamicConfig(ctx, req.(*SetDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListDynamicConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDynamicConfig(ctx, req.(*ListDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ExplainDynamicConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExplainDynamicConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ExplainDynamicConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ExplainDynamicConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ExplainDynamicConfig(ctx, req.(*ExplainDynamicConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListDynamicConfigDeviations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDynamicConfigDeviationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListDynamicConfigDeviations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListDynamicConfigDeviations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListDynamicConfigDeviations(ctx, req.(*ListDynamicConfigDeviationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_CreateScheduleExclusionCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScheduleExclusionCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).CreateScheduleExclusionCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_CreateScheduleExclusionCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).CreateScheduleExclusionCalendar(ctx, req.(*CreateScheduleExclusionCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_UpdateScheduleExclusionCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScheduleExclusionCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).UpdateScheduleExclusionCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_UpdateScheduleExclusionCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).UpdateScheduleExclusionCalendar(ctx, req.(*UpdateScheduleExclusionCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DescribeScheduleExclusionCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DescribeScheduleExclusionCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DescribeScheduleExclusionCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DescribeScheduleExclusionCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DescribeScheduleExclusionCalendar(ctx, req.(*DescribeScheduleExclusionCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_DeleteScheduleExclusionCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScheduleExclusionCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).DeleteScheduleExclusionCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_DeleteScheduleExclusionCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).DeleteScheduleExclusionCalendar(ctx, req.(*DeleteScheduleExclusionCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListScheduleExclusionCalendars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduleExclusionCalendarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListScheduleExclusionCalendars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListScheduleExclusionCalendars_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListScheduleExclusionCalendars(ctx, req.(*ListScheduleExclusionCalendarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_ListBatchOperationResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBatchOperationResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).ListBatchOperationResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AdminService_ListBatchOperationResults_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).ListBatchOperationResults(ctx, req.(*ListBatchOperationResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1766,6 +2233,58 @@ var AdminService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ForceUnloadTaskQueuePartition",
 			Handler:    _AdminService_ForceUnloadTaskQueuePartition_Handler,
 		},
+		{
+			MethodName: "ModifyWorkflowProperties",
+			Handler:    _AdminService_ModifyWorkflowProperties_Handler,
+		},
+		{
+			MethodName: "ModifyActivityProperties",
+			Handler:    _AdminService_ModifyActivityProperties_Handler,
+		},
+		{
+			MethodName: "GetDynamicConfig",
+			Handler:    _AdminService_GetDynamicConfig_Handler,
+		},
+		{
+			MethodName: "SetDynamicConfig",
+			Handler:    _AdminService_SetDynamicConfig_Handler,
+		},
+		{
+			MethodName: "ListDynamicConfig",
+			Handler:    _AdminService_ListDynamicConfig_Handler,
+		},
+		{
+			MethodName: "ExplainDynamicConfig",
+			Handler:    _AdminService_ExplainDynamicConfig_Handler,
+		},
+		{
+			MethodName: "ListDynamicConfigDeviations",
+			Handler:    _AdminService_ListDynamicConfigDeviations_Handler,
+		},
+		{
+			MethodName: "CreateScheduleExclusionCalendar",
+			Handler:    _AdminService_CreateScheduleExclusionCalendar_Handler,
+		},
+		{
+			MethodName: "UpdateScheduleExclusionCalendar",
+			Handler:    _AdminService_UpdateScheduleExclusionCalendar_Handler,
+		},
+		{
+			MethodName: "DescribeScheduleExclusionCalendar",
+			Handler:    _AdminService_DescribeScheduleExclusionCalendar_Handler,
+		},
+		{
+			MethodName: "DeleteScheduleExclusionCalendar",
+			Handler:    _AdminService_DeleteScheduleExclusionCalendar_Handler,
+		},
+		{
+			MethodName: "ListScheduleExclusionCalendars",
+			Handler:    _AdminService_ListScheduleExclusionCalendars_Handler,
+		},
+		{
+			MethodName: "ListBatchOperationResults",
+			Handler:    _AdminService_ListBatchOperationResults_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
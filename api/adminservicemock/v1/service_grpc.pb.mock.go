@@ -143,6 +143,26 @@ func (mr *MockAdminServiceClientMockRecorder) CloseShard(ctx, in any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceClient)(nil).CloseShard), varargs...)
 }
 
+// CreateScheduleExclusionCalendar mocks base method.
+func (m *MockAdminServiceClient) CreateScheduleExclusionCalendar(ctx context.Context, in *adminservice.CreateScheduleExclusionCalendarRequest, opts ...grpc.CallOption) (*adminservice.CreateScheduleExclusionCalendarResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CreateScheduleExclusionCalendar", varargs...)
+	ret0, _ := ret[0].(*adminservice.CreateScheduleExclusionCalendarResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScheduleExclusionCalendar indicates an expected call of CreateScheduleExclusionCalendar.
+func (mr *MockAdminServiceClientMockRecorder) CreateScheduleExclusionCalendar(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduleExclusionCalendar", reflect.TypeOf((*MockAdminServiceClient)(nil).CreateScheduleExclusionCalendar), varargs...)
+}
+
 // DeepHealthCheck mocks base method.
 func (m *MockAdminServiceClient) DeepHealthCheck(ctx context.Context, in *adminservice.DeepHealthCheckRequest, opts ...grpc.CallOption) (*adminservice.DeepHealthCheckResponse, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +183,26 @@ func (mr *MockAdminServiceClientMockRecorder) DeepHealthCheck(ctx, in any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeepHealthCheck", reflect.TypeOf((*MockAdminServiceClient)(nil).DeepHealthCheck), varargs...)
 }
 
+// DeleteScheduleExclusionCalendar mocks base method.
+func (m *MockAdminServiceClient) DeleteScheduleExclusionCalendar(ctx context.Context, in *adminservice.DeleteScheduleExclusionCalendarRequest, opts ...grpc.CallOption) (*adminservice.DeleteScheduleExclusionCalendarResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteScheduleExclusionCalendar", varargs...)
+	ret0, _ := ret[0].(*adminservice.DeleteScheduleExclusionCalendarResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteScheduleExclusionCalendar indicates an expected call of DeleteScheduleExclusionCalendar.
+func (mr *MockAdminServiceClientMockRecorder) DeleteScheduleExclusionCalendar(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScheduleExclusionCalendar", reflect.TypeOf((*MockAdminServiceClient)(nil).DeleteScheduleExclusionCalendar), varargs...)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceClient) DeleteWorkflowExecution(ctx context.Context, in *adminservice.DeleteWorkflowExecutionRequest, opts ...grpc.CallOption) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -263,6 +303,26 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeMutableState(ctx, in any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeMutableState), varargs...)
 }
 
+// DescribeScheduleExclusionCalendar mocks base method.
+func (m *MockAdminServiceClient) DescribeScheduleExclusionCalendar(ctx context.Context, in *adminservice.DescribeScheduleExclusionCalendarRequest, opts ...grpc.CallOption) (*adminservice.DescribeScheduleExclusionCalendarResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeScheduleExclusionCalendar", varargs...)
+	ret0, _ := ret[0].(*adminservice.DescribeScheduleExclusionCalendarResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeScheduleExclusionCalendar indicates an expected call of DescribeScheduleExclusionCalendar.
+func (mr *MockAdminServiceClientMockRecorder) DescribeScheduleExclusionCalendar(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeScheduleExclusionCalendar", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeScheduleExclusionCalendar), varargs...)
+}
+
 // DescribeTaskQueuePartition mocks base method.
 func (m *MockAdminServiceClient) DescribeTaskQueuePartition(ctx context.Context, in *adminservice.DescribeTaskQueuePartitionRequest, opts ...grpc.CallOption) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -283,6 +343,26 @@ func (mr *MockAdminServiceClientMockRecorder) DescribeTaskQueuePartition(ctx, in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartition", reflect.TypeOf((*MockAdminServiceClient)(nil).DescribeTaskQueuePartition), varargs...)
 }
 
+// ExplainDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) ExplainDynamicConfig(ctx context.Context, in *adminservice.ExplainDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.ExplainDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ExplainDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.ExplainDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainDynamicConfig indicates an expected call of ExplainDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) ExplainDynamicConfig(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).ExplainDynamicConfig), varargs...)
+}
+
 // ForceUnloadTaskQueuePartition mocks base method.
 func (m *MockAdminServiceClient) ForceUnloadTaskQueuePartition(ctx context.Context, in *adminservice.ForceUnloadTaskQueuePartitionRequest, opts ...grpc.CallOption) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -383,6 +463,26 @@ func (mr *MockAdminServiceClientMockRecorder) GetDLQTasks(ctx, in any, opts ...a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDLQTasks), varargs...)
 }
 
+// GetDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) GetDynamicConfig(ctx context.Context, in *adminservice.GetDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.GetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.GetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfig indicates an expected call of GetDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) GetDynamicConfig(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).GetDynamicConfig), varargs...)
+}
+
 // GetNamespace mocks base method.
 func (m *MockAdminServiceClient) GetNamespace(ctx context.Context, in *adminservice.GetNamespaceRequest, opts ...grpc.CallOption) (*adminservice.GetNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -563,6 +663,26 @@ func (mr *MockAdminServiceClientMockRecorder) ImportWorkflowExecution(ctx, in an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowExecution", reflect.TypeOf((*MockAdminServiceClient)(nil).ImportWorkflowExecution), varargs...)
 }
 
+// ListBatchOperationResults mocks base method.
+func (m *MockAdminServiceClient) ListBatchOperationResults(ctx context.Context, in *adminservice.ListBatchOperationResultsRequest, opts ...grpc.CallOption) (*adminservice.ListBatchOperationResultsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBatchOperationResults", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListBatchOperationResultsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBatchOperationResults indicates an expected call of ListBatchOperationResults.
+func (mr *MockAdminServiceClientMockRecorder) ListBatchOperationResults(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBatchOperationResults", reflect.TypeOf((*MockAdminServiceClient)(nil).ListBatchOperationResults), varargs...)
+}
+
 // ListClusterMembers mocks base method.
 func (m *MockAdminServiceClient) ListClusterMembers(ctx context.Context, in *adminservice.ListClusterMembersRequest, opts ...grpc.CallOption) (*adminservice.ListClusterMembersResponse, error) {
 	m.ctrl.T.Helper()
@@ -603,6 +723,46 @@ func (mr *MockAdminServiceClientMockRecorder) ListClusters(ctx, in any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockAdminServiceClient)(nil).ListClusters), varargs...)
 }
 
+// ListDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) ListDynamicConfig(ctx context.Context, in *adminservice.ListDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.ListDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfig indicates an expected call of ListDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) ListDynamicConfig(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).ListDynamicConfig), varargs...)
+}
+
+// ListDynamicConfigDeviations mocks base method.
+func (m *MockAdminServiceClient) ListDynamicConfigDeviations(ctx context.Context, in *adminservice.ListDynamicConfigDeviationsRequest, opts ...grpc.CallOption) (*adminservice.ListDynamicConfigDeviationsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDynamicConfigDeviations", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigDeviationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfigDeviations indicates an expected call of ListDynamicConfigDeviations.
+func (mr *MockAdminServiceClientMockRecorder) ListDynamicConfigDeviations(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfigDeviations", reflect.TypeOf((*MockAdminServiceClient)(nil).ListDynamicConfigDeviations), varargs...)
+}
+
 // ListHistoryTasks mocks base method.
 func (m *MockAdminServiceClient) ListHistoryTasks(ctx context.Context, in *adminservice.ListHistoryTasksRequest, opts ...grpc.CallOption) (*adminservice.ListHistoryTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -643,6 +803,26 @@ func (mr *MockAdminServiceClientMockRecorder) ListQueues(ctx, in any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockAdminServiceClient)(nil).ListQueues), varargs...)
 }
 
+// ListScheduleExclusionCalendars mocks base method.
+func (m *MockAdminServiceClient) ListScheduleExclusionCalendars(ctx context.Context, in *adminservice.ListScheduleExclusionCalendarsRequest, opts ...grpc.CallOption) (*adminservice.ListScheduleExclusionCalendarsResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListScheduleExclusionCalendars", varargs...)
+	ret0, _ := ret[0].(*adminservice.ListScheduleExclusionCalendarsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduleExclusionCalendars indicates an expected call of ListScheduleExclusionCalendars.
+func (mr *MockAdminServiceClientMockRecorder) ListScheduleExclusionCalendars(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduleExclusionCalendars", reflect.TypeOf((*MockAdminServiceClient)(nil).ListScheduleExclusionCalendars), varargs...)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) MergeDLQMessages(ctx context.Context, in *adminservice.MergeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -683,6 +863,46 @@ func (mr *MockAdminServiceClientMockRecorder) MergeDLQTasks(ctx, in any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).MergeDLQTasks), varargs...)
 }
 
+// ModifyActivityProperties mocks base method.
+func (m *MockAdminServiceClient) ModifyActivityProperties(ctx context.Context, in *adminservice.ModifyActivityPropertiesRequest, opts ...grpc.CallOption) (*adminservice.ModifyActivityPropertiesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ModifyActivityProperties", varargs...)
+	ret0, _ := ret[0].(*adminservice.ModifyActivityPropertiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyActivityProperties indicates an expected call of ModifyActivityProperties.
+func (mr *MockAdminServiceClientMockRecorder) ModifyActivityProperties(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyActivityProperties", reflect.TypeOf((*MockAdminServiceClient)(nil).ModifyActivityProperties), varargs...)
+}
+
+// ModifyWorkflowProperties mocks base method.
+func (m *MockAdminServiceClient) ModifyWorkflowProperties(ctx context.Context, in *adminservice.ModifyWorkflowPropertiesRequest, opts ...grpc.CallOption) (*adminservice.ModifyWorkflowPropertiesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ModifyWorkflowProperties", varargs...)
+	ret0, _ := ret[0].(*adminservice.ModifyWorkflowPropertiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyWorkflowProperties indicates an expected call of ModifyWorkflowProperties.
+func (mr *MockAdminServiceClientMockRecorder) ModifyWorkflowProperties(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyWorkflowProperties", reflect.TypeOf((*MockAdminServiceClient)(nil).ModifyWorkflowProperties), varargs...)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceClient) PurgeDLQMessages(ctx context.Context, in *adminservice.PurgeDLQMessagesRequest, opts ...grpc.CallOption) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -863,6 +1083,26 @@ func (mr *MockAdminServiceClientMockRecorder) ResendReplicationTasks(ctx, in any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceClient)(nil).ResendReplicationTasks), varargs...)
 }
 
+// SetDynamicConfig mocks base method.
+func (m *MockAdminServiceClient) SetDynamicConfig(ctx context.Context, in *adminservice.SetDynamicConfigRequest, opts ...grpc.CallOption) (*adminservice.SetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetDynamicConfig", varargs...)
+	ret0, _ := ret[0].(*adminservice.SetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDynamicConfig indicates an expected call of SetDynamicConfig.
+func (mr *MockAdminServiceClientMockRecorder) SetDynamicConfig(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDynamicConfig", reflect.TypeOf((*MockAdminServiceClient)(nil).SetDynamicConfig), varargs...)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceClient) StreamWorkflowReplicationMessages(ctx context.Context, opts ...grpc.CallOption) (adminservice.AdminService_StreamWorkflowReplicationMessagesClient, error) {
 	m.ctrl.T.Helper()
@@ -903,6 +1143,26 @@ func (mr *MockAdminServiceClientMockRecorder) SyncWorkflowState(ctx, in any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceClient)(nil).SyncWorkflowState), varargs...)
 }
 
+// UpdateScheduleExclusionCalendar mocks base method.
+func (m *MockAdminServiceClient) UpdateScheduleExclusionCalendar(ctx context.Context, in *adminservice.UpdateScheduleExclusionCalendarRequest, opts ...grpc.CallOption) (*adminservice.UpdateScheduleExclusionCalendarResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "UpdateScheduleExclusionCalendar", varargs...)
+	ret0, _ := ret[0].(*adminservice.UpdateScheduleExclusionCalendarResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateScheduleExclusionCalendar indicates an expected call of UpdateScheduleExclusionCalendar.
+func (mr *MockAdminServiceClientMockRecorder) UpdateScheduleExclusionCalendar(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScheduleExclusionCalendar", reflect.TypeOf((*MockAdminServiceClient)(nil).UpdateScheduleExclusionCalendar), varargs...)
+}
+
 // MockAdminService_StreamWorkflowReplicationMessagesClient is a mock of AdminService_StreamWorkflowReplicationMessagesClient interface.
 type MockAdminService_StreamWorkflowReplicationMessagesClient struct {
 	ctrl     *gomock.Controller
@@ -1140,6 +1400,21 @@ func (mr *MockAdminServiceServerMockRecorder) CloseShard(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseShard", reflect.TypeOf((*MockAdminServiceServer)(nil).CloseShard), arg0, arg1)
 }
 
+// CreateScheduleExclusionCalendar mocks base method.
+func (m *MockAdminServiceServer) CreateScheduleExclusionCalendar(arg0 context.Context, arg1 *adminservice.CreateScheduleExclusionCalendarRequest) (*adminservice.CreateScheduleExclusionCalendarResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScheduleExclusionCalendar", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.CreateScheduleExclusionCalendarResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScheduleExclusionCalendar indicates an expected call of CreateScheduleExclusionCalendar.
+func (mr *MockAdminServiceServerMockRecorder) CreateScheduleExclusionCalendar(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduleExclusionCalendar", reflect.TypeOf((*MockAdminServiceServer)(nil).CreateScheduleExclusionCalendar), arg0, arg1)
+}
+
 // DeepHealthCheck mocks base method.
 func (m *MockAdminServiceServer) DeepHealthCheck(arg0 context.Context, arg1 *adminservice.DeepHealthCheckRequest) (*adminservice.DeepHealthCheckResponse, error) {
 	m.ctrl.T.Helper()
@@ -1155,6 +1430,21 @@ func (mr *MockAdminServiceServerMockRecorder) DeepHealthCheck(arg0, arg1 any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeepHealthCheck", reflect.TypeOf((*MockAdminServiceServer)(nil).DeepHealthCheck), arg0, arg1)
 }
 
+// DeleteScheduleExclusionCalendar mocks base method.
+func (m *MockAdminServiceServer) DeleteScheduleExclusionCalendar(arg0 context.Context, arg1 *adminservice.DeleteScheduleExclusionCalendarRequest) (*adminservice.DeleteScheduleExclusionCalendarResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteScheduleExclusionCalendar", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DeleteScheduleExclusionCalendarResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteScheduleExclusionCalendar indicates an expected call of DeleteScheduleExclusionCalendar.
+func (mr *MockAdminServiceServerMockRecorder) DeleteScheduleExclusionCalendar(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteScheduleExclusionCalendar", reflect.TypeOf((*MockAdminServiceServer)(nil).DeleteScheduleExclusionCalendar), arg0, arg1)
+}
+
 // DeleteWorkflowExecution mocks base method.
 func (m *MockAdminServiceServer) DeleteWorkflowExecution(arg0 context.Context, arg1 *adminservice.DeleteWorkflowExecutionRequest) (*adminservice.DeleteWorkflowExecutionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1230,6 +1520,21 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeMutableState(arg0, arg1 an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeMutableState", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeMutableState), arg0, arg1)
 }
 
+// DescribeScheduleExclusionCalendar mocks base method.
+func (m *MockAdminServiceServer) DescribeScheduleExclusionCalendar(arg0 context.Context, arg1 *adminservice.DescribeScheduleExclusionCalendarRequest) (*adminservice.DescribeScheduleExclusionCalendarResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeScheduleExclusionCalendar", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.DescribeScheduleExclusionCalendarResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeScheduleExclusionCalendar indicates an expected call of DescribeScheduleExclusionCalendar.
+func (mr *MockAdminServiceServerMockRecorder) DescribeScheduleExclusionCalendar(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeScheduleExclusionCalendar", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeScheduleExclusionCalendar), arg0, arg1)
+}
+
 // DescribeTaskQueuePartition mocks base method.
 func (m *MockAdminServiceServer) DescribeTaskQueuePartition(arg0 context.Context, arg1 *adminservice.DescribeTaskQueuePartitionRequest) (*adminservice.DescribeTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1245,6 +1550,21 @@ func (mr *MockAdminServiceServerMockRecorder) DescribeTaskQueuePartition(arg0, a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeTaskQueuePartition", reflect.TypeOf((*MockAdminServiceServer)(nil).DescribeTaskQueuePartition), arg0, arg1)
 }
 
+// ExplainDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) ExplainDynamicConfig(arg0 context.Context, arg1 *adminservice.ExplainDynamicConfigRequest) (*adminservice.ExplainDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExplainDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ExplainDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExplainDynamicConfig indicates an expected call of ExplainDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) ExplainDynamicConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExplainDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).ExplainDynamicConfig), arg0, arg1)
+}
+
 // ForceUnloadTaskQueuePartition mocks base method.
 func (m *MockAdminServiceServer) ForceUnloadTaskQueuePartition(arg0 context.Context, arg1 *adminservice.ForceUnloadTaskQueuePartitionRequest) (*adminservice.ForceUnloadTaskQueuePartitionResponse, error) {
 	m.ctrl.T.Helper()
@@ -1320,6 +1640,21 @@ func (mr *MockAdminServiceServerMockRecorder) GetDLQTasks(arg0, arg1 any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDLQTasks), arg0, arg1)
 }
 
+// GetDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) GetDynamicConfig(arg0 context.Context, arg1 *adminservice.GetDynamicConfigRequest) (*adminservice.GetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.GetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDynamicConfig indicates an expected call of GetDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) GetDynamicConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).GetDynamicConfig), arg0, arg1)
+}
+
 // GetNamespace mocks base method.
 func (m *MockAdminServiceServer) GetNamespace(arg0 context.Context, arg1 *adminservice.GetNamespaceRequest) (*adminservice.GetNamespaceResponse, error) {
 	m.ctrl.T.Helper()
@@ -1455,6 +1790,21 @@ func (mr *MockAdminServiceServerMockRecorder) ImportWorkflowExecution(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportWorkflowExecution", reflect.TypeOf((*MockAdminServiceServer)(nil).ImportWorkflowExecution), arg0, arg1)
 }
 
+// ListBatchOperationResults mocks base method.
+func (m *MockAdminServiceServer) ListBatchOperationResults(arg0 context.Context, arg1 *adminservice.ListBatchOperationResultsRequest) (*adminservice.ListBatchOperationResultsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBatchOperationResults", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListBatchOperationResultsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBatchOperationResults indicates an expected call of ListBatchOperationResults.
+func (mr *MockAdminServiceServerMockRecorder) ListBatchOperationResults(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBatchOperationResults", reflect.TypeOf((*MockAdminServiceServer)(nil).ListBatchOperationResults), arg0, arg1)
+}
+
 // ListClusterMembers mocks base method.
 func (m *MockAdminServiceServer) ListClusterMembers(arg0 context.Context, arg1 *adminservice.ListClusterMembersRequest) (*adminservice.ListClusterMembersResponse, error) {
 	m.ctrl.T.Helper()
@@ -1485,6 +1835,36 @@ func (mr *MockAdminServiceServerMockRecorder) ListClusters(arg0, arg1 any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockAdminServiceServer)(nil).ListClusters), arg0, arg1)
 }
 
+// ListDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) ListDynamicConfig(arg0 context.Context, arg1 *adminservice.ListDynamicConfigRequest) (*adminservice.ListDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfig indicates an expected call of ListDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) ListDynamicConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).ListDynamicConfig), arg0, arg1)
+}
+
+// ListDynamicConfigDeviations mocks base method.
+func (m *MockAdminServiceServer) ListDynamicConfigDeviations(arg0 context.Context, arg1 *adminservice.ListDynamicConfigDeviationsRequest) (*adminservice.ListDynamicConfigDeviationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDynamicConfigDeviations", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListDynamicConfigDeviationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDynamicConfigDeviations indicates an expected call of ListDynamicConfigDeviations.
+func (mr *MockAdminServiceServerMockRecorder) ListDynamicConfigDeviations(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDynamicConfigDeviations", reflect.TypeOf((*MockAdminServiceServer)(nil).ListDynamicConfigDeviations), arg0, arg1)
+}
+
 // ListHistoryTasks mocks base method.
 func (m *MockAdminServiceServer) ListHistoryTasks(arg0 context.Context, arg1 *adminservice.ListHistoryTasksRequest) (*adminservice.ListHistoryTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -1515,6 +1895,21 @@ func (mr *MockAdminServiceServerMockRecorder) ListQueues(arg0, arg1 any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQueues", reflect.TypeOf((*MockAdminServiceServer)(nil).ListQueues), arg0, arg1)
 }
 
+// ListScheduleExclusionCalendars mocks base method.
+func (m *MockAdminServiceServer) ListScheduleExclusionCalendars(arg0 context.Context, arg1 *adminservice.ListScheduleExclusionCalendarsRequest) (*adminservice.ListScheduleExclusionCalendarsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListScheduleExclusionCalendars", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ListScheduleExclusionCalendarsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListScheduleExclusionCalendars indicates an expected call of ListScheduleExclusionCalendars.
+func (mr *MockAdminServiceServerMockRecorder) ListScheduleExclusionCalendars(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListScheduleExclusionCalendars", reflect.TypeOf((*MockAdminServiceServer)(nil).ListScheduleExclusionCalendars), arg0, arg1)
+}
+
 // MergeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) MergeDLQMessages(arg0 context.Context, arg1 *adminservice.MergeDLQMessagesRequest) (*adminservice.MergeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1545,6 +1940,36 @@ func (mr *MockAdminServiceServerMockRecorder) MergeDLQTasks(arg0, arg1 any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).MergeDLQTasks), arg0, arg1)
 }
 
+// ModifyActivityProperties mocks base method.
+func (m *MockAdminServiceServer) ModifyActivityProperties(arg0 context.Context, arg1 *adminservice.ModifyActivityPropertiesRequest) (*adminservice.ModifyActivityPropertiesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyActivityProperties", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ModifyActivityPropertiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyActivityProperties indicates an expected call of ModifyActivityProperties.
+func (mr *MockAdminServiceServerMockRecorder) ModifyActivityProperties(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyActivityProperties", reflect.TypeOf((*MockAdminServiceServer)(nil).ModifyActivityProperties), arg0, arg1)
+}
+
+// ModifyWorkflowProperties mocks base method.
+func (m *MockAdminServiceServer) ModifyWorkflowProperties(arg0 context.Context, arg1 *adminservice.ModifyWorkflowPropertiesRequest) (*adminservice.ModifyWorkflowPropertiesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyWorkflowProperties", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.ModifyWorkflowPropertiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyWorkflowProperties indicates an expected call of ModifyWorkflowProperties.
+func (mr *MockAdminServiceServerMockRecorder) ModifyWorkflowProperties(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyWorkflowProperties", reflect.TypeOf((*MockAdminServiceServer)(nil).ModifyWorkflowProperties), arg0, arg1)
+}
+
 // PurgeDLQMessages mocks base method.
 func (m *MockAdminServiceServer) PurgeDLQMessages(arg0 context.Context, arg1 *adminservice.PurgeDLQMessagesRequest) (*adminservice.PurgeDLQMessagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1680,6 +2105,21 @@ func (mr *MockAdminServiceServerMockRecorder) ResendReplicationTasks(arg0, arg1
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResendReplicationTasks", reflect.TypeOf((*MockAdminServiceServer)(nil).ResendReplicationTasks), arg0, arg1)
 }
 
+// SetDynamicConfig mocks base method.
+func (m *MockAdminServiceServer) SetDynamicConfig(arg0 context.Context, arg1 *adminservice.SetDynamicConfigRequest) (*adminservice.SetDynamicConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetDynamicConfig", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.SetDynamicConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetDynamicConfig indicates an expected call of SetDynamicConfig.
+func (mr *MockAdminServiceServerMockRecorder) SetDynamicConfig(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDynamicConfig", reflect.TypeOf((*MockAdminServiceServer)(nil).SetDynamicConfig), arg0, arg1)
+}
+
 // StreamWorkflowReplicationMessages mocks base method.
 func (m *MockAdminServiceServer) StreamWorkflowReplicationMessages(arg0 adminservice.AdminService_StreamWorkflowReplicationMessagesServer) error {
 	m.ctrl.T.Helper()
@@ -1709,6 +2149,21 @@ func (mr *MockAdminServiceServerMockRecorder) SyncWorkflowState(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncWorkflowState", reflect.TypeOf((*MockAdminServiceServer)(nil).SyncWorkflowState), arg0, arg1)
 }
 
+// UpdateScheduleExclusionCalendar mocks base method.
+func (m *MockAdminServiceServer) UpdateScheduleExclusionCalendar(arg0 context.Context, arg1 *adminservice.UpdateScheduleExclusionCalendarRequest) (*adminservice.UpdateScheduleExclusionCalendarResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScheduleExclusionCalendar", arg0, arg1)
+	ret0, _ := ret[0].(*adminservice.UpdateScheduleExclusionCalendarResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateScheduleExclusionCalendar indicates an expected call of UpdateScheduleExclusionCalendar.
+func (mr *MockAdminServiceServerMockRecorder) UpdateScheduleExclusionCalendar(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScheduleExclusionCalendar", reflect.TypeOf((*MockAdminServiceServer)(nil).UpdateScheduleExclusionCalendar), arg0, arg1)
+}
+
 // mustEmbedUnimplementedAdminServiceServer mocks base method.
 func (m *MockAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {
 	m.ctrl.T.Helper()
@@ -0,0 +1,463 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// plugins:
+// 	protoc-gen-go
+// 	protoc
+// source: temporal/server/api/batch/v1/message.proto
+
+package batch
+
+import (
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+
+	v12 "go.temporal.io/api/common/v1"
+	v11 "go.temporal.io/api/enums/v1"
+	v13 "go.temporal.io/api/taskqueue/v1"
+	v1 "go.temporal.io/api/update/v1"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// An operation of a batch that isn't one of the public batch operation types. It's carried
+// in the header of a signal batch operation.
+type BatchOperationExtension struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Operation:
+	//
+	//	*BatchOperationExtension_UpdateWorkflow
+	//	*BatchOperationExtension_SignalWithStart
+	Operation     isBatchOperationExtension_Operation `protobuf_oneof:"operation"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchOperationExtension) Reset() {
+	*x = BatchOperationExtension{}
+	mi := &file_temporal_server_api_batch_v1_message_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchOperationExtension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchOperationExtension) ProtoMessage() {}
+
+func (x *BatchOperationExtension) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_batch_v1_message_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchOperationExtension.ProtoReflect.Descriptor instead.
+func (*BatchOperationExtension) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_batch_v1_message_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *BatchOperationExtension) GetOperation() isBatchOperationExtension_Operation {
+	if x != nil {
+		return x.Operation
+	}
+	return nil
+}
+
+func (x *BatchOperationExtension) GetUpdateWorkflow() *BatchOperationUpdateWorkflow {
+	if x != nil {
+		if x, ok := x.Operation.(*BatchOperationExtension_UpdateWorkflow); ok {
+			return x.UpdateWorkflow
+		}
+	}
+	return nil
+}
+
+func (x *BatchOperationExtension) GetSignalWithStart() *BatchOperationSignalWithStart {
+	if x != nil {
+		if x, ok := x.Operation.(*BatchOperationExtension_SignalWithStart); ok {
+			return x.SignalWithStart
+		}
+	}
+	return nil
+}
+
+type isBatchOperationExtension_Operation interface {
+	isBatchOperationExtension_Operation()
+}
+
+type BatchOperationExtension_UpdateWorkflow struct {
+	UpdateWorkflow *BatchOperationUpdateWorkflow `protobuf:"bytes,1,opt,name=update_workflow,json=updateWorkflow,proto3,oneof"`
+}
+
+type BatchOperationExtension_SignalWithStart struct {
+	SignalWithStart *BatchOperationSignalWithStart `protobuf:"bytes,2,opt,name=signal_with_start,json=signalWithStart,proto3,oneof"`
+}
+
+func (*BatchOperationExtension_UpdateWorkflow) isBatchOperationExtension_Operation() {}
+
+func (*BatchOperationExtension_SignalWithStart) isBatchOperationExtension_Operation() {}
+
+// Sends an update to each workflow and records the stage it reached and its failure.
+type BatchOperationUpdateWorkflow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name, arguments and header of the update. The id of the update is the job id of the
+	// batch, so an update isn't applied twice to a workflow if the batch retries it.
+	Input *v1.Input `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	// Stage to wait for before moving on to the next workflow. Waiting for
+	// UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_ACCEPTED only records rejections by update
+	// validators. Defaults to UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED.
+	WaitStage     v11.UpdateWorkflowExecutionLifecycleStage `protobuf:"varint,2,opt,name=wait_stage,json=waitStage,proto3,enum=temporal.api.enums.v1.UpdateWorkflowExecutionLifecycleStage" json:"wait_stage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchOperationUpdateWorkflow) Reset() {
+	*x = BatchOperationUpdateWorkflow{}
+	mi := &file_temporal_server_api_batch_v1_message_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchOperationUpdateWorkflow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchOperationUpdateWorkflow) ProtoMessage() {}
+
+func (x *BatchOperationUpdateWorkflow) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_batch_v1_message_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchOperationUpdateWorkflow.ProtoReflect.Descriptor instead.
+func (*BatchOperationUpdateWorkflow) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_batch_v1_message_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BatchOperationUpdateWorkflow) GetInput() *v1.Input {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *BatchOperationUpdateWorkflow) GetWaitStage() v11.UpdateWorkflowExecutionLifecycleStage {
+	if x != nil {
+		return x.WaitStage
+	}
+	return v11.UpdateWorkflowExecutionLifecycleStage(0)
+}
+
+// Signals each workflow, starting a new run if it isn't running. The signal name and input
+// are those of the signal batch operation. Closed workflows match the query of the batch too,
+// so a workflow id with several matching runs is signaled once per run.
+type BatchOperationSignalWithStart struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Type and task queue of started workflows. They default to those of the matched
+	// workflow, and are required if the batch is given a list of executions.
+	WorkflowType *v12.WorkflowType `protobuf:"bytes,1,opt,name=workflow_type,json=workflowType,proto3" json:"workflow_type,omitempty"`
+	TaskQueue    *v13.TaskQueue    `protobuf:"bytes,2,opt,name=task_queue,json=taskQueue,proto3" json:"task_queue,omitempty"`
+	// Input of started workflows.
+	Input         *v12.Payloads `protobuf:"bytes,3,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchOperationSignalWithStart) Reset() {
+	*x = BatchOperationSignalWithStart{}
+	mi := &file_temporal_server_api_batch_v1_message_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchOperationSignalWithStart) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchOperationSignalWithStart) ProtoMessage() {}
+
+func (x *BatchOperationSignalWithStart) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_batch_v1_message_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchOperationSignalWithStart.ProtoReflect.Descriptor instead.
+func (*BatchOperationSignalWithStart) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_batch_v1_message_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *BatchOperationSignalWithStart) GetWorkflowType() *v12.WorkflowType {
+	if x != nil {
+		return x.WorkflowType
+	}
+	return nil
+}
+
+func (x *BatchOperationSignalWithStart) GetTaskQueue() *v13.TaskQueue {
+	if x != nil {
+		return x.TaskQueue
+	}
+	return nil
+}
+
+func (x *BatchOperationSignalWithStart) GetInput() *v12.Payloads {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+// Result of a batch operation for a single workflow.
+type BatchOperationItemResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The run id is the one of the started run for signal-with-start.
+	Execution *v12.WorkflowExecution `protobuf:"bytes,1,opt,name=execution,proto3" json:"execution,omitempty"`
+	// Set if the operation failed for the workflow. An update that's rejected or fails is a
+	// failure too, with the message of its failure.
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
+	// Stage an update reached. Only its status is kept: results are kept for many workflows,
+	// and the payloads of update outcomes are unbounded.
+	UpdateStage   v11.UpdateWorkflowExecutionLifecycleStage `protobuf:"varint,3,opt,name=update_stage,json=updateStage,proto3,enum=temporal.api.enums.v1.UpdateWorkflowExecutionLifecycleStage" json:"update_stage,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchOperationItemResult) Reset() {
+	*x = BatchOperationItemResult{}
+	mi := &file_temporal_server_api_batch_v1_message_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchOperationItemResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchOperationItemResult) ProtoMessage() {}
+
+func (x *BatchOperationItemResult) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_batch_v1_message_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchOperationItemResult.ProtoReflect.Descriptor instead.
+func (*BatchOperationItemResult) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_batch_v1_message_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *BatchOperationItemResult) GetExecution() *v12.WorkflowExecution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *BatchOperationItemResult) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BatchOperationItemResult) GetUpdateStage() v11.UpdateWorkflowExecutionLifecycleStage {
+	if x != nil {
+		return x.UpdateStage
+	}
+	return v11.UpdateWorkflowExecutionLifecycleStage(0)
+}
+
+// Per-workflow results of a batch operation, in the order they were processed.
+type BatchOperationResults struct {
+	state protoimpl.MessageState      `protogen:"open.v1"`
+	Items []*BatchOperationItemResult `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// Number of successful results that were dropped to stay within the result limit of the
+	// batch. Failures are dropped only if there are more failures than the limit.
+	DroppedSuccesses int64 `protobuf:"varint,2,opt,name=dropped_successes,json=droppedSuccesses,proto3" json:"dropped_successes,omitempty"`
+	DroppedFailures  int64 `protobuf:"varint,3,opt,name=dropped_failures,json=droppedFailures,proto3" json:"dropped_failures,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *BatchOperationResults) Reset() {
+	*x = BatchOperationResults{}
+	mi := &file_temporal_server_api_batch_v1_message_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchOperationResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchOperationResults) ProtoMessage() {}
+
+func (x *BatchOperationResults) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_batch_v1_message_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchOperationResults.ProtoReflect.Descriptor instead.
+func (*BatchOperationResults) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_batch_v1_message_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *BatchOperationResults) GetItems() []*BatchOperationItemResult {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *BatchOperationResults) GetDroppedSuccesses() int64 {
+	if x != nil {
+		return x.DroppedSuccesses
+	}
+	return 0
+}
+
+func (x *BatchOperationResults) GetDroppedFailures() int64 {
+	if x != nil {
+		return x.DroppedFailures
+	}
+	return 0
+}
+
+var File_temporal_server_api_batch_v1_message_proto protoreflect.FileDescriptor
+
+const file_temporal_server_api_batch_v1_message_proto_rawDesc = "" +
+	"\n" +
+	"*temporal/server/api/batch/v1/message.proto\x12\x1ctemporal.server.api.batch.v1\x1a$temporal/api/common/v1/message.proto\x1a\"temporal/api/enums/v1/update.proto\x1a'temporal/api/taskqueue/v1/message.proto\x1a$temporal/api/update/v1/message.proto\"\xf8\x01\n" +
+	"\x17BatchOperationExtension\x12e\n" +
+	"\x0fupdate_workflow\x18\x01 \x01(\v2:.temporal.server.api.batch.v1.BatchOperationUpdateWorkflowH\x00R\x0eupdateWorkflow\x12i\n" +
+	"\x11signal_with_start\x18\x02 \x01(\v2;.temporal.server.api.batch.v1.BatchOperationSignalWithStartH\x00R\x0fsignalWithStartB\v\n" +
+	"\toperation\"\xb0\x01\n" +
+	"\x1cBatchOperationUpdateWorkflow\x123\n" +
+	"\x05input\x18\x01 \x01(\v2\x1d.temporal.api.update.v1.InputR\x05input\x12[\n" +
+	"\n" +
+	"wait_stage\x18\x02 \x01(\x0e2<.temporal.api.enums.v1.UpdateWorkflowExecutionLifecycleStageR\twaitStage\"\xe7\x01\n" +
+	"\x1dBatchOperationSignalWithStart\x12I\n" +
+	"\rworkflow_type\x18\x01 \x01(\v2$.temporal.api.common.v1.WorkflowTypeR\fworkflowType\x12C\n" +
+	"\n" +
+	"task_queue\x18\x02 \x01(\v2$.temporal.api.taskqueue.v1.TaskQueueR\ttaskQueue\x126\n" +
+	"\x05input\x18\x03 \x01(\v2 .temporal.api.common.v1.PayloadsR\x05input\"\xda\x01\n" +
+	"\x18BatchOperationItemResult\x12G\n" +
+	"\texecution\x18\x01 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x12\x14\n" +
+	"\x05error\x18\x02 \x01(\tR\x05error\x12_\n" +
+	"\fupdate_stage\x18\x03 \x01(\x0e2<.temporal.api.enums.v1.UpdateWorkflowExecutionLifecycleStageR\vupdateStage\"\xbd\x01\n" +
+	"\x15BatchOperationResults\x12L\n" +
+	"\x05items\x18\x01 \x03(\v26.temporal.server.api.batch.v1.BatchOperationItemResultR\x05items\x12+\n" +
+	"\x11dropped_successes\x18\x02 \x01(\x03R\x10droppedSuccesses\x12)\n" +
+	"\x10dropped_failures\x18\x03 \x01(\x03R\x0fdroppedFailuresB*Z(go.temporal.io/server/api/batch/v1;batchb\x06proto3"
+
+var (
+	file_temporal_server_api_batch_v1_message_proto_rawDescOnce sync.Once
+	file_temporal_server_api_batch_v1_message_proto_rawDescData []byte
+)
+
+func file_temporal_server_api_batch_v1_message_proto_rawDescGZIP() []byte {
+	file_temporal_server_api_batch_v1_message_proto_rawDescOnce.Do(func() {
+		file_temporal_server_api_batch_v1_message_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_temporal_server_api_batch_v1_message_proto_rawDesc), len(file_temporal_server_api_batch_v1_message_proto_rawDesc)))
+	})
+	return file_temporal_server_api_batch_v1_message_proto_rawDescData
+}
+
+var file_temporal_server_api_batch_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_temporal_server_api_batch_v1_message_proto_goTypes = []any{
+	(*BatchOperationExtension)(nil),                // 0: temporal.server.api.batch.v1.BatchOperationExtension
+	(*BatchOperationUpdateWorkflow)(nil),           // 1: temporal.server.api.batch.v1.BatchOperationUpdateWorkflow
+	(*BatchOperationSignalWithStart)(nil),          // 2: temporal.server.api.batch.v1.BatchOperationSignalWithStart
+	(*BatchOperationItemResult)(nil),               // 3: temporal.server.api.batch.v1.BatchOperationItemResult
+	(*BatchOperationResults)(nil),                  // 4: temporal.server.api.batch.v1.BatchOperationResults
+	(*v1.Input)(nil),                               // 5: temporal.api.update.v1.Input
+	(v11.UpdateWorkflowExecutionLifecycleStage)(0), // 6: temporal.api.enums.v1.UpdateWorkflowExecutionLifecycleStage
+	(*v12.WorkflowType)(nil),                       // 7: temporal.api.common.v1.WorkflowType
+	(*v13.TaskQueue)(nil),                          // 8: temporal.api.taskqueue.v1.TaskQueue
+	(*v12.Payloads)(nil),                           // 9: temporal.api.common.v1.Payloads
+	(*v12.WorkflowExecution)(nil),                  // 10: temporal.api.common.v1.WorkflowExecution
+}
+var file_temporal_server_api_batch_v1_message_proto_depIdxs = []int32{
+	1,  // 0: temporal.server.api.batch.v1.BatchOperationExtension.update_workflow:type_name -> temporal.server.api.batch.v1.BatchOperationUpdateWorkflow
+	2,  // 1: temporal.server.api.batch.v1.BatchOperationExtension.signal_with_start:type_name -> temporal.server.api.batch.v1.BatchOperationSignalWithStart
+	5,  // 2: temporal.server.api.batch.v1.BatchOperationUpdateWorkflow.input:type_name -> temporal.api.update.v1.Input
+	6,  // 3: temporal.server.api.batch.v1.BatchOperationUpdateWorkflow.wait_stage:type_name -> temporal.api.enums.v1.UpdateWorkflowExecutionLifecycleStage
+	7,  // 4: temporal.server.api.batch.v1.BatchOperationSignalWithStart.workflow_type:type_name -> temporal.api.common.v1.WorkflowType
+	8,  // 5: temporal.server.api.batch.v1.BatchOperationSignalWithStart.task_queue:type_name -> temporal.api.taskqueue.v1.TaskQueue
+	9,  // 6: temporal.server.api.batch.v1.BatchOperationSignalWithStart.input:type_name -> temporal.api.common.v1.Payloads
+	10, // 7: temporal.server.api.batch.v1.BatchOperationItemResult.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	6,  // 8: temporal.server.api.batch.v1.BatchOperationItemResult.update_stage:type_name -> temporal.api.enums.v1.UpdateWorkflowExecutionLifecycleStage
+	3,  // 9: temporal.server.api.batch.v1.BatchOperationResults.items:type_name -> temporal.server.api.batch.v1.BatchOperationItemResult
+	10, // [10:10] is the sub-list for method output_type
+	10, // [10:10] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
+}
+
+func init() { file_temporal_server_api_batch_v1_message_proto_init() }
+func file_temporal_server_api_batch_v1_message_proto_init() {
+	if File_temporal_server_api_batch_v1_message_proto != nil {
+		return
+	}
+	file_temporal_server_api_batch_v1_message_proto_msgTypes[0].OneofWrappers = []any{
+		(*BatchOperationExtension_UpdateWorkflow)(nil),
+		(*BatchOperationExtension_SignalWithStart)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_batch_v1_message_proto_rawDesc), len(file_temporal_server_api_batch_v1_message_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_temporal_server_api_batch_v1_message_proto_goTypes,
+		DependencyIndexes: file_temporal_server_api_batch_v1_message_proto_depIdxs,
+		MessageInfos:      file_temporal_server_api_batch_v1_message_proto_msgTypes,
+	}.Build()
+	File_temporal_server_api_batch_v1_message_proto = out.File
+	file_temporal_server_api_batch_v1_message_proto_goTypes = nil
+	file_temporal_server_api_batch_v1_message_proto_depIdxs = nil
+}
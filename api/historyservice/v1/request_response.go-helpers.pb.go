@@ -5554,3 +5554,225 @@ func (this *UpdateWorkflowExecutionOptionsResponse) Equal(that interface{}) bool
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type ModifyWorkflowPropertiesRequest to the protobuf v3 wire format
+func (val *ModifyWorkflowPropertiesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ModifyWorkflowPropertiesRequest from the protobuf v3 wire format
+func (val *ModifyWorkflowPropertiesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ModifyWorkflowPropertiesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ModifyWorkflowPropertiesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ModifyWorkflowPropertiesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ModifyWorkflowPropertiesRequest
+	switch t := that.(type) {
+	case *ModifyWorkflowPropertiesRequest:
+		that1 = t
+	case ModifyWorkflowPropertiesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ModifyWorkflowPropertiesResponse to the protobuf v3 wire format
+func (val *ModifyWorkflowPropertiesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ModifyWorkflowPropertiesResponse from the protobuf v3 wire format
+func (val *ModifyWorkflowPropertiesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ModifyWorkflowPropertiesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ModifyWorkflowPropertiesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ModifyWorkflowPropertiesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ModifyWorkflowPropertiesResponse
+	switch t := that.(type) {
+	case *ModifyWorkflowPropertiesResponse:
+		that1 = t
+	case ModifyWorkflowPropertiesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ModifyActivityPropertiesRequest to the protobuf v3 wire format
+func (val *ModifyActivityPropertiesRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ModifyActivityPropertiesRequest from the protobuf v3 wire format
+func (val *ModifyActivityPropertiesRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ModifyActivityPropertiesRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ModifyActivityPropertiesRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ModifyActivityPropertiesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ModifyActivityPropertiesRequest
+	switch t := that.(type) {
+	case *ModifyActivityPropertiesRequest:
+		that1 = t
+	case ModifyActivityPropertiesRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ModifyActivityPropertiesResponse to the protobuf v3 wire format
+func (val *ModifyActivityPropertiesResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ModifyActivityPropertiesResponse from the protobuf v3 wire format
+func (val *ModifyActivityPropertiesResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ModifyActivityPropertiesResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ModifyActivityPropertiesResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ModifyActivityPropertiesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ModifyActivityPropertiesResponse
+	switch t := that.(type) {
+	case *ModifyActivityPropertiesResponse:
+		that1 = t
+	case ModifyActivityPropertiesResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MigrateScheduleRequest to the protobuf v3 wire format
+func (val *MigrateScheduleRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MigrateScheduleRequest from the protobuf v3 wire format
+func (val *MigrateScheduleRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MigrateScheduleRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MigrateScheduleRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MigrateScheduleRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MigrateScheduleRequest
+	switch t := that.(type) {
+	case *MigrateScheduleRequest:
+		that1 = t
+	case MigrateScheduleRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type MigrateScheduleResponse to the protobuf v3 wire format
+func (val *MigrateScheduleResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type MigrateScheduleResponse from the protobuf v3 wire format
+func (val *MigrateScheduleResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *MigrateScheduleResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two MigrateScheduleResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *MigrateScheduleResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *MigrateScheduleResponse
+	switch t := that.(type) {
+	case *MigrateScheduleResponse:
+		that1 = t
+	case MigrateScheduleResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
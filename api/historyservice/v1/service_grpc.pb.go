@@ -91,6 +91,9 @@ const (
 	HistoryService_PauseActivity_FullMethodName                          = "/temporal.server.api.historyservice.v1.HistoryService/PauseActivity"
 	HistoryService_UnpauseActivity_FullMethodName                        = "/temporal.server.api.historyservice.v1.HistoryService/UnpauseActivity"
 	HistoryService_ResetActivity_FullMethodName                          = "/temporal.server.api.historyservice.v1.HistoryService/ResetActivity"
+	HistoryService_ModifyWorkflowProperties_FullMethodName               = "/temporal.server.api.historyservice.v1.HistoryService/ModifyWorkflowProperties"
+	HistoryService_ModifyActivityProperties_FullMethodName               = "/temporal.server.api.historyservice.v1.HistoryService/ModifyActivityProperties"
+	HistoryService_MigrateSchedule_FullMethodName                        = "/temporal.server.api.historyservice.v1.HistoryService/MigrateSchedule"
 )
 
 // HistoryServiceClient is the client API for HistoryService service.
@@ -369,6 +372,16 @@ type HistoryServiceClient interface {
 	// (-- api-linter: core::0134::method-signature=disabled
 	// (-- api-linter: core::0134::response-message-name=disabled
 	ResetActivity(ctx context.Context, in *ResetActivityRequest, opts ...grpc.CallOption) (*ResetActivityResponse, error)
+	// ModifyWorkflowProperties records a WorkflowPropertiesModifiedExternally event on a running
+	// workflow and applies it.
+	ModifyWorkflowProperties(ctx context.Context, in *ModifyWorkflowPropertiesRequest, opts ...grpc.CallOption) (*ModifyWorkflowPropertiesResponse, error)
+	// ModifyActivityProperties records an ActivityPropertiesModifiedExternally event for a
+	// pending activity and applies it.
+	ModifyActivityProperties(ctx context.Context, in *ModifyActivityPropertiesRequest, opts ...grpc.CallOption) (*ModifyActivityPropertiesResponse, error)
+	// MigrateSchedule moves a schedule between its scheduler workflow and a state machine
+	// scheduler hosted on the scheduler workflow's execution, according to the namespace's
+	// schedule migration mode.
+	MigrateSchedule(ctx context.Context, in *MigrateScheduleRequest, opts ...grpc.CallOption) (*MigrateScheduleResponse, error)
 }
 
 type historyServiceClient struct {
@@ -1040,6 +1053,33 @@ func (c *historyServiceClient) ResetActivity(ctx context.Context, in *ResetActiv
 	return out, nil
 }
 
+func (c *historyServiceClient) ModifyWorkflowProperties(ctx context.Context, in *ModifyWorkflowPropertiesRequest, opts ...grpc.CallOption) (*ModifyWorkflowPropertiesResponse, error) {
+	out := new(ModifyWorkflowPropertiesResponse)
+	err := c.cc.Invoke(ctx, HistoryService_ModifyWorkflowProperties_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) ModifyActivityProperties(ctx context.Context, in *ModifyActivityPropertiesRequest, opts ...grpc.CallOption) (*ModifyActivityPropertiesResponse, error) {
+	out := new(ModifyActivityPropertiesResponse)
+	err := c.cc.Invoke(ctx, HistoryService_ModifyActivityProperties_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *historyServiceClient) MigrateSchedule(ctx context.Context, in *MigrateScheduleRequest, opts ...grpc.CallOption) (*MigrateScheduleResponse, error) {
+	out := new(MigrateScheduleResponse)
+	err := c.cc.Invoke(ctx, HistoryService_MigrateSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // HistoryServiceServer is the server API for HistoryService service.
 // All implementations must embed UnimplementedHistoryServiceServer
 // for forward compatibility
@@ -1316,6 +1356,16 @@ type HistoryServiceServer interface {
 	// (-- api-linter: core::0134::method-signature=disabled
 	// (-- api-linter: core::0134::response-message-name=disabled
 	ResetActivity(context.Context, *ResetActivityRequest) (*ResetActivityResponse, error)
+	// ModifyWorkflowProperties records a WorkflowPropertiesModifiedExternally event on a running
+	// workflow and applies it.
+	ModifyWorkflowProperties(context.Context, *ModifyWorkflowPropertiesRequest) (*ModifyWorkflowPropertiesResponse, error)
+	// ModifyActivityProperties records an ActivityPropertiesModifiedExternally event for a
+	// pending activity and applies it.
+	ModifyActivityProperties(context.Context, *ModifyActivityPropertiesRequest) (*ModifyActivityPropertiesResponse, error)
+	// MigrateSchedule moves a schedule between its scheduler workflow and a state machine
+	// scheduler hosted on the scheduler workflow's execution, according to the namespace's
+	// schedule migration mode.
+	MigrateSchedule(context.Context, *MigrateScheduleRequest) (*MigrateScheduleResponse, error)
 	mustEmbedUnimplementedHistoryServiceServer()
 }
 
@@ -1536,6 +1586,15 @@ func (UnimplementedHistoryServiceServer) UnpauseActivity(context.Context, *Unpau
 func (UnimplementedHistoryServiceServer) ResetActivity(context.Context, *ResetActivityRequest) (*ResetActivityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ResetActivity not implemented")
 }
+func (UnimplementedHistoryServiceServer) ModifyWorkflowProperties(context.Context, *ModifyWorkflowPropertiesRequest) (*ModifyWorkflowPropertiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyWorkflowProperties not implemented")
+}
+func (UnimplementedHistoryServiceServer) ModifyActivityProperties(context.Context, *ModifyActivityPropertiesRequest) (*ModifyActivityPropertiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyActivityProperties not implemented")
+}
+func (UnimplementedHistoryServiceServer) MigrateSchedule(context.Context, *MigrateScheduleRequest) (*MigrateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateSchedule not implemented")
+}
 func (UnimplementedHistoryServiceServer) mustEmbedUnimplementedHistoryServiceServer() {}
 
 // UnsafeHistoryServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -2835,6 +2894,60 @@ func _HistoryService_ResetActivity_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _HistoryService_ModifyWorkflowProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyWorkflowPropertiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ModifyWorkflowProperties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_ModifyWorkflowProperties_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ModifyWorkflowProperties(ctx, req.(*ModifyWorkflowPropertiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_ModifyActivityProperties_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyActivityPropertiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).ModifyActivityProperties(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_ModifyActivityProperties_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).ModifyActivityProperties(ctx, req.(*ModifyActivityPropertiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _HistoryService_MigrateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(HistoryServiceServer).MigrateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: HistoryService_MigrateSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(HistoryServiceServer).MigrateSchedule(ctx, req.(*MigrateScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // HistoryService_ServiceDesc is the grpc.ServiceDesc for HistoryService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -3122,6 +3235,18 @@ var HistoryService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ResetActivity",
 			Handler:    _HistoryService_ResetActivity_Handler,
 		},
+		{
+			MethodName: "ModifyWorkflowProperties",
+			Handler:    _HistoryService_ModifyWorkflowProperties_Handler,
+		},
+		{
+			MethodName: "ModifyActivityProperties",
+			Handler:    _HistoryService_ModifyActivityProperties_Handler,
+		},
+		{
+			MethodName: "MigrateSchedule",
+			Handler:    _HistoryService_MigrateSchedule_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -663,6 +663,66 @@ func (mr *MockHistoryServiceClientMockRecorder) MergeDLQMessages(ctx, in any, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockHistoryServiceClient)(nil).MergeDLQMessages), varargs...)
 }
 
+// MigrateSchedule mocks base method.
+func (m *MockHistoryServiceClient) MigrateSchedule(ctx context.Context, in *historyservice.MigrateScheduleRequest, opts ...grpc.CallOption) (*historyservice.MigrateScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MigrateSchedule", varargs...)
+	ret0, _ := ret[0].(*historyservice.MigrateScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateSchedule indicates an expected call of MigrateSchedule.
+func (mr *MockHistoryServiceClientMockRecorder) MigrateSchedule(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSchedule", reflect.TypeOf((*MockHistoryServiceClient)(nil).MigrateSchedule), varargs...)
+}
+
+// ModifyActivityProperties mocks base method.
+func (m *MockHistoryServiceClient) ModifyActivityProperties(ctx context.Context, in *historyservice.ModifyActivityPropertiesRequest, opts ...grpc.CallOption) (*historyservice.ModifyActivityPropertiesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ModifyActivityProperties", varargs...)
+	ret0, _ := ret[0].(*historyservice.ModifyActivityPropertiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyActivityProperties indicates an expected call of ModifyActivityProperties.
+func (mr *MockHistoryServiceClientMockRecorder) ModifyActivityProperties(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyActivityProperties", reflect.TypeOf((*MockHistoryServiceClient)(nil).ModifyActivityProperties), varargs...)
+}
+
+// ModifyWorkflowProperties mocks base method.
+func (m *MockHistoryServiceClient) ModifyWorkflowProperties(ctx context.Context, in *historyservice.ModifyWorkflowPropertiesRequest, opts ...grpc.CallOption) (*historyservice.ModifyWorkflowPropertiesResponse, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ModifyWorkflowProperties", varargs...)
+	ret0, _ := ret[0].(*historyservice.ModifyWorkflowPropertiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyWorkflowProperties indicates an expected call of ModifyWorkflowProperties.
+func (mr *MockHistoryServiceClientMockRecorder) ModifyWorkflowProperties(ctx, in any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyWorkflowProperties", reflect.TypeOf((*MockHistoryServiceClient)(nil).ModifyWorkflowProperties), varargs...)
+}
+
 // PauseActivity mocks base method.
 func (m *MockHistoryServiceClient) PauseActivity(ctx context.Context, in *historyservice.PauseActivityRequest, opts ...grpc.CallOption) (*historyservice.PauseActivityResponse, error) {
 	m.ctrl.T.Helper()
@@ -2090,6 +2150,51 @@ func (mr *MockHistoryServiceServerMockRecorder) MergeDLQMessages(arg0, arg1 any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockHistoryServiceServer)(nil).MergeDLQMessages), arg0, arg1)
 }
 
+// MigrateSchedule mocks base method.
+func (m *MockHistoryServiceServer) MigrateSchedule(arg0 context.Context, arg1 *historyservice.MigrateScheduleRequest) (*historyservice.MigrateScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateSchedule", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.MigrateScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateSchedule indicates an expected call of MigrateSchedule.
+func (mr *MockHistoryServiceServerMockRecorder) MigrateSchedule(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSchedule", reflect.TypeOf((*MockHistoryServiceServer)(nil).MigrateSchedule), arg0, arg1)
+}
+
+// ModifyActivityProperties mocks base method.
+func (m *MockHistoryServiceServer) ModifyActivityProperties(arg0 context.Context, arg1 *historyservice.ModifyActivityPropertiesRequest) (*historyservice.ModifyActivityPropertiesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyActivityProperties", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ModifyActivityPropertiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyActivityProperties indicates an expected call of ModifyActivityProperties.
+func (mr *MockHistoryServiceServerMockRecorder) ModifyActivityProperties(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyActivityProperties", reflect.TypeOf((*MockHistoryServiceServer)(nil).ModifyActivityProperties), arg0, arg1)
+}
+
+// ModifyWorkflowProperties mocks base method.
+func (m *MockHistoryServiceServer) ModifyWorkflowProperties(arg0 context.Context, arg1 *historyservice.ModifyWorkflowPropertiesRequest) (*historyservice.ModifyWorkflowPropertiesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyWorkflowProperties", arg0, arg1)
+	ret0, _ := ret[0].(*historyservice.ModifyWorkflowPropertiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyWorkflowProperties indicates an expected call of ModifyWorkflowProperties.
+func (mr *MockHistoryServiceServerMockRecorder) ModifyWorkflowProperties(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyWorkflowProperties", reflect.TypeOf((*MockHistoryServiceServer)(nil).ModifyWorkflowProperties), arg0, arg1)
+}
+
 // PauseActivity mocks base method.
 func (m *MockHistoryServiceServer) PauseActivity(arg0 context.Context, arg1 *historyservice.PauseActivityRequest) (*historyservice.PauseActivityResponse, error) {
 	m.ctrl.T.Helper()
@@ -78,3 +78,188 @@ func (this *IndexSearchAttributes) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type DynamicConfig to the protobuf v3 wire format
+func (val *DynamicConfig) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DynamicConfig from the protobuf v3 wire format
+func (val *DynamicConfig) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DynamicConfig) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DynamicConfig values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DynamicConfig) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DynamicConfig
+	switch t := that.(type) {
+	case *DynamicConfig:
+		that1 = t
+	case DynamicConfig:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DynamicConfigValues to the protobuf v3 wire format
+func (val *DynamicConfigValues) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DynamicConfigValues from the protobuf v3 wire format
+func (val *DynamicConfigValues) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DynamicConfigValues) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DynamicConfigValues values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DynamicConfigValues) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DynamicConfigValues
+	switch t := that.(type) {
+	case *DynamicConfigValues:
+		that1 = t
+	case DynamicConfigValues:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DynamicConfigConstrainedValue to the protobuf v3 wire format
+func (val *DynamicConfigConstrainedValue) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DynamicConfigConstrainedValue from the protobuf v3 wire format
+func (val *DynamicConfigConstrainedValue) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DynamicConfigConstrainedValue) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DynamicConfigConstrainedValue values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DynamicConfigConstrainedValue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DynamicConfigConstrainedValue
+	switch t := that.(type) {
+	case *DynamicConfigConstrainedValue:
+		that1 = t
+	case DynamicConfigConstrainedValue:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DynamicConfigConstraints to the protobuf v3 wire format
+func (val *DynamicConfigConstraints) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DynamicConfigConstraints from the protobuf v3 wire format
+func (val *DynamicConfigConstraints) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DynamicConfigConstraints) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DynamicConfigConstraints values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DynamicConfigConstraints) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DynamicConfigConstraints
+	switch t := that.(type) {
+	case *DynamicConfigConstraints:
+		that1 = t
+	case DynamicConfigConstraints:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type DynamicConfigChange to the protobuf v3 wire format
+func (val *DynamicConfigChange) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type DynamicConfigChange from the protobuf v3 wire format
+func (val *DynamicConfigChange) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *DynamicConfigChange) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two DynamicConfigChange values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *DynamicConfigChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *DynamicConfigChange
+	switch t := that.(type) {
+	case *DynamicConfigChange:
+		that1 = t
+	case DynamicConfigChange:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
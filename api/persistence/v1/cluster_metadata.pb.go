@@ -13,8 +13,11 @@ import (
 
 	v11 "go.temporal.io/api/enums/v1"
 	v1 "go.temporal.io/api/version/v1"
+	v12 "go.temporal.io/server/api/enums/v1"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	structpb "google.golang.org/protobuf/types/known/structpb"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const (
@@ -40,8 +43,11 @@ type ClusterMetadata struct {
 	IsConnectionEnabled      bool                              `protobuf:"varint,10,opt,name=is_connection_enabled,json=isConnectionEnabled,proto3" json:"is_connection_enabled,omitempty"`
 	UseClusterIdMembership   bool                              `protobuf:"varint,11,opt,name=use_cluster_id_membership,json=useClusterIdMembership,proto3" json:"use_cluster_id_membership,omitempty"`
 	Tags                     map[string]string                 `protobuf:"bytes,12,rep,name=tags,proto3" json:"tags,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
-	unknownFields            protoimpl.UnknownFields
-	sizeCache                protoimpl.SizeCache
+	// Dynamic config values set through the admin API. They take precedence over the values of
+	// the dynamic config client. Only set on the record of the current cluster.
+	DynamicConfig *DynamicConfig `protobuf:"bytes,14,opt,name=dynamic_config,json=dynamicConfig,proto3" json:"dynamic_config,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ClusterMetadata) Reset() {
@@ -165,6 +171,13 @@ func (x *ClusterMetadata) GetTags() map[string]string {
 	return nil
 }
 
+func (x *ClusterMetadata) GetDynamicConfig() *DynamicConfig {
+	if x != nil {
+		return x.DynamicConfig
+	}
+	return nil
+}
+
 type IndexSearchAttributes struct {
 	state                  protoimpl.MessageState          `protogen:"open.v1"`
 	CustomSearchAttributes map[string]v11.IndexedValueType `protobuf:"bytes,1,rep,name=custom_search_attributes,json=customSearchAttributes,proto3" json:"custom_search_attributes,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"varint,2,opt,name=value,enum=temporal.api.enums.v1.IndexedValueType"`
@@ -209,11 +222,342 @@ func (x *IndexSearchAttributes) GetCustomSearchAttributes() map[string]v11.Index
 	return nil
 }
 
+type DynamicConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Keys are lower case dynamic config keys.
+	Values map[string]*DynamicConfigValues `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// The most recent changes, oldest first.
+	Changes       []*DynamicConfigChange `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DynamicConfig) Reset() {
+	*x = DynamicConfig{}
+	mi := &file_temporal_server_api_persistence_v1_cluster_metadata_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DynamicConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicConfig) ProtoMessage() {}
+
+func (x *DynamicConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_cluster_metadata_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynamicConfig.ProtoReflect.Descriptor instead.
+func (*DynamicConfig) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DynamicConfig) GetValues() map[string]*DynamicConfigValues {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *DynamicConfig) GetChanges() []*DynamicConfigChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
+type DynamicConfigValues struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Values        []*DynamicConfigConstrainedValue `protobuf:"bytes,1,rep,name=values,proto3" json:"values,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DynamicConfigValues) Reset() {
+	*x = DynamicConfigValues{}
+	mi := &file_temporal_server_api_persistence_v1_cluster_metadata_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DynamicConfigValues) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicConfigValues) ProtoMessage() {}
+
+func (x *DynamicConfigValues) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_cluster_metadata_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynamicConfigValues.ProtoReflect.Descriptor instead.
+func (*DynamicConfigValues) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DynamicConfigValues) GetValues() []*DynamicConfigConstrainedValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+type DynamicConfigConstrainedValue struct {
+	state       protoimpl.MessageState    `protogen:"open.v1"`
+	Constraints *DynamicConfigConstraints `protobuf:"bytes,1,opt,name=constraints,proto3" json:"constraints,omitempty"`
+	// Durations are stored as strings, e.g. "10s".
+	Value         *structpb.Value `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DynamicConfigConstrainedValue) Reset() {
+	*x = DynamicConfigConstrainedValue{}
+	mi := &file_temporal_server_api_persistence_v1_cluster_metadata_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DynamicConfigConstrainedValue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicConfigConstrainedValue) ProtoMessage() {}
+
+func (x *DynamicConfigConstrainedValue) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_cluster_metadata_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynamicConfigConstrainedValue.ProtoReflect.Descriptor instead.
+func (*DynamicConfigConstrainedValue) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DynamicConfigConstrainedValue) GetConstraints() *DynamicConfigConstraints {
+	if x != nil {
+		return x.Constraints
+	}
+	return nil
+}
+
+func (x *DynamicConfigConstrainedValue) GetValue() *structpb.Value {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+// See dynamicconfig.Constraints.
+type DynamicConfigConstraints struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Namespace     string                 `protobuf:"bytes,1,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	NamespaceId   string                 `protobuf:"bytes,2,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	TaskQueueName string                 `protobuf:"bytes,3,opt,name=task_queue_name,json=taskQueueName,proto3" json:"task_queue_name,omitempty"`
+	TaskQueueType v11.TaskQueueType      `protobuf:"varint,4,opt,name=task_queue_type,json=taskQueueType,proto3,enum=temporal.api.enums.v1.TaskQueueType" json:"task_queue_type,omitempty"`
+	ShardId       int32                  `protobuf:"varint,5,opt,name=shard_id,json=shardId,proto3" json:"shard_id,omitempty"`
+	TaskType      v12.TaskType           `protobuf:"varint,6,opt,name=task_type,json=taskType,proto3,enum=temporal.server.api.enums.v1.TaskType" json:"task_type,omitempty"`
+	Destination   string                 `protobuf:"bytes,7,opt,name=destination,proto3" json:"destination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DynamicConfigConstraints) Reset() {
+	*x = DynamicConfigConstraints{}
+	mi := &file_temporal_server_api_persistence_v1_cluster_metadata_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DynamicConfigConstraints) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicConfigConstraints) ProtoMessage() {}
+
+func (x *DynamicConfigConstraints) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_cluster_metadata_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynamicConfigConstraints.ProtoReflect.Descriptor instead.
+func (*DynamicConfigConstraints) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *DynamicConfigConstraints) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *DynamicConfigConstraints) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *DynamicConfigConstraints) GetTaskQueueName() string {
+	if x != nil {
+		return x.TaskQueueName
+	}
+	return ""
+}
+
+func (x *DynamicConfigConstraints) GetTaskQueueType() v11.TaskQueueType {
+	if x != nil {
+		return x.TaskQueueType
+	}
+	return v11.TaskQueueType(0)
+}
+
+func (x *DynamicConfigConstraints) GetShardId() int32 {
+	if x != nil {
+		return x.ShardId
+	}
+	return 0
+}
+
+func (x *DynamicConfigConstraints) GetTaskType() v12.TaskType {
+	if x != nil {
+		return x.TaskType
+	}
+	return v12.TaskType(0)
+}
+
+func (x *DynamicConfigConstraints) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+type DynamicConfigChange struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Key   string                 `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Values of the key after the change. Empty if the key was removed.
+	Values []*DynamicConfigConstrainedValue `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	// Identity supplied by the caller.
+	Identity string `protobuf:"bytes,3,opt,name=identity,proto3" json:"identity,omitempty"`
+	// Subject of the caller's authorization claims, if any.
+	Subject       string                 `protobuf:"bytes,4,opt,name=subject,proto3" json:"subject,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChangeTime    *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=change_time,json=changeTime,proto3" json:"change_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DynamicConfigChange) Reset() {
+	*x = DynamicConfigChange{}
+	mi := &file_temporal_server_api_persistence_v1_cluster_metadata_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DynamicConfigChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DynamicConfigChange) ProtoMessage() {}
+
+func (x *DynamicConfigChange) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_persistence_v1_cluster_metadata_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DynamicConfigChange.ProtoReflect.Descriptor instead.
+func (*DynamicConfigChange) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *DynamicConfigChange) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *DynamicConfigChange) GetValues() []*DynamicConfigConstrainedValue {
+	if x != nil {
+		return x.Values
+	}
+	return nil
+}
+
+func (x *DynamicConfigChange) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *DynamicConfigChange) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *DynamicConfigChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *DynamicConfigChange) GetChangeTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangeTime
+	}
+	return nil
+}
+
 var File_temporal_server_api_persistence_v1_cluster_metadata_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDesc = "" +
 	"\n" +
-	"9temporal/server/api/persistence/v1/cluster_metadata.proto\x12\"temporal.server.api.persistence.v1\x1a\"temporal/api/enums/v1/common.proto\x1a%temporal/api/version/v1/message.proto\"\xd9\a\n" +
+	"9temporal/server/api/persistence/v1/cluster_metadata.proto\x12\"temporal.server.api.persistence.v1\x1a\x1cgoogle/protobuf/struct.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\"temporal/api/enums/v1/common.proto\x1a&temporal/api/enums/v1/task_queue.proto\x1a%temporal/api/version/v1/message.proto\x1a'temporal/server/api/enums/v1/task.proto\"\xb3\b\n" +
 	"\x0fClusterMetadata\x12!\n" +
 	"\fcluster_name\x18\x01 \x01(\tR\vclusterName\x12.\n" +
 	"\x13history_shard_count\x18\x02 \x01(\x05R\x11historyShardCount\x12\x1d\n" +
@@ -229,7 +573,8 @@ const file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDesc = "
 	"\x15is_connection_enabled\x18\n" +
 	" \x01(\bR\x13isConnectionEnabled\x129\n" +
 	"\x19use_cluster_id_membership\x18\v \x01(\bR\x16useClusterIdMembership\x12Q\n" +
-	"\x04tags\x18\f \x03(\v2=.temporal.server.api.persistence.v1.ClusterMetadata.TagsEntryR\x04tags\x1a\x83\x01\n" +
+	"\x04tags\x18\f \x03(\v2=.temporal.server.api.persistence.v1.ClusterMetadata.TagsEntryR\x04tags\x12X\n" +
+	"\x0edynamic_config\x18\x0e \x01(\v21.temporal.server.api.persistence.v1.DynamicConfigR\rdynamicConfig\x1a\x83\x01\n" +
 	"\x1aIndexSearchAttributesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12O\n" +
 	"\x05value\x18\x02 \x01(\v29.temporal.server.api.persistence.v1.IndexSearchAttributesR\x05value:\x028\x01\x1a7\n" +
@@ -240,7 +585,34 @@ const file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDesc = "
 	"\x18custom_search_attributes\x18\x01 \x03(\v2U.temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntryR\x16customSearchAttributes\x1ar\n" +
 	"\x1bCustomSearchAttributesEntry\x12\x10\n" +
 	"\x03key\x18\x01 \x01(\tR\x03key\x12=\n" +
-	"\x05value\x18\x02 \x01(\x0e2'.temporal.api.enums.v1.IndexedValueTypeR\x05value:\x028\x01B6Z4go.temporal.io/server/api/persistence/v1;persistenceb\x06proto3"
+	"\x05value\x18\x02 \x01(\x0e2'.temporal.api.enums.v1.IndexedValueTypeR\x05value:\x028\x01\"\xad\x02\n" +
+	"\rDynamicConfig\x12U\n" +
+	"\x06values\x18\x01 \x03(\v2=.temporal.server.api.persistence.v1.DynamicConfig.ValuesEntryR\x06values\x12Q\n" +
+	"\achanges\x18\x02 \x03(\v27.temporal.server.api.persistence.v1.DynamicConfigChangeR\achanges\x1ar\n" +
+	"\vValuesEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12M\n" +
+	"\x05value\x18\x02 \x01(\v27.temporal.server.api.persistence.v1.DynamicConfigValuesR\x05value:\x028\x01\"p\n" +
+	"\x13DynamicConfigValues\x12Y\n" +
+	"\x06values\x18\x01 \x03(\v2A.temporal.server.api.persistence.v1.DynamicConfigConstrainedValueR\x06values\"\xad\x01\n" +
+	"\x1dDynamicConfigConstrainedValue\x12^\n" +
+	"\vconstraints\x18\x01 \x01(\v2<.temporal.server.api.persistence.v1.DynamicConfigConstraintsR\vconstraints\x12,\n" +
+	"\x05value\x18\x02 \x01(\v2\x16.google.protobuf.ValueR\x05value\"\xd3\x02\n" +
+	"\x18DynamicConfigConstraints\x12\x1c\n" +
+	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
+	"\fnamespace_id\x18\x02 \x01(\tR\vnamespaceId\x12&\n" +
+	"\x0ftask_queue_name\x18\x03 \x01(\tR\rtaskQueueName\x12L\n" +
+	"\x0ftask_queue_type\x18\x04 \x01(\x0e2$.temporal.api.enums.v1.TaskQueueTypeR\rtaskQueueType\x12\x19\n" +
+	"\bshard_id\x18\x05 \x01(\x05R\ashardId\x12C\n" +
+	"\ttask_type\x18\x06 \x01(\x0e2&.temporal.server.api.enums.v1.TaskTypeR\btaskType\x12 \n" +
+	"\vdestination\x18\a \x01(\tR\vdestination\"\x8d\x02\n" +
+	"\x13DynamicConfigChange\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12Y\n" +
+	"\x06values\x18\x02 \x03(\v2A.temporal.server.api.persistence.v1.DynamicConfigConstrainedValueR\x06values\x12\x1a\n" +
+	"\bidentity\x18\x03 \x01(\tR\bidentity\x12\x18\n" +
+	"\asubject\x18\x04 \x01(\tR\asubject\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12;\n" +
+	"\vchange_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"changeTimeB6Z4go.temporal.io/server/api/persistence/v1;persistenceb\x06proto3"
 
 var (
 	file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDescOnce sync.Once
@@ -254,28 +626,49 @@ func file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDescGZIP(
 	return file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDescData
 }
 
-var file_temporal_server_api_persistence_v1_cluster_metadata_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_temporal_server_api_persistence_v1_cluster_metadata_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_temporal_server_api_persistence_v1_cluster_metadata_proto_goTypes = []any{
-	(*ClusterMetadata)(nil),       // 0: temporal.server.api.persistence.v1.ClusterMetadata
-	(*IndexSearchAttributes)(nil), // 1: temporal.server.api.persistence.v1.IndexSearchAttributes
-	nil,                           // 2: temporal.server.api.persistence.v1.ClusterMetadata.IndexSearchAttributesEntry
-	nil,                           // 3: temporal.server.api.persistence.v1.ClusterMetadata.TagsEntry
-	nil,                           // 4: temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntry
-	(*v1.VersionInfo)(nil),        // 5: temporal.api.version.v1.VersionInfo
-	(v11.IndexedValueType)(0),     // 6: temporal.api.enums.v1.IndexedValueType
+	(*ClusterMetadata)(nil),               // 0: temporal.server.api.persistence.v1.ClusterMetadata
+	(*IndexSearchAttributes)(nil),         // 1: temporal.server.api.persistence.v1.IndexSearchAttributes
+	(*DynamicConfig)(nil),                 // 2: temporal.server.api.persistence.v1.DynamicConfig
+	(*DynamicConfigValues)(nil),           // 3: temporal.server.api.persistence.v1.DynamicConfigValues
+	(*DynamicConfigConstrainedValue)(nil), // 4: temporal.server.api.persistence.v1.DynamicConfigConstrainedValue
+	(*DynamicConfigConstraints)(nil),      // 5: temporal.server.api.persistence.v1.DynamicConfigConstraints
+	(*DynamicConfigChange)(nil),           // 6: temporal.server.api.persistence.v1.DynamicConfigChange
+	nil,                                   // 7: temporal.server.api.persistence.v1.ClusterMetadata.IndexSearchAttributesEntry
+	nil,                                   // 8: temporal.server.api.persistence.v1.ClusterMetadata.TagsEntry
+	nil,                                   // 9: temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntry
+	nil,                                   // 10: temporal.server.api.persistence.v1.DynamicConfig.ValuesEntry
+	(*v1.VersionInfo)(nil),                // 11: temporal.api.version.v1.VersionInfo
+	(*structpb.Value)(nil),                // 12: google.protobuf.Value
+	(v11.TaskQueueType)(0),                // 13: temporal.api.enums.v1.TaskQueueType
+	(v12.TaskType)(0),                     // 14: temporal.server.api.enums.v1.TaskType
+	(*timestamppb.Timestamp)(nil),         // 15: google.protobuf.Timestamp
+	(v11.IndexedValueType)(0),             // 16: temporal.api.enums.v1.IndexedValueType
 }
 var file_temporal_server_api_persistence_v1_cluster_metadata_proto_depIdxs = []int32{
-	5, // 0: temporal.server.api.persistence.v1.ClusterMetadata.version_info:type_name -> temporal.api.version.v1.VersionInfo
-	2, // 1: temporal.server.api.persistence.v1.ClusterMetadata.index_search_attributes:type_name -> temporal.server.api.persistence.v1.ClusterMetadata.IndexSearchAttributesEntry
-	3, // 2: temporal.server.api.persistence.v1.ClusterMetadata.tags:type_name -> temporal.server.api.persistence.v1.ClusterMetadata.TagsEntry
-	4, // 3: temporal.server.api.persistence.v1.IndexSearchAttributes.custom_search_attributes:type_name -> temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntry
-	1, // 4: temporal.server.api.persistence.v1.ClusterMetadata.IndexSearchAttributesEntry.value:type_name -> temporal.server.api.persistence.v1.IndexSearchAttributes
-	6, // 5: temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	11, // 0: temporal.server.api.persistence.v1.ClusterMetadata.version_info:type_name -> temporal.api.version.v1.VersionInfo
+	7,  // 1: temporal.server.api.persistence.v1.ClusterMetadata.index_search_attributes:type_name -> temporal.server.api.persistence.v1.ClusterMetadata.IndexSearchAttributesEntry
+	8,  // 2: temporal.server.api.persistence.v1.ClusterMetadata.tags:type_name -> temporal.server.api.persistence.v1.ClusterMetadata.TagsEntry
+	2,  // 3: temporal.server.api.persistence.v1.ClusterMetadata.dynamic_config:type_name -> temporal.server.api.persistence.v1.DynamicConfig
+	9,  // 4: temporal.server.api.persistence.v1.IndexSearchAttributes.custom_search_attributes:type_name -> temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntry
+	10, // 5: temporal.server.api.persistence.v1.DynamicConfig.values:type_name -> temporal.server.api.persistence.v1.DynamicConfig.ValuesEntry
+	6,  // 6: temporal.server.api.persistence.v1.DynamicConfig.changes:type_name -> temporal.server.api.persistence.v1.DynamicConfigChange
+	4,  // 7: temporal.server.api.persistence.v1.DynamicConfigValues.values:type_name -> temporal.server.api.persistence.v1.DynamicConfigConstrainedValue
+	5,  // 8: temporal.server.api.persistence.v1.DynamicConfigConstrainedValue.constraints:type_name -> temporal.server.api.persistence.v1.DynamicConfigConstraints
+	12, // 9: temporal.server.api.persistence.v1.DynamicConfigConstrainedValue.value:type_name -> google.protobuf.Value
+	13, // 10: temporal.server.api.persistence.v1.DynamicConfigConstraints.task_queue_type:type_name -> temporal.api.enums.v1.TaskQueueType
+	14, // 11: temporal.server.api.persistence.v1.DynamicConfigConstraints.task_type:type_name -> temporal.server.api.enums.v1.TaskType
+	4,  // 12: temporal.server.api.persistence.v1.DynamicConfigChange.values:type_name -> temporal.server.api.persistence.v1.DynamicConfigConstrainedValue
+	15, // 13: temporal.server.api.persistence.v1.DynamicConfigChange.change_time:type_name -> google.protobuf.Timestamp
+	1,  // 14: temporal.server.api.persistence.v1.ClusterMetadata.IndexSearchAttributesEntry.value:type_name -> temporal.server.api.persistence.v1.IndexSearchAttributes
+	16, // 15: temporal.server.api.persistence.v1.IndexSearchAttributes.CustomSearchAttributesEntry.value:type_name -> temporal.api.enums.v1.IndexedValueType
+	3,  // 16: temporal.server.api.persistence.v1.DynamicConfig.ValuesEntry.value:type_name -> temporal.server.api.persistence.v1.DynamicConfigValues
+	17, // [17:17] is the sub-list for method output_type
+	17, // [17:17] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_persistence_v1_cluster_metadata_proto_init() }
@@ -289,7 +682,7 @@ func file_temporal_server_api_persistence_v1_cluster_metadata_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDesc), len(file_temporal_server_api_persistence_v1_cluster_metadata_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   5,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -338,6 +338,154 @@ func (this *StartWorkflowResponse) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type SignalWorkflowRequest to the protobuf v3 wire format
+func (val *SignalWorkflowRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SignalWorkflowRequest from the protobuf v3 wire format
+func (val *SignalWorkflowRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SignalWorkflowRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SignalWorkflowRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SignalWorkflowRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SignalWorkflowRequest
+	switch t := that.(type) {
+	case *SignalWorkflowRequest:
+		that1 = t
+	case SignalWorkflowRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SignalWorkflowResponse to the protobuf v3 wire format
+func (val *SignalWorkflowResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SignalWorkflowResponse from the protobuf v3 wire format
+func (val *SignalWorkflowResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SignalWorkflowResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SignalWorkflowResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SignalWorkflowResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SignalWorkflowResponse
+	switch t := that.(type) {
+	case *SignalWorkflowResponse:
+		that1 = t
+	case SignalWorkflowResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartNexusOperationRequest to the protobuf v3 wire format
+func (val *StartNexusOperationRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartNexusOperationRequest from the protobuf v3 wire format
+func (val *StartNexusOperationRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartNexusOperationRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartNexusOperationRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartNexusOperationRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartNexusOperationRequest
+	switch t := that.(type) {
+	case *StartNexusOperationRequest:
+		that1 = t
+	case StartNexusOperationRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type StartNexusOperationResponse to the protobuf v3 wire format
+func (val *StartNexusOperationResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type StartNexusOperationResponse from the protobuf v3 wire format
+func (val *StartNexusOperationResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *StartNexusOperationResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two StartNexusOperationResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *StartNexusOperationResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *StartNexusOperationResponse
+	switch t := that.(type) {
+	case *StartNexusOperationResponse:
+		that1 = t
+	case StartNexusOperationResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type CancelWorkflowRequest to the protobuf v3 wire format
 func (val *CancelWorkflowRequest) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -412,6 +560,302 @@ func (this *TerminateWorkflowRequest) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type GetExclusionCalendarsRequest to the protobuf v3 wire format
+func (val *GetExclusionCalendarsRequest) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetExclusionCalendarsRequest from the protobuf v3 wire format
+func (val *GetExclusionCalendarsRequest) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetExclusionCalendarsRequest) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetExclusionCalendarsRequest values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetExclusionCalendarsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetExclusionCalendarsRequest
+	switch t := that.(type) {
+	case *GetExclusionCalendarsRequest:
+		that1 = t
+	case GetExclusionCalendarsRequest:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type GetExclusionCalendarsResponse to the protobuf v3 wire format
+func (val *GetExclusionCalendarsResponse) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type GetExclusionCalendarsResponse from the protobuf v3 wire format
+func (val *GetExclusionCalendarsResponse) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *GetExclusionCalendarsResponse) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two GetExclusionCalendarsResponse values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *GetExclusionCalendarsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *GetExclusionCalendarsResponse
+	switch t := that.(type) {
+	case *GetExclusionCalendarsResponse:
+		that1 = t
+	case GetExclusionCalendarsResponse:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExclusionCalendar to the protobuf v3 wire format
+func (val *ExclusionCalendar) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExclusionCalendar from the protobuf v3 wire format
+func (val *ExclusionCalendar) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExclusionCalendar) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExclusionCalendar values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExclusionCalendar) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExclusionCalendar
+	switch t := that.(type) {
+	case *ExclusionCalendar:
+		that1 = t
+	case ExclusionCalendar:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ExclusionCalendarDate to the protobuf v3 wire format
+func (val *ExclusionCalendarDate) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ExclusionCalendarDate from the protobuf v3 wire format
+func (val *ExclusionCalendarDate) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ExclusionCalendarDate) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ExclusionCalendarDate values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ExclusionCalendarDate) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ExclusionCalendarDate
+	switch t := that.(type) {
+	case *ExclusionCalendarDate:
+		that1 = t
+	case ExclusionCalendarDate:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type ScheduleActionExtension to the protobuf v3 wire format
+func (val *ScheduleActionExtension) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type ScheduleActionExtension from the protobuf v3 wire format
+func (val *ScheduleActionExtension) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *ScheduleActionExtension) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two ScheduleActionExtension values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *ScheduleActionExtension) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *ScheduleActionExtension
+	switch t := that.(type) {
+	case *ScheduleActionExtension:
+		that1 = t
+	case ScheduleActionExtension:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type SignalWorkflowAction to the protobuf v3 wire format
+func (val *SignalWorkflowAction) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SignalWorkflowAction from the protobuf v3 wire format
+func (val *SignalWorkflowAction) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SignalWorkflowAction) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SignalWorkflowAction values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SignalWorkflowAction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SignalWorkflowAction
+	switch t := that.(type) {
+	case *SignalWorkflowAction:
+		that1 = t
+	case SignalWorkflowAction:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type NexusOperationAction to the protobuf v3 wire format
+func (val *NexusOperationAction) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type NexusOperationAction from the protobuf v3 wire format
+func (val *NexusOperationAction) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *NexusOperationAction) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two NexusOperationAction values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *NexusOperationAction) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *NexusOperationAction
+	switch t := that.(type) {
+	case *NexusOperationAction:
+		that1 = t
+	case NexusOperationAction:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
+// Marshal an object of type NexusOperationActionResult to the protobuf v3 wire format
+func (val *NexusOperationActionResult) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type NexusOperationActionResult from the protobuf v3 wire format
+func (val *NexusOperationActionResult) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *NexusOperationActionResult) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two NexusOperationActionResult values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *NexusOperationActionResult) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *NexusOperationActionResult
+	switch t := that.(type) {
+	case *NexusOperationActionResult:
+		that1 = t
+	case NexusOperationActionResult:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type NextTimeCache to the protobuf v3 wire format
 func (val *NextTimeCache) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -596,3 +1040,40 @@ func (this *BackfillerInternal) Equal(that interface{}) bool {
 
 	return proto.Equal(this, that1)
 }
+
+// Marshal an object of type SchedulerMigrationState to the protobuf v3 wire format
+func (val *SchedulerMigrationState) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type SchedulerMigrationState from the protobuf v3 wire format
+func (val *SchedulerMigrationState) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *SchedulerMigrationState) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two SchedulerMigrationState values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *SchedulerMigrationState) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *SchedulerMigrationState
+	switch t := that.(type) {
+	case *SchedulerMigrationState:
+		that1 = t
+	case SchedulerMigrationState:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
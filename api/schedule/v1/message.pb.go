@@ -160,8 +160,14 @@ type InternalState struct {
 	// conflict token is implemented as simple sequence number
 	ConflictToken int64 `protobuf:"varint,7,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
 	NeedRefresh   bool  `protobuf:"varint,9,opt,name=need_refresh,json=needRefresh,proto3" json:"need_refresh,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Exclusion calendars named by exclusion_calendar_names, as of the refresh time.
+	ExclusionCalendars            []*ExclusionCalendar   `protobuf:"bytes,11,rep,name=exclusion_calendars,json=exclusionCalendars,proto3" json:"exclusion_calendars,omitempty"`
+	ExclusionCalendarsRefreshTime *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=exclusion_calendars_refresh_time,json=exclusionCalendarsRefreshTime,proto3" json:"exclusion_calendars_refresh_time,omitempty"`
+	// Names of the namespace's exclusion calendars whose days the schedule skips. The frontend
+	// takes them out of the spec of a created or updated schedule.
+	ExclusionCalendarNames []string `protobuf:"bytes,13,rep,name=exclusion_calendar_names,json=exclusionCalendarNames,proto3" json:"exclusion_calendar_names,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *InternalState) Reset() {
@@ -264,6 +270,27 @@ func (x *InternalState) GetNeedRefresh() bool {
 	return false
 }
 
+func (x *InternalState) GetExclusionCalendars() []*ExclusionCalendar {
+	if x != nil {
+		return x.ExclusionCalendars
+	}
+	return nil
+}
+
+func (x *InternalState) GetExclusionCalendarsRefreshTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExclusionCalendarsRefreshTime
+	}
+	return nil
+}
+
+func (x *InternalState) GetExclusionCalendarNames() []string {
+	if x != nil {
+		return x.ExclusionCalendarNames
+	}
+	return nil
+}
+
 type StartScheduleArgs struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Schedule      *v11.Schedule          `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
@@ -337,8 +364,10 @@ type FullUpdateRequest struct {
 	Schedule         *v11.Schedule          `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	ConflictToken    int64                  `protobuf:"varint,2,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
 	SearchAttributes *v12.SearchAttributes  `protobuf:"bytes,3,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
+	// Replaces InternalState.exclusion_calendar_names.
+	ExclusionCalendarNames []string `protobuf:"bytes,4,rep,name=exclusion_calendar_names,json=exclusionCalendarNames,proto3" json:"exclusion_calendar_names,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
 func (x *FullUpdateRequest) Reset() {
@@ -392,11 +421,21 @@ func (x *FullUpdateRequest) GetSearchAttributes() *v12.SearchAttributes {
 	return nil
 }
 
+func (x *FullUpdateRequest) GetExclusionCalendarNames() []string {
+	if x != nil {
+		return x.ExclusionCalendarNames
+	}
+	return nil
+}
+
 type DescribeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Schedule      *v11.Schedule          `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
 	Info          *v11.ScheduleInfo      `protobuf:"bytes,2,opt,name=info,proto3" json:"info,omitempty"`
 	ConflictToken int64                  `protobuf:"varint,3,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
+	// Whether the schedule is run by the state machine scheduler, in which case the
+	// schedule and info are as of the workflow's last check of its state.
+	Migrated      bool `protobuf:"varint,4,opt,name=migrated,proto3" json:"migrated,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -452,6 +491,13 @@ func (x *DescribeResponse) GetConflictToken() int64 {
 	return 0
 }
 
+func (x *DescribeResponse) GetMigrated() bool {
+	if x != nil {
+		return x.Migrated
+	}
+	return false
+}
+
 type WatchWorkflowRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// Note: this will be sent to the activity with empty execution.run_id, and
@@ -717,31 +763,30 @@ func (x *StartWorkflowResponse) GetRealStartTime() *timestamppb.Timestamp {
 	return nil
 }
 
-type CancelWorkflowRequest struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	RequestId string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Identity  string                 `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
-	// Note: run id in execution is first execution run id
-	Execution     *v12.WorkflowExecution `protobuf:"bytes,5,opt,name=execution,proto3" json:"execution,omitempty"`
-	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type SignalWorkflowRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Exactly one of signal_request and signal_with_start_request is set.
+	SignalRequest           *v14.SignalWorkflowExecutionRequest          `protobuf:"bytes,1,opt,name=signal_request,json=signalRequest,proto3" json:"signal_request,omitempty"`
+	SignalWithStartRequest  *v14.SignalWithStartWorkflowExecutionRequest `protobuf:"bytes,2,opt,name=signal_with_start_request,json=signalWithStartRequest,proto3" json:"signal_with_start_request,omitempty"`
+	CompletedRateLimitSleep bool                                         `protobuf:"varint,3,opt,name=completed_rate_limit_sleep,json=completedRateLimitSleep,proto3" json:"completed_rate_limit_sleep,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
-func (x *CancelWorkflowRequest) Reset() {
-	*x = CancelWorkflowRequest{}
+func (x *SignalWorkflowRequest) Reset() {
+	*x = SignalWorkflowRequest{}
 	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelWorkflowRequest) String() string {
+func (x *SignalWorkflowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelWorkflowRequest) ProtoMessage() {}
+func (*SignalWorkflowRequest) ProtoMessage() {}
 
-func (x *CancelWorkflowRequest) ProtoReflect() protoreflect.Message {
+func (x *SignalWorkflowRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -753,64 +798,55 @@ func (x *CancelWorkflowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelWorkflowRequest.ProtoReflect.Descriptor instead.
-func (*CancelWorkflowRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SignalWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*SignalWorkflowRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *CancelWorkflowRequest) GetRequestId() string {
-	if x != nil {
-		return x.RequestId
-	}
-	return ""
-}
-
-func (x *CancelWorkflowRequest) GetIdentity() string {
+func (x *SignalWorkflowRequest) GetSignalRequest() *v14.SignalWorkflowExecutionRequest {
 	if x != nil {
-		return x.Identity
+		return x.SignalRequest
 	}
-	return ""
+	return nil
 }
 
-func (x *CancelWorkflowRequest) GetExecution() *v12.WorkflowExecution {
+func (x *SignalWorkflowRequest) GetSignalWithStartRequest() *v14.SignalWithStartWorkflowExecutionRequest {
 	if x != nil {
-		return x.Execution
+		return x.SignalWithStartRequest
 	}
 	return nil
 }
 
-func (x *CancelWorkflowRequest) GetReason() string {
+func (x *SignalWorkflowRequest) GetCompletedRateLimitSleep() bool {
 	if x != nil {
-		return x.Reason
+		return x.CompletedRateLimitSleep
 	}
-	return ""
+	return false
 }
 
-type TerminateWorkflowRequest struct {
-	state     protoimpl.MessageState `protogen:"open.v1"`
-	RequestId string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	Identity  string                 `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
-	// Note: run id in execution is first execution run id
-	Execution     *v12.WorkflowExecution `protobuf:"bytes,5,opt,name=execution,proto3" json:"execution,omitempty"`
-	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+type SignalWorkflowResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Run id of the signaled workflow. Only set for signal-with-start.
+	RunId         string                 `protobuf:"bytes,1,opt,name=run_id,json=runId,proto3" json:"run_id,omitempty"`
+	RealTime      *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=real_time,json=realTime,proto3" json:"real_time,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TerminateWorkflowRequest) Reset() {
-	*x = TerminateWorkflowRequest{}
+func (x *SignalWorkflowResponse) Reset() {
+	*x = SignalWorkflowResponse{}
 	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TerminateWorkflowRequest) String() string {
+func (x *SignalWorkflowResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TerminateWorkflowRequest) ProtoMessage() {}
+func (*SignalWorkflowResponse) ProtoMessage() {}
 
-func (x *TerminateWorkflowRequest) ProtoReflect() protoreflect.Message {
+func (x *SignalWorkflowResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -822,70 +858,48 @@ func (x *TerminateWorkflowRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TerminateWorkflowRequest.ProtoReflect.Descriptor instead.
-func (*TerminateWorkflowRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SignalWorkflowResponse.ProtoReflect.Descriptor instead.
+func (*SignalWorkflowResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *TerminateWorkflowRequest) GetRequestId() string {
-	if x != nil {
-		return x.RequestId
-	}
-	return ""
-}
-
-func (x *TerminateWorkflowRequest) GetIdentity() string {
+func (x *SignalWorkflowResponse) GetRunId() string {
 	if x != nil {
-		return x.Identity
+		return x.RunId
 	}
 	return ""
 }
 
-func (x *TerminateWorkflowRequest) GetExecution() *v12.WorkflowExecution {
+func (x *SignalWorkflowResponse) GetRealTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Execution
+		return x.RealTime
 	}
 	return nil
 }
 
-func (x *TerminateWorkflowRequest) GetReason() string {
-	if x != nil {
-		return x.Reason
-	}
-	return ""
-}
-
-type NextTimeCache struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// workflow logic version (invalidate when changed)
-	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
-	// start time that the results were calculated from
-	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	// next_times and nominal_times are a series of timestamp pairs, encoded as a nanosecond
-	// offset from start_time. next_times has one value for each time in the cache.
-	// nominal_times may have up to the same number of values, but it may also be shorter (or
-	// empty), if the corresponding nominal time is equal to the next time.
-	NextTimes     []int64 `protobuf:"varint,3,rep,packed,name=next_times,json=nextTimes,proto3" json:"next_times,omitempty"`
-	NominalTimes  []int64 `protobuf:"varint,4,rep,packed,name=nominal_times,json=nominalTimes,proto3" json:"nominal_times,omitempty"`
-	Completed     bool    `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type StartNexusOperationRequest struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Operation               *NexusOperationAction  `protobuf:"bytes,1,opt,name=operation,proto3" json:"operation,omitempty"`
+	RequestId               string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	CompletedRateLimitSleep bool                   `protobuf:"varint,3,opt,name=completed_rate_limit_sleep,json=completedRateLimitSleep,proto3" json:"completed_rate_limit_sleep,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
-func (x *NextTimeCache) Reset() {
-	*x = NextTimeCache{}
+func (x *StartNexusOperationRequest) Reset() {
+	*x = StartNexusOperationRequest{}
 	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *NextTimeCache) String() string {
+func (x *StartNexusOperationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NextTimeCache) ProtoMessage() {}
+func (*StartNexusOperationRequest) ProtoMessage() {}
 
-func (x *NextTimeCache) ProtoReflect() protoreflect.Message {
+func (x *StartNexusOperationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -897,78 +911,55 @@ func (x *NextTimeCache) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NextTimeCache.ProtoReflect.Descriptor instead.
-func (*NextTimeCache) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartNexusOperationRequest.ProtoReflect.Descriptor instead.
+func (*StartNexusOperationRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *NextTimeCache) GetVersion() int64 {
-	if x != nil {
-		return x.Version
-	}
-	return 0
-}
-
-func (x *NextTimeCache) GetStartTime() *timestamppb.Timestamp {
-	if x != nil {
-		return x.StartTime
-	}
-	return nil
-}
-
-func (x *NextTimeCache) GetNextTimes() []int64 {
+func (x *StartNexusOperationRequest) GetOperation() *NexusOperationAction {
 	if x != nil {
-		return x.NextTimes
+		return x.Operation
 	}
 	return nil
 }
 
-func (x *NextTimeCache) GetNominalTimes() []int64 {
+func (x *StartNexusOperationRequest) GetRequestId() string {
 	if x != nil {
-		return x.NominalTimes
+		return x.RequestId
 	}
-	return nil
+	return ""
 }
 
-func (x *NextTimeCache) GetCompleted() bool {
+func (x *StartNexusOperationRequest) GetCompletedRateLimitSleep() bool {
 	if x != nil {
-		return x.Completed
+		return x.CompletedRateLimitSleep
 	}
 	return false
 }
 
-// State machine scheduler internal state.
-type SchedulerInternal struct {
+type StartNexusOperationResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Scheduler request parameters and metadata.
-	Schedule     *v11.Schedule      `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
-	Info         *v11.ScheduleInfo  `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
-	InitialPatch *v11.SchedulePatch `protobuf:"bytes,4,opt,name=initial_patch,json=initialPatch,proto3" json:"initial_patch,omitempty"`
-	// State common to all generators is stored in the top-level machine.
-	Namespace   string `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
-	NamespaceId string `protobuf:"bytes,6,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
-	ScheduleId  string `protobuf:"bytes,7,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
-	// Implemented as a sequence number. Used for optimistic locking against
-	// update requests.
-	ConflictToken int64 `protobuf:"varint,8,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	// Set if the operation was started asynchronously.
+	OperationToken string                 `protobuf:"bytes,1,opt,name=operation_token,json=operationToken,proto3" json:"operation_token,omitempty"`
+	RealTime       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=real_time,json=realTime,proto3" json:"real_time,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *SchedulerInternal) Reset() {
-	*x = SchedulerInternal{}
+func (x *StartNexusOperationResponse) Reset() {
+	*x = StartNexusOperationResponse{}
 	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SchedulerInternal) String() string {
+func (x *StartNexusOperationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SchedulerInternal) ProtoMessage() {}
+func (*StartNexusOperationResponse) ProtoMessage() {}
 
-func (x *SchedulerInternal) ProtoReflect() protoreflect.Message {
+func (x *StartNexusOperationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -980,85 +971,50 @@ func (x *SchedulerInternal) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SchedulerInternal.ProtoReflect.Descriptor instead.
-func (*SchedulerInternal) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartNexusOperationResponse.ProtoReflect.Descriptor instead.
+func (*StartNexusOperationResponse) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *SchedulerInternal) GetSchedule() *v11.Schedule {
-	if x != nil {
-		return x.Schedule
-	}
-	return nil
-}
-
-func (x *SchedulerInternal) GetInfo() *v11.ScheduleInfo {
-	if x != nil {
-		return x.Info
-	}
-	return nil
-}
-
-func (x *SchedulerInternal) GetInitialPatch() *v11.SchedulePatch {
-	if x != nil {
-		return x.InitialPatch
-	}
-	return nil
-}
-
-func (x *SchedulerInternal) GetNamespace() string {
-	if x != nil {
-		return x.Namespace
-	}
-	return ""
-}
-
-func (x *SchedulerInternal) GetNamespaceId() string {
+func (x *StartNexusOperationResponse) GetOperationToken() string {
 	if x != nil {
-		return x.NamespaceId
-	}
-	return ""
-}
-
-func (x *SchedulerInternal) GetScheduleId() string {
-	if x != nil {
-		return x.ScheduleId
+		return x.OperationToken
 	}
 	return ""
 }
 
-func (x *SchedulerInternal) GetConflictToken() int64 {
+func (x *StartNexusOperationResponse) GetRealTime() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ConflictToken
+		return x.RealTime
 	}
-	return 0
+	return nil
 }
 
-// State machine scheduler's Generator internal state.
-type GeneratorInternal struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Generator waits for the next_invocation_time before buffering more actions.
-	NextInvocationTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=next_invocation_time,json=nextInvocationTime,proto3" json:"next_invocation_time,omitempty"`
-	// High water mark.
-	LastProcessedTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_processed_time,json=lastProcessedTime,proto3" json:"last_processed_time,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type CancelWorkflowRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RequestId string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Identity  string                 `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	// Note: run id in execution is first execution run id
+	Execution     *v12.WorkflowExecution `protobuf:"bytes,5,opt,name=execution,proto3" json:"execution,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GeneratorInternal) Reset() {
-	*x = GeneratorInternal{}
+func (x *CancelWorkflowRequest) Reset() {
+	*x = CancelWorkflowRequest{}
 	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GeneratorInternal) String() string {
+func (x *CancelWorkflowRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GeneratorInternal) ProtoMessage() {}
+func (*CancelWorkflowRequest) ProtoMessage() {}
 
-func (x *GeneratorInternal) ProtoReflect() protoreflect.Message {
+func (x *CancelWorkflowRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1070,23 +1026,938 @@ func (x *GeneratorInternal) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GeneratorInternal.ProtoReflect.Descriptor instead.
-func (*GeneratorInternal) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*CancelWorkflowRequest) Descriptor() ([]byte, []int) {
 	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *GeneratorInternal) GetNextInvocationTime() *timestamppb.Timestamp {
+func (x *CancelWorkflowRequest) GetRequestId() string {
 	if x != nil {
-		return x.NextInvocationTime
+		return x.RequestId
 	}
-	return nil
+	return ""
 }
 
-func (x *GeneratorInternal) GetLastProcessedTime() *timestamppb.Timestamp {
+func (x *CancelWorkflowRequest) GetIdentity() string {
 	if x != nil {
-		return x.LastProcessedTime
+		return x.Identity
 	}
-	return nil
+	return ""
+}
+
+func (x *CancelWorkflowRequest) GetExecution() *v12.WorkflowExecution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *CancelWorkflowRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type TerminateWorkflowRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	RequestId string                 `protobuf:"bytes,3,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	Identity  string                 `protobuf:"bytes,4,opt,name=identity,proto3" json:"identity,omitempty"`
+	// Note: run id in execution is first execution run id
+	Execution     *v12.WorkflowExecution `protobuf:"bytes,5,opt,name=execution,proto3" json:"execution,omitempty"`
+	Reason        string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TerminateWorkflowRequest) Reset() {
+	*x = TerminateWorkflowRequest{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TerminateWorkflowRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TerminateWorkflowRequest) ProtoMessage() {}
+
+func (x *TerminateWorkflowRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TerminateWorkflowRequest.ProtoReflect.Descriptor instead.
+func (*TerminateWorkflowRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *TerminateWorkflowRequest) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
+func (x *TerminateWorkflowRequest) GetIdentity() string {
+	if x != nil {
+		return x.Identity
+	}
+	return ""
+}
+
+func (x *TerminateWorkflowRequest) GetExecution() *v12.WorkflowExecution {
+	if x != nil {
+		return x.Execution
+	}
+	return nil
+}
+
+func (x *TerminateWorkflowRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type GetExclusionCalendarsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Names         []string               `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExclusionCalendarsRequest) Reset() {
+	*x = GetExclusionCalendarsRequest{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExclusionCalendarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExclusionCalendarsRequest) ProtoMessage() {}
+
+func (x *GetExclusionCalendarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExclusionCalendarsRequest.ProtoReflect.Descriptor instead.
+func (*GetExclusionCalendarsRequest) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetExclusionCalendarsRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+type GetExclusionCalendarsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Calendars that were not found are omitted.
+	Calendars     []*ExclusionCalendar `protobuf:"bytes,1,rep,name=calendars,proto3" json:"calendars,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetExclusionCalendarsResponse) Reset() {
+	*x = GetExclusionCalendarsResponse{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetExclusionCalendarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetExclusionCalendarsResponse) ProtoMessage() {}
+
+func (x *GetExclusionCalendarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetExclusionCalendarsResponse.ProtoReflect.Descriptor instead.
+func (*GetExclusionCalendarsResponse) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetExclusionCalendarsResponse) GetCalendars() []*ExclusionCalendar {
+	if x != nil {
+		return x.Calendars
+	}
+	return nil
+}
+
+// A named calendar of days to exclude from schedules. Calendars are registered per
+// namespace and referenced by name from the exclude calendars of a schedule spec.
+type ExclusionCalendar struct {
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Name        string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Days excluded by this calendar, e.g. holidays.
+	Dates []*ExclusionCalendarDate `protobuf:"bytes,3,rep,name=dates,proto3" json:"dates,omitempty"`
+	// If set, only these business days of each month are allowed and all other days are
+	// excluded. Positive values count from the start of the month (1 is the first business
+	// day), negative values from the end (-1 is the last business day).
+	BusinessDaysOfMonth []int32 `protobuf:"varint,4,rep,packed,name=business_days_of_month,json=businessDaysOfMonth,proto3" json:"business_days_of_month,omitempty"`
+	// Days of the week that are not business days, from 0 (Sunday) to 6 (Saturday).
+	// Defaults to Saturday and Sunday. Days in dates are never business days.
+	WeekendDays       []int32                `protobuf:"varint,5,rep,packed,name=weekend_days,json=weekendDays,proto3" json:"weekend_days,omitempty"`
+	UpdateTime        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=update_time,json=updateTime,proto3" json:"update_time,omitempty"`
+	UpdatedByIdentity string                 `protobuf:"bytes,7,opt,name=updated_by_identity,json=updatedByIdentity,proto3" json:"updated_by_identity,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ExclusionCalendar) Reset() {
+	*x = ExclusionCalendar{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExclusionCalendar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExclusionCalendar) ProtoMessage() {}
+
+func (x *ExclusionCalendar) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExclusionCalendar.ProtoReflect.Descriptor instead.
+func (*ExclusionCalendar) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ExclusionCalendar) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ExclusionCalendar) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *ExclusionCalendar) GetDates() []*ExclusionCalendarDate {
+	if x != nil {
+		return x.Dates
+	}
+	return nil
+}
+
+func (x *ExclusionCalendar) GetBusinessDaysOfMonth() []int32 {
+	if x != nil {
+		return x.BusinessDaysOfMonth
+	}
+	return nil
+}
+
+func (x *ExclusionCalendar) GetWeekendDays() []int32 {
+	if x != nil {
+		return x.WeekendDays
+	}
+	return nil
+}
+
+func (x *ExclusionCalendar) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+func (x *ExclusionCalendar) GetUpdatedByIdentity() string {
+	if x != nil {
+		return x.UpdatedByIdentity
+	}
+	return ""
+}
+
+type ExclusionCalendarDate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Year  int32                  `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// 1 to 12
+	Month         int32  `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	Day           int32  `protobuf:"varint,3,opt,name=day,proto3" json:"day,omitempty"`
+	Comment       string `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExclusionCalendarDate) Reset() {
+	*x = ExclusionCalendarDate{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExclusionCalendarDate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExclusionCalendarDate) ProtoMessage() {}
+
+func (x *ExclusionCalendarDate) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExclusionCalendarDate.ProtoReflect.Descriptor instead.
+func (*ExclusionCalendarDate) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ExclusionCalendarDate) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *ExclusionCalendarDate) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *ExclusionCalendarDate) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *ExclusionCalendarDate) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+// An action of a schedule that the public ScheduleAction can't express. It's carried in the
+// header of the schedule's start_workflow action, under the key "temporal-schedule-action".
+// The workflow id of start_workflow identifies the workflow to signal; the remaining fields of
+// start_workflow are only used to start the workflow for signal-with-start.
+type ScheduleActionExtension struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Action:
+	//
+	//	*ScheduleActionExtension_SignalWorkflow
+	//	*ScheduleActionExtension_NexusOperation
+	Action        isScheduleActionExtension_Action `protobuf_oneof:"action"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ScheduleActionExtension) Reset() {
+	*x = ScheduleActionExtension{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ScheduleActionExtension) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ScheduleActionExtension) ProtoMessage() {}
+
+func (x *ScheduleActionExtension) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ScheduleActionExtension.ProtoReflect.Descriptor instead.
+func (*ScheduleActionExtension) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ScheduleActionExtension) GetAction() isScheduleActionExtension_Action {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+func (x *ScheduleActionExtension) GetSignalWorkflow() *SignalWorkflowAction {
+	if x != nil {
+		if x, ok := x.Action.(*ScheduleActionExtension_SignalWorkflow); ok {
+			return x.SignalWorkflow
+		}
+	}
+	return nil
+}
+
+func (x *ScheduleActionExtension) GetNexusOperation() *NexusOperationAction {
+	if x != nil {
+		if x, ok := x.Action.(*ScheduleActionExtension_NexusOperation); ok {
+			return x.NexusOperation
+		}
+	}
+	return nil
+}
+
+type isScheduleActionExtension_Action interface {
+	isScheduleActionExtension_Action()
+}
+
+type ScheduleActionExtension_SignalWorkflow struct {
+	SignalWorkflow *SignalWorkflowAction `protobuf:"bytes,1,opt,name=signal_workflow,json=signalWorkflow,proto3,oneof"`
+}
+
+type ScheduleActionExtension_NexusOperation struct {
+	NexusOperation *NexusOperationAction `protobuf:"bytes,2,opt,name=nexus_operation,json=nexusOperation,proto3,oneof"`
+}
+
+func (*ScheduleActionExtension_SignalWorkflow) isScheduleActionExtension_Action() {}
+
+func (*ScheduleActionExtension_NexusOperation) isScheduleActionExtension_Action() {}
+
+type SignalWorkflowAction struct {
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	SignalName string                 `protobuf:"bytes,1,opt,name=signal_name,json=signalName,proto3" json:"signal_name,omitempty"`
+	Input      *v12.Payloads          `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	// If true, the workflow is started with the signal if it isn't running (signal-with-start).
+	// Otherwise the action fails if the workflow isn't running.
+	StartIfNotRunning bool `protobuf:"varint,3,opt,name=start_if_not_running,json=startIfNotRunning,proto3" json:"start_if_not_running,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SignalWorkflowAction) Reset() {
+	*x = SignalWorkflowAction{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignalWorkflowAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignalWorkflowAction) ProtoMessage() {}
+
+func (x *SignalWorkflowAction) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignalWorkflowAction.ProtoReflect.Descriptor instead.
+func (*SignalWorkflowAction) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SignalWorkflowAction) GetSignalName() string {
+	if x != nil {
+		return x.SignalName
+	}
+	return ""
+}
+
+func (x *SignalWorkflowAction) GetInput() *v12.Payloads {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *SignalWorkflowAction) GetStartIfNotRunning() bool {
+	if x != nil {
+		return x.StartIfNotRunning
+	}
+	return false
+}
+
+type NexusOperationAction struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Name of a registered Nexus endpoint.
+	Endpoint      string            `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Service       string            `protobuf:"bytes,2,opt,name=service,proto3" json:"service,omitempty"`
+	Operation     string            `protobuf:"bytes,3,opt,name=operation,proto3" json:"operation,omitempty"`
+	Input         *v12.Payload      `protobuf:"bytes,4,opt,name=input,proto3" json:"input,omitempty"`
+	NexusHeader   map[string]string `protobuf:"bytes,5,rep,name=nexus_header,json=nexusHeader,proto3" json:"nexus_header,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NexusOperationAction) Reset() {
+	*x = NexusOperationAction{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NexusOperationAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NexusOperationAction) ProtoMessage() {}
+
+func (x *NexusOperationAction) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NexusOperationAction.ProtoReflect.Descriptor instead.
+func (*NexusOperationAction) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *NexusOperationAction) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *NexusOperationAction) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *NexusOperationAction) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *NexusOperationAction) GetInput() *v12.Payload {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *NexusOperationAction) GetNexusHeader() map[string]string {
+	if x != nil {
+		return x.NexusHeader
+	}
+	return nil
+}
+
+// NexusOperationActionResult records a Nexus operation started by a schedule. The
+// ScheduleActionResult of the action only has its times, since it describes workflows.
+type NexusOperationActionResult struct {
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	ScheduleTime *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=schedule_time,json=scheduleTime,proto3" json:"schedule_time,omitempty"`
+	ActualTime   *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=actual_time,json=actualTime,proto3" json:"actual_time,omitempty"`
+	Endpoint     string                 `protobuf:"bytes,3,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Service      string                 `protobuf:"bytes,4,opt,name=service,proto3" json:"service,omitempty"`
+	Operation    string                 `protobuf:"bytes,5,opt,name=operation,proto3" json:"operation,omitempty"`
+	// Set if the operation was started asynchronously.
+	OperationToken string `protobuf:"bytes,6,opt,name=operation_token,json=operationToken,proto3" json:"operation_token,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *NexusOperationActionResult) Reset() {
+	*x = NexusOperationActionResult{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NexusOperationActionResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NexusOperationActionResult) ProtoMessage() {}
+
+func (x *NexusOperationActionResult) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NexusOperationActionResult.ProtoReflect.Descriptor instead.
+func (*NexusOperationActionResult) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *NexusOperationActionResult) GetScheduleTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ScheduleTime
+	}
+	return nil
+}
+
+func (x *NexusOperationActionResult) GetActualTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ActualTime
+	}
+	return nil
+}
+
+func (x *NexusOperationActionResult) GetEndpoint() string {
+	if x != nil {
+		return x.Endpoint
+	}
+	return ""
+}
+
+func (x *NexusOperationActionResult) GetService() string {
+	if x != nil {
+		return x.Service
+	}
+	return ""
+}
+
+func (x *NexusOperationActionResult) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *NexusOperationActionResult) GetOperationToken() string {
+	if x != nil {
+		return x.OperationToken
+	}
+	return ""
+}
+
+type NextTimeCache struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// workflow logic version (invalidate when changed)
+	Version int64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
+	// start time that the results were calculated from
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// next_times and nominal_times are a series of timestamp pairs, encoded as a nanosecond
+	// offset from start_time. next_times has one value for each time in the cache.
+	// nominal_times may have up to the same number of values, but it may also be shorter (or
+	// empty), if the corresponding nominal time is equal to the next time.
+	NextTimes     []int64 `protobuf:"varint,3,rep,packed,name=next_times,json=nextTimes,proto3" json:"next_times,omitempty"`
+	NominalTimes  []int64 `protobuf:"varint,4,rep,packed,name=nominal_times,json=nominalTimes,proto3" json:"nominal_times,omitempty"`
+	Completed     bool    `protobuf:"varint,5,opt,name=completed,proto3" json:"completed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NextTimeCache) Reset() {
+	*x = NextTimeCache{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NextTimeCache) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NextTimeCache) ProtoMessage() {}
+
+func (x *NextTimeCache) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NextTimeCache.ProtoReflect.Descriptor instead.
+func (*NextTimeCache) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *NextTimeCache) GetVersion() int64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+func (x *NextTimeCache) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *NextTimeCache) GetNextTimes() []int64 {
+	if x != nil {
+		return x.NextTimes
+	}
+	return nil
+}
+
+func (x *NextTimeCache) GetNominalTimes() []int64 {
+	if x != nil {
+		return x.NominalTimes
+	}
+	return nil
+}
+
+func (x *NextTimeCache) GetCompleted() bool {
+	if x != nil {
+		return x.Completed
+	}
+	return false
+}
+
+// State machine scheduler internal state.
+type SchedulerInternal struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Scheduler request parameters and metadata.
+	Schedule     *v11.Schedule      `protobuf:"bytes,2,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	Info         *v11.ScheduleInfo  `protobuf:"bytes,3,opt,name=info,proto3" json:"info,omitempty"`
+	InitialPatch *v11.SchedulePatch `protobuf:"bytes,4,opt,name=initial_patch,json=initialPatch,proto3" json:"initial_patch,omitempty"`
+	// State common to all generators is stored in the top-level machine.
+	Namespace   string `protobuf:"bytes,5,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	NamespaceId string `protobuf:"bytes,6,opt,name=namespace_id,json=namespaceId,proto3" json:"namespace_id,omitempty"`
+	ScheduleId  string `protobuf:"bytes,7,opt,name=schedule_id,json=scheduleId,proto3" json:"schedule_id,omitempty"`
+	// Implemented as a sequence number. Used for optimistic locking against
+	// update requests.
+	ConflictToken int64 `protobuf:"varint,8,opt,name=conflict_token,json=conflictToken,proto3" json:"conflict_token,omitempty"`
+	// Last completion/failure, carried over when a schedule is migrated from the
+	// scheduler workflow.
+	LastCompletionResult *v12.Payloads `protobuf:"bytes,9,opt,name=last_completion_result,json=lastCompletionResult,proto3" json:"last_completion_result,omitempty"`
+	ContinuedFailure     *v13.Failure  `protobuf:"bytes,10,opt,name=continued_failure,json=continuedFailure,proto3" json:"continued_failure,omitempty"`
+	// Most recent Nexus operations started by the schedule's actions, kept like
+	// info.recent_actions.
+	RecentNexusOperations []*NexusOperationActionResult `protobuf:"bytes,11,rep,name=recent_nexus_operations,json=recentNexusOperations,proto3" json:"recent_nexus_operations,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *SchedulerInternal) Reset() {
+	*x = SchedulerInternal{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulerInternal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulerInternal) ProtoMessage() {}
+
+func (x *SchedulerInternal) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulerInternal.ProtoReflect.Descriptor instead.
+func (*SchedulerInternal) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SchedulerInternal) GetSchedule() *v11.Schedule {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *SchedulerInternal) GetInfo() *v11.ScheduleInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+func (x *SchedulerInternal) GetInitialPatch() *v11.SchedulePatch {
+	if x != nil {
+		return x.InitialPatch
+	}
+	return nil
+}
+
+func (x *SchedulerInternal) GetNamespace() string {
+	if x != nil {
+		return x.Namespace
+	}
+	return ""
+}
+
+func (x *SchedulerInternal) GetNamespaceId() string {
+	if x != nil {
+		return x.NamespaceId
+	}
+	return ""
+}
+
+func (x *SchedulerInternal) GetScheduleId() string {
+	if x != nil {
+		return x.ScheduleId
+	}
+	return ""
+}
+
+func (x *SchedulerInternal) GetConflictToken() int64 {
+	if x != nil {
+		return x.ConflictToken
+	}
+	return 0
+}
+
+func (x *SchedulerInternal) GetLastCompletionResult() *v12.Payloads {
+	if x != nil {
+		return x.LastCompletionResult
+	}
+	return nil
+}
+
+func (x *SchedulerInternal) GetContinuedFailure() *v13.Failure {
+	if x != nil {
+		return x.ContinuedFailure
+	}
+	return nil
+}
+
+func (x *SchedulerInternal) GetRecentNexusOperations() []*NexusOperationActionResult {
+	if x != nil {
+		return x.RecentNexusOperations
+	}
+	return nil
+}
+
+// State machine scheduler's Generator internal state.
+type GeneratorInternal struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Generator waits for the next_invocation_time before buffering more actions.
+	NextInvocationTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=next_invocation_time,json=nextInvocationTime,proto3" json:"next_invocation_time,omitempty"`
+	// High water mark.
+	LastProcessedTime *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_processed_time,json=lastProcessedTime,proto3" json:"last_processed_time,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GeneratorInternal) Reset() {
+	*x = GeneratorInternal{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GeneratorInternal) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GeneratorInternal) ProtoMessage() {}
+
+func (x *GeneratorInternal) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GeneratorInternal.ProtoReflect.Descriptor instead.
+func (*GeneratorInternal) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GeneratorInternal) GetNextInvocationTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.NextInvocationTime
+	}
+	return nil
+}
+
+func (x *GeneratorInternal) GetLastProcessedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastProcessedTime
+	}
+	return nil
 }
 
 // State machine scheduler's Invoker internal state.
@@ -1111,7 +1982,7 @@ type InvokerInternal struct {
 
 func (x *InvokerInternal) Reset() {
 	*x = InvokerInternal{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[14]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1123,7 +1994,7 @@ func (x *InvokerInternal) String() string {
 func (*InvokerInternal) ProtoMessage() {}
 
 func (x *InvokerInternal) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[14]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1136,7 +2007,7 @@ func (x *InvokerInternal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InvokerInternal.ProtoReflect.Descriptor instead.
 func (*InvokerInternal) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{14}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *InvokerInternal) GetState() v15.SchedulerInvokerState {
@@ -1175,19 +2046,34 @@ func (x *InvokerInternal) GetLastProcessedTime() *timestamppb.Timestamp {
 }
 
 // State machine scheduler's Backfiller internal state. Backfill requests are 1:1
-// with Backfiller nodes.
+// with Backfiller nodes. Trigger immediately requests are handled as backfills of
+// a single action.
 type BackfillerInternal struct {
-	state   protoimpl.MessageState `protogen:"open.v1"`
-	Request *v11.BackfillRequest   `protobuf:"bytes,1,opt,name=request,proto3" json:"request,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Request:
+	//
+	//	*BackfillerInternal_BackfillRequest
+	//	*BackfillerInternal_TriggerRequest
+	Request isBackfillerInternal_Request `protobuf_oneof:"request"`
 	// Backfiller waits for the next_invocation_time before buffering more actions.
 	NextInvocationTime *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=next_invocation_time,json=nextInvocationTime,proto3" json:"next_invocation_time,omitempty"`
-	unknownFields      protoimpl.UnknownFields
-	sizeCache          protoimpl.SizeCache
+	// Unique ID of the backfill, used to generate the request IDs of the buffered
+	// starts, which may overlap with both automatically-buffered starts and the
+	// starts of other backfills.
+	BackfillId string `protobuf:"bytes,4,opt,name=backfill_id,json=backfillId,proto3" json:"backfill_id,omitempty"`
+	// High water mark. Large backfills are buffered incrementally, and resume from
+	// last_processed_time (exclusive) on each invocation.
+	LastProcessedTime *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_processed_time,json=lastProcessedTime,proto3" json:"last_processed_time,omitempty"`
+	// Number of consecutive invocations that were unable to buffer actions because
+	// the Invoker's buffer was full, used to back off.
+	Attempt       int64 `protobuf:"varint,6,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *BackfillerInternal) Reset() {
 	*x = BackfillerInternal{}
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[15]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1199,7 +2085,7 @@ func (x *BackfillerInternal) String() string {
 func (*BackfillerInternal) ProtoMessage() {}
 
 func (x *BackfillerInternal) ProtoReflect() protoreflect.Message {
-	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[15]
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1212,16 +2098,34 @@ func (x *BackfillerInternal) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BackfillerInternal.ProtoReflect.Descriptor instead.
 func (*BackfillerInternal) Descriptor() ([]byte, []int) {
-	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{15}
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *BackfillerInternal) GetRequest() *v11.BackfillRequest {
+func (x *BackfillerInternal) GetRequest() isBackfillerInternal_Request {
 	if x != nil {
 		return x.Request
 	}
 	return nil
 }
 
+func (x *BackfillerInternal) GetBackfillRequest() *v11.BackfillRequest {
+	if x != nil {
+		if x, ok := x.Request.(*BackfillerInternal_BackfillRequest); ok {
+			return x.BackfillRequest
+		}
+	}
+	return nil
+}
+
+func (x *BackfillerInternal) GetTriggerRequest() *v11.TriggerImmediatelyRequest {
+	if x != nil {
+		if x, ok := x.Request.(*BackfillerInternal_TriggerRequest); ok {
+			return x.TriggerRequest
+		}
+	}
+	return nil
+}
+
 func (x *BackfillerInternal) GetNextInvocationTime() *timestamppb.Timestamp {
 	if x != nil {
 		return x.NextInvocationTime
@@ -1229,6 +2133,131 @@ func (x *BackfillerInternal) GetNextInvocationTime() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *BackfillerInternal) GetBackfillId() string {
+	if x != nil {
+		return x.BackfillId
+	}
+	return ""
+}
+
+func (x *BackfillerInternal) GetLastProcessedTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastProcessedTime
+	}
+	return nil
+}
+
+func (x *BackfillerInternal) GetAttempt() int64 {
+	if x != nil {
+		return x.Attempt
+	}
+	return 0
+}
+
+type isBackfillerInternal_Request interface {
+	isBackfillerInternal_Request()
+}
+
+type BackfillerInternal_BackfillRequest struct {
+	BackfillRequest *v11.BackfillRequest `protobuf:"bytes,1,opt,name=backfill_request,json=backfillRequest,proto3,oneof"`
+}
+
+type BackfillerInternal_TriggerRequest struct {
+	TriggerRequest *v11.TriggerImmediatelyRequest `protobuf:"bytes,3,opt,name=trigger_request,json=triggerRequest,proto3,oneof"`
+}
+
+func (*BackfillerInternal_BackfillRequest) isBackfillerInternal_Request() {}
+
+func (*BackfillerInternal_TriggerRequest) isBackfillerInternal_Request() {}
+
+// SchedulerMigrationState is a complete snapshot of a schedule, used to migrate a
+// schedule between the scheduler workflow and the state machine scheduler.
+type SchedulerMigrationState struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Scheduler *SchedulerInternal     `protobuf:"bytes,1,opt,name=scheduler,proto3" json:"scheduler,omitempty"`
+	Generator *GeneratorInternal     `protobuf:"bytes,2,opt,name=generator,proto3" json:"generator,omitempty"`
+	Invoker   *InvokerInternal       `protobuf:"bytes,3,opt,name=invoker,proto3" json:"invoker,omitempty"`
+	// One for each ongoing backfill or trigger immediately request.
+	Backfillers []*BackfillerInternal `protobuf:"bytes,4,rep,name=backfillers,proto3" json:"backfillers,omitempty"`
+	// Memo and search attributes of the schedule's execution.
+	Memo             *v12.Memo             `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+	SearchAttributes *v12.SearchAttributes `protobuf:"bytes,6,opt,name=search_attributes,json=searchAttributes,proto3" json:"search_attributes,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *SchedulerMigrationState) Reset() {
+	*x = SchedulerMigrationState{}
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SchedulerMigrationState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SchedulerMigrationState) ProtoMessage() {}
+
+func (x *SchedulerMigrationState) ProtoReflect() protoreflect.Message {
+	mi := &file_temporal_server_api_schedule_v1_message_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SchedulerMigrationState.ProtoReflect.Descriptor instead.
+func (*SchedulerMigrationState) Descriptor() ([]byte, []int) {
+	return file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SchedulerMigrationState) GetScheduler() *SchedulerInternal {
+	if x != nil {
+		return x.Scheduler
+	}
+	return nil
+}
+
+func (x *SchedulerMigrationState) GetGenerator() *GeneratorInternal {
+	if x != nil {
+		return x.Generator
+	}
+	return nil
+}
+
+func (x *SchedulerMigrationState) GetInvoker() *InvokerInternal {
+	if x != nil {
+		return x.Invoker
+	}
+	return nil
+}
+
+func (x *SchedulerMigrationState) GetBackfillers() []*BackfillerInternal {
+	if x != nil {
+		return x.Backfillers
+	}
+	return nil
+}
+
+func (x *SchedulerMigrationState) GetMemo() *v12.Memo {
+	if x != nil {
+		return x.Memo
+	}
+	return nil
+}
+
+func (x *SchedulerMigrationState) GetSearchAttributes() *v12.SearchAttributes {
+	if x != nil {
+		return x.SearchAttributes
+	}
+	return nil
+}
+
 var File_temporal_server_api_schedule_v1_message_proto protoreflect.FileDescriptor
 
 const file_temporal_server_api_schedule_v1_message_proto_rawDesc = "" +
@@ -1244,7 +2273,7 @@ const file_temporal_server_api_schedule_v1_message_proto_rawDesc = "" +
 	"\n" +
 	"request_id\x18\x06 \x01(\tR\trequestId\x12\x18\n" +
 	"\aattempt\x18\a \x01(\x03R\aattempt\x12=\n" +
-	"\fbackoff_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vbackoffTime\"\xdf\x04\n" +
+	"\fbackoff_time\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\vbackoffTime\"\xe3\x06\n" +
 	"\rInternalState\x12\x1c\n" +
 	"\tnamespace\x18\x01 \x01(\tR\tnamespace\x12!\n" +
 	"\fnamespace_id\x18\x02 \x01(\tR\vnamespaceId\x12\x1f\n" +
@@ -1257,20 +2286,25 @@ const file_temporal_server_api_schedule_v1_message_proto_rawDesc = "" +
 	"\x16last_completion_result\x18\x05 \x01(\v2 .temporal.api.common.v1.PayloadsR\x14lastCompletionResult\x12M\n" +
 	"\x11continued_failure\x18\x06 \x01(\v2 .temporal.api.failure.v1.FailureR\x10continuedFailure\x12%\n" +
 	"\x0econflict_token\x18\a \x01(\x03R\rconflictToken\x12!\n" +
-	"\fneed_refresh\x18\t \x01(\bR\vneedRefresh\"\xa3\x02\n" +
+	"\fneed_refresh\x18\t \x01(\bR\vneedRefresh\x12c\n" +
+	"\x13exclusion_calendars\x18\v \x03(\v22.temporal.server.api.schedule.v1.ExclusionCalendarR\x12exclusionCalendars\x12c\n" +
+	" exclusion_calendars_refresh_time\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\x1dexclusionCalendarsRefreshTime\x128\n" +
+	"\x18exclusion_calendar_names\x18\r \x03(\tR\x16exclusionCalendarNames\"\xa3\x02\n" +
 	"\x11StartScheduleArgs\x12>\n" +
 	"\bschedule\x18\x01 \x01(\v2\".temporal.api.schedule.v1.ScheduleR\bschedule\x12:\n" +
 	"\x04info\x18\x02 \x01(\v2&.temporal.api.schedule.v1.ScheduleInfoR\x04info\x12L\n" +
 	"\rinitial_patch\x18\x03 \x01(\v2'.temporal.api.schedule.v1.SchedulePatchR\finitialPatch\x12D\n" +
-	"\x05state\x18\x04 \x01(\v2..temporal.server.api.schedule.v1.InternalStateR\x05state\"\xd1\x01\n" +
+	"\x05state\x18\x04 \x01(\v2..temporal.server.api.schedule.v1.InternalStateR\x05state\"\x8b\x02\n" +
 	"\x11FullUpdateRequest\x12>\n" +
 	"\bschedule\x18\x01 \x01(\v2\".temporal.api.schedule.v1.ScheduleR\bschedule\x12%\n" +
 	"\x0econflict_token\x18\x02 \x01(\x03R\rconflictToken\x12U\n" +
-	"\x11search_attributes\x18\x03 \x01(\v2(.temporal.api.common.v1.SearchAttributesR\x10searchAttributes\"\xb5\x01\n" +
+	"\x11search_attributes\x18\x03 \x01(\v2(.temporal.api.common.v1.SearchAttributesR\x10searchAttributes\x128\n" +
+	"\x18exclusion_calendar_names\x18\x04 \x03(\tR\x16exclusionCalendarNames\"\xd1\x01\n" +
 	"\x10DescribeResponse\x12>\n" +
 	"\bschedule\x18\x01 \x01(\v2\".temporal.api.schedule.v1.ScheduleR\bschedule\x12:\n" +
 	"\x04info\x18\x02 \x01(\v2&.temporal.api.schedule.v1.ScheduleInfoR\x04info\x12%\n" +
-	"\x0econflict_token\x18\x03 \x01(\x03R\rconflictToken\"\xb1\x01\n" +
+	"\x0econflict_token\x18\x03 \x01(\x03R\rconflictToken\x12\x1a\n" +
+	"\bmigrated\x18\x04 \x01(\bR\bmigrated\"\xb1\x01\n" +
 	"\x14WatchWorkflowRequest\x12G\n" +
 	"\texecution\x18\x03 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x123\n" +
 	"\x16first_execution_run_id\x18\x04 \x01(\tR\x13firstExecutionRunId\x12\x1b\n" +
@@ -1287,7 +2321,22 @@ const file_temporal_server_api_schedule_v1_message_proto_rawDesc = "" +
 	"\x1acompleted_rate_limit_sleep\x18\x06 \x01(\bR\x17completedRateLimitSleepJ\x04\b\x03\x10\x04J\x04\b\x04\x10\x05J\x04\b\x05\x10\x06\"r\n" +
 	"\x15StartWorkflowResponse\x12\x15\n" +
 	"\x06run_id\x18\x01 \x01(\tR\x05runId\x12B\n" +
-	"\x0freal_start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\rrealStartTime\"\xb3\x01\n" +
+	"\x0freal_start_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\rrealStartTime\"\xc2\x02\n" +
+	"\x15SignalWorkflowRequest\x12f\n" +
+	"\x0esignal_request\x18\x01 \x01(\v2?.temporal.api.workflowservice.v1.SignalWorkflowExecutionRequestR\rsignalRequest\x12\x83\x01\n" +
+	"\x19signal_with_start_request\x18\x02 \x01(\v2H.temporal.api.workflowservice.v1.SignalWithStartWorkflowExecutionRequestR\x16signalWithStartRequest\x12;\n" +
+	"\x1acompleted_rate_limit_sleep\x18\x03 \x01(\bR\x17completedRateLimitSleep\"h\n" +
+	"\x16SignalWorkflowResponse\x12\x15\n" +
+	"\x06run_id\x18\x01 \x01(\tR\x05runId\x127\n" +
+	"\treal_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\brealTime\"\xcd\x01\n" +
+	"\x1aStartNexusOperationRequest\x12S\n" +
+	"\toperation\x18\x01 \x01(\v25.temporal.server.api.schedule.v1.NexusOperationActionR\toperation\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x02 \x01(\tR\trequestId\x12;\n" +
+	"\x1acompleted_rate_limit_sleep\x18\x03 \x01(\bR\x17completedRateLimitSleep\"\x7f\n" +
+	"\x1bStartNexusOperationResponse\x12'\n" +
+	"\x0foperation_token\x18\x01 \x01(\tR\x0eoperationToken\x127\n" +
+	"\treal_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\brealTime\"\xb3\x01\n" +
 	"\x15CancelWorkflowRequest\x12\x1d\n" +
 	"\n" +
 	"request_id\x18\x03 \x01(\tR\trequestId\x12\x1a\n" +
@@ -1299,7 +2348,51 @@ const file_temporal_server_api_schedule_v1_message_proto_rawDesc = "" +
 	"request_id\x18\x03 \x01(\tR\trequestId\x12\x1a\n" +
 	"\bidentity\x18\x04 \x01(\tR\bidentity\x12G\n" +
 	"\texecution\x18\x05 \x01(\v2).temporal.api.common.v1.WorkflowExecutionR\texecution\x12\x16\n" +
-	"\x06reason\x18\x06 \x01(\tR\x06reason\"\xc6\x01\n" +
+	"\x06reason\x18\x06 \x01(\tR\x06reason\"4\n" +
+	"\x1cGetExclusionCalendarsRequest\x12\x14\n" +
+	"\x05names\x18\x01 \x03(\tR\x05names\"q\n" +
+	"\x1dGetExclusionCalendarsResponse\x12P\n" +
+	"\tcalendars\x18\x01 \x03(\v22.temporal.server.api.schedule.v1.ExclusionCalendarR\tcalendars\"\xdc\x02\n" +
+	"\x11ExclusionCalendar\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12L\n" +
+	"\x05dates\x18\x03 \x03(\v26.temporal.server.api.schedule.v1.ExclusionCalendarDateR\x05dates\x123\n" +
+	"\x16business_days_of_month\x18\x04 \x03(\x05R\x13businessDaysOfMonth\x12!\n" +
+	"\fweekend_days\x18\x05 \x03(\x05R\vweekendDays\x12;\n" +
+	"\vupdate_time\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime\x12.\n" +
+	"\x13updated_by_identity\x18\a \x01(\tR\x11updatedByIdentity\"m\n" +
+	"\x15ExclusionCalendarDate\x12\x12\n" +
+	"\x04year\x18\x01 \x01(\x05R\x04year\x12\x14\n" +
+	"\x05month\x18\x02 \x01(\x05R\x05month\x12\x10\n" +
+	"\x03day\x18\x03 \x01(\x05R\x03day\x12\x18\n" +
+	"\acomment\x18\x04 \x01(\tR\acomment\"\xe7\x01\n" +
+	"\x17ScheduleActionExtension\x12`\n" +
+	"\x0fsignal_workflow\x18\x01 \x01(\v25.temporal.server.api.schedule.v1.SignalWorkflowActionH\x00R\x0esignalWorkflow\x12`\n" +
+	"\x0fnexus_operation\x18\x02 \x01(\v25.temporal.server.api.schedule.v1.NexusOperationActionH\x00R\x0enexusOperationB\b\n" +
+	"\x06action\"\xa0\x01\n" +
+	"\x14SignalWorkflowAction\x12\x1f\n" +
+	"\vsignal_name\x18\x01 \x01(\tR\n" +
+	"signalName\x126\n" +
+	"\x05input\x18\x02 \x01(\v2 .temporal.api.common.v1.PayloadsR\x05input\x12/\n" +
+	"\x14start_if_not_running\x18\x03 \x01(\bR\x11startIfNotRunning\"\xcc\x02\n" +
+	"\x14NexusOperationAction\x12\x1a\n" +
+	"\bendpoint\x18\x01 \x01(\tR\bendpoint\x12\x18\n" +
+	"\aservice\x18\x02 \x01(\tR\aservice\x12\x1c\n" +
+	"\toperation\x18\x03 \x01(\tR\toperation\x125\n" +
+	"\x05input\x18\x04 \x01(\v2\x1f.temporal.api.common.v1.PayloadR\x05input\x12i\n" +
+	"\fnexus_header\x18\x05 \x03(\v2F.temporal.server.api.schedule.v1.NexusOperationAction.NexusHeaderEntryR\vnexusHeader\x1a>\n" +
+	"\x10NexusHeaderEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\x97\x02\n" +
+	"\x1aNexusOperationActionResult\x12?\n" +
+	"\rschedule_time\x18\x01 \x01(\v2\x1a.google.protobuf.TimestampR\fscheduleTime\x12;\n" +
+	"\vactual_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"actualTime\x12\x1a\n" +
+	"\bendpoint\x18\x03 \x01(\tR\bendpoint\x12\x18\n" +
+	"\aservice\x18\x04 \x01(\tR\aservice\x12\x1c\n" +
+	"\toperation\x18\x05 \x01(\tR\toperation\x12'\n" +
+	"\x0foperation_token\x18\x06 \x01(\tR\x0eoperationToken\"\xc6\x01\n" +
 	"\rNextTimeCache\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\x03R\aversion\x129\n" +
 	"\n" +
@@ -1307,7 +2400,7 @@ const file_temporal_server_api_schedule_v1_message_proto_rawDesc = "" +
 	"\n" +
 	"next_times\x18\x03 \x03(\x03R\tnextTimes\x12#\n" +
 	"\rnominal_times\x18\x04 \x03(\x03R\fnominalTimes\x12\x1c\n" +
-	"\tcompleted\x18\x05 \x01(\bR\tcompleted\"\xe6\x02\n" +
+	"\tcompleted\x18\x05 \x01(\bR\tcompleted\"\x82\x05\n" +
 	"\x11SchedulerInternal\x12>\n" +
 	"\bschedule\x18\x02 \x01(\v2\".temporal.api.schedule.v1.ScheduleR\bschedule\x12:\n" +
 	"\x04info\x18\x03 \x01(\v2&.temporal.api.schedule.v1.ScheduleInfoR\x04info\x12L\n" +
@@ -1316,7 +2409,11 @@ const file_temporal_server_api_schedule_v1_message_proto_rawDesc = "" +
 	"\fnamespace_id\x18\x06 \x01(\tR\vnamespaceId\x12\x1f\n" +
 	"\vschedule_id\x18\a \x01(\tR\n" +
 	"scheduleId\x12%\n" +
-	"\x0econflict_token\x18\b \x01(\x03R\rconflictToken\"\xad\x01\n" +
+	"\x0econflict_token\x18\b \x01(\x03R\rconflictToken\x12V\n" +
+	"\x16last_completion_result\x18\t \x01(\v2 .temporal.api.common.v1.PayloadsR\x14lastCompletionResult\x12M\n" +
+	"\x11continued_failure\x18\n" +
+	" \x01(\v2 .temporal.api.failure.v1.FailureR\x10continuedFailure\x12s\n" +
+	"\x17recent_nexus_operations\x18\v \x03(\v2;.temporal.server.api.schedule.v1.NexusOperationActionResultR\x15recentNexusOperations\"\xad\x01\n" +
 	"\x11GeneratorInternal\x12L\n" +
 	"\x14next_invocation_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x12nextInvocationTime\x12J\n" +
 	"\x13last_processed_time\x18\x03 \x01(\v2\x1a.google.protobuf.TimestampR\x11lastProcessedTime\"\xb3\x03\n" +
@@ -1325,10 +2422,23 @@ const file_temporal_server_api_schedule_v1_message_proto_rawDesc = "" +
 	"\x0fbuffered_starts\x18\x02 \x03(\v2..temporal.server.api.schedule.v1.BufferedStartR\x0ebufferedStarts\x12T\n" +
 	"\x10cancel_workflows\x18\x03 \x03(\v2).temporal.api.common.v1.WorkflowExecutionR\x0fcancelWorkflows\x12Z\n" +
 	"\x13terminate_workflows\x18\x04 \x03(\v2).temporal.api.common.v1.WorkflowExecutionR\x12terminateWorkflows\x12J\n" +
-	"\x13last_processed_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x11lastProcessedTime\"\xa7\x01\n" +
-	"\x12BackfillerInternal\x12C\n" +
-	"\arequest\x18\x01 \x01(\v2).temporal.api.schedule.v1.BackfillRequestR\arequest\x12L\n" +
-	"\x14next_invocation_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x12nextInvocationTimeB0Z.go.temporal.io/server/api/schedule/v1;scheduleb\x06proto3"
+	"\x13last_processed_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x11lastProcessedTime\"\xac\x03\n" +
+	"\x12BackfillerInternal\x12V\n" +
+	"\x10backfill_request\x18\x01 \x01(\v2).temporal.api.schedule.v1.BackfillRequestH\x00R\x0fbackfillRequest\x12^\n" +
+	"\x0ftrigger_request\x18\x03 \x01(\v23.temporal.api.schedule.v1.TriggerImmediatelyRequestH\x00R\x0etriggerRequest\x12L\n" +
+	"\x14next_invocation_time\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\x12nextInvocationTime\x12\x1f\n" +
+	"\vbackfill_id\x18\x04 \x01(\tR\n" +
+	"backfillId\x12J\n" +
+	"\x13last_processed_time\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\x11lastProcessedTime\x12\x18\n" +
+	"\aattempt\x18\x06 \x01(\x03R\aattemptB\t\n" +
+	"\arequest\"\xe9\x03\n" +
+	"\x17SchedulerMigrationState\x12P\n" +
+	"\tscheduler\x18\x01 \x01(\v22.temporal.server.api.schedule.v1.SchedulerInternalR\tscheduler\x12P\n" +
+	"\tgenerator\x18\x02 \x01(\v22.temporal.server.api.schedule.v1.GeneratorInternalR\tgenerator\x12J\n" +
+	"\ainvoker\x18\x03 \x01(\v20.temporal.server.api.schedule.v1.InvokerInternalR\ainvoker\x12U\n" +
+	"\vbackfillers\x18\x04 \x03(\v23.temporal.server.api.schedule.v1.BackfillerInternalR\vbackfillers\x120\n" +
+	"\x04memo\x18\x05 \x01(\v2\x1c.temporal.api.common.v1.MemoR\x04memo\x12U\n" +
+	"\x11search_attributes\x18\x06 \x01(\v2(.temporal.api.common.v1.SearchAttributesR\x10searchAttributesB0Z.go.temporal.io/server/api/schedule/v1;scheduleb\x06proto3"
 
 var (
 	file_temporal_server_api_schedule_v1_message_proto_rawDescOnce sync.Once
@@ -1342,84 +2452,131 @@ func file_temporal_server_api_schedule_v1_message_proto_rawDescGZIP() []byte {
 	return file_temporal_server_api_schedule_v1_message_proto_rawDescData
 }
 
-var file_temporal_server_api_schedule_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_temporal_server_api_schedule_v1_message_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
 var file_temporal_server_api_schedule_v1_message_proto_goTypes = []any{
-	(*BufferedStart)(nil),                     // 0: temporal.server.api.schedule.v1.BufferedStart
-	(*InternalState)(nil),                     // 1: temporal.server.api.schedule.v1.InternalState
-	(*StartScheduleArgs)(nil),                 // 2: temporal.server.api.schedule.v1.StartScheduleArgs
-	(*FullUpdateRequest)(nil),                 // 3: temporal.server.api.schedule.v1.FullUpdateRequest
-	(*DescribeResponse)(nil),                  // 4: temporal.server.api.schedule.v1.DescribeResponse
-	(*WatchWorkflowRequest)(nil),              // 5: temporal.server.api.schedule.v1.WatchWorkflowRequest
-	(*WatchWorkflowResponse)(nil),             // 6: temporal.server.api.schedule.v1.WatchWorkflowResponse
-	(*StartWorkflowRequest)(nil),              // 7: temporal.server.api.schedule.v1.StartWorkflowRequest
-	(*StartWorkflowResponse)(nil),             // 8: temporal.server.api.schedule.v1.StartWorkflowResponse
-	(*CancelWorkflowRequest)(nil),             // 9: temporal.server.api.schedule.v1.CancelWorkflowRequest
-	(*TerminateWorkflowRequest)(nil),          // 10: temporal.server.api.schedule.v1.TerminateWorkflowRequest
-	(*NextTimeCache)(nil),                     // 11: temporal.server.api.schedule.v1.NextTimeCache
-	(*SchedulerInternal)(nil),                 // 12: temporal.server.api.schedule.v1.SchedulerInternal
-	(*GeneratorInternal)(nil),                 // 13: temporal.server.api.schedule.v1.GeneratorInternal
-	(*InvokerInternal)(nil),                   // 14: temporal.server.api.schedule.v1.InvokerInternal
-	(*BackfillerInternal)(nil),                // 15: temporal.server.api.schedule.v1.BackfillerInternal
-	(*timestamppb.Timestamp)(nil),             // 16: google.protobuf.Timestamp
-	(v1.ScheduleOverlapPolicy)(0),             // 17: temporal.api.enums.v1.ScheduleOverlapPolicy
-	(*v11.BackfillRequest)(nil),               // 18: temporal.api.schedule.v1.BackfillRequest
-	(*v12.Payloads)(nil),                      // 19: temporal.api.common.v1.Payloads
-	(*v13.Failure)(nil),                       // 20: temporal.api.failure.v1.Failure
-	(*v11.Schedule)(nil),                      // 21: temporal.api.schedule.v1.Schedule
-	(*v11.ScheduleInfo)(nil),                  // 22: temporal.api.schedule.v1.ScheduleInfo
-	(*v11.SchedulePatch)(nil),                 // 23: temporal.api.schedule.v1.SchedulePatch
-	(*v12.SearchAttributes)(nil),              // 24: temporal.api.common.v1.SearchAttributes
-	(*v12.WorkflowExecution)(nil),             // 25: temporal.api.common.v1.WorkflowExecution
-	(v1.WorkflowExecutionStatus)(0),           // 26: temporal.api.enums.v1.WorkflowExecutionStatus
-	(*v14.StartWorkflowExecutionRequest)(nil), // 27: temporal.api.workflowservice.v1.StartWorkflowExecutionRequest
-	(v15.SchedulerInvokerState)(0),            // 28: temporal.server.api.enums.v1.SchedulerInvokerState
+	(*BufferedStart)(nil),                               // 0: temporal.server.api.schedule.v1.BufferedStart
+	(*InternalState)(nil),                               // 1: temporal.server.api.schedule.v1.InternalState
+	(*StartScheduleArgs)(nil),                           // 2: temporal.server.api.schedule.v1.StartScheduleArgs
+	(*FullUpdateRequest)(nil),                           // 3: temporal.server.api.schedule.v1.FullUpdateRequest
+	(*DescribeResponse)(nil),                            // 4: temporal.server.api.schedule.v1.DescribeResponse
+	(*WatchWorkflowRequest)(nil),                        // 5: temporal.server.api.schedule.v1.WatchWorkflowRequest
+	(*WatchWorkflowResponse)(nil),                       // 6: temporal.server.api.schedule.v1.WatchWorkflowResponse
+	(*StartWorkflowRequest)(nil),                        // 7: temporal.server.api.schedule.v1.StartWorkflowRequest
+	(*StartWorkflowResponse)(nil),                       // 8: temporal.server.api.schedule.v1.StartWorkflowResponse
+	(*SignalWorkflowRequest)(nil),                       // 9: temporal.server.api.schedule.v1.SignalWorkflowRequest
+	(*SignalWorkflowResponse)(nil),                      // 10: temporal.server.api.schedule.v1.SignalWorkflowResponse
+	(*StartNexusOperationRequest)(nil),                  // 11: temporal.server.api.schedule.v1.StartNexusOperationRequest
+	(*StartNexusOperationResponse)(nil),                 // 12: temporal.server.api.schedule.v1.StartNexusOperationResponse
+	(*CancelWorkflowRequest)(nil),                       // 13: temporal.server.api.schedule.v1.CancelWorkflowRequest
+	(*TerminateWorkflowRequest)(nil),                    // 14: temporal.server.api.schedule.v1.TerminateWorkflowRequest
+	(*GetExclusionCalendarsRequest)(nil),                // 15: temporal.server.api.schedule.v1.GetExclusionCalendarsRequest
+	(*GetExclusionCalendarsResponse)(nil),               // 16: temporal.server.api.schedule.v1.GetExclusionCalendarsResponse
+	(*ExclusionCalendar)(nil),                           // 17: temporal.server.api.schedule.v1.ExclusionCalendar
+	(*ExclusionCalendarDate)(nil),                       // 18: temporal.server.api.schedule.v1.ExclusionCalendarDate
+	(*ScheduleActionExtension)(nil),                     // 19: temporal.server.api.schedule.v1.ScheduleActionExtension
+	(*SignalWorkflowAction)(nil),                        // 20: temporal.server.api.schedule.v1.SignalWorkflowAction
+	(*NexusOperationAction)(nil),                        // 21: temporal.server.api.schedule.v1.NexusOperationAction
+	(*NexusOperationActionResult)(nil),                  // 22: temporal.server.api.schedule.v1.NexusOperationActionResult
+	(*NextTimeCache)(nil),                               // 23: temporal.server.api.schedule.v1.NextTimeCache
+	(*SchedulerInternal)(nil),                           // 24: temporal.server.api.schedule.v1.SchedulerInternal
+	(*GeneratorInternal)(nil),                           // 25: temporal.server.api.schedule.v1.GeneratorInternal
+	(*InvokerInternal)(nil),                             // 26: temporal.server.api.schedule.v1.InvokerInternal
+	(*BackfillerInternal)(nil),                          // 27: temporal.server.api.schedule.v1.BackfillerInternal
+	(*SchedulerMigrationState)(nil),                     // 28: temporal.server.api.schedule.v1.SchedulerMigrationState
+	nil,                                                 // 29: temporal.server.api.schedule.v1.NexusOperationAction.NexusHeaderEntry
+	(*timestamppb.Timestamp)(nil),                       // 30: google.protobuf.Timestamp
+	(v1.ScheduleOverlapPolicy)(0),                       // 31: temporal.api.enums.v1.ScheduleOverlapPolicy
+	(*v11.BackfillRequest)(nil),                         // 32: temporal.api.schedule.v1.BackfillRequest
+	(*v12.Payloads)(nil),                                // 33: temporal.api.common.v1.Payloads
+	(*v13.Failure)(nil),                                 // 34: temporal.api.failure.v1.Failure
+	(*v11.Schedule)(nil),                                // 35: temporal.api.schedule.v1.Schedule
+	(*v11.ScheduleInfo)(nil),                            // 36: temporal.api.schedule.v1.ScheduleInfo
+	(*v11.SchedulePatch)(nil),                           // 37: temporal.api.schedule.v1.SchedulePatch
+	(*v12.SearchAttributes)(nil),                        // 38: temporal.api.common.v1.SearchAttributes
+	(*v12.WorkflowExecution)(nil),                       // 39: temporal.api.common.v1.WorkflowExecution
+	(v1.WorkflowExecutionStatus)(0),                     // 40: temporal.api.enums.v1.WorkflowExecutionStatus
+	(*v14.StartWorkflowExecutionRequest)(nil),           // 41: temporal.api.workflowservice.v1.StartWorkflowExecutionRequest
+	(*v14.SignalWorkflowExecutionRequest)(nil),          // 42: temporal.api.workflowservice.v1.SignalWorkflowExecutionRequest
+	(*v14.SignalWithStartWorkflowExecutionRequest)(nil), // 43: temporal.api.workflowservice.v1.SignalWithStartWorkflowExecutionRequest
+	(*v12.Payload)(nil),                                 // 44: temporal.api.common.v1.Payload
+	(v15.SchedulerInvokerState)(0),                      // 45: temporal.server.api.enums.v1.SchedulerInvokerState
+	(*v11.TriggerImmediatelyRequest)(nil),               // 46: temporal.api.schedule.v1.TriggerImmediatelyRequest
+	(*v12.Memo)(nil),                                    // 47: temporal.api.common.v1.Memo
 }
 var file_temporal_server_api_schedule_v1_message_proto_depIdxs = []int32{
-	16, // 0: temporal.server.api.schedule.v1.BufferedStart.nominal_time:type_name -> google.protobuf.Timestamp
-	16, // 1: temporal.server.api.schedule.v1.BufferedStart.actual_time:type_name -> google.protobuf.Timestamp
-	16, // 2: temporal.server.api.schedule.v1.BufferedStart.desired_time:type_name -> google.protobuf.Timestamp
-	17, // 3: temporal.server.api.schedule.v1.BufferedStart.overlap_policy:type_name -> temporal.api.enums.v1.ScheduleOverlapPolicy
-	16, // 4: temporal.server.api.schedule.v1.BufferedStart.backoff_time:type_name -> google.protobuf.Timestamp
-	16, // 5: temporal.server.api.schedule.v1.InternalState.last_processed_time:type_name -> google.protobuf.Timestamp
+	30, // 0: temporal.server.api.schedule.v1.BufferedStart.nominal_time:type_name -> google.protobuf.Timestamp
+	30, // 1: temporal.server.api.schedule.v1.BufferedStart.actual_time:type_name -> google.protobuf.Timestamp
+	30, // 2: temporal.server.api.schedule.v1.BufferedStart.desired_time:type_name -> google.protobuf.Timestamp
+	31, // 3: temporal.server.api.schedule.v1.BufferedStart.overlap_policy:type_name -> temporal.api.enums.v1.ScheduleOverlapPolicy
+	30, // 4: temporal.server.api.schedule.v1.BufferedStart.backoff_time:type_name -> google.protobuf.Timestamp
+	30, // 5: temporal.server.api.schedule.v1.InternalState.last_processed_time:type_name -> google.protobuf.Timestamp
 	0,  // 6: temporal.server.api.schedule.v1.InternalState.buffered_starts:type_name -> temporal.server.api.schedule.v1.BufferedStart
-	18, // 7: temporal.server.api.schedule.v1.InternalState.ongoing_backfills:type_name -> temporal.api.schedule.v1.BackfillRequest
-	19, // 8: temporal.server.api.schedule.v1.InternalState.last_completion_result:type_name -> temporal.api.common.v1.Payloads
-	20, // 9: temporal.server.api.schedule.v1.InternalState.continued_failure:type_name -> temporal.api.failure.v1.Failure
-	21, // 10: temporal.server.api.schedule.v1.StartScheduleArgs.schedule:type_name -> temporal.api.schedule.v1.Schedule
-	22, // 11: temporal.server.api.schedule.v1.StartScheduleArgs.info:type_name -> temporal.api.schedule.v1.ScheduleInfo
-	23, // 12: temporal.server.api.schedule.v1.StartScheduleArgs.initial_patch:type_name -> temporal.api.schedule.v1.SchedulePatch
-	1,  // 13: temporal.server.api.schedule.v1.StartScheduleArgs.state:type_name -> temporal.server.api.schedule.v1.InternalState
-	21, // 14: temporal.server.api.schedule.v1.FullUpdateRequest.schedule:type_name -> temporal.api.schedule.v1.Schedule
-	24, // 15: temporal.server.api.schedule.v1.FullUpdateRequest.search_attributes:type_name -> temporal.api.common.v1.SearchAttributes
-	21, // 16: temporal.server.api.schedule.v1.DescribeResponse.schedule:type_name -> temporal.api.schedule.v1.Schedule
-	22, // 17: temporal.server.api.schedule.v1.DescribeResponse.info:type_name -> temporal.api.schedule.v1.ScheduleInfo
-	25, // 18: temporal.server.api.schedule.v1.WatchWorkflowRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	26, // 19: temporal.server.api.schedule.v1.WatchWorkflowResponse.status:type_name -> temporal.api.enums.v1.WorkflowExecutionStatus
-	19, // 20: temporal.server.api.schedule.v1.WatchWorkflowResponse.result:type_name -> temporal.api.common.v1.Payloads
-	20, // 21: temporal.server.api.schedule.v1.WatchWorkflowResponse.failure:type_name -> temporal.api.failure.v1.Failure
-	16, // 22: temporal.server.api.schedule.v1.WatchWorkflowResponse.close_time:type_name -> google.protobuf.Timestamp
-	27, // 23: temporal.server.api.schedule.v1.StartWorkflowRequest.request:type_name -> temporal.api.workflowservice.v1.StartWorkflowExecutionRequest
-	16, // 24: temporal.server.api.schedule.v1.StartWorkflowResponse.real_start_time:type_name -> google.protobuf.Timestamp
-	25, // 25: temporal.server.api.schedule.v1.CancelWorkflowRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	25, // 26: temporal.server.api.schedule.v1.TerminateWorkflowRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
-	16, // 27: temporal.server.api.schedule.v1.NextTimeCache.start_time:type_name -> google.protobuf.Timestamp
-	21, // 28: temporal.server.api.schedule.v1.SchedulerInternal.schedule:type_name -> temporal.api.schedule.v1.Schedule
-	22, // 29: temporal.server.api.schedule.v1.SchedulerInternal.info:type_name -> temporal.api.schedule.v1.ScheduleInfo
-	23, // 30: temporal.server.api.schedule.v1.SchedulerInternal.initial_patch:type_name -> temporal.api.schedule.v1.SchedulePatch
-	16, // 31: temporal.server.api.schedule.v1.GeneratorInternal.next_invocation_time:type_name -> google.protobuf.Timestamp
-	16, // 32: temporal.server.api.schedule.v1.GeneratorInternal.last_processed_time:type_name -> google.protobuf.Timestamp
-	28, // 33: temporal.server.api.schedule.v1.InvokerInternal.state:type_name -> temporal.server.api.enums.v1.SchedulerInvokerState
-	0,  // 34: temporal.server.api.schedule.v1.InvokerInternal.buffered_starts:type_name -> temporal.server.api.schedule.v1.BufferedStart
-	25, // 35: temporal.server.api.schedule.v1.InvokerInternal.cancel_workflows:type_name -> temporal.api.common.v1.WorkflowExecution
-	25, // 36: temporal.server.api.schedule.v1.InvokerInternal.terminate_workflows:type_name -> temporal.api.common.v1.WorkflowExecution
-	16, // 37: temporal.server.api.schedule.v1.InvokerInternal.last_processed_time:type_name -> google.protobuf.Timestamp
-	18, // 38: temporal.server.api.schedule.v1.BackfillerInternal.request:type_name -> temporal.api.schedule.v1.BackfillRequest
-	16, // 39: temporal.server.api.schedule.v1.BackfillerInternal.next_invocation_time:type_name -> google.protobuf.Timestamp
-	40, // [40:40] is the sub-list for method output_type
-	40, // [40:40] is the sub-list for method input_type
-	40, // [40:40] is the sub-list for extension type_name
-	40, // [40:40] is the sub-list for extension extendee
-	0,  // [0:40] is the sub-list for field type_name
+	32, // 7: temporal.server.api.schedule.v1.InternalState.ongoing_backfills:type_name -> temporal.api.schedule.v1.BackfillRequest
+	33, // 8: temporal.server.api.schedule.v1.InternalState.last_completion_result:type_name -> temporal.api.common.v1.Payloads
+	34, // 9: temporal.server.api.schedule.v1.InternalState.continued_failure:type_name -> temporal.api.failure.v1.Failure
+	17, // 10: temporal.server.api.schedule.v1.InternalState.exclusion_calendars:type_name -> temporal.server.api.schedule.v1.ExclusionCalendar
+	30, // 11: temporal.server.api.schedule.v1.InternalState.exclusion_calendars_refresh_time:type_name -> google.protobuf.Timestamp
+	35, // 12: temporal.server.api.schedule.v1.StartScheduleArgs.schedule:type_name -> temporal.api.schedule.v1.Schedule
+	36, // 13: temporal.server.api.schedule.v1.StartScheduleArgs.info:type_name -> temporal.api.schedule.v1.ScheduleInfo
+	37, // 14: temporal.server.api.schedule.v1.StartScheduleArgs.initial_patch:type_name -> temporal.api.schedule.v1.SchedulePatch
+	1,  // 15: temporal.server.api.schedule.v1.StartScheduleArgs.state:type_name -> temporal.server.api.schedule.v1.InternalState
+	35, // 16: temporal.server.api.schedule.v1.FullUpdateRequest.schedule:type_name -> temporal.api.schedule.v1.Schedule
+	38, // 17: temporal.server.api.schedule.v1.FullUpdateRequest.search_attributes:type_name -> temporal.api.common.v1.SearchAttributes
+	35, // 18: temporal.server.api.schedule.v1.DescribeResponse.schedule:type_name -> temporal.api.schedule.v1.Schedule
+	36, // 19: temporal.server.api.schedule.v1.DescribeResponse.info:type_name -> temporal.api.schedule.v1.ScheduleInfo
+	39, // 20: temporal.server.api.schedule.v1.WatchWorkflowRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	40, // 21: temporal.server.api.schedule.v1.WatchWorkflowResponse.status:type_name -> temporal.api.enums.v1.WorkflowExecutionStatus
+	33, // 22: temporal.server.api.schedule.v1.WatchWorkflowResponse.result:type_name -> temporal.api.common.v1.Payloads
+	34, // 23: temporal.server.api.schedule.v1.WatchWorkflowResponse.failure:type_name -> temporal.api.failure.v1.Failure
+	30, // 24: temporal.server.api.schedule.v1.WatchWorkflowResponse.close_time:type_name -> google.protobuf.Timestamp
+	41, // 25: temporal.server.api.schedule.v1.StartWorkflowRequest.request:type_name -> temporal.api.workflowservice.v1.StartWorkflowExecutionRequest
+	30, // 26: temporal.server.api.schedule.v1.StartWorkflowResponse.real_start_time:type_name -> google.protobuf.Timestamp
+	42, // 27: temporal.server.api.schedule.v1.SignalWorkflowRequest.signal_request:type_name -> temporal.api.workflowservice.v1.SignalWorkflowExecutionRequest
+	43, // 28: temporal.server.api.schedule.v1.SignalWorkflowRequest.signal_with_start_request:type_name -> temporal.api.workflowservice.v1.SignalWithStartWorkflowExecutionRequest
+	30, // 29: temporal.server.api.schedule.v1.SignalWorkflowResponse.real_time:type_name -> google.protobuf.Timestamp
+	21, // 30: temporal.server.api.schedule.v1.StartNexusOperationRequest.operation:type_name -> temporal.server.api.schedule.v1.NexusOperationAction
+	30, // 31: temporal.server.api.schedule.v1.StartNexusOperationResponse.real_time:type_name -> google.protobuf.Timestamp
+	39, // 32: temporal.server.api.schedule.v1.CancelWorkflowRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	39, // 33: temporal.server.api.schedule.v1.TerminateWorkflowRequest.execution:type_name -> temporal.api.common.v1.WorkflowExecution
+	17, // 34: temporal.server.api.schedule.v1.GetExclusionCalendarsResponse.calendars:type_name -> temporal.server.api.schedule.v1.ExclusionCalendar
+	18, // 35: temporal.server.api.schedule.v1.ExclusionCalendar.dates:type_name -> temporal.server.api.schedule.v1.ExclusionCalendarDate
+	30, // 36: temporal.server.api.schedule.v1.ExclusionCalendar.update_time:type_name -> google.protobuf.Timestamp
+	20, // 37: temporal.server.api.schedule.v1.ScheduleActionExtension.signal_workflow:type_name -> temporal.server.api.schedule.v1.SignalWorkflowAction
+	21, // 38: temporal.server.api.schedule.v1.ScheduleActionExtension.nexus_operation:type_name -> temporal.server.api.schedule.v1.NexusOperationAction
+	33, // 39: temporal.server.api.schedule.v1.SignalWorkflowAction.input:type_name -> temporal.api.common.v1.Payloads
+	44, // 40: temporal.server.api.schedule.v1.NexusOperationAction.input:type_name -> temporal.api.common.v1.Payload
+	29, // 41: temporal.server.api.schedule.v1.NexusOperationAction.nexus_header:type_name -> temporal.server.api.schedule.v1.NexusOperationAction.NexusHeaderEntry
+	30, // 42: temporal.server.api.schedule.v1.NexusOperationActionResult.schedule_time:type_name -> google.protobuf.Timestamp
+	30, // 43: temporal.server.api.schedule.v1.NexusOperationActionResult.actual_time:type_name -> google.protobuf.Timestamp
+	30, // 44: temporal.server.api.schedule.v1.NextTimeCache.start_time:type_name -> google.protobuf.Timestamp
+	35, // 45: temporal.server.api.schedule.v1.SchedulerInternal.schedule:type_name -> temporal.api.schedule.v1.Schedule
+	36, // 46: temporal.server.api.schedule.v1.SchedulerInternal.info:type_name -> temporal.api.schedule.v1.ScheduleInfo
+	37, // 47: temporal.server.api.schedule.v1.SchedulerInternal.initial_patch:type_name -> temporal.api.schedule.v1.SchedulePatch
+	33, // 48: temporal.server.api.schedule.v1.SchedulerInternal.last_completion_result:type_name -> temporal.api.common.v1.Payloads
+	34, // 49: temporal.server.api.schedule.v1.SchedulerInternal.continued_failure:type_name -> temporal.api.failure.v1.Failure
+	22, // 50: temporal.server.api.schedule.v1.SchedulerInternal.recent_nexus_operations:type_name -> temporal.server.api.schedule.v1.NexusOperationActionResult
+	30, // 51: temporal.server.api.schedule.v1.GeneratorInternal.next_invocation_time:type_name -> google.protobuf.Timestamp
+	30, // 52: temporal.server.api.schedule.v1.GeneratorInternal.last_processed_time:type_name -> google.protobuf.Timestamp
+	45, // 53: temporal.server.api.schedule.v1.InvokerInternal.state:type_name -> temporal.server.api.enums.v1.SchedulerInvokerState
+	0,  // 54: temporal.server.api.schedule.v1.InvokerInternal.buffered_starts:type_name -> temporal.server.api.schedule.v1.BufferedStart
+	39, // 55: temporal.server.api.schedule.v1.InvokerInternal.cancel_workflows:type_name -> temporal.api.common.v1.WorkflowExecution
+	39, // 56: temporal.server.api.schedule.v1.InvokerInternal.terminate_workflows:type_name -> temporal.api.common.v1.WorkflowExecution
+	30, // 57: temporal.server.api.schedule.v1.InvokerInternal.last_processed_time:type_name -> google.protobuf.Timestamp
+	32, // 58: temporal.server.api.schedule.v1.BackfillerInternal.backfill_request:type_name -> temporal.api.schedule.v1.BackfillRequest
+	46, // 59: temporal.server.api.schedule.v1.BackfillerInternal.trigger_request:type_name -> temporal.api.schedule.v1.TriggerImmediatelyRequest
+	30, // 60: temporal.server.api.schedule.v1.BackfillerInternal.next_invocation_time:type_name -> google.protobuf.Timestamp
+	30, // 61: temporal.server.api.schedule.v1.BackfillerInternal.last_processed_time:type_name -> google.protobuf.Timestamp
+	24, // 62: temporal.server.api.schedule.v1.SchedulerMigrationState.scheduler:type_name -> temporal.server.api.schedule.v1.SchedulerInternal
+	25, // 63: temporal.server.api.schedule.v1.SchedulerMigrationState.generator:type_name -> temporal.server.api.schedule.v1.GeneratorInternal
+	26, // 64: temporal.server.api.schedule.v1.SchedulerMigrationState.invoker:type_name -> temporal.server.api.schedule.v1.InvokerInternal
+	27, // 65: temporal.server.api.schedule.v1.SchedulerMigrationState.backfillers:type_name -> temporal.server.api.schedule.v1.BackfillerInternal
+	47, // 66: temporal.server.api.schedule.v1.SchedulerMigrationState.memo:type_name -> temporal.api.common.v1.Memo
+	38, // 67: temporal.server.api.schedule.v1.SchedulerMigrationState.search_attributes:type_name -> temporal.api.common.v1.SearchAttributes
+	68, // [68:68] is the sub-list for method output_type
+	68, // [68:68] is the sub-list for method input_type
+	68, // [68:68] is the sub-list for extension type_name
+	68, // [68:68] is the sub-list for extension extendee
+	0,  // [0:68] is the sub-list for field type_name
 }
 
 func init() { file_temporal_server_api_schedule_v1_message_proto_init() }
@@ -1431,13 +2588,21 @@ func file_temporal_server_api_schedule_v1_message_proto_init() {
 		(*WatchWorkflowResponse_Result)(nil),
 		(*WatchWorkflowResponse_Failure)(nil),
 	}
+	file_temporal_server_api_schedule_v1_message_proto_msgTypes[19].OneofWrappers = []any{
+		(*ScheduleActionExtension_SignalWorkflow)(nil),
+		(*ScheduleActionExtension_NexusOperation)(nil),
+	}
+	file_temporal_server_api_schedule_v1_message_proto_msgTypes[27].OneofWrappers = []any{
+		(*BackfillerInternal_BackfillRequest)(nil),
+		(*BackfillerInternal_TriggerRequest)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_temporal_server_api_schedule_v1_message_proto_rawDesc), len(file_temporal_server_api_schedule_v1_message_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   16,
+			NumMessages:   30,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -153,6 +153,43 @@ func (this *PhysicalTaskQueueInfo) Equal(that interface{}) bool {
 	return proto.Equal(this, that1)
 }
 
+// Marshal an object of type FairnessKeyStatus to the protobuf v3 wire format
+func (val *FairnessKeyStatus) Marshal() ([]byte, error) {
+	return proto.Marshal(val)
+}
+
+// Unmarshal an object of type FairnessKeyStatus from the protobuf v3 wire format
+func (val *FairnessKeyStatus) Unmarshal(buf []byte) error {
+	return proto.Unmarshal(buf, val)
+}
+
+// Size returns the size of the object, in bytes, once serialized
+func (val *FairnessKeyStatus) Size() int {
+	return proto.Size(val)
+}
+
+// Equal returns whether two FairnessKeyStatus values are equivalent by recursively
+// comparing the message's fields.
+// For more information see the documentation for
+// https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func (this *FairnessKeyStatus) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	var that1 *FairnessKeyStatus
+	switch t := that.(type) {
+	case *FairnessKeyStatus:
+		that1 = t
+	case FairnessKeyStatus:
+		that1 = &t
+	default:
+		return false
+	}
+
+	return proto.Equal(this, that1)
+}
+
 // Marshal an object of type TaskQueuePartition to the protobuf v3 wire format
 func (val *TaskQueuePartition) Marshal() ([]byte, error) {
 	return proto.Marshal(val)
@@ -7,13 +7,13 @@ import (
 type engine interface {
 	newInstance(
 		context.Context,
-		EntityKey,
+		ComponentRef,
 		func(MutableContext) (Component, error),
 		...TransitionOption,
 	) (ComponentRef, error)
 	updateWithNewInstance(
 		context.Context,
-		EntityKey,
+		ComponentRef,
 		func(MutableContext) (Component, error),
 		func(MutableContext, Component) error,
 		...TransitionOption,
@@ -41,13 +41,18 @@ type engine interface {
 	) (ComponentRef, error)
 }
 
+// BusinessIDReusePolicy determines if a new entity can be created when
+// the current entity with the same BusinessID is closed.
 type BusinessIDReusePolicy int
 
 const (
 	BusinessIDReusePolicyAllowDuplicate BusinessIDReusePolicy = iota
 	BusinessIDReusePolicyRejectDuplicate
+	BusinessIDReusePolicyAllowDuplicateFailedOnly
 )
 
+// BusinessIDConflictPolicy determines how to resolve the conflict when
+// the current entity with the same BusinessID is still running.
 type BusinessIDConflictPolicy int
 
 const (
@@ -56,28 +61,58 @@ const (
 	BusinessIDConflictPolicyUseExisting
 )
 
-type transitionOptions struct {
+// TransitionOptions is exported for use by the CHASM engine implementation only.
+// CHASM component authors should use the TransitionOption functions instead.
+type TransitionOptions struct {
+	ReusePolicy    BusinessIDReusePolicy
+	ConflictPolicy BusinessIDConflictPolicy
+	Speculative    bool
 }
 
-type TransitionOption func(*transitionOptions)
+type TransitionOption func(*TransitionOptions)
+
+// NewTransitionOptions applies the given TransitionOption functions on top of the default options.
+func NewTransitionOptions(
+	opts ...TransitionOption,
+) TransitionOptions {
+	var options TransitionOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+	return options
+}
 
 // (only) this transition will not be persisted
 // The next non-speculative transition will persist this transition as well.
 // Compared to the EntityEphemeral() operation on RegistrableComponent,
 // the scope of this operation is limited to a certain transition,
 // while the EntityEphemeral() applies to all transitions.
-// TODO: we need to figure out a way to run the tasks
-// generated in a speculative transition
+//
+// Tasks generated in a speculative transition are buffered in memory along with
+// the state changes, and will only be scheduled when the next non-speculative
+// transition persists them.
+//
+// Speculative changes (and their tasks) are rolled back if the entity is evicted
+// from the cache, its shard is closed, or a later transition fails before persisting them.
+//
+// This only applies to UpdateComponent.
 func WithSpeculative() TransitionOption {
-	panic("not implemented")
+	return func(o *TransitionOptions) {
+		o.Speculative = true
+	}
 }
 
 // this only applies to NewEntity and UpdateWithNewEntity
+// By default, a new entity is allowed if the current entity is closed,
+// and the request fails if the current entity is still running.
 func WithBusinessIDPolicy(
 	reusePolicy BusinessIDReusePolicy,
 	conflictPolicy BusinessIDConflictPolicy,
 ) TransitionOption {
-	panic("not implemented")
+	return func(o *TransitionOptions) {
+		o.ReusePolicy = reusePolicy
+		o.ConflictPolicy = conflictPolicy
+	}
 }
 
 // Not needed for V1
@@ -97,7 +132,7 @@ func NewEntity[C Component, I any, O any](
 	var output O
 	ref, err := engineFromContext(ctx).newInstance(
 		ctx,
-		key,
+		NewComponentRef[C](key),
 		func(ctx MutableContext) (Component, error) {
 			var c C
 			var err error
@@ -125,7 +160,7 @@ func UpdateWithNewEntity[C Component, I any, O1 any, O2 any](
 	var output2 O2
 	ref, err := engineFromContext(ctx).updateWithNewInstance(
 		ctx,
-		key,
+		NewComponentRef[C](key),
 		func(ctx MutableContext) (Component, error) {
 			var c C
 			var err error
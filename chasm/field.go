@@ -44,12 +44,16 @@ func NewComponentField[C Component](
 	}
 }
 
+// NewComponentPointerField creates a field which refers to a component that is
+// already a part of the tree (e.g. a sibling or an ancestor).
+// The pointer is persisted as the path of the target component and is resolved on Get.
 func NewComponentPointerField[C Component](
 	ctx MutableContext,
 	c C,
 ) Field[C] {
-	//nolint:forbidigo
-	panic("not implemented")
+	return Field[C]{
+		Internal: newFieldInternalWithValue(fieldTypeComponentPointer, c),
+	}
 }
 
 func NewDataPointerField[D proto.Message](
@@ -86,8 +90,22 @@ func (f Field[T]) Get(chasmContext Context) (T, error) {
 			return nilT, err
 		}
 	case fieldTypeComponentPointer:
-		//nolint:forbidigo
-		panic("not implemented")
+		if f.Internal.node.valueState == valueStateNeedSerialize {
+			// Pointer was created in the current transaction and node value is the target component itself.
+			break
+		}
+		targetNode, err := f.Internal.node.resolvePointer()
+		if err != nil {
+			return nilT, err
+		}
+		if err := targetNode.prepareComponentValue(chasmContext); err != nil {
+			return nilT, err
+		}
+		vT, isT := targetNode.value.(T)
+		if !isT {
+			return nilT, serviceerror.NewInternalf("pointer target value doesn't implement %s", reflect.TypeFor[T]().Name())
+		}
+		return vT, nil
 	default:
 		return nilT, serviceerror.NewInternalf("unsupported field type: %v", f.Internal.fieldType())
 	}
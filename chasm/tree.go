@@ -104,6 +104,10 @@ type (
 		// Mutations accumulated so far in this transaction.
 		mutation NodesMutation
 		newTasks map[any][]taskWithAttributes // component value -> task & attributes
+
+		// speculative is true when all changes in the current transaction are
+		// made by speculative transitions and the transaction is intentionally left open.
+		speculative bool
 	}
 
 	taskWithAttributes struct {
@@ -294,6 +298,16 @@ func (n *Node) Component(
 	return componentValue, nil
 }
 
+// SetRootComponent sets the root component of a new CHASM tree.
+// It is used by the CHASM engine when creating a new entity.
+func (n *Node) SetRootComponent(
+	rootComponent Component,
+) {
+	root := n.root()
+	root.value = rootComponent
+	root.valueState = valueStateNeedSerialize
+}
+
 func (n *Node) prepareComponentValue(
 	chasmContext Context,
 ) error {
@@ -417,7 +431,17 @@ func (n *Node) initSerializedNode(ft fieldType) {
 			},
 		}
 	case fieldTypeComponentPointer:
-		panic("not implemented")
+		n.serializedNode = &persistencespb.ChasmNode{
+			Metadata: &persistencespb.ChasmNodeMetadata{
+				InitialVersionedTransition: &persistencespb.VersionedTransition{
+					TransitionCount:          n.backend.NextTransitionCount(),
+					NamespaceFailoverVersion: n.backend.GetCurrentVersion(),
+				},
+				Attributes: &persistencespb.ChasmNodeMetadata_PointerAttributes{
+					PointerAttributes: &persistencespb.ChasmPointerAttributes{},
+				},
+			},
+		}
 	case fieldTypeUnspecified:
 		// Do nothing. Panic?
 	}
@@ -470,7 +494,7 @@ func (n *Node) serialize() error {
 	case *persistencespb.ChasmNodeMetadata_CollectionAttributes:
 		return n.serializeCollectionNode()
 	case *persistencespb.ChasmNodeMetadata_PointerAttributes:
-		panic("not implemented")
+		return n.serializePointerNode()
 	default:
 		return serviceerror.NewInternal("unknown node type")
 	}
@@ -785,6 +809,63 @@ func (n *Node) serializeCollectionNode() error {
 	return nil
 }
 
+// serializePointerNode resolves the component the pointer node value refers to
+// and stores its path in the pointer attributes.
+// It must be called after the tree structure is synced with component values,
+// so pointers to components created in the same transaction can be resolved.
+func (n *Node) serializePointerNode() error {
+	targetPath, ok := n.root().findComponentNodePath(n.value)
+	if !ok {
+		return serviceerror.NewInternalf(
+			"component pointer %s refers to a component of type %v which is not in the tree", n.nodeName, reflect.TypeOf(n.value),
+		)
+	}
+
+	n.serializedNode.GetMetadata().GetPointerAttributes().NodePath = targetPath
+	n.updateLastUpdateVersionedTransition()
+	n.valueState = valueStateSynced
+	return nil
+}
+
+// findComponentNodePath returns the path of the component node (starting from node n) which holds the given value.
+func (n *Node) findComponentNodePath(
+	value any,
+) ([]string, bool) {
+	if value == nil {
+		return nil, false
+	}
+	for nodePath, node := range n.andAllChildren() {
+		if node.serializedNode.GetMetadata().GetComponentAttributes() == nil {
+			// Pointer nodes hold the target value too, only component nodes can be the target.
+			continue
+		}
+		if node.value == value {
+			return slices.Clone(nodePath), true
+		}
+	}
+	return nil, false
+}
+
+// resolvePointer returns the component node the persisted pointer node n refers to.
+// The target is looked up by path every time, so it is resolved lazily after the tree is loaded
+// or updated by replication.
+func (n *Node) resolvePointer() (*Node, error) {
+	pointerAttr := n.serializedNode.GetMetadata().GetPointerAttributes()
+	if pointerAttr == nil {
+		return nil, serviceerror.NewInternalf(
+			"expect chasm node to have PointerAttributes, actual attributes: %v", n.serializedNode.GetMetadata().GetAttributes(),
+		)
+	}
+
+	targetNode, ok := n.root().getNodeByPath(pointerAttr.GetNodePath())
+	if !ok || targetNode.serializedNode.GetMetadata().GetComponentAttributes() == nil {
+		return nil, serviceerror.NewNotFoundf(
+			"component pointer %s target %v not found, it might have been deleted", n.nodeName, pointerAttr.GetNodePath(),
+		)
+	}
+	return targetNode, nil
+}
+
 func (n *Node) updateLastUpdateVersionedTransition() {
 	if n.serializedNode.GetMetadata().GetLastUpdateVersionedTransition() == nil {
 		n.serializedNode.GetMetadata().LastUpdateVersionedTransition = &persistencespb.VersionedTransition{}
@@ -817,7 +898,7 @@ func (n *Node) deserialize(
 	case *persistencespb.ChasmNodeMetadata_CollectionAttributes:
 		softassert.Fail(n.logger, "deserialize shouldn't be called on the collection node because it is deserialized with the parent component.")
 	case *persistencespb.ChasmNodeMetadata_PointerAttributes:
-		// TODO: return serviceerror.NewInternal(...) instead.
+		return serviceerror.NewInternal("pointer node must be resolved to the target component node instead of being deserialized")
 	}
 	return nil
 }
@@ -1292,6 +1373,7 @@ func (n *Node) cleanupTransaction() {
 		DeletedNodes: make(map[string]struct{}),
 	}
 	n.newTasks = make(map[any][]taskWithAttributes)
+	n.speculative = false
 }
 
 // Snapshot returns all nodes in the tree that have been modified after the given min versioned transition.
@@ -1436,8 +1518,9 @@ func (n *Node) applyUpdates(
 		}
 
 		node, ok := n.getNodeByPath(path)
-		if !ok {
-			// Node doesn't exist, we need to create it.
+		if !ok || node.serializedNode == nil {
+			// Node doesn't exist, or it was only created as a placeholder while
+			// applying updates to its children, we need to create it.
 			n.setSerializedNode(path, updatedNode)
 			n.mutation.UpdatedNodes[encodedPath] = updatedNode
 			continue
@@ -1465,7 +1548,7 @@ func (n *Node) applyUpdates(
 			n.mutation.UpdatedNodes[encodedPath] = updatedNode
 			node.serializedNode = updatedNode
 			node.value = nil
-			n.valueState = valueStateNeedDeserialize
+			node.valueState = valueStateNeedDeserialize
 
 			// Clearing decoded value for ancestor nodes is not necessary because the value field is not referenced directly.
 			// Parent node is pointing to the Node struct.
@@ -1543,6 +1626,20 @@ func (n *Node) IsDirty() bool {
 	return n.isValueNeedSerialize()
 }
 
+// SetSpeculative marks changes in the current transaction as speculative.
+// Speculative changes stay in memory and will be persisted along with the next
+// non-speculative transition, which resets the flag in CloseTransaction().
+func (n *Node) SetSpeculative() {
+	n.speculative = true
+}
+
+// IsSpeculative returns true if all pending changes in the tree are made by
+// speculative transitions. MutableState uses it to exclude those changes from
+// its dirty check when releasing the lock.
+func (n *Node) IsSpeculative() bool {
+	return n.speculative
+}
+
 func (n *Node) IsStale(
 	ref ComponentRef,
 ) error {
@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common"
@@ -812,6 +813,85 @@ func (s *nodeSuite) TestApplyMutation() {
 	s.Equal(expectedMutation, root.mutation)
 }
 
+func (s *nodeSuite) TestApplyMutation_DescendantBeforeAncestor() {
+	root, err := NewTree(map[string]*persistencespb.ChasmNode{
+		"": {
+			Metadata: &persistencespb.ChasmNodeMetadata{
+				InitialVersionedTransition:    &persistencespb.VersionedTransition{TransitionCount: 1},
+				LastUpdateVersionedTransition: &persistencespb.VersionedTransition{TransitionCount: 1},
+			},
+		},
+	}, s.registry, s.timeSource, s.nodeBackend, s.nodePathEncoder, s.logger)
+	s.NoError(err)
+
+	grandchild := &persistencespb.ChasmNode{
+		Metadata: &persistencespb.ChasmNodeMetadata{
+			InitialVersionedTransition:    &persistencespb.VersionedTransition{TransitionCount: 3},
+			LastUpdateVersionedTransition: &persistencespb.VersionedTransition{TransitionCount: 3},
+		},
+	}
+	child := &persistencespb.ChasmNode{
+		Metadata: &persistencespb.ChasmNodeMetadata{
+			InitialVersionedTransition:    &persistencespb.VersionedTransition{TransitionCount: 2},
+			LastUpdateVersionedTransition: &persistencespb.VersionedTransition{TransitionCount: 2},
+		},
+	}
+
+	// Applying the grandchild first leaves a placeholder node for the child.
+	err = root.ApplyMutation(NodesMutation{
+		UpdatedNodes: map[string]*persistencespb.ChasmNode{"child/grandchild": grandchild},
+	})
+	s.NoError(err)
+	s.Nil(root.children["child"].serializedNode)
+
+	err = root.ApplyMutation(NodesMutation{
+		UpdatedNodes: map[string]*persistencespb.ChasmNode{"child": child},
+	})
+	s.NoError(err)
+	s.Equal(child, root.children["child"].serializedNode)
+	s.Equal(grandchild, root.children["child"].children["grandchild"].serializedNode)
+	s.Equal(map[string]*persistencespb.ChasmNode{
+		"child/grandchild": grandchild,
+		"child":            child,
+	}, root.mutation.UpdatedNodes)
+}
+
+func (s *nodeSuite) TestApplyMutation_ResetsUpdatedNodeValueState() {
+	persistenceNodes := map[string]*persistencespb.ChasmNode{
+		"": {
+			Metadata: &persistencespb.ChasmNodeMetadata{
+				InitialVersionedTransition:    &persistencespb.VersionedTransition{TransitionCount: 1},
+				LastUpdateVersionedTransition: &persistencespb.VersionedTransition{TransitionCount: 1},
+			},
+		},
+		"child": {
+			Metadata: &persistencespb.ChasmNodeMetadata{
+				InitialVersionedTransition:    &persistencespb.VersionedTransition{TransitionCount: 2},
+				LastUpdateVersionedTransition: &persistencespb.VersionedTransition{TransitionCount: 2},
+			},
+		},
+	}
+	root, err := NewTree(persistenceNodes, s.registry, s.timeSource, s.nodeBackend, s.nodePathEncoder, s.logger)
+	s.NoError(err)
+	root.valueState = valueStateSynced
+	root.children["child"].valueState = valueStateSynced
+
+	err = root.ApplyMutation(NodesMutation{
+		UpdatedNodes: map[string]*persistencespb.ChasmNode{
+			"child": {
+				Metadata: &persistencespb.ChasmNodeMetadata{
+					InitialVersionedTransition:    &persistencespb.VersionedTransition{TransitionCount: 2},
+					LastUpdateVersionedTransition: &persistencespb.VersionedTransition{TransitionCount: 20},
+				},
+			},
+		},
+	})
+	s.NoError(err)
+
+	s.Equal(valueStateNeedDeserialize, root.children["child"].valueState)
+	s.Equal(valueStateSynced, root.valueState)
+}
+
 func (s *nodeSuite) TestApplySnapshot() {
 	// Setup initial tree with a root, a child, and a grandchild.
 	persistenceNodes := map[string]*persistencespb.ChasmNode{
@@ -1282,6 +1362,70 @@ func (s *nodeSuite) TestSerializeDeserializeTask() {
 	}
 }
 
+func (s *nodeSuite) TestComponentPointerField() {
+	node := s.testComponentTree()
+	tv := testvars.New(s.T())
+
+	s.nodeBackend.EXPECT().NextTransitionCount().Return(int64(1)).AnyTimes()
+	s.nodeBackend.EXPECT().GetCurrentVersion().Return(int64(1)).AnyTimes()
+	s.nodeBackend.EXPECT().GetWorkflowKey().Return(tv.Any().WorkflowKey()).AnyTimes()
+	s.nodeBackend.EXPECT().UpdateWorkflowStateStatus(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	chasmCtx := NewMutableContext(context.Background(), node)
+	tc, err := node.Component(chasmCtx, ComponentRef{componentPath: RootPath})
+	s.NoError(err)
+	testComponent := tc.(*TestComponent)
+	sc1, err := testComponent.SubComponent1.Get(chasmCtx)
+	s.NoError(err)
+	sc11, err := sc1.SubComponent11.Get(chasmCtx)
+	s.NoError(err)
+
+	testComponent.SubComponent11Pointer = NewComponentPointerField(chasmCtx, sc11)
+	// Pointer can be resolved before it is persisted.
+	pointedSC11, err := testComponent.SubComponent11Pointer.Get(chasmCtx)
+	s.NoError(err)
+	s.Same(sc11, pointedSC11)
+
+	mutations, err := node.CloseTransaction()
+	s.NoError(err)
+	s.Contains(mutations.UpdatedNodes, "SubComponent11Pointer", "SubComponent11Pointer must be in UpdatedNodes")
+	pointerAttr := mutations.UpdatedNodes["SubComponent11Pointer"].GetMetadata().GetPointerAttributes()
+	s.NotNil(pointerAttr)
+	s.Equal([]string{"SubComponent1", "SubComponent11"}, pointerAttr.GetNodePath())
+
+	// Pointer is resolved lazily after the tree is loaded from persistence.
+	loadedNode, err := NewTree(node.Snapshot(nil).Nodes, s.registry, s.timeSource, s.nodeBackend, s.nodePathEncoder, s.logger)
+	s.NoError(err)
+	loadedCtx := NewContext(context.Background(), loadedNode)
+	loadedTC, err := loadedNode.Component(loadedCtx, ComponentRef{componentPath: RootPath})
+	s.NoError(err)
+	loadedSC11, err := loadedTC.(*TestComponent).SubComponent11Pointer.Get(loadedCtx)
+	s.NoError(err)
+	s.Equal("sub-component1-sub-component11-data", loadedSC11.SubComponent11Data.GetCreateRequestId())
+
+	// Pointer survives replication of the tree.
+	var nilSerializedNodes map[string]*persistencespb.ChasmNode
+	replicatedNode, err := NewTree(nilSerializedNodes, s.registry, s.timeSource, s.nodeBackend, s.nodePathEncoder, s.logger)
+	s.NoError(err)
+	err = replicatedNode.ApplySnapshot(node.Snapshot(nil))
+	s.NoError(err)
+	replicatedCtx := NewContext(context.Background(), replicatedNode)
+	replicatedTC, err := replicatedNode.Component(replicatedCtx, ComponentRef{componentPath: RootPath})
+	s.NoError(err)
+	replicatedSC11, err := replicatedTC.(*TestComponent).SubComponent11Pointer.Get(replicatedCtx)
+	s.NoError(err)
+	s.Equal("sub-component1-sub-component11-data", replicatedSC11.SubComponent11Data.GetCreateRequestId())
+
+	// Pointer returns NotFound error when the target component is deleted.
+	err = replicatedNode.ApplyMutation(NodesMutation{
+		DeletedNodes: map[string]struct{}{"SubComponent1/SubComponent11": {}},
+	})
+	s.NoError(err)
+	_, err = replicatedTC.(*TestComponent).SubComponent11Pointer.Get(replicatedCtx)
+	var notFoundErr *serviceerror.NotFound
+	s.ErrorAs(err, &notFoundErr)
+}
+
 func (s *nodeSuite) TestCloseTransaction_Success() {
 	node := s.testComponentTree()
 	tv := testvars.New(s.T())
@@ -1318,6 +1462,33 @@ func (s *nodeSuite) TestCloseTransaction_Success() {
 	s.Empty(mutations.DeletedNodes)
 }
 
+func (s *nodeSuite) TestCloseTransaction_Speculative() {
+	node := s.testComponentTree()
+	tv := testvars.New(s.T())
+
+	chasmCtx := NewMutableContext(context.Background(), node)
+	tc, err := node.Component(chasmCtx, ComponentRef{componentPath: RootPath})
+	s.NoError(err)
+	tc.(*TestComponent).ComponentData = &protoMessageType{CreateRequestId: tv.Any().String()}
+
+	s.False(node.IsSpeculative())
+	node.SetSpeculative()
+	s.True(node.IsSpeculative())
+	s.True(node.IsDirty(), "speculative changes are still pending")
+
+	s.nodeBackend.EXPECT().NextTransitionCount().Return(int64(1)).AnyTimes()
+	s.nodeBackend.EXPECT().GetCurrentVersion().Return(int64(1)).AnyTimes()
+	s.nodeBackend.EXPECT().GetWorkflowKey().Return(tv.Any().WorkflowKey()).AnyTimes()
+	s.nodeBackend.EXPECT().UpdateWorkflowStateStatus(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	// Next non-speculative transition persists the speculative changes.
+	mutations, err := node.CloseTransaction()
+	s.NoError(err)
+	s.Contains(mutations.UpdatedNodes, "", "root component must be in UpdatedNodes")
+	s.False(node.IsSpeculative())
+	s.False(node.IsDirty())
+}
+
 func (s *nodeSuite) TestCloseTransaction_EmptyNode() {
 	s.nodeBackend.EXPECT().NextTransitionCount().Return(int64(1)).Times(1) // for InitialVersionedTransition of the root component.
 	s.nodeBackend.EXPECT().GetCurrentVersion().Return(int64(1)).Times(1)
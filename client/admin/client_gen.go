@@ -59,6 +59,16 @@ func (c *clientImpl) CloseShard(
 	return c.client.CloseShard(ctx, request, opts...)
 }
 
+func (c *clientImpl) CreateScheduleExclusionCalendar(
+	ctx context.Context,
+	request *adminservice.CreateScheduleExclusionCalendarRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CreateScheduleExclusionCalendarResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.CreateScheduleExclusionCalendar(ctx, request, opts...)
+}
+
 func (c *clientImpl) DeepHealthCheck(
 	ctx context.Context,
 	request *adminservice.DeepHealthCheckRequest,
@@ -69,6 +79,16 @@ func (c *clientImpl) DeepHealthCheck(
 	return c.client.DeepHealthCheck(ctx, request, opts...)
 }
 
+func (c *clientImpl) DeleteScheduleExclusionCalendar(
+	ctx context.Context,
+	request *adminservice.DeleteScheduleExclusionCalendarRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteScheduleExclusionCalendarResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DeleteScheduleExclusionCalendar(ctx, request, opts...)
+}
+
 func (c *clientImpl) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -119,6 +139,16 @@ func (c *clientImpl) DescribeMutableState(
 	return c.client.DescribeMutableState(ctx, request, opts...)
 }
 
+func (c *clientImpl) DescribeScheduleExclusionCalendar(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleExclusionCalendarRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeScheduleExclusionCalendarResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.DescribeScheduleExclusionCalendar(ctx, request, opts...)
+}
+
 func (c *clientImpl) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -129,6 +159,16 @@ func (c *clientImpl) DescribeTaskQueuePartition(
 	return c.client.DescribeTaskQueuePartition(ctx, request, opts...)
 }
 
+func (c *clientImpl) ExplainDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ExplainDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExplainDynamicConfigResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ExplainDynamicConfig(ctx, request, opts...)
+}
+
 func (c *clientImpl) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -179,6 +219,16 @@ func (c *clientImpl) GetDLQTasks(
 	return c.client.GetDLQTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.GetDynamicConfig(ctx, request, opts...)
+}
+
 func (c *clientImpl) GetNamespace(
 	ctx context.Context,
 	request *adminservice.GetNamespaceRequest,
@@ -269,6 +319,16 @@ func (c *clientImpl) ImportWorkflowExecution(
 	return c.client.ImportWorkflowExecution(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListBatchOperationResults(
+	ctx context.Context,
+	request *adminservice.ListBatchOperationResultsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListBatchOperationResultsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListBatchOperationResults(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -289,6 +349,26 @@ func (c *clientImpl) ListClusters(
 	return c.client.ListClusters(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListDynamicConfig(ctx, request, opts...)
+}
+
+func (c *clientImpl) ListDynamicConfigDeviations(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigDeviationsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigDeviationsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListDynamicConfigDeviations(ctx, request, opts...)
+}
+
 func (c *clientImpl) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -309,6 +389,16 @@ func (c *clientImpl) ListQueues(
 	return c.client.ListQueues(ctx, request, opts...)
 }
 
+func (c *clientImpl) ListScheduleExclusionCalendars(
+	ctx context.Context,
+	request *adminservice.ListScheduleExclusionCalendarsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListScheduleExclusionCalendarsResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ListScheduleExclusionCalendars(ctx, request, opts...)
+}
+
 func (c *clientImpl) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -329,6 +419,26 @@ func (c *clientImpl) MergeDLQTasks(
 	return c.client.MergeDLQTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) ModifyActivityProperties(
+	ctx context.Context,
+	request *adminservice.ModifyActivityPropertiesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ModifyActivityPropertiesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ModifyActivityProperties(ctx, request, opts...)
+}
+
+func (c *clientImpl) ModifyWorkflowProperties(
+	ctx context.Context,
+	request *adminservice.ModifyWorkflowPropertiesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ModifyWorkflowPropertiesResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.ModifyWorkflowProperties(ctx, request, opts...)
+}
+
 func (c *clientImpl) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -419,6 +529,16 @@ func (c *clientImpl) ResendReplicationTasks(
 	return c.client.ResendReplicationTasks(ctx, request, opts...)
 }
 
+func (c *clientImpl) SetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetDynamicConfigResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.SetDynamicConfig(ctx, request, opts...)
+}
+
 func (c *clientImpl) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -428,3 +548,13 @@ func (c *clientImpl) SyncWorkflowState(
 	defer cancel()
 	return c.client.SyncWorkflowState(ctx, request, opts...)
 }
+
+func (c *clientImpl) UpdateScheduleExclusionCalendar(
+	ctx context.Context,
+	request *adminservice.UpdateScheduleExclusionCalendarRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateScheduleExclusionCalendarResponse, error) {
+	ctx, cancel := c.createContext(ctx)
+	defer cancel()
+	return c.client.UpdateScheduleExclusionCalendar(ctx, request, opts...)
+}
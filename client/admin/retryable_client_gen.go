@@ -86,6 +86,21 @@ func (c *retryableClient) CloseShard(
 	return resp, err
 }
 
+func (c *retryableClient) CreateScheduleExclusionCalendar(
+	ctx context.Context,
+	request *adminservice.CreateScheduleExclusionCalendarRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.CreateScheduleExclusionCalendarResponse, error) {
+	var resp *adminservice.CreateScheduleExclusionCalendarResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.CreateScheduleExclusionCalendar(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DeepHealthCheck(
 	ctx context.Context,
 	request *adminservice.DeepHealthCheckRequest,
@@ -101,6 +116,21 @@ func (c *retryableClient) DeepHealthCheck(
 	return resp, err
 }
 
+func (c *retryableClient) DeleteScheduleExclusionCalendar(
+	ctx context.Context,
+	request *adminservice.DeleteScheduleExclusionCalendarRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DeleteScheduleExclusionCalendarResponse, error) {
+	var resp *adminservice.DeleteScheduleExclusionCalendarResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DeleteScheduleExclusionCalendar(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -176,6 +206,21 @@ func (c *retryableClient) DescribeMutableState(
 	return resp, err
 }
 
+func (c *retryableClient) DescribeScheduleExclusionCalendar(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleExclusionCalendarRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.DescribeScheduleExclusionCalendarResponse, error) {
+	var resp *adminservice.DescribeScheduleExclusionCalendarResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.DescribeScheduleExclusionCalendar(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) DescribeTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.DescribeTaskQueuePartitionRequest,
@@ -191,6 +236,21 @@ func (c *retryableClient) DescribeTaskQueuePartition(
 	return resp, err
 }
 
+func (c *retryableClient) ExplainDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ExplainDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ExplainDynamicConfigResponse, error) {
+	var resp *adminservice.ExplainDynamicConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ExplainDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ForceUnloadTaskQueuePartition(
 	ctx context.Context,
 	request *adminservice.ForceUnloadTaskQueuePartitionRequest,
@@ -266,6 +326,21 @@ func (c *retryableClient) GetDLQTasks(
 	return resp, err
 }
 
+func (c *retryableClient) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.GetDynamicConfigResponse, error) {
+	var resp *adminservice.GetDynamicConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.GetDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) GetNamespace(
 	ctx context.Context,
 	request *adminservice.GetNamespaceRequest,
@@ -401,6 +476,21 @@ func (c *retryableClient) ImportWorkflowExecution(
 	return resp, err
 }
 
+func (c *retryableClient) ListBatchOperationResults(
+	ctx context.Context,
+	request *adminservice.ListBatchOperationResultsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListBatchOperationResultsResponse, error) {
+	var resp *adminservice.ListBatchOperationResultsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListBatchOperationResults(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListClusterMembers(
 	ctx context.Context,
 	request *adminservice.ListClusterMembersRequest,
@@ -431,6 +521,36 @@ func (c *retryableClient) ListClusters(
 	return resp, err
 }
 
+func (c *retryableClient) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigResponse, error) {
+	var resp *adminservice.ListDynamicConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ListDynamicConfigDeviations(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigDeviationsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListDynamicConfigDeviationsResponse, error) {
+	var resp *adminservice.ListDynamicConfigDeviationsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListDynamicConfigDeviations(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) ListHistoryTasks(
 	ctx context.Context,
 	request *adminservice.ListHistoryTasksRequest,
@@ -461,6 +581,21 @@ func (c *retryableClient) ListQueues(
 	return resp, err
 }
 
+func (c *retryableClient) ListScheduleExclusionCalendars(
+	ctx context.Context,
+	request *adminservice.ListScheduleExclusionCalendarsRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ListScheduleExclusionCalendarsResponse, error) {
+	var resp *adminservice.ListScheduleExclusionCalendarsResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ListScheduleExclusionCalendars(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) MergeDLQMessages(
 	ctx context.Context,
 	request *adminservice.MergeDLQMessagesRequest,
@@ -491,6 +626,36 @@ func (c *retryableClient) MergeDLQTasks(
 	return resp, err
 }
 
+func (c *retryableClient) ModifyActivityProperties(
+	ctx context.Context,
+	request *adminservice.ModifyActivityPropertiesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ModifyActivityPropertiesResponse, error) {
+	var resp *adminservice.ModifyActivityPropertiesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ModifyActivityProperties(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ModifyWorkflowProperties(
+	ctx context.Context,
+	request *adminservice.ModifyWorkflowPropertiesRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.ModifyWorkflowPropertiesResponse, error) {
+	var resp *adminservice.ModifyWorkflowPropertiesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ModifyWorkflowProperties(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PurgeDLQMessages(
 	ctx context.Context,
 	request *adminservice.PurgeDLQMessagesRequest,
@@ -626,6 +791,21 @@ func (c *retryableClient) ResendReplicationTasks(
 	return resp, err
 }
 
+func (c *retryableClient) SetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.SetDynamicConfigResponse, error) {
+	var resp *adminservice.SetDynamicConfigResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.SetDynamicConfig(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) SyncWorkflowState(
 	ctx context.Context,
 	request *adminservice.SyncWorkflowStateRequest,
@@ -640,3 +820,18 @@ func (c *retryableClient) SyncWorkflowState(
 	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
 	return resp, err
 }
+
+func (c *retryableClient) UpdateScheduleExclusionCalendar(
+	ctx context.Context,
+	request *adminservice.UpdateScheduleExclusionCalendarRequest,
+	opts ...grpc.CallOption,
+) (*adminservice.UpdateScheduleExclusionCalendarResponse, error) {
+	var resp *adminservice.UpdateScheduleExclusionCalendarResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.UpdateScheduleExclusionCalendar(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
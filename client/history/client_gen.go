@@ -554,6 +554,66 @@ func (c *clientImpl) MergeDLQMessages(
 	return response, nil
 }
 
+func (c *clientImpl) MigrateSchedule(
+	ctx context.Context,
+	request *historyservice.MigrateScheduleRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.MigrateScheduleResponse, error) {
+	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	var response *historyservice.MigrateScheduleResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.MigrateSchedule(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) ModifyActivityProperties(
+	ctx context.Context,
+	request *historyservice.ModifyActivityPropertiesRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ModifyActivityPropertiesResponse, error) {
+	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	var response *historyservice.ModifyActivityPropertiesResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ModifyActivityProperties(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+func (c *clientImpl) ModifyWorkflowProperties(
+	ctx context.Context,
+	request *historyservice.ModifyWorkflowPropertiesRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ModifyWorkflowPropertiesResponse, error) {
+	shardID := c.shardIDFromWorkflowID(request.GetNamespaceId(), request.GetExecution().GetWorkflowId())
+	var response *historyservice.ModifyWorkflowPropertiesResponse
+	op := func(ctx context.Context, client historyservice.HistoryServiceClient) error {
+		var err error
+		ctx, cancel := c.createContext(ctx)
+		defer cancel()
+		response, err = client.ModifyWorkflowProperties(ctx, request, opts...)
+		return err
+	}
+	if err := c.executeWithRedirect(ctx, shardID, op); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
 func (c *clientImpl) PauseActivity(
 	ctx context.Context,
 	request *historyservice.PauseActivityRequest,
@@ -443,6 +443,48 @@ func (c *metricClient) MergeDLQMessages(
 	return c.client.MergeDLQMessages(ctx, request, opts...)
 }
 
+func (c *metricClient) MigrateSchedule(
+	ctx context.Context,
+	request *historyservice.MigrateScheduleRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.MigrateScheduleResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientMigrateSchedule")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.MigrateSchedule(ctx, request, opts...)
+}
+
+func (c *metricClient) ModifyActivityProperties(
+	ctx context.Context,
+	request *historyservice.ModifyActivityPropertiesRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.ModifyActivityPropertiesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientModifyActivityProperties")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ModifyActivityProperties(ctx, request, opts...)
+}
+
+func (c *metricClient) ModifyWorkflowProperties(
+	ctx context.Context,
+	request *historyservice.ModifyWorkflowPropertiesRequest,
+	opts ...grpc.CallOption,
+) (_ *historyservice.ModifyWorkflowPropertiesResponse, retError error) {
+
+	metricsHandler, startTime := c.startMetricsRecording(ctx, "HistoryClientModifyWorkflowProperties")
+	defer func() {
+		c.finishMetricsRecording(metricsHandler, startTime, retError)
+	}()
+
+	return c.client.ModifyWorkflowProperties(ctx, request, opts...)
+}
+
 func (c *metricClient) PauseActivity(
 	ctx context.Context,
 	request *historyservice.PauseActivityRequest,
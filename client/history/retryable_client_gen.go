@@ -476,6 +476,51 @@ func (c *retryableClient) MergeDLQMessages(
 	return resp, err
 }
 
+func (c *retryableClient) MigrateSchedule(
+	ctx context.Context,
+	request *historyservice.MigrateScheduleRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.MigrateScheduleResponse, error) {
+	var resp *historyservice.MigrateScheduleResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.MigrateSchedule(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ModifyActivityProperties(
+	ctx context.Context,
+	request *historyservice.ModifyActivityPropertiesRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ModifyActivityPropertiesResponse, error) {
+	var resp *historyservice.ModifyActivityPropertiesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ModifyActivityProperties(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
+func (c *retryableClient) ModifyWorkflowProperties(
+	ctx context.Context,
+	request *historyservice.ModifyWorkflowPropertiesRequest,
+	opts ...grpc.CallOption,
+) (*historyservice.ModifyWorkflowPropertiesResponse, error) {
+	var resp *historyservice.ModifyWorkflowPropertiesResponse
+	op := func(ctx context.Context) error {
+		var err error
+		resp, err = c.client.ModifyWorkflowProperties(ctx, request, opts...)
+		return err
+	}
+	err := backoff.ThrottleRetryContext(ctx, op, c.policy, c.isRetryable)
+	return resp, err
+}
+
 func (c *retryableClient) PauseActivity(
 	ctx context.Context,
 	request *historyservice.PauseActivityRequest,
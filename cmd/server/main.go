@@ -78,6 +78,12 @@ func buildCLI() *cli.App {
 			Name:      "validate-dynamic-config",
 			Usage:     "Validate a dynamic config file[s] with known keys and types",
 			ArgsUsage: "<file> ...",
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:  "strict",
+					Usage: "treat warnings, e.g. unregistered keys and constraints that never match, as errors",
+				},
+			},
 			Action: func(c *cli.Context) error {
 				total := 0
 				for _, fileName := range c.Args().Slice() {
@@ -87,6 +93,9 @@ func buildCLI() *cli.App {
 					}
 					result := dynamicconfig.ValidateFile(contents)
 					total += len(result.Errors)
+					if c.Bool("strict") {
+						total += len(result.Warnings)
+					}
 					fmt.Println(fileName)
 					t := template.Must(template.New("").Parse(
 						"{{range .Errors}}  error: {{.}}\n" +
@@ -96,7 +105,7 @@ func buildCLI() *cli.App {
 					_ = t.Execute(os.Stdout, result)
 				}
 				if total > 0 {
-					return fmt.Errorf("%d total errors", total)
+					return cli.Exit(fmt.Errorf("%d total errors", total), 1)
 				}
 				return nil
 			},
@@ -188,8 +197,9 @@ func buildCLI() *cli.App {
 					logger.Info("Dynamic config client is not configured. Using noop client.")
 				}
 
-				authorizer, err := authorization.GetAuthorizerFromConfig(
+				authorizer, err := authorization.NewAuthorizerFromConfig(
 					&cfg.Global.Authorization,
+					logger,
 				)
 				if err != nil {
 					return cli.Exit(fmt.Sprintf("Unable to instantiate authorizer. Error: %v", err), 1)
@@ -16,6 +16,8 @@ type (
 		Name   string
 		GoArgs string
 		Expr   string
+		// Bind assigns the arguments of Expr from the fields of a Constraints value cs.
+		Bind string
 	}
 
 	dynamicConfigData struct {
@@ -70,11 +72,13 @@ var (
 				Name:   "Namespace",
 				GoArgs: "namespace string",
 				Expr:   "[]Constraints{{Namespace: namespace}, {}}",
+				Bind:   "namespace := cs.Namespace",
 			},
 			{
 				Name:   "NamespaceID",
 				GoArgs: "namespaceID namespace.ID",
 				Expr:   "[]Constraints{{NamespaceID: namespaceID.String()}, {}}",
+				Bind:   "namespaceID := namespace.ID(cs.NamespaceID)",
 			},
 			{
 				Name:   "TaskQueue",
@@ -89,16 +93,19 @@ var (
 			{Namespace: namespace},
 			{},
 		}`,
+				Bind: "namespace, taskQueue, taskQueueType := cs.Namespace, cs.TaskQueueName, cs.TaskQueueType",
 			},
 			{
 				Name:   "ShardID",
 				GoArgs: "shardID int32",
 				Expr:   "[]Constraints{{ShardID: shardID}, {}}",
+				Bind:   "shardID := cs.ShardID",
 			},
 			{
 				Name:   "TaskType",
 				GoArgs: "taskType enumsspb.TaskType",
 				Expr:   "[]Constraints{{TaskType: taskType}, {}}",
+				Bind:   "taskType := cs.TaskType",
 			},
 			{
 				Name:   "Destination",
@@ -109,6 +116,7 @@ var (
 			{Namespace: namespace},
 			{},
 		}`,
+				Bind: "namespace, destination := cs.Namespace, cs.Destination",
 			},
 		}}
 )
@@ -170,6 +170,11 @@ var (
 		"GetNexusEndpoint":         {Scope: ScopeCluster, Access: AccessAdmin, Polling: PollingNone},
 		"ListNexusEndpoints":       {Scope: ScopeCluster, Access: AccessAdmin, Polling: PollingNone},
 	}
+	// AdminService methods are cluster/admin, except for those listed here.
+	adminServiceMetadata = map[string]MethodMetadata{
+		// Results of a batch operation are readable by whoever can describe it.
+		"ListBatchOperationResults": {Scope: ScopeNamespace, Access: AccessReadOnly, Polling: PollingNone},
+	}
 	nexusServiceMetadata = map[string]MethodMetadata{
 		"DispatchNexusTask":               {Scope: ScopeNamespace, Access: AccessWrite, Polling: PollingNone},
 		"DispatchByNamespaceAndTaskQueue": {Scope: ScopeNamespace, Access: AccessWrite, Polling: PollingNone},
@@ -189,6 +194,9 @@ func GetMethodMetadata(fullApiName string) MethodMetadata {
 	case strings.HasPrefix(fullApiName, NexusServicePrefix):
 		return nexusServiceMetadata[MethodName(fullApiName)]
 	case strings.HasPrefix(fullApiName, AdminServicePrefix):
+		if md, ok := adminServiceMetadata[MethodName(fullApiName)]; ok {
+			return md
+		}
 		return MethodMetadata{Scope: ScopeCluster, Access: AccessAdmin}
 	default:
 		return MethodMetadata{Scope: ScopeUnknown, Access: AccessUnknown}
@@ -79,11 +79,15 @@ func TestGetMethodMetadata(t *testing.T) {
 	assert.Equal(t, ScopeNamespace, md.Scope)
 	assert.Equal(t, AccessWrite, md.Access)
 
-	// all AdminService is cluster/admin
+	// AdminService is cluster/admin unless listed
 	md = GetMethodMetadata("/temporal.server.api.adminservice.v1.AdminService/CloseShard")
 	assert.Equal(t, ScopeCluster, md.Scope)
 	assert.Equal(t, AccessAdmin, md.Access)
 
+	md = GetMethodMetadata("/temporal.server.api.adminservice.v1.AdminService/ListBatchOperationResults")
+	assert.Equal(t, ScopeNamespace, md.Scope)
+	assert.Equal(t, AccessReadOnly, md.Access)
+
 	md = GetMethodMetadata("/OtherService/Method1")
 	assert.Equal(t, ScopeUnknown, md.Scope)
 	assert.Equal(t, AccessUnknown, md.Access)
@@ -47,6 +47,8 @@ const (
 var (
 	errInvalidFileMode = errors.New("invalid file mode")
 	errInvalidDirMode  = errors.New("invalid directory mode")
+
+	errInvalidVisibilityFormat = errors.New("invalid visibility format")
 )
 
 type (
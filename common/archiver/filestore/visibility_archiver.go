@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path"
+	"slices"
 	"sort"
 	"strconv"
 	"strings"
@@ -15,12 +16,14 @@ import (
 	workflowpb "go.temporal.io/api/workflow/v1"
 	archiverspb "go.temporal.io/server/api/archiver/v1"
 	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/collection"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/searchattribute"
+	"go.uber.org/multierr"
 )
 
 const (
@@ -34,6 +37,8 @@ type (
 		fileMode       os.FileMode
 		dirMode        os.FileMode
 		queryParser    QueryParser
+		// segments is set if visibility records are stored in the segment format
+		segments *visibilitySegmentStore
 	}
 
 	queryVisibilityToken struct {
@@ -63,12 +68,21 @@ func NewVisibilityArchiver(
 	if err != nil {
 		return nil, errInvalidDirMode
 	}
+	var segments *visibilitySegmentStore
+	switch config.VisibilityFormat {
+	case "", VisibilityFormatJSON:
+	case VisibilityFormatSegment:
+		segments = newVisibilitySegmentStore(os.FileMode(fileMode), os.FileMode(dirMode))
+	default:
+		return nil, errInvalidVisibilityFormat
+	}
 	return &visibilityArchiver{
 		logger:         logger,
 		metricsHandler: metricsHandler,
 		fileMode:       os.FileMode(fileMode),
 		dirMode:        os.FileMode(dirMode),
 		queryParser:    NewQueryParser(),
+		segments:       segments,
 	}, nil
 }
 
@@ -103,6 +117,14 @@ func (v *visibilityArchiver) Archive(
 		return err
 	}
 
+	if v.segments != nil {
+		if err := v.segments.append(dirPath, request); err != nil {
+			logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errWriteFile), tag.Error(err))
+			return err
+		}
+		return nil
+	}
+
 	encodedVisibilityRecord, err := encode(request)
 	if err != nil {
 		logger.Error(archiver.ArchiveNonRetryableErrorMsg, tag.ArchivalArchiveFailReason(errEncodeVisibilityRecord), tag.Error(err))
@@ -134,6 +156,14 @@ func (v *visibilityArchiver) Query(
 		return nil, serviceerror.NewInvalidArgument(archiver.ErrInvalidQueryVisibilityRequest.Error())
 	}
 
+	if v.segments != nil {
+		query, err := parseVisibilityQuery(request.Query, saTypeMap)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(err.Error())
+		}
+		return v.querySegments(ctx, URI, request, query, saTypeMap)
+	}
+
 	parsedQuery, err := v.queryParser.Parse(request.Query)
 	if err != nil {
 		return nil, serviceerror.NewInvalidArgument(err.Error())
@@ -236,6 +266,195 @@ func (v *visibilityArchiver) query(
 	return response, nil
 }
 
+type segmentQueryMatch struct {
+	record    *archiverspb.VisibilityRecord
+	sortKey   visibilitySortKey
+	partition string
+}
+
+// segmentQueryPage keeps the first limit matches in query order seen so far, so a query never
+// holds more than a page of records in memory regardless of how many records match.
+type segmentQueryPage struct {
+	limit   int
+	matches collection.Queue[segmentQueryMatch]
+	compare func(a, b visibilitySortKey) int
+}
+
+func newSegmentQueryPage(query *visibilityQuery, limit int) *segmentQueryPage {
+	return &segmentQueryPage{
+		limit: limit,
+		// the last match in query order is on top, to be evicted by a match sorting before it
+		matches: collection.NewPriorityQueue(func(a, b segmentQueryMatch) bool {
+			return query.compareSortKeys(a.sortKey, b.sortKey) > 0
+		}),
+		compare: query.compareSortKeys,
+	}
+}
+
+func (p *segmentQueryPage) add(match segmentQueryMatch) {
+	if p.matches.Len() == p.limit {
+		if p.compare(match.sortKey, p.matches.Peek().sortKey) >= 0 {
+			return
+		}
+		p.matches.Remove()
+	}
+	p.matches.Add(match)
+}
+
+func (p *segmentQueryPage) len() int {
+	return p.matches.Len()
+}
+
+// sorted drains the page and returns its matches in query order.
+func (p *segmentQueryPage) sorted() []segmentQueryMatch {
+	matches := make([]segmentQueryMatch, p.matches.Len())
+	for i := len(matches) - 1; i >= 0; i-- {
+		matches[i] = p.matches.Remove()
+	}
+	return matches
+}
+
+// querySegments answers a query from segments. Partitions are read newest first, and for the
+// default order (newest first), reading starts at the partition of the page token and stops as
+// soon as a page is filled. Other orders read every partition in the close time range, keeping
+// only the matches of the page, plus one to tell whether there are more, in memory; the page
+// token carries the sort key of the last match returned.
+func (v *visibilityArchiver) querySegments(
+	ctx context.Context,
+	URI archiver.URI,
+	request *archiver.QueryVisibilityRequest,
+	query *visibilityQuery,
+	saTypeMap searchattribute.NameTypeMap,
+) (*archiver.QueryVisibilityResponse, error) {
+	var after *visibilitySortKey
+	var afterPartition string
+	if request.NextPageToken != nil {
+		key, partition, err := query.deserializeToken(request.NextPageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgument(archiver.ErrNextPageTokenCorrupted.Error())
+		}
+		after = &key
+		afterPartition = partition
+	}
+
+	partitions, err := v.segments.partitions(
+		path.Join(URI.Path(), request.NamespaceID),
+		query.earliestCloseTime,
+		query.latestCloseTime,
+	)
+	if err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	if query.isDefaultOrder() && afterPartition != "" {
+		// Records of newer partitions were returned by previous pages. Partition names sort
+		// like their hours.
+		partitions = slices.DeleteFunc(partitions, func(p segmentPartition) bool {
+			return p.name > afterPartition
+		})
+	}
+
+	page := newSegmentQueryPage(query, request.PageSize+1)
+	partitionsRead := 0
+	for _, partition := range partitions {
+		if query.isDefaultOrder() && page.len() >= request.PageSize {
+			break
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		if err := v.queryPartition(partition, query, after, page, saTypeMap); err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		partitionsRead++
+	}
+
+	matches := page.sorted()
+
+	response := &archiver.QueryVisibilityResponse{}
+	for _, match := range matches[:min(len(matches), request.PageSize)] {
+		executionInfo, err := convertToExecutionInfo(match.record, saTypeMap)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		response.Executions = append(response.Executions, executionInfo)
+	}
+	if len(matches) > request.PageSize ||
+		(len(matches) == request.PageSize && partitionsRead < len(partitions)) {
+		last := matches[request.PageSize-1]
+		var lastPartition string
+		if query.isDefaultOrder() {
+			lastPartition = last.partition
+		}
+		response.NextPageToken, err = query.serializeToken(last.sortKey, lastPartition)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+	}
+	return response, nil
+}
+
+func (v *visibilityArchiver) queryPartition(
+	partition segmentPartition,
+	query *visibilityQuery,
+	after *visibilitySortKey,
+	page *segmentQueryPage,
+	saTypeMap searchattribute.NameTypeMap,
+) (retErr error) {
+	entries, err := v.segments.readIndex(partition)
+	if err != nil {
+		return err
+	}
+
+	// a record is archived again if archival is retried, use the latest one
+	latest := make(map[string]*segmentIndexEntry, len(entries))
+	for _, entry := range entries {
+		latest[entry.RunID] = entry
+	}
+
+	var segment *os.File
+	defer func() {
+		if segment != nil {
+			retErr = multierr.Combine(retErr, segment.Close())
+		}
+	}()
+
+	for _, entry := range entries {
+		if latest[entry.RunID] != entry {
+			continue
+		}
+		closeTime := time.Unix(0, entry.CloseTime)
+		if closeTime.Before(query.earliestCloseTime) || closeTime.After(query.latestCloseTime) {
+			continue
+		}
+		if !query.matchIndex(entry.values) {
+			continue
+		}
+
+		if segment == nil {
+			if segment, err = v.segments.openSegment(partition); err != nil {
+				return err
+			}
+		}
+		record, err := v.segments.readRecord(segment, entry)
+		if err != nil {
+			return err
+		}
+		values, err := visibilityRecordValues(record, saTypeMap)
+		if err != nil {
+			return err
+		}
+		if !query.match(values) {
+			continue
+		}
+		sortKey := query.sortKey(values, record.GetRunId())
+		if after != nil && query.compareSortKeys(sortKey, *after) <= 0 {
+			continue
+		}
+		page.add(segmentQueryMatch{record: record, sortKey: sortKey, partition: partition.name})
+	}
+	return nil
+}
+
 func (v *visibilityArchiver) ValidateURI(URI archiver.URI) error {
 	if URI.Scheme() != URIScheme {
 		return archiver.ErrURISchemeMismatch
@@ -0,0 +1,677 @@
+package filestore
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/temporalio/sqlparser"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/common/sqlquery"
+	"go.temporal.io/server/common/util"
+)
+
+type (
+	// visibilityQuery is a visibility query compiled for evaluation against archived records.
+	// Unlike parsedQuery, it supports the full visibility query grammar: comparisons, IN,
+	// BETWEEN, STARTS_WITH, IS NULL, AND, OR, and NOT on system fields and custom search
+	// attributes, and ORDER BY.
+	visibilityQuery struct {
+		filter  visibilityFilter // nil matches all records
+		orderBy []visibilityOrderBy
+
+		// indexFilters are top-level conjuncts that only reference indexed fields, so every
+		// matching record must satisfy them. They can be evaluated against the segment index
+		// without reading the record.
+		indexFilters []visibilityFilter
+
+		// close time range implied by the query, used to skip whole segments
+		earliestCloseTime time.Time
+		latestCloseTime   time.Time
+	}
+
+	visibilityOrderBy struct {
+		field     string
+		valueType enumspb.IndexedValueType
+		desc      bool
+	}
+
+	// visibilityFilter returns whether a record with the given field values matches.
+	visibilityFilter func(values visibilityValues) bool
+
+	// visibilityValues returns the value of a field, or false if the record doesn't have it.
+	// Values are string, []string, int64, float64, bool, or time.Time. ExecutionStatus is an
+	// int64 of the enum value and ExecutionDuration is an int64 of nanoseconds.
+	visibilityValues func(field string) (any, bool)
+
+	// visibilitySortKey holds the values of the ORDER BY fields of a record and its run id,
+	// which breaks ties.
+	visibilitySortKey struct {
+		values []any
+		runID  string
+	}
+
+	// segmentQueryToken is the next page token for segment queries. Values has the ORDER BY
+	// values of the last returned record, with times as unix nanos. Partition is the partition
+	// of the last returned record for queries in the default order, so that the next page starts
+	// reading from it instead of the newest partition.
+	segmentQueryToken struct {
+		Values    []any  `json:"values"`
+		RunID     string `json:"runId"`
+		Partition string `json:"partition,omitempty"`
+	}
+)
+
+// segmentIndexedFields are the fields stored in the segment index.
+var segmentIndexedFields = map[string]struct{}{
+	searchattribute.WorkflowID:      {},
+	searchattribute.RunID:           {},
+	searchattribute.WorkflowType:    {},
+	searchattribute.ExecutionStatus: {},
+	searchattribute.StartTime:       {},
+	searchattribute.CloseTime:       {},
+}
+
+var errUnsupportedValueType = errors.New("unsupported value type")
+
+// parseVisibilityQuery parses and compiles query. Custom search attribute names and types
+// are resolved with saTypeMap.
+func parseVisibilityQuery(query string, saTypeMap searchattribute.NameTypeMap) (*visibilityQuery, error) {
+	q := &visibilityQuery{
+		earliestCloseTime: time.Time{},
+		latestCloseTime:   time.Now().UTC(),
+	}
+
+	query = strings.TrimSpace(query)
+	var sql string
+	switch {
+	case query == "":
+		q.orderBy = defaultVisibilityOrderBy()
+		return q, nil
+	case strings.HasPrefix(strings.ToLower(query), "order by "):
+		sql = "select * from dummy " + query
+	default:
+		sql = fmt.Sprintf(sqlquery.QueryTemplate, query)
+	}
+
+	stmt, err := sqlparser.Parse(sql)
+	if err != nil {
+		return nil, err
+	}
+	selectStmt, ok := stmt.(*sqlparser.Select)
+	if !ok {
+		return nil, fmt.Errorf("unsupported query: %s", query)
+	}
+	if selectStmt.Limit != nil || selectStmt.GroupBy != nil || selectStmt.Having != nil {
+		return nil, fmt.Errorf("only where and order by clauses are supported: %s", query)
+	}
+
+	c := &visibilityQueryCompiler{saTypeMap: saTypeMap}
+	if selectStmt.Where != nil {
+		q.filter, _, err = c.compile(selectStmt.Where.Expr)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.compileConjuncts(selectStmt.Where.Expr, q); err != nil {
+			return nil, err
+		}
+	}
+
+	q.orderBy, err = c.compileOrderBy(selectStmt.OrderBy)
+	if err != nil {
+		return nil, err
+	}
+	return q, nil
+}
+
+func defaultVisibilityOrderBy() []visibilityOrderBy {
+	return []visibilityOrderBy{{
+		field:     searchattribute.CloseTime,
+		valueType: enumspb.INDEXED_VALUE_TYPE_DATETIME,
+		desc:      true,
+	}}
+}
+
+// isDefaultOrder returns true if records are ordered by close time, newest first, which is
+// also the order of segments.
+func (q *visibilityQuery) isDefaultOrder() bool {
+	return len(q.orderBy) == 1 &&
+		q.orderBy[0].field == searchattribute.CloseTime &&
+		q.orderBy[0].desc
+}
+
+func (q *visibilityQuery) match(values visibilityValues) bool {
+	return q.filter == nil || q.filter(values)
+}
+
+func (q *visibilityQuery) matchIndex(values visibilityValues) bool {
+	for _, f := range q.indexFilters {
+		if !f(values) {
+			return false
+		}
+	}
+	return true
+}
+
+func (q *visibilityQuery) sortKey(values visibilityValues, runID string) visibilitySortKey {
+	key := visibilitySortKey{values: make([]any, len(q.orderBy)), runID: runID}
+	for i, o := range q.orderBy {
+		if v, ok := values(o.field); ok {
+			key.values[i] = v
+		}
+	}
+	return key
+}
+
+// compareSortKeys returns a negative number if a sorts before b. Missing values sort last.
+func (q *visibilityQuery) compareSortKeys(a, b visibilitySortKey) int {
+	for i, o := range q.orderBy {
+		av, bv := a.values[i], b.values[i]
+		switch {
+		case av == nil && bv == nil:
+			continue
+		case av == nil:
+			return 1
+		case bv == nil:
+			return -1
+		}
+		c, _ := compareValues(av, bv)
+		if o.desc {
+			c = -c
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	return strings.Compare(a.runID, b.runID)
+}
+
+func (q *visibilityQuery) serializeToken(key visibilitySortKey, partition string) ([]byte, error) {
+	token := &segmentQueryToken{Values: make([]any, len(key.values)), RunID: key.runID, Partition: partition}
+	for i, v := range key.values {
+		if t, ok := v.(time.Time); ok {
+			v = t.UnixNano()
+		}
+		token.Values[i] = v
+	}
+	return json.Marshal(token)
+}
+
+// deserializeToken returns the sort key and the partition of the last returned record.
+func (q *visibilityQuery) deserializeToken(data []byte) (visibilitySortKey, string, error) {
+	var token segmentQueryToken
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	if err := decoder.Decode(&token); err != nil {
+		return visibilitySortKey{}, "", err
+	}
+	if len(token.Values) != len(q.orderBy) {
+		return visibilitySortKey{}, "", errors.New("next page token doesn't match query")
+	}
+
+	key := visibilitySortKey{values: make([]any, len(token.Values)), runID: token.RunID}
+	for i, v := range token.Values {
+		if v == nil {
+			continue
+		}
+		value, err := decodeTokenValue(v, q.orderBy[i].valueType)
+		if err != nil {
+			return visibilitySortKey{}, "", err
+		}
+		key.values[i] = value
+	}
+	return key, token.Partition, nil
+}
+
+func decodeTokenValue(v any, t enumspb.IndexedValueType) (any, error) {
+	switch t {
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD:
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_INT, enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		if n, ok := v.(json.Number); ok {
+			i, err := n.Int64()
+			if err != nil {
+				return nil, err
+			}
+			if t == enumspb.INDEXED_VALUE_TYPE_DATETIME {
+				return time.Unix(0, i).UTC(), nil
+			}
+			return i, nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		if n, ok := v.(json.Number); ok {
+			return n.Float64()
+		}
+	}
+	return nil, fmt.Errorf("%w in next page token: %v", errUnsupportedValueType, v)
+}
+
+type visibilityQueryCompiler struct {
+	saTypeMap searchattribute.NameTypeMap
+}
+
+// fieldType returns the type of a system field or custom search attribute.
+func (c *visibilityQueryCompiler) fieldType(name string) (enumspb.IndexedValueType, error) {
+	switch name {
+	case searchattribute.WorkflowID, searchattribute.RunID, searchattribute.WorkflowType:
+		return enumspb.INDEXED_VALUE_TYPE_KEYWORD, nil
+	case searchattribute.StartTime, searchattribute.CloseTime, searchattribute.ExecutionTime:
+		return enumspb.INDEXED_VALUE_TYPE_DATETIME, nil
+	case searchattribute.ExecutionStatus, searchattribute.ExecutionDuration, searchattribute.HistoryLength:
+		return enumspb.INDEXED_VALUE_TYPE_INT, nil
+	}
+	t, err := c.saTypeMap.GetType(name)
+	if err != nil {
+		return enumspb.INDEXED_VALUE_TYPE_UNSPECIFIED, fmt.Errorf("unknown filter name: %s", name)
+	}
+	return t, nil
+}
+
+func (c *visibilityQueryCompiler) column(expr sqlparser.Expr) (string, enumspb.IndexedValueType, error) {
+	colName, ok := expr.(*sqlparser.ColName)
+	if !ok {
+		return "", 0, fmt.Errorf("invalid filter name: %s", sqlparser.String(expr))
+	}
+	name := colName.Name.String()
+	t, err := c.fieldType(name)
+	return name, t, err
+}
+
+// literal converts a literal in the query to the representation of the field's values.
+func (c *visibilityQueryCompiler) literal(field string, t enumspb.IndexedValueType, expr sqlparser.Expr) (any, error) {
+	if b, ok := expr.(sqlparser.BoolVal); ok {
+		if t != enumspb.INDEXED_VALUE_TYPE_BOOL {
+			return nil, fmt.Errorf("invalid value for %s: %s", field, sqlparser.String(expr))
+		}
+		return bool(b), nil
+	}
+	val, ok := expr.(*sqlparser.SQLVal)
+	if !ok {
+		return nil, fmt.Errorf("invalid value: %s", sqlparser.String(expr))
+	}
+	valStr := sqlparser.String(val)
+
+	switch field {
+	case searchattribute.ExecutionStatus:
+		statusStr, err := sqlquery.ExtractStringValue(valStr)
+		if err != nil {
+			// if failed to extract string value, it means user input close status as a number
+			statusStr = valStr
+		}
+		status, err := convertStatusStr(statusStr)
+		if err != nil {
+			return nil, err
+		}
+		return int64(status), nil
+	case searchattribute.ExecutionDuration:
+		if durationStr, err := sqlquery.ExtractStringValue(valStr); err == nil {
+			d, err := time.ParseDuration(durationStr)
+			if err != nil {
+				return nil, fmt.Errorf("invalid value for %s: %s", field, valStr)
+			}
+			return d.Nanoseconds(), nil
+		}
+	}
+
+	switch t {
+	case enumspb.INDEXED_VALUE_TYPE_KEYWORD, enumspb.INDEXED_VALUE_TYPE_TEXT, enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST:
+		return sqlquery.ExtractStringValue(valStr)
+	case enumspb.INDEXED_VALUE_TYPE_DATETIME:
+		return sqlquery.ConvertToTime(valStr)
+	case enumspb.INDEXED_VALUE_TYPE_INT:
+		v, err := sqlquery.ParseValue(valStr)
+		if i, ok := v.(int64); ok && err == nil {
+			return i, nil
+		}
+	case enumspb.INDEXED_VALUE_TYPE_DOUBLE:
+		v, err := sqlquery.ParseValue(valStr)
+		if err == nil {
+			switch n := v.(type) {
+			case int64:
+				return float64(n), nil
+			case float64:
+				return n, nil
+			}
+		}
+	case enumspb.INDEXED_VALUE_TYPE_BOOL:
+		if s, err := sqlquery.ExtractStringValue(valStr); err == nil {
+			switch strings.ToLower(s) {
+			case "true":
+				return true, nil
+			case "false":
+				return false, nil
+			}
+		}
+	}
+	return nil, fmt.Errorf("invalid value for %s: %s", field, valStr)
+}
+
+// compile returns a filter for expr and the fields it references.
+func (c *visibilityQueryCompiler) compile(expr sqlparser.Expr) (visibilityFilter, []string, error) {
+	switch expr := expr.(type) {
+	case *sqlparser.ParenExpr:
+		return c.compile(expr.Expr)
+	case *sqlparser.AndExpr:
+		left, lf, err := c.compile(expr.Left)
+		if err != nil {
+			return nil, nil, err
+		}
+		right, rf, err := c.compile(expr.Right)
+		if err != nil {
+			return nil, nil, err
+		}
+		return func(v visibilityValues) bool { return left(v) && right(v) }, append(lf, rf...), nil
+	case *sqlparser.OrExpr:
+		left, lf, err := c.compile(expr.Left)
+		if err != nil {
+			return nil, nil, err
+		}
+		right, rf, err := c.compile(expr.Right)
+		if err != nil {
+			return nil, nil, err
+		}
+		return func(v visibilityValues) bool { return left(v) || right(v) }, append(lf, rf...), nil
+	case *sqlparser.NotExpr:
+		inner, fields, err := c.compile(expr.Expr)
+		if err != nil {
+			return nil, nil, err
+		}
+		return func(v visibilityValues) bool { return !inner(v) }, fields, nil
+	case *sqlparser.ComparisonExpr:
+		return c.compileComparison(expr)
+	case *sqlparser.RangeCond:
+		return c.compileRange(expr)
+	case *sqlparser.IsExpr:
+		return c.compileIs(expr)
+	default:
+		return nil, nil, fmt.Errorf("unsupported expression: %s", sqlparser.String(expr))
+	}
+}
+
+func (c *visibilityQueryCompiler) compileComparison(expr *sqlparser.ComparisonExpr) (visibilityFilter, []string, error) {
+	field, t, err := c.column(expr.Left)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var negate bool
+	var pred func(any) bool
+	switch expr.Operator {
+	case sqlparser.InStr, sqlparser.NotInStr:
+		tuple, ok := expr.Right.(sqlparser.ValTuple)
+		if !ok {
+			return nil, nil, fmt.Errorf("invalid value: %s", sqlparser.String(expr.Right))
+		}
+		values := make([]any, len(tuple))
+		for i, e := range tuple {
+			if values[i], err = c.literal(field, t, e); err != nil {
+				return nil, nil, err
+			}
+		}
+		negate = expr.Operator == sqlparser.NotInStr
+		pred = func(v any) bool {
+			return slices.ContainsFunc(values, func(value any) bool {
+				c, ok := compareValues(v, value)
+				return ok && c == 0
+			})
+		}
+	case sqlparser.StartsWithStr, sqlparser.NotStartsWithStr:
+		if t != enumspb.INDEXED_VALUE_TYPE_KEYWORD && t != enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST {
+			return nil, nil, fmt.Errorf("operator %s is not supported for %s", expr.Operator, field)
+		}
+		value, err := c.literal(field, t, expr.Right)
+		if err != nil {
+			return nil, nil, err
+		}
+		prefix := value.(string)
+		negate = expr.Operator == sqlparser.NotStartsWithStr
+		pred = func(v any) bool {
+			s, ok := v.(string)
+			return ok && strings.HasPrefix(s, prefix)
+		}
+	case sqlparser.EqualStr, sqlparser.NotEqualStr:
+		value, err := c.literal(field, t, expr.Right)
+		if err != nil {
+			return nil, nil, err
+		}
+		negate = expr.Operator == sqlparser.NotEqualStr
+		if t == enumspb.INDEXED_VALUE_TYPE_TEXT {
+			// like full text search, = matches text that contains the value
+			substr := strings.ToLower(value.(string))
+			pred = func(v any) bool {
+				s, ok := v.(string)
+				return ok && strings.Contains(strings.ToLower(s), substr)
+			}
+		} else {
+			pred = func(v any) bool {
+				c, ok := compareValues(v, value)
+				return ok && c == 0
+			}
+		}
+	case sqlparser.LessThanStr, sqlparser.LessEqualStr, sqlparser.GreaterThanStr, sqlparser.GreaterEqualStr:
+		if t == enumspb.INDEXED_VALUE_TYPE_TEXT || t == enumspb.INDEXED_VALUE_TYPE_BOOL ||
+			field == searchattribute.ExecutionStatus {
+			return nil, nil, fmt.Errorf("operator %s is not supported for %s", expr.Operator, field)
+		}
+		value, err := c.literal(field, t, expr.Right)
+		if err != nil {
+			return nil, nil, err
+		}
+		op := expr.Operator
+		pred = func(v any) bool {
+			c, ok := compareValues(v, value)
+			if !ok {
+				return false
+			}
+			switch op {
+			case sqlparser.LessThanStr:
+				return c < 0
+			case sqlparser.LessEqualStr:
+				return c <= 0
+			case sqlparser.GreaterThanStr:
+				return c > 0
+			default:
+				return c >= 0
+			}
+		}
+	default:
+		return nil, nil, fmt.Errorf("operator %s is not supported", expr.Operator)
+	}
+
+	return fieldFilter(field, pred, negate), []string{field}, nil
+}
+
+func (c *visibilityQueryCompiler) compileRange(expr *sqlparser.RangeCond) (visibilityFilter, []string, error) {
+	field, t, err := c.column(expr.Left)
+	if err != nil {
+		return nil, nil, err
+	}
+	if t == enumspb.INDEXED_VALUE_TYPE_TEXT || t == enumspb.INDEXED_VALUE_TYPE_BOOL ||
+		field == searchattribute.ExecutionStatus {
+		return nil, nil, fmt.Errorf("operator %s is not supported for %s", expr.Operator, field)
+	}
+	from, err := c.literal(field, t, expr.From)
+	if err != nil {
+		return nil, nil, err
+	}
+	to, err := c.literal(field, t, expr.To)
+	if err != nil {
+		return nil, nil, err
+	}
+	pred := func(v any) bool {
+		cf, okf := compareValues(v, from)
+		ct, okt := compareValues(v, to)
+		return okf && okt && cf >= 0 && ct <= 0
+	}
+	return fieldFilter(field, pred, expr.Operator == sqlparser.NotBetweenStr), []string{field}, nil
+}
+
+func (c *visibilityQueryCompiler) compileIs(expr *sqlparser.IsExpr) (visibilityFilter, []string, error) {
+	field, _, err := c.column(expr.Expr)
+	if err != nil {
+		return nil, nil, err
+	}
+	switch expr.Operator {
+	case sqlparser.IsNullStr:
+		return func(v visibilityValues) bool { _, ok := v(field); return !ok }, []string{field}, nil
+	case sqlparser.IsNotNullStr:
+		return func(v visibilityValues) bool { _, ok := v(field); return ok }, []string{field}, nil
+	default:
+		return nil, nil, fmt.Errorf("operator %s is not supported", expr.Operator)
+	}
+}
+
+// fieldFilter applies pred to the field value, or to any value for keyword lists. Negated
+// operators match records where the positive operator doesn't, including records without
+// the field.
+func fieldFilter(field string, pred func(any) bool, negate bool) visibilityFilter {
+	return func(values visibilityValues) bool {
+		v, ok := values(field)
+		matched := false
+		if ok {
+			if list, isList := v.([]string); isList {
+				matched = slices.ContainsFunc(list, func(s string) bool { return pred(s) })
+			} else {
+				matched = pred(v)
+			}
+		}
+		return matched != negate
+	}
+}
+
+// compileConjuncts finds top-level conjuncts of expr that can be used to skip records
+// without reading them: ones that only use indexed fields, and close time ranges.
+func (c *visibilityQueryCompiler) compileConjuncts(expr sqlparser.Expr, q *visibilityQuery) error {
+	switch e := expr.(type) {
+	case *sqlparser.ParenExpr:
+		return c.compileConjuncts(e.Expr, q)
+	case *sqlparser.AndExpr:
+		if err := c.compileConjuncts(e.Left, q); err != nil {
+			return err
+		}
+		return c.compileConjuncts(e.Right, q)
+	}
+
+	filter, fields, err := c.compile(expr)
+	if err != nil {
+		return err
+	}
+	indexed := true
+	for _, f := range fields {
+		if _, ok := segmentIndexedFields[f]; !ok {
+			indexed = false
+		}
+	}
+	if indexed {
+		q.indexFilters = append(q.indexFilters, filter)
+	}
+
+	switch e := expr.(type) {
+	case *sqlparser.ComparisonExpr:
+		if name, ok := e.Left.(*sqlparser.ColName); ok && name.Name.String() == searchattribute.CloseTime {
+			// values that aren't a single time, e.g. for IN, don't narrow the range
+			if value, err := c.literal(searchattribute.CloseTime, enumspb.INDEXED_VALUE_TYPE_DATETIME, e.Right); err == nil {
+				q.narrowCloseTime(value.(time.Time), e.Operator)
+			}
+		}
+	case *sqlparser.RangeCond:
+		if name, ok := e.Left.(*sqlparser.ColName); ok && name.Name.String() == searchattribute.CloseTime &&
+			e.Operator == sqlparser.BetweenStr {
+			from, err := c.literal(searchattribute.CloseTime, enumspb.INDEXED_VALUE_TYPE_DATETIME, e.From)
+			if err != nil {
+				return err
+			}
+			to, err := c.literal(searchattribute.CloseTime, enumspb.INDEXED_VALUE_TYPE_DATETIME, e.To)
+			if err != nil {
+				return err
+			}
+			q.narrowCloseTime(from.(time.Time), sqlparser.GreaterEqualStr)
+			q.narrowCloseTime(to.(time.Time), sqlparser.LessEqualStr)
+		}
+	}
+	return nil
+}
+
+func (q *visibilityQuery) narrowCloseTime(t time.Time, op string) {
+	switch op {
+	case sqlparser.EqualStr:
+		q.earliestCloseTime = util.MaxTime(q.earliestCloseTime, t)
+		q.latestCloseTime = util.MinTime(q.latestCloseTime, t)
+	case sqlparser.LessThanStr:
+		q.latestCloseTime = util.MinTime(q.latestCloseTime, t.Add(-time.Nanosecond))
+	case sqlparser.LessEqualStr:
+		q.latestCloseTime = util.MinTime(q.latestCloseTime, t)
+	case sqlparser.GreaterThanStr:
+		q.earliestCloseTime = util.MaxTime(q.earliestCloseTime, t.Add(time.Nanosecond))
+	case sqlparser.GreaterEqualStr:
+		q.earliestCloseTime = util.MaxTime(q.earliestCloseTime, t)
+	}
+}
+
+func (c *visibilityQueryCompiler) compileOrderBy(orderBy sqlparser.OrderBy) ([]visibilityOrderBy, error) {
+	if len(orderBy) == 0 {
+		return defaultVisibilityOrderBy(), nil
+	}
+	result := make([]visibilityOrderBy, len(orderBy))
+	for i, o := range orderBy {
+		field, t, err := c.column(o.Expr)
+		if err != nil {
+			return nil, err
+		}
+		if t == enumspb.INDEXED_VALUE_TYPE_TEXT || t == enumspb.INDEXED_VALUE_TYPE_KEYWORD_LIST {
+			return nil, fmt.Errorf("unable to sort by %s of type %s", field, t)
+		}
+		result[i] = visibilityOrderBy{
+			field:     field,
+			valueType: t,
+			desc:      o.Direction == sqlparser.DescScr,
+		}
+	}
+	return result, nil
+}
+
+// compareValues compares two values of the same type. It returns false if the types differ.
+func compareValues(a, b any) (int, bool) {
+	switch a := a.(type) {
+	case string:
+		if b, ok := b.(string); ok {
+			return strings.Compare(a, b), true
+		}
+	case int64:
+		if b, ok := b.(int64); ok {
+			return cmp.Compare(a, b), true
+		}
+	case float64:
+		if b, ok := b.(float64); ok {
+			return cmp.Compare(a, b), true
+		}
+	case time.Time:
+		if b, ok := b.(time.Time); ok {
+			return a.Compare(b), true
+		}
+	case bool:
+		if b, ok := b.(bool); ok {
+			switch {
+			case a == b:
+				return 0, true
+			case !a:
+				return -1, true
+			default:
+				return 1, true
+			}
+		}
+	}
+	return 0, false
+}
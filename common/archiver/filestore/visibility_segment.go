@@ -0,0 +1,341 @@
+package filestore
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/searchattribute"
+	"go.uber.org/multierr"
+)
+
+const (
+	// VisibilityFormatJSON stores each visibility record in its own JSON file. This is the default.
+	VisibilityFormatJSON = "json"
+	// VisibilityFormatSegment stores visibility records in compressed, time-partitioned segment
+	// files with a sidecar index, and supports the full visibility query grammar.
+	VisibilityFormatSegment = "segment"
+
+	segmentsDirName       = "segments"
+	segmentFileExt        = ".seg"
+	segmentIndexFileExt   = ".idx"
+	segmentDayDirFormat   = "20060102"
+	segmentHourFileFormat = "2006010215"
+)
+
+type (
+	// visibilitySegmentStore stores visibility records in segment files, one per hour of close
+	// time, grouped in a directory per day:
+	//
+	//	<namespaceID>/segments/<yyyymmdd>/<yyyymmddhh>.seg
+	//	<namespaceID>/segments/<yyyymmdd>/<yyyymmddhh>.idx
+	//
+	// Each record is appended to the segment as a separate gzip member, so it can be read by
+	// offset and length without decompressing the rest of the segment. The sidecar index has a
+	// JSON line per record with its offset and length, and the fields most queries filter on,
+	// so that records can be skipped without reading them.
+	//
+	// Both files are only appended to with a single write per record, so that archivers in
+	// different processes can append to the same partition concurrently.
+	visibilitySegmentStore struct {
+		fileMode os.FileMode
+		dirMode  os.FileMode
+	}
+
+	segmentIndexEntry struct {
+		Offset       int64                           `json:"offset"`
+		Length       int64                           `json:"length"`
+		WorkflowID   string                          `json:"workflowId"`
+		RunID        string                          `json:"runId"`
+		WorkflowType string                          `json:"workflowType"`
+		Status       enumspb.WorkflowExecutionStatus `json:"status"`
+		StartTime    int64                           `json:"startTime,omitempty"` // unix nanos
+		CloseTime    int64                           `json:"closeTime"`           // unix nanos
+	}
+
+	segmentPartition struct {
+		dir  string
+		name string // file name without extension
+		hour time.Time
+	}
+)
+
+func newVisibilitySegmentStore(fileMode os.FileMode, dirMode os.FileMode) *visibilitySegmentStore {
+	return &visibilitySegmentStore{
+		fileMode: fileMode,
+		dirMode:  dirMode,
+	}
+}
+
+// append adds record to the segment for its close time.
+func (s *visibilitySegmentStore) append(namespaceDir string, record *archiverspb.VisibilityRecord) error {
+	encodedRecord, err := encode(record)
+	if err != nil {
+		return err
+	}
+	var compressed bytes.Buffer
+	gz := gzip.NewWriter(&compressed)
+	if _, err := gz.Write(encodedRecord); err != nil {
+		return err
+	}
+	if err := gz.Close(); err != nil {
+		return err
+	}
+
+	closeTime := record.CloseTime.AsTime().UTC()
+	hour := closeTime.Truncate(time.Hour)
+	dir := path.Join(namespaceDir, segmentsDirName, hour.Format(segmentDayDirFormat))
+	if err := mkdirAll(dir, s.dirMode); err != nil {
+		return err
+	}
+	partition := path.Join(dir, hour.Format(segmentHourFileFormat))
+
+	offset, err := appendToFile(partition+segmentFileExt, compressed.Bytes(), s.fileMode)
+	if err != nil {
+		return err
+	}
+
+	entry := &segmentIndexEntry{
+		Offset:       offset,
+		Length:       int64(compressed.Len()),
+		WorkflowID:   record.GetWorkflowId(),
+		RunID:        record.GetRunId(),
+		WorkflowType: record.GetWorkflowTypeName(),
+		Status:       record.GetStatus(),
+		CloseTime:    closeTime.UnixNano(),
+	}
+	if record.StartTime != nil {
+		entry.StartTime = record.StartTime.AsTime().UnixNano()
+	}
+	encodedEntry, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	// If this fails after the segment was written, the record is not indexed and won't be
+	// found by queries. It's written again when archival is retried.
+	_, err = appendToFile(partition+segmentIndexFileExt, append(encodedEntry, '\n'), s.fileMode)
+	return err
+}
+
+// partitions returns the partitions that may have records with close time in the given
+// range, newest first.
+func (s *visibilitySegmentStore) partitions(namespaceDir string, earliest, latest time.Time) ([]segmentPartition, error) {
+	segmentsDir := path.Join(namespaceDir, segmentsDirName)
+	exists, err := directoryExists(segmentsDir)
+	if err != nil || !exists {
+		return nil, err
+	}
+	days, err := listFiles(segmentsDir)
+	if err != nil {
+		return nil, err
+	}
+
+	earliestHour := earliest.UTC().Truncate(time.Hour)
+	earliestDay := earliestHour.Format(segmentDayDirFormat)
+	latestDay := latest.UTC().Format(segmentDayDirFormat)
+
+	var partitions []segmentPartition
+	for _, day := range days {
+		if _, err := time.Parse(segmentDayDirFormat, day); err != nil {
+			continue
+		}
+		if day < earliestDay || day > latestDay {
+			continue
+		}
+		dayDir := path.Join(segmentsDir, day)
+		files, err := listFiles(dayDir)
+		if err != nil {
+			return nil, err
+		}
+		for _, file := range files {
+			name, ok := strings.CutSuffix(file, segmentIndexFileExt)
+			if !ok {
+				continue
+			}
+			hour, err := time.Parse(segmentHourFileFormat, name)
+			if err != nil {
+				continue
+			}
+			if hour.Before(earliestHour) || hour.After(latest) {
+				continue
+			}
+			partitions = append(partitions, segmentPartition{dir: dayDir, name: name, hour: hour})
+		}
+	}
+
+	slices.SortFunc(partitions, func(a, b segmentPartition) int {
+		return b.hour.Compare(a.hour)
+	})
+	return partitions, nil
+}
+
+// readIndex returns the index entries of a partition.
+func (s *visibilitySegmentStore) readIndex(p segmentPartition) ([]*segmentIndexEntry, error) {
+	data, err := readFile(path.Join(p.dir, p.name+segmentIndexFileExt))
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []*segmentIndexEntry
+	scanner := bufio.NewScanner(bytes.NewReader(data))
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(line) == 0 {
+			continue
+		}
+		entry := &segmentIndexEntry{}
+		if err := json.Unmarshal(line, entry); err != nil {
+			// a line may be incomplete if a write was interrupted, the record is written
+			// again when archival is retried
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	return entries, scanner.Err()
+}
+
+// openSegment opens the segment file of a partition for reading records.
+func (s *visibilitySegmentStore) openSegment(p segmentPartition) (*os.File, error) {
+	// #nosec
+	return os.Open(path.Join(p.dir, p.name+segmentFileExt))
+}
+
+// readRecord reads the record of an index entry from its segment.
+func (s *visibilitySegmentStore) readRecord(segment io.ReaderAt, entry *segmentIndexEntry) (*archiverspb.VisibilityRecord, error) {
+	gz, err := gzip.NewReader(io.NewSectionReader(segment, entry.Offset, entry.Length))
+	if err != nil {
+		return nil, err
+	}
+	gz.Multistream(false)
+	data, err := io.ReadAll(gz)
+	if err != nil {
+		return nil, err
+	}
+	return decodeVisibilityRecord(data)
+}
+
+// values returns the indexed field values of the entry.
+func (e *segmentIndexEntry) values(field string) (any, bool) {
+	switch field {
+	case searchattribute.WorkflowID:
+		return e.WorkflowID, true
+	case searchattribute.RunID:
+		return e.RunID, true
+	case searchattribute.WorkflowType:
+		return e.WorkflowType, true
+	case searchattribute.ExecutionStatus:
+		return int64(e.Status), true
+	case searchattribute.StartTime:
+		if e.StartTime == 0 {
+			return nil, false
+		}
+		return time.Unix(0, e.StartTime).UTC(), true
+	case searchattribute.CloseTime:
+		return time.Unix(0, e.CloseTime).UTC(), true
+	default:
+		return nil, false
+	}
+}
+
+// visibilityRecordValues returns the field values of a record, including custom search
+// attributes decoded with saTypeMap.
+func visibilityRecordValues(
+	record *archiverspb.VisibilityRecord,
+	saTypeMap searchattribute.NameTypeMap,
+) (visibilityValues, error) {
+	searchAttributes, err := searchattribute.Parse(record.SearchAttributes, &saTypeMap)
+	if err != nil {
+		return nil, err
+	}
+	customValues := make(map[string]any, len(searchAttributes.GetIndexedFields()))
+	for name, payload := range searchAttributes.GetIndexedFields() {
+		t, err := saTypeMap.GetType(name)
+		if err != nil {
+			continue
+		}
+		value, err := searchattribute.DecodeValue(payload, t, true)
+		if err != nil || value == nil {
+			continue
+		}
+		// values of types other than keyword list may be lists too, use the first one
+		if list, ok := value.([]any); ok {
+			if len(list) == 0 {
+				continue
+			}
+			value = list[0]
+		}
+		if t, ok := value.(time.Time); ok {
+			value = t.UTC()
+		}
+		customValues[name] = value
+	}
+
+	return func(field string) (any, bool) {
+		switch field {
+		case searchattribute.WorkflowID:
+			return record.GetWorkflowId(), true
+		case searchattribute.RunID:
+			return record.GetRunId(), true
+		case searchattribute.WorkflowType:
+			return record.GetWorkflowTypeName(), true
+		case searchattribute.ExecutionStatus:
+			return int64(record.GetStatus()), true
+		case searchattribute.StartTime:
+			return optionalTime(record.GetStartTime().AsTime(), record.StartTime != nil)
+		case searchattribute.ExecutionTime:
+			return optionalTime(record.GetExecutionTime().AsTime(), record.ExecutionTime != nil)
+		case searchattribute.CloseTime:
+			return optionalTime(record.GetCloseTime().AsTime(), record.CloseTime != nil)
+		case searchattribute.ExecutionDuration:
+			if record.ExecutionDuration == nil {
+				return nil, false
+			}
+			return record.ExecutionDuration.AsDuration().Nanoseconds(), true
+		case searchattribute.HistoryLength:
+			return record.GetHistoryLength(), true
+		}
+		value, ok := customValues[field]
+		return value, ok
+	}, nil
+}
+
+func optionalTime(t time.Time, ok bool) (any, bool) {
+	if !ok {
+		return nil, false
+	}
+	return t.UTC(), true
+}
+
+// appendToFile appends data to a file with a single write and returns the offset it was
+// written at.
+func appendToFile(filepath string, data []byte, fileMode os.FileMode) (offset int64, retErr error) {
+	// #nosec
+	f, err := os.OpenFile(filepath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, fileMode)
+	if err != nil {
+		return 0, err
+	}
+	defer func() {
+		retErr = multierr.Combine(retErr, f.Close())
+	}()
+
+	if _, err := f.Write(data); err != nil {
+		return 0, err
+	}
+	// With O_APPEND, the write moves the file offset to the end of the file and the data is
+	// written there atomically, even if other processes append to the file at the same time.
+	// The offset after the write is then the end of the data.
+	end, err := f.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return 0, err
+	}
+	return end - int64(len(data)), nil
+}
@@ -0,0 +1,419 @@
+package filestore
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	archiverspb "go.temporal.io/server/api/archiver/v1"
+	"go.temporal.io/server/common/archiver"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/tests/testutils"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type visibilitySegmentSuite struct {
+	*require.Assertions
+	suite.Suite
+
+	baseTime time.Time
+	records  []*archiverspb.VisibilityRecord
+}
+
+func TestVisibilitySegmentSuite(t *testing.T) {
+	suite.Run(t, new(visibilitySegmentSuite))
+}
+
+func (s *visibilitySegmentSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.baseTime = time.Date(2024, 1, 2, 10, 0, 0, 0, time.UTC)
+
+	// 3 records per hour over 4 hours, the last one in the next day
+	hours := []time.Duration{0, 1, 2, 14}
+	s.records = nil
+	for i, h := range hours {
+		for j := 0; j < 3; j++ {
+			n := i*3 + j
+			status := enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED
+			if n%2 == 1 {
+				status = enumspb.WORKFLOW_EXECUTION_STATUS_FAILED
+			}
+			closeTime := s.baseTime.Add(h*time.Hour + time.Duration(j)*time.Minute)
+			s.records = append(s.records, &archiverspb.VisibilityRecord{
+				NamespaceId:       testNamespaceID,
+				Namespace:         testNamespace,
+				WorkflowId:        fmt.Sprintf("wf-%02d", n),
+				RunId:             fmt.Sprintf("run-%02d", n),
+				WorkflowTypeName:  fmt.Sprintf("type-%d", n%3),
+				StartTime:         timestamppb.New(closeTime.Add(-time.Minute)),
+				CloseTime:         timestamppb.New(closeTime),
+				ExecutionDuration: durationpb.New(time.Minute),
+				Status:            status,
+				HistoryLength:     int64(100 + n),
+				SearchAttributes: map[string]string{
+					"CustomKeywordField": fmt.Sprintf("key-%d", n%4),
+					"CustomIntField":     fmt.Sprintf("%d", n),
+				},
+			})
+		}
+	}
+}
+
+func (s *visibilitySegmentSuite) newArchiver(format string) (*visibilityArchiver, error) {
+	a, err := NewVisibilityArchiver(log.NewNoopLogger(), metrics.NoopMetricsHandler, &config.FilestoreArchiver{
+		FileMode:         testFileModeStr,
+		DirMode:          testDirModeStr,
+		VisibilityFormat: format,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return a.(*visibilityArchiver), nil
+}
+
+func (s *visibilitySegmentSuite) archiveAll(URI archiver.URI) *visibilityArchiver {
+	a, err := s.newArchiver(VisibilityFormatSegment)
+	s.NoError(err)
+	for _, record := range s.records {
+		s.NoError(a.Archive(context.Background(), URI, record))
+	}
+	return a
+}
+
+func (s *visibilitySegmentSuite) queryAll(a *visibilityArchiver, URI archiver.URI, query string, pageSize int) []string {
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    pageSize,
+		Query:       query,
+	}
+	var runIDs []string
+	for {
+		response, err := a.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+		s.NoError(err)
+		s.LessOrEqual(len(response.Executions), pageSize)
+		for _, execution := range response.Executions {
+			runIDs = append(runIDs, execution.GetExecution().GetRunId())
+		}
+		if response.NextPageToken == nil {
+			return runIDs
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+}
+
+func (s *visibilitySegmentSuite) TestNewVisibilityArchiver_InvalidFormat() {
+	_, err := s.newArchiver("parquet")
+	s.ErrorIs(err, errInvalidVisibilityFormat)
+
+	a, err := s.newArchiver(VisibilityFormatJSON)
+	s.NoError(err)
+	s.Nil(a.segments)
+}
+
+func (s *visibilitySegmentSuite) TestArchive_SegmentLayout() {
+	dir := testutils.MkdirTemp(s.T(), "", "TestArchive_SegmentLayout")
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	s.archiveAll(URI)
+
+	files, err := listFiles(path.Join(dir, testNamespaceID, segmentsDirName, "20240102"))
+	s.NoError(err)
+	s.ElementsMatch([]string{
+		"2024010210.seg", "2024010210.idx",
+		"2024010211.seg", "2024010211.idx",
+		"2024010212.seg", "2024010212.idx",
+	}, files)
+	files, err = listFiles(path.Join(dir, testNamespaceID, segmentsDirName, "20240103"))
+	s.NoError(err)
+	s.ElementsMatch([]string{"2024010300.seg", "2024010300.idx"}, files)
+}
+
+func (s *visibilitySegmentSuite) TestQuery_DefaultOrderAndPagination() {
+	dir := testutils.MkdirTemp(s.T(), "", "TestQuery_DefaultOrderAndPagination")
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	a := s.archiveAll(URI)
+
+	var expected []string
+	for i := len(s.records) - 1; i >= 0; i-- {
+		expected = append(expected, s.records[i].GetRunId())
+	}
+	for _, pageSize := range []int{1, 2, 3, 5, 100} {
+		s.Equal(expected, s.queryAll(a, URI, "", pageSize), "page size %d", pageSize)
+	}
+}
+
+func (s *visibilitySegmentSuite) TestQuery_Filters() {
+	dir := testutils.MkdirTemp(s.T(), "", "TestQuery_Filters")
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	a := s.archiveAll(URI)
+
+	testCases := []struct {
+		query    string
+		expected []string
+	}{
+		{
+			query:    "WorkflowId = 'wf-04'",
+			expected: []string{"run-04"},
+		},
+		{
+			query:    "ExecutionStatus = 'Failed' AND WorkflowType = 'type-0'",
+			expected: []string{"run-09", "run-03"},
+		},
+		{
+			query:    "WorkflowType IN ('type-1', 'type-2') AND CustomIntField < 5",
+			expected: []string{"run-04", "run-02", "run-01"},
+		},
+		{
+			query:    "CustomKeywordField = 'key-0' OR (HistoryLength >= 110 AND NOT ExecutionStatus = 'Completed')",
+			expected: []string{"run-11", "run-08", "run-04", "run-00"},
+		},
+		{
+			query:    "WorkflowId STARTS_WITH 'wf-1' AND CustomIntField BETWEEN 10 AND 20",
+			expected: []string{"run-11", "run-10"},
+		},
+		{
+			query: fmt.Sprintf("CloseTime >= '%s' AND CloseTime < '%s'",
+				s.baseTime.Add(time.Hour).Format(time.RFC3339), s.baseTime.Add(2*time.Hour).Format(time.RFC3339)),
+			expected: []string{"run-05", "run-04", "run-03"},
+		},
+		{
+			query:    "CustomDoubleField IS NULL AND CustomIntField > 9",
+			expected: []string{"run-11", "run-10"},
+		},
+		{
+			query:    "ExecutionDuration = '1m' AND RunId = 'run-07'",
+			expected: []string{"run-07"},
+		},
+		{
+			query:    "CustomKeywordField != 'key-1' AND CustomIntField < 4",
+			expected: []string{"run-03", "run-02", "run-00"},
+		},
+	}
+	for _, tc := range testCases {
+		s.Equal(tc.expected, s.queryAll(a, URI, tc.query, 2), tc.query)
+	}
+}
+
+func (s *visibilitySegmentSuite) TestQuery_PageTokenStartsAtPartition() {
+	dir := testutils.MkdirTemp(s.T(), "", "TestQuery_PageTokenStartsAtPartition")
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	a := s.archiveAll(URI)
+
+	request := &archiver.QueryVisibilityRequest{
+		NamespaceID: testNamespaceID,
+		PageSize:    4,
+	}
+	response, err := a.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	s.Len(response.Executions, 4)
+	s.Equal("run-08", response.Executions[3].GetExecution().GetRunId())
+	s.NotNil(response.NextPageToken)
+
+	// the next page doesn't read the newest partition, whose records were all returned
+	newestSegment := path.Join(dir, testNamespaceID, segmentsDirName, "20240103", "2024010300"+segmentFileExt)
+	s.NoError(os.WriteFile(newestSegment, []byte("corrupted"), testFileMode))
+
+	request.NextPageToken = response.NextPageToken
+	response, err = a.Query(context.Background(), URI, request, searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	var runIDs []string
+	for _, execution := range response.Executions {
+		runIDs = append(runIDs, execution.GetExecution().GetRunId())
+	}
+	s.Equal([]string{"run-07", "run-06", "run-05", "run-04"}, runIDs)
+}
+
+func (s *visibilitySegmentSuite) TestQuery_OrderBy() {
+	dir := testutils.MkdirTemp(s.T(), "", "TestQuery_OrderBy")
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	a := s.archiveAll(URI)
+
+	s.Equal(
+		[]string{"run-00", "run-04", "run-08", "run-01", "run-05", "run-09"},
+		s.queryAll(a, URI, "CustomKeywordField IN ('key-0', 'key-1') ORDER BY CustomKeywordField, CustomIntField", 4),
+	)
+	s.Equal(
+		[]string{"run-00", "run-01", "run-02"},
+		s.queryAll(a, URI, "CustomIntField < 3 ORDER BY CloseTime ASC", 1),
+	)
+	s.Equal(
+		[]string{"run-11", "run-10", "run-09"},
+		s.queryAll(a, URI, "HistoryLength > 108 order by HistoryLength desc", 2),
+	)
+	s.Len(s.queryAll(a, URI, "order by WorkflowId", 5), len(s.records))
+}
+
+func (s *visibilitySegmentSuite) TestSegmentQueryPage_KeepsFirstMatches() {
+	query, err := parseVisibilityQuery("order by HistoryLength", searchattribute.TestNameTypeMap)
+	s.NoError(err)
+	page := newSegmentQueryPage(query, 3)
+	for _, i := range []int{7, 2, 9, 0, 5, 11, 1} {
+		record := s.records[i]
+		values, err := visibilityRecordValues(record, searchattribute.TestNameTypeMap)
+		s.NoError(err)
+		page.add(segmentQueryMatch{record: record, sortKey: query.sortKey(values, record.GetRunId())})
+		s.LessOrEqual(page.len(), 3)
+	}
+
+	var runIDs []string
+	for _, match := range page.sorted() {
+		runIDs = append(runIDs, match.record.GetRunId())
+	}
+	s.Equal([]string{"run-00", "run-01", "run-02"}, runIDs)
+}
+
+func (s *visibilitySegmentSuite) TestQuery_InvalidQuery() {
+	a, err := s.newArchiver(VisibilityFormatSegment)
+	s.NoError(err)
+	URI, err := archiver.NewURI("file:///a/b/c")
+	s.NoError(err)
+
+	for _, query := range []string{
+		"UnknownField = 'a'",
+		"CustomIntField = 'not a number'",
+		"ExecutionStatus = 'NotAStatus'",
+		"WorkflowId = 'a' LIMIT 10",
+		"WorkflowId = ",
+		"ORDER BY UnknownField",
+	} {
+		_, err := a.Query(context.Background(), URI, &archiver.QueryVisibilityRequest{
+			NamespaceID: testNamespaceID,
+			PageSize:    10,
+			Query:       query,
+		}, searchattribute.TestNameTypeMap)
+		var invalidArgument *serviceerror.InvalidArgument
+		s.ErrorAs(err, &invalidArgument, query)
+	}
+}
+
+func (s *visibilitySegmentSuite) TestQuery_InvalidToken() {
+	dir := testutils.MkdirTemp(s.T(), "", "TestQuery_InvalidToken")
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	a := s.archiveAll(URI)
+
+	_, err = a.Query(context.Background(), URI, &archiver.QueryVisibilityRequest{
+		NamespaceID:   testNamespaceID,
+		PageSize:      10,
+		NextPageToken: []byte("some invalid token"),
+	}, searchattribute.TestNameTypeMap)
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *visibilitySegmentSuite) TestQuery_DirectoryNotExist() {
+	dir := testutils.MkdirTemp(s.T(), "", "TestQuery_DirectoryNotExist")
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	a, err := s.newArchiver(VisibilityFormatSegment)
+	s.NoError(err)
+	s.Empty(s.queryAll(a, URI, "", 10))
+}
+
+func (s *visibilitySegmentSuite) TestQuery_DuplicateArchival() {
+	dir := testutils.MkdirTemp(s.T(), "", "TestQuery_DuplicateArchival")
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	a := s.archiveAll(URI)
+	// archival may be retried after the record was written
+	s.NoError(a.Archive(context.Background(), URI, s.records[4]))
+
+	s.Len(s.queryAll(a, URI, "", 5), len(s.records))
+	s.Equal([]string{"run-04"}, s.queryAll(a, URI, "WorkflowId = 'wf-04'", 5))
+}
+
+func (s *visibilitySegmentSuite) TestArchive_ConcurrentArchivers() {
+	dir := testutils.MkdirTemp(s.T(), "", "TestArchive_ConcurrentArchivers")
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+
+	// archivers don't share state, like archivers of different processes
+	const archiverCount, recordCount = 4, 25
+	var wg sync.WaitGroup
+	errs := make(chan error, archiverCount*recordCount)
+	for i := 0; i < archiverCount; i++ {
+		a, err := s.newArchiver(VisibilityFormatSegment)
+		s.NoError(err)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < recordCount; j++ {
+				record := proto.Clone(s.records[0]).(*archiverspb.VisibilityRecord)
+				record.RunId = fmt.Sprintf("run-%d-%d", i, j)
+				errs <- a.Archive(context.Background(), URI, record)
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		s.NoError(err)
+	}
+
+	a, err := s.newArchiver(VisibilityFormatSegment)
+	s.NoError(err)
+	s.Len(s.queryAll(a, URI, "", 30), archiverCount*recordCount)
+}
+
+func (s *visibilitySegmentSuite) TestQuery_IncompleteIndexLine() {
+	dir := testutils.MkdirTemp(s.T(), "", "TestQuery_IncompleteIndexLine")
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	a := s.archiveAll(URI)
+
+	indexPath := path.Join(dir, testNamespaceID, segmentsDirName, "20240102", "2024010210"+segmentIndexFileExt)
+	f, err := os.OpenFile(indexPath, os.O_APPEND|os.O_WRONLY, testFileMode)
+	s.NoError(err)
+	_, err = f.WriteString(`{"offset":12,"len`)
+	s.NoError(err)
+	s.NoError(f.Close())
+
+	s.Len(s.queryAll(a, URI, "", 5), len(s.records))
+}
+
+func (s *visibilitySegmentSuite) TestPartitions_CloseTimeRange() {
+	dir := testutils.MkdirTemp(s.T(), "", "TestPartitions_CloseTimeRange")
+	URI, err := archiver.NewURI("file://" + dir)
+	s.NoError(err)
+	a := s.archiveAll(URI)
+	nsDir := path.Join(dir, testNamespaceID)
+
+	partitions, err := a.segments.partitions(nsDir, time.Time{}, time.Now())
+	s.NoError(err)
+	s.Len(partitions, 4)
+	s.Equal("2024010300", partitions[0].name)
+	s.Equal("2024010210", partitions[3].name)
+
+	partitions, err = a.segments.partitions(nsDir, s.baseTime.Add(90*time.Minute), s.baseTime.Add(150*time.Minute))
+	s.NoError(err)
+	s.Len(partitions, 2)
+	s.Equal("2024010212", partitions[0].name)
+	s.Equal("2024010211", partitions[1].name)
+
+	query, err := parseVisibilityQuery(
+		fmt.Sprintf("CloseTime BETWEEN '%s' AND '%s' AND WorkflowType = 'type-1'",
+			s.baseTime.Add(90*time.Minute).Format(time.RFC3339), s.baseTime.Add(150*time.Minute).Format(time.RFC3339)),
+		searchattribute.TestNameTypeMap,
+	)
+	s.NoError(err)
+	s.Equal(s.baseTime.Add(90*time.Minute), query.earliestCloseTime)
+	s.Equal(s.baseTime.Add(150*time.Minute), query.latestCloseTime)
+	s.Len(query.indexFilters, 2)
+}
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
 )
 
 const (
@@ -59,12 +60,23 @@ type hasNamespace interface {
 }
 
 func GetAuthorizerFromConfig(config *config.Authorization) (Authorizer, error) {
+	return NewAuthorizerFromConfig(config, log.NewNoopLogger())
+}
 
+// NewAuthorizerFromConfig creates the authorizer selected by config. Unlike
+// GetAuthorizerFromConfig, the policy authorizer logs its decisions to logger.
+func NewAuthorizerFromConfig(config *config.Authorization, logger log.Logger) (Authorizer, error) {
 	switch strings.ToLower(config.Authorizer) {
 	case "":
 		return NewNoopAuthorizer(), nil
 	case "default":
 		return NewDefaultAuthorizer(), nil
+	case "policy":
+		authorizer, err := NewPolicyAuthorizer(config.PolicyAuthorizer, logger)
+		if err != nil {
+			return nil, err
+		}
+		return authorizer, nil
 	}
 	return nil, fmt.Errorf("unknown authorizer: %s", config.Authorizer)
 }
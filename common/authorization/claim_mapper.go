@@ -59,12 +59,34 @@ func (*noopClaimMapper) AuthInfoRequired() bool {
 }
 
 func GetClaimMapperFromConfig(config *config.Authorization, logger log.Logger) (ClaimMapper, error) {
+	names := strings.Split(config.ClaimMapper, ",")
+	if len(names) == 1 {
+		return getClaimMapper(strings.TrimSpace(names[0]), config, logger)
+	}
+
+	mappers := make([]ClaimMapper, 0, len(names))
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			return nil, fmt.Errorf("unknown claim mapper: %s", config.ClaimMapper)
+		}
+		mapper, err := getClaimMapper(name, config, logger)
+		if err != nil {
+			return nil, err
+		}
+		mappers = append(mappers, mapper)
+	}
+	return NewCompositeClaimMapper(mappers...), nil
+}
 
-	switch strings.ToLower(config.ClaimMapper) {
+func getClaimMapper(name string, config *config.Authorization, logger log.Logger) (ClaimMapper, error) {
+	switch strings.ToLower(name) {
 	case "":
 		return NewNoopClaimMapper(), nil
 	case "default":
 		return NewDefaultJWTClaimMapper(NewDefaultTokenKeyProvider(config, logger), config, logger), nil
+	case "tls":
+		return NewTLSClaimMapper(&config.TLSClaimMapper)
 	}
-	return nil, fmt.Errorf("unknown claim mapper: %s", config.ClaimMapper)
+	return nil, fmt.Errorf("unknown claim mapper: %s", name)
 }
@@ -14,6 +14,7 @@ import (
 
 const (
 	defaultPermissionsClaimName = "permissions"
+	defaultGroupsClaimName      = "groups"
 	authorizationBearer         = "bearer"
 	headerSubject               = "sub"
 	permissionScopeSystem       = primitives.SystemLocalNamespace
@@ -28,6 +29,10 @@ type defaultJWTClaimMapper struct {
 	keyProvider          TokenKeyProvider
 	logger               log.Logger
 	permissionsClaimName string
+	groupsClaimName      string
+	groupPermissions     map[string][]string
+	issuer               string
+	audience             string
 }
 
 func NewDefaultJWTClaimMapper(provider TokenKeyProvider, cfg *config.Authorization, logger log.Logger) ClaimMapper {
@@ -35,7 +40,19 @@ func NewDefaultJWTClaimMapper(provider TokenKeyProvider, cfg *config.Authorizati
 	if claimName == "" {
 		claimName = defaultPermissionsClaimName
 	}
-	return &defaultJWTClaimMapper{keyProvider: provider, logger: logger, permissionsClaimName: claimName}
+	groupsClaimName := cfg.GroupsClaimName
+	if groupsClaimName == "" {
+		groupsClaimName = defaultGroupsClaimName
+	}
+	return &defaultJWTClaimMapper{
+		keyProvider:          provider,
+		logger:               logger,
+		permissionsClaimName: claimName,
+		groupsClaimName:      groupsClaimName,
+		groupPermissions:     cfg.GroupPermissions,
+		issuer:               strings.TrimSuffix(strings.TrimSpace(cfg.JWTKeyProvider.Issuer), "/"),
+		audience:             strings.TrimSpace(cfg.Audience),
+	}
 }
 
 var _ ClaimMapper = (*defaultJWTClaimMapper)(nil)
@@ -59,6 +76,15 @@ func (a *defaultJWTClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
 	if err != nil {
 		return nil, err
 	}
+	if a.issuer != "" {
+		issuer, _ := jwtClaims["iss"].(string)
+		if strings.TrimSuffix(issuer, "/") != a.issuer {
+			return nil, serviceerror.NewPermissionDenied("issuer mismatch", "")
+		}
+	}
+	if a.audience != "" && !jwtClaims.VerifyAudience(a.audience, true) {
+		return nil, serviceerror.NewPermissionDenied("audience mismatch", "")
+	}
 	subject, ok := jwtClaims[headerSubject].(string)
 	if !ok {
 		return nil, serviceerror.NewPermissionDenied("unexpected value type of \"sub\" claim", "")
@@ -71,9 +97,47 @@ func (a *defaultJWTClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
 			return nil, err
 		}
 	}
+	if len(a.groupPermissions) > 0 {
+		a.extractGroupPermissions(jwtClaims[a.groupsClaimName], &claims)
+	}
+	// The claims of the token are passed on to the authorizer, with the groups claim under its
+	// default name, so that the policy authorizer can match them.
+	if groups, ok := jwtClaims[a.groupsClaimName]; ok {
+		jwtClaims[defaultGroupsClaimName] = groups
+	}
+	claims.Extensions = jwtClaims
 	return &claims, nil
 }
 
+// extractGroupPermissions adds the permissions mapped to the groups of the groups claim, which
+// may be a list of groups or a single group.
+func (a *defaultJWTClaimMapper) extractGroupPermissions(groupsClaim interface{}, claims *Claims) {
+	var groups []interface{}
+	switch g := groupsClaim.(type) {
+	case []interface{}:
+		groups = g
+	case string:
+		groups = []interface{}{g}
+	case nil:
+		return
+	default:
+		a.logger.Warn(fmt.Sprintf("ignoring groups claim of unexpected type: %T", groupsClaim))
+		return
+	}
+	for _, group := range groups {
+		g, ok := group.(string)
+		if !ok {
+			a.logger.Warn(fmt.Sprintf("ignoring group that is not a string: %v", group))
+			continue
+		}
+		for _, permission := range a.groupPermissions[g] {
+			if !addPermission(claims, permission) {
+				a.logger.Warn(fmt.Sprintf("ignoring permission in unexpected format for group %s: %v", g, permission))
+			}
+		}
+	}
+}
+
 func (a *defaultJWTClaimMapper) extractPermissions(permissions []interface{}, claims *Claims) error {
 	for _, permission := range permissions {
 		p, ok := permission.(string)
@@ -81,26 +145,34 @@ func (a *defaultJWTClaimMapper) extractPermissions(permissions []interface{}, cl
 			a.logger.Warn(fmt.Sprintf("ignoring permission that is not a string: %v", permission))
 			continue
 		}
-		parts := strings.Split(p, ":")
-		if len(parts) != 2 {
+		if !addPermission(claims, p) {
 			a.logger.Warn(fmt.Sprintf("ignoring permission in unexpected format: %v", permission))
-			continue
-		}
-		namespace := parts[0]
-		if namespace == permissionScopeSystem {
-			claims.System |= permissionToRole(parts[1])
-		} else {
-			if claims.Namespaces == nil {
-				claims.Namespaces = make(map[string]Role)
-			}
-			role := claims.Namespaces[namespace]
-			role |= permissionToRole(parts[1])
-			claims.Namespaces[namespace] = role
 		}
 	}
 	return nil
 }
 
+// addPermission adds the role of a "<namespace>:<permission>" permission to claims. Returns
+// false if the permission is not in this format.
+func addPermission(claims *Claims, permission string) bool {
+	parts := strings.Split(permission, ":")
+	if len(parts) != 2 {
+		return false
+	}
+	namespace := parts[0]
+	if namespace == permissionScopeSystem {
+		claims.System |= permissionToRole(parts[1])
+	} else {
+		if claims.Namespaces == nil {
+			claims.Namespaces = make(map[string]Role)
+		}
+		role := claims.Namespaces[namespace]
+		role |= permissionToRole(parts[1])
+		claims.Namespaces[namespace] = role
+	}
+	return true
+}
+
 func parseJWT(tokenString string, keyProvider TokenKeyProvider) (jwt.MapClaims, error) {
 	return parseJWTWithAudience(tokenString, keyProvider, "")
 }
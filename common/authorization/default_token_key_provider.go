@@ -3,6 +3,7 @@ package authorization
 import (
 	"crypto/ecdsa"
 	"crypto/rsa"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"net/http"
@@ -18,21 +19,52 @@ import (
 	"go.uber.org/multierr"
 )
 
+const (
+	openIDConfigurationPath = "/.well-known/openid-configuration"
+
+	keySourceTimeout = 10 * time.Second
+	// unknown key IDs trigger a refresh of keys, at most once per this interval
+	minKeyRefreshInterval = 10 * time.Second
+)
+
+var (
+	supportedRSAMethods   = []string{jwt.SigningMethodRS256.Name, jwt.SigningMethodRS384.Name, jwt.SigningMethodRS512.Name}
+	supportedECDSAMethods = []string{jwt.SigningMethodES256.Name, jwt.SigningMethodES384.Name, jwt.SigningMethodES512.Name}
+	supportedHMACMethods  = []string{jwt.SigningMethodHS256.Name, jwt.SigningMethodHS384.Name, jwt.SigningMethodHS512.Name}
+)
+
 // Default token key provider
 type defaultTokenKeyProvider struct {
-	config   config.JWTKeyProvider
-	rsaKeys  map[string]*rsa.PublicKey
-	ecKeys   map[string]*ecdsa.PublicKey
-	keysLock sync.RWMutex
-	ticker   *time.Ticker
-	logger   log.Logger
-	stop     chan bool
+	config     config.JWTKeyProvider
+	rsaKeys    map[string]*rsa.PublicKey
+	ecKeys     map[string]*ecdsa.PublicKey
+	hmacKeys   map[string][]byte
+	keysLock   sync.RWMutex
+	ticker     *time.Ticker
+	logger     log.Logger
+	stop       chan bool
+	httpClient *http.Client
+
+	// serializes refreshes of keys for unknown key IDs
+	refreshLock        sync.Mutex
+	lastRefresh        time.Time
+	minRefreshInterval time.Duration
+}
+
+type openIDConfiguration struct {
+	Issuer  string `json:"issuer"`
+	JWKSURI string `json:"jwks_uri"`
 }
 
 var _ TokenKeyProvider = (*defaultTokenKeyProvider)(nil)
 
 func NewDefaultTokenKeyProvider(cfg *config.Authorization, logger log.Logger) *defaultTokenKeyProvider {
-	provider := defaultTokenKeyProvider{config: cfg.JWTKeyProvider, logger: logger}
+	provider := defaultTokenKeyProvider{
+		config:             cfg.JWTKeyProvider,
+		logger:             logger,
+		httpClient:         &http.Client{Timeout: keySourceTimeout},
+		minRefreshInterval: minKeyRefreshInterval,
+	}
 	provider.initialize()
 	return &provider
 }
@@ -40,7 +72,9 @@ func NewDefaultTokenKeyProvider(cfg *config.Authorization, logger log.Logger) *d
 func (a *defaultTokenKeyProvider) initialize() {
 	a.rsaKeys = make(map[string]*rsa.PublicKey)
 	a.ecKeys = make(map[string]*ecdsa.PublicKey)
+	a.hmacKeys = a.configuredHMACKeys()
 	if a.config.HasSourceURIsConfigured() {
+		a.lastRefresh = time.Now()
 		err := a.updateKeys()
 		if err != nil {
 			a.logger.Error("error during initial retrieval of token keys: ", tag.Error(err))
@@ -60,13 +94,11 @@ func (a *defaultTokenKeyProvider) Close() {
 }
 
 func (a *defaultTokenKeyProvider) RsaKey(alg string, kid string) (*rsa.PublicKey, error) {
-	if !strings.EqualFold(alg, jwt.SigningMethodRS256.Name) {
+	if !containsFold(supportedRSAMethods, alg) {
 		return nil, fmt.Errorf("unexpected signing algorithm: %s", alg)
 	}
 
-	a.keysLock.RLock()
-	key, found := a.rsaKeys[kid]
-	a.keysLock.RUnlock()
+	key, found := lookupKey(a, func() map[string]*rsa.PublicKey { return a.rsaKeys }, kid)
 	if !found {
 		return nil, fmt.Errorf("RSA key not found for key ID: %s", kid)
 	}
@@ -74,21 +106,69 @@ func (a *defaultTokenKeyProvider) RsaKey(alg string, kid string) (*rsa.PublicKey
 }
 
 func (a *defaultTokenKeyProvider) EcdsaKey(alg string, kid string) (*ecdsa.PublicKey, error) {
-	if !strings.EqualFold(alg, jwt.SigningMethodES256.Name) {
+	if !containsFold(supportedECDSAMethods, alg) {
 		return nil, fmt.Errorf("unexpected signing algorithm: %s", alg)
 	}
 
-	a.keysLock.RLock()
-	key, found := a.ecKeys[kid]
-	a.keysLock.RUnlock()
+	key, found := lookupKey(a, func() map[string]*ecdsa.PublicKey { return a.ecKeys }, kid)
 	if !found {
 		return nil, fmt.Errorf("ECDSA key not found for key ID: %s", kid)
 	}
 	return key, nil
 }
 
+func (a *defaultTokenKeyProvider) HmacKey(alg string, kid string) ([]byte, error) {
+	if !containsFold(supportedHMACMethods, alg) {
+		return nil, fmt.Errorf("unexpected signing algorithm: %s", alg)
+	}
+
+	key, found := lookupKey(a, func() map[string][]byte { return a.hmacKeys }, kid)
+	if !found {
+		return nil, fmt.Errorf("HMAC key not found for key ID: %s", kid)
+	}
+	return key, nil
+}
+
 func (a *defaultTokenKeyProvider) SupportedMethods() []string {
-	return []string{jwt.SigningMethodRS256.Name, jwt.SigningMethodES256.Name}
+	methods := make([]string, 0, len(supportedRSAMethods)+len(supportedECDSAMethods)+len(supportedHMACMethods))
+	methods = append(methods, supportedRSAMethods...)
+	methods = append(methods, supportedECDSAMethods...)
+	return append(methods, supportedHMACMethods...)
+}
+
+// lookupKey returns the key with the given ID. If there is none, keys may have been rotated,
+// so they are refreshed and looked up again.
+func lookupKey[K any](a *defaultTokenKeyProvider, keys func() map[string]K, kid string) (K, bool) {
+	a.keysLock.RLock()
+	key, found := keys()[kid]
+	a.keysLock.RUnlock()
+	if found || !a.refreshForUnknownKey() {
+		return key, found
+	}
+
+	a.keysLock.RLock()
+	key, found = keys()[kid]
+	a.keysLock.RUnlock()
+	return key, found
+}
+
+// refreshForUnknownKey refreshes keys unless they were refreshed recently. Returns true if
+// keys were refreshed.
+func (a *defaultTokenKeyProvider) refreshForUnknownKey() bool {
+	if !a.config.HasSourceURIsConfigured() {
+		return false
+	}
+	a.refreshLock.Lock()
+	defer a.refreshLock.Unlock()
+	if time.Since(a.lastRefresh) < a.minRefreshInterval {
+		return false
+	}
+	a.lastRefresh = time.Now()
+	if err := a.updateKeys(); err != nil {
+		a.logger.Error("error while refreshing token keys for unknown key ID: ", tag.Error(err))
+		return false
+	}
+	return true
 }
 
 func (a *defaultTokenKeyProvider) timerCallback() {
@@ -115,7 +195,16 @@ func (a *defaultTokenKeyProvider) updateKeys() error {
 	rsaKeys := make(map[string]*rsa.PublicKey)
 	ecKeys := make(map[string]*ecdsa.PublicKey)
 
-	for _, uri := range a.config.KeySourceURIs {
+	uris := a.config.KeySourceURIs
+	if strings.TrimSpace(a.config.Issuer) != "" {
+		jwksURI, err := a.discoverJWKSURI()
+		if err != nil {
+			return err
+		}
+		uris = append([]string{jwksURI}, uris...)
+	}
+
+	for _, uri := range uris {
 		if strings.TrimSpace(uri) == "" {
 			continue
 		}
@@ -132,13 +221,40 @@ func (a *defaultTokenKeyProvider) updateKeys() error {
 	return nil
 }
 
+// discoverJWKSURI returns the JWKS URI from the OpenID Connect discovery document of the
+// configured issuer.
+func (a *defaultTokenKeyProvider) discoverJWKSURI() (uri string, err error) {
+	issuer := strings.TrimSuffix(strings.TrimSpace(a.config.Issuer), "/")
+	resp, err := a.httpClient.Get(issuer + openIDConfigurationPath)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		err = multierr.Combine(err, resp.Body.Close())
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("unexpected status of OpenID configuration of issuer %s: %s", issuer, resp.Status)
+	}
+
+	var oidcConfig openIDConfiguration
+	if err := json.NewDecoder(resp.Body).Decode(&oidcConfig); err != nil {
+		return "", err
+	}
+	if strings.TrimSuffix(oidcConfig.Issuer, "/") != issuer {
+		return "", fmt.Errorf("issuer %q of OpenID configuration doesn't match %q", oidcConfig.Issuer, issuer)
+	}
+	if oidcConfig.JWKSURI == "" {
+		return "", fmt.Errorf("OpenID configuration of issuer %s has no jwks_uri", issuer)
+	}
+	return oidcConfig.JWKSURI, nil
+}
+
 func (a *defaultTokenKeyProvider) updateKeysFromURI(
 	uri string,
 	rsaKeys map[string]*rsa.PublicKey,
 	ecKeys map[string]*ecdsa.PublicKey,
 ) (err error) {
-
-	resp, err := http.Get(uri)
+	resp, err := a.httpClient.Get(uri)
 	if err != nil {
 		return err
 	}
@@ -153,11 +269,15 @@ func (a *defaultTokenKeyProvider) updateKeysFromURI(
 	}
 
 	for _, k := range jwks.Keys {
-		switch k.Key.(type) {
+		switch key := k.Key.(type) {
 		case *rsa.PublicKey:
-			rsaKeys[k.KeyID] = k.Key.(*rsa.PublicKey)
+			rsaKeys[k.KeyID] = key
 		case *ecdsa.PublicKey:
-			ecKeys[k.KeyID] = k.Key.(*ecdsa.PublicKey)
+			ecKeys[k.KeyID] = key
+		case []byte:
+			// a shared secret published at a URL can't be trusted to verify tokens, HMAC keys
+			// are only taken from the config
+			a.logger.Warn(fmt.Sprintf("ignoring symmetric JWKS key %s", k.KeyID))
 		default:
 			a.logger.Warn(fmt.Sprintf("unexpected type of JWKS public key %s", k.Algorithm))
 		}
@@ -165,6 +285,25 @@ func (a *defaultTokenKeyProvider) updateKeysFromURI(
 	return nil
 }
 
-func (a *defaultTokenKeyProvider) HmacKey(alg string, kid string) ([]byte, error) {
-	return nil, fmt.Errorf("unsupported key type HMAC for: %s", alg)
+// configuredHMACKeys decodes the HMAC keys of the config.
+func (a *defaultTokenKeyProvider) configuredHMACKeys() map[string][]byte {
+	keys := make(map[string][]byte, len(a.config.HMACKeys))
+	for kid, encoded := range a.config.HMACKeys {
+		key, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil {
+			a.logger.Error(fmt.Sprintf("ignoring HMAC key %s that is not base64 encoded", kid), tag.Error(err))
+			continue
+		}
+		keys[kid] = key
+	}
+	return keys
+}
+
+func containsFold(values []string, value string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, value) {
+			return true
+		}
+	}
+	return false
 }
@@ -0,0 +1,320 @@
+package authorization
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/primitives"
+)
+
+type (
+	tokenKeyProviderSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		idp *identityProviderStandIn
+	}
+
+	// identityProviderStandIn serves an OpenID configuration and a JWKS, and signs tokens with
+	// its keys.
+	identityProviderStandIn struct {
+		server *httptest.Server
+
+		lock       sync.Mutex
+		rsaKeys    map[string]*rsa.PrivateKey
+		ecKeys     map[string]*ecdsa.PrivateKey
+		hmacKeys   map[string][]byte
+		jwksGets   int
+		issuerName string
+	}
+)
+
+func TestTokenKeyProviderSuite(t *testing.T) {
+	suite.Run(t, new(tokenKeyProviderSuite))
+}
+
+func (s *tokenKeyProviderSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.idp = newIdentityProviderStandIn(s.T())
+	s.idp.addRSAKey(s.T(), "rsa-1")
+	s.idp.addECKey(s.T(), "ec-1")
+	s.idp.addHMACKey("hmac-1", []byte("jwks-hmac-secret"))
+}
+
+func newIdentityProviderStandIn(t *testing.T) *identityProviderStandIn {
+	idp := &identityProviderStandIn{
+		rsaKeys:  make(map[string]*rsa.PrivateKey),
+		ecKeys:   make(map[string]*ecdsa.PrivateKey),
+		hmacKeys: make(map[string][]byte),
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(openIDConfigurationPath, func(w http.ResponseWriter, r *http.Request) {
+		issuer := idp.server.URL
+		if idp.issuerName != "" {
+			issuer = idp.issuerName
+		}
+		_ = json.NewEncoder(w).Encode(openIDConfiguration{
+			Issuer:  issuer,
+			JWKSURI: idp.server.URL + "/keys",
+		})
+	})
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, r *http.Request) {
+		idp.lock.Lock()
+		defer idp.lock.Unlock()
+		idp.jwksGets++
+		var jwks jose.JSONWebKeySet
+		for kid, key := range idp.rsaKeys {
+			jwks.Keys = append(jwks.Keys, jose.JSONWebKey{Key: &key.PublicKey, KeyID: kid, Algorithm: "RS256", Use: "sig"})
+		}
+		for kid, key := range idp.ecKeys {
+			jwks.Keys = append(jwks.Keys, jose.JSONWebKey{Key: &key.PublicKey, KeyID: kid, Algorithm: "ES256", Use: "sig"})
+		}
+		for kid, key := range idp.hmacKeys {
+			jwks.Keys = append(jwks.Keys, jose.JSONWebKey{Key: key, KeyID: kid, Algorithm: "HS256", Use: "sig"})
+		}
+		_ = json.NewEncoder(w).Encode(jwks)
+	})
+	idp.server = httptest.NewServer(mux)
+	t.Cleanup(idp.server.Close)
+	return idp
+}
+
+func (idp *identityProviderStandIn) addRSAKey(t *testing.T, kid string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	idp.lock.Lock()
+	defer idp.lock.Unlock()
+	idp.rsaKeys[kid] = key
+}
+
+func (idp *identityProviderStandIn) addECKey(t *testing.T, kid string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	idp.lock.Lock()
+	defer idp.lock.Unlock()
+	idp.ecKeys[kid] = key
+}
+
+func (idp *identityProviderStandIn) addHMACKey(kid string, key []byte) {
+	idp.lock.Lock()
+	defer idp.lock.Unlock()
+	idp.hmacKeys[kid] = key
+}
+
+func (idp *identityProviderStandIn) sign(t *testing.T, method jwt.SigningMethod, kid string, claims jwt.MapClaims) string {
+	idp.lock.Lock()
+	defer idp.lock.Unlock()
+	token := jwt.NewWithClaims(method, claims)
+	token.Header["kid"] = kid
+	var key interface{}
+	switch method.(type) {
+	case *jwt.SigningMethodRSA:
+		key = idp.rsaKeys[kid]
+	case *jwt.SigningMethodECDSA:
+		key = idp.ecKeys[kid]
+	case *jwt.SigningMethodHMAC:
+		key = idp.hmacKeys[kid]
+	}
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+	return signed
+}
+
+func (idp *identityProviderStandIn) claims(subject string, extra jwt.MapClaims) jwt.MapClaims {
+	claims := jwt.MapClaims{
+		"sub": subject,
+		"iss": idp.server.URL,
+		"aud": []string{"temporal"},
+		"exp": time.Now().Add(time.Hour).Unix(),
+	}
+	for k, v := range extra {
+		claims[k] = v
+	}
+	return claims
+}
+
+func (s *tokenKeyProviderSuite) newAuthorizationConfig() *config.Authorization {
+	return &config.Authorization{
+		JWTKeyProvider: config.JWTKeyProvider{
+			Issuer: s.idp.server.URL,
+			HMACKeys: map[string]string{
+				"configured": base64.StdEncoding.EncodeToString([]byte("configured-hmac-secret")),
+			},
+		},
+		Audience: "temporal",
+		GroupPermissions: map[string][]string{
+			"payments-devs": {"payments:write", "payments-staging:admin"},
+			"operators":     {primitives.SystemLocalNamespace + ":admin"},
+			"auditors":      {"payments:read", "billing:read"},
+		},
+	}
+}
+
+func (s *tokenKeyProviderSuite) TestDiscovery() {
+	provider := NewDefaultTokenKeyProvider(s.newAuthorizationConfig(), log.NewNoopLogger())
+
+	rsaKey, err := provider.RsaKey("RS256", "rsa-1")
+	s.NoError(err)
+	s.True(rsaKey.Equal(&s.idp.rsaKeys["rsa-1"].PublicKey))
+	ecKey, err := provider.EcdsaKey("ES256", "ec-1")
+	s.NoError(err)
+	s.True(ecKey.Equal(&s.idp.ecKeys["ec-1"].PublicKey))
+	// symmetric keys of the JWKS are not trusted
+	_, err = provider.HmacKey("HS256", "hmac-1")
+	s.Error(err)
+	hmacKey, err := provider.HmacKey("HS512", "configured")
+	s.NoError(err)
+	s.Equal([]byte("configured-hmac-secret"), hmacKey)
+
+	_, err = provider.RsaKey("ES256", "rsa-1")
+	s.Error(err)
+	_, err = provider.HmacKey("RS256", "configured")
+	s.Error(err)
+}
+
+func (s *tokenKeyProviderSuite) TestDiscovery_IssuerMismatch() {
+	s.idp.issuerName = "https://other.example.com"
+	provider := NewDefaultTokenKeyProvider(s.newAuthorizationConfig(), log.NewNoopLogger())
+
+	_, err := provider.RsaKey("RS256", "rsa-1")
+	s.Error(err)
+}
+
+func (s *tokenKeyProviderSuite) TestKeyRotation() {
+	provider := NewDefaultTokenKeyProvider(s.newAuthorizationConfig(), log.NewNoopLogger())
+	s.Equal(1, s.idp.jwksGets)
+
+	s.idp.addRSAKey(s.T(), "rsa-2")
+
+	// unknown keys don't trigger a refresh if keys were refreshed recently
+	_, err := provider.RsaKey("RS256", "rsa-2")
+	s.Error(err)
+	s.Equal(1, s.idp.jwksGets)
+
+	provider.minRefreshInterval = 0
+	_, err = provider.RsaKey("RS256", "rsa-2")
+	s.NoError(err)
+	s.Equal(2, s.idp.jwksGets)
+}
+
+func (s *tokenKeyProviderSuite) TestPeriodicRefresh() {
+	cfg := s.newAuthorizationConfig()
+	cfg.JWTKeyProvider.RefreshInterval = 10 * time.Millisecond
+	provider := NewDefaultTokenKeyProvider(cfg, log.NewNoopLogger())
+	defer provider.Close()
+	provider.refreshLock.Lock()
+	provider.minRefreshInterval = time.Hour
+	provider.refreshLock.Unlock()
+
+	s.idp.addECKey(s.T(), "ec-2")
+	s.Eventually(func() bool {
+		_, err := provider.EcdsaKey("ES256", "ec-2")
+		return err == nil
+	}, time.Second, 10*time.Millisecond)
+}
+
+func (s *tokenKeyProviderSuite) TestClaimMapper() {
+	cfg := s.newAuthorizationConfig()
+	provider := NewDefaultTokenKeyProvider(cfg, log.NewNoopLogger())
+	claimMapper := NewDefaultJWTClaimMapper(provider, cfg, log.NewNoopLogger())
+
+	testCases := []struct {
+		name   string
+		method jwt.SigningMethod
+		kid    string
+		claims jwt.MapClaims
+	}{
+		{"RSA", jwt.SigningMethodRS256, "rsa-1", s.idp.claims("rsa-user", nil)},
+		{"ECDSA", jwt.SigningMethodES256, "ec-1", s.idp.claims("ec-user", nil)},
+	}
+	for _, tc := range testCases {
+		token := s.idp.sign(s.T(), tc.method, tc.kid, tc.claims)
+		claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(token)})
+		s.NoError(err, tc.name)
+		s.Equal(tc.claims["sub"], claims.Subject, tc.name)
+	}
+
+	// HMAC tokens are verified with the configured keys only
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, s.idp.claims("hmac-user", nil))
+	token.Header["kid"] = "configured"
+	signed, err := token.SignedString([]byte("configured-hmac-secret"))
+	s.NoError(err)
+	claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(signed)})
+	s.NoError(err)
+	s.Equal("hmac-user", claims.Subject)
+
+	signed = s.idp.sign(s.T(), jwt.SigningMethodHS256, "hmac-1", s.idp.claims("hmac-user", nil))
+	_, err = claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(signed)})
+	s.Error(err)
+}
+
+func (s *tokenKeyProviderSuite) TestClaimMapper_Groups() {
+	cfg := s.newAuthorizationConfig()
+	provider := NewDefaultTokenKeyProvider(cfg, log.NewNoopLogger())
+	claimMapper := NewDefaultJWTClaimMapper(provider, cfg, log.NewNoopLogger())
+
+	token := s.idp.sign(s.T(), jwt.SigningMethodRS256, "rsa-1", s.idp.claims("dev", jwt.MapClaims{
+		"groups":      []string{"payments-devs", "auditors", "unmapped"},
+		"permissions": []string{"default:read"},
+	}))
+	claims, err := claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(token)})
+	s.NoError(err)
+	s.Equal(RoleUndefined, claims.System)
+	s.Equal(map[string]Role{
+		"payments":         RoleWriter | RoleReader,
+		"payments-staging": RoleAdmin,
+		"billing":          RoleReader,
+		"default":          RoleReader,
+	}, claims.Namespaces)
+	s.Equal([]any{"payments-devs", "auditors", "unmapped"}, claims.Extensions.(jwt.MapClaims)["groups"])
+
+	cfg.GroupsClaimName = "roles"
+	claimMapper = NewDefaultJWTClaimMapper(provider, cfg, log.NewNoopLogger())
+	token = s.idp.sign(s.T(), jwt.SigningMethodRS256, "rsa-1", s.idp.claims("operator", jwt.MapClaims{
+		"roles": "operators",
+	}))
+	claims, err = claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(token)})
+	s.NoError(err)
+	s.Equal(RoleAdmin, claims.System)
+	s.Empty(claims.Namespaces)
+	s.Equal("operators", claims.Extensions.(jwt.MapClaims)["groups"])
+}
+
+func (s *tokenKeyProviderSuite) TestClaimMapper_IssuerAndAudience() {
+	cfg := s.newAuthorizationConfig()
+	provider := NewDefaultTokenKeyProvider(cfg, log.NewNoopLogger())
+	claimMapper := NewDefaultJWTClaimMapper(provider, cfg, log.NewNoopLogger())
+
+	token := s.idp.sign(s.T(), jwt.SigningMethodRS256, "rsa-1", s.idp.claims("user", jwt.MapClaims{
+		"iss": "https://other.example.com",
+	}))
+	_, err := claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(token)})
+	s.Error(err)
+
+	token = s.idp.sign(s.T(), jwt.SigningMethodRS256, "rsa-1", s.idp.claims("user", jwt.MapClaims{
+		"aud": "other-service",
+	}))
+	_, err = claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(token)})
+	s.Error(err)
+
+	token = s.idp.sign(s.T(), jwt.SigningMethodRS256, "rsa-1", s.idp.claims("user", jwt.MapClaims{
+		"iss": s.idp.server.URL + "/",
+	}))
+	_, err = claimMapper.GetClaims(&AuthInfo{AuthToken: AddBearer(token)})
+	s.NoError(err)
+}
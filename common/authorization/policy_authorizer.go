@@ -0,0 +1,405 @@
+package authorization
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/server/common/api"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	policyEffectAllow = "allow"
+	policyEffectDeny  = "deny"
+
+	defaultPolicyRefreshInterval = 10 * time.Second
+)
+
+type (
+	// Policy is the content of a policy file for the policy authorizer.
+	//
+	// A rule matches a call if every condition it sets matches, conditions that are not set
+	// match any call. A call is denied if any deny rule matches, otherwise allowed if any allow
+	// rule matches or if the caller's system role grants access to the API, as it does for the
+	// default authorizer, otherwise DefaultDecision applies. This lets internode and other
+	// system callers through a policy that denies by default. Health check APIs are always
+	// allowed.
+	Policy struct {
+		// DefaultDecision applies to calls no rule matches: "deny" (the default) or "allow".
+		DefaultDecision string       `yaml:"defaultDecision"`
+		Rules           []PolicyRule `yaml:"rules"`
+	}
+
+	// PolicyRule allows or denies the calls it matches. All conditions are lists of glob
+	// patterns, where "*" matches any sequence of characters and "?" any single character.
+	PolicyRule struct {
+		// Name identifies the rule in decision logs.
+		Name string `yaml:"name"`
+		// Effect is "allow" or "deny".
+		Effect string `yaml:"effect"`
+		// APIs are matched against the full API name, or against the method name if the
+		// pattern has no "/", e.g. "Poll*".
+		APIs       []string `yaml:"apis"`
+		Namespaces []string `yaml:"namespaces"`
+		// TaskQueues and WorkflowTypes only match calls whose request has a task queue or
+		// workflow type.
+		TaskQueues    []string `yaml:"taskQueues"`
+		WorkflowTypes []string `yaml:"workflowTypes"`
+		// Subjects are matched against Claims.Subject.
+		Subjects []string `yaml:"subjects"`
+		// Groups are matched against the "groups" claim, see Claims. The default JWT claim
+		// mapper sets it from the configured groups claim, the TLS claim mapper from the
+		// organizational units of the client certificate.
+		Groups []string `yaml:"groups"`
+		// Claims are matched against Claims.Extensions, which must be a map with string keys,
+		// such as the claims of a JWT or the certificate fields set by the TLS claim mapper.
+		// Each claim must have a value, or for list claims an element, matching one of the
+		// patterns.
+		Claims map[string][]string `yaml:"claims"`
+	}
+
+	policyAuthorizer struct {
+		config config.PolicyAuthorizer
+		logger log.Logger
+
+		policy  atomic.Pointer[compiledPolicy]
+		modTime time.Time
+		size    int64
+		ticker  *time.Ticker
+		stop    chan struct{}
+	}
+
+	compiledPolicy struct {
+		defaultDecision Decision
+		rules           []PolicyRule
+	}
+
+	hasTaskQueue interface {
+		GetTaskQueue() *taskqueuepb.TaskQueue
+	}
+
+	hasTaskQueueName interface {
+		GetTaskQueue() string
+	}
+
+	hasWorkflowType interface {
+		GetWorkflowType() *commonpb.WorkflowType
+	}
+)
+
+const (
+	policyGroupsClaim = "groups"
+	// policySystemRole is logged as the rule of calls allowed by the caller's system role.
+	policySystemRole = "system-role"
+)
+
+var _ Authorizer = (*policyAuthorizer)(nil)
+
+// NewPolicyAuthorizer creates an authorizer which evaluates the rules of a policy file. The
+// file is checked for changes every RefreshInterval and reloaded, if the new content is not
+// valid the previous policy is kept. In audit only mode, decisions are logged but all calls
+// are allowed.
+func NewPolicyAuthorizer(cfg config.PolicyAuthorizer, logger log.Logger) (*policyAuthorizer, error) {
+	if cfg.File == "" {
+		return nil, errors.New("policy authorizer requires a policy file")
+	}
+	a := &policyAuthorizer{
+		config: cfg,
+		logger: log.With(logger, tag.NewStringTag("policy-file", cfg.File)),
+	}
+	if err := a.reload(); err != nil {
+		return nil, err
+	}
+
+	refreshInterval := cfg.RefreshInterval
+	if refreshInterval == 0 {
+		refreshInterval = defaultPolicyRefreshInterval
+	}
+	if refreshInterval > 0 {
+		a.stop = make(chan struct{})
+		a.ticker = time.NewTicker(refreshInterval)
+		go a.timerCallback()
+	}
+	return a, nil
+}
+
+// Close stops reloading the policy file.
+func (a *policyAuthorizer) Close() {
+	if a.ticker != nil {
+		a.ticker.Stop()
+		close(a.stop)
+	}
+}
+
+func (a *policyAuthorizer) Authorize(_ context.Context, claims *Claims, target *CallTarget) (Result, error) {
+	if IsHealthCheckAPI(target.APIName) {
+		return resultAllow, nil
+	}
+
+	decision, rule := a.policy.Load().evaluate(claims, target)
+
+	subject := ""
+	if claims != nil {
+		subject = claims.Subject
+	}
+
+	logger := log.With(a.logger,
+		tag.NewStringTag("api", target.APIName),
+		tag.WorkflowNamespace(target.Namespace),
+		tag.NewStringTag("subject", subject),
+		tag.NewStringTag("policy-rule", rule),
+		tag.NewBoolTag("audit-only", a.config.AuditOnly),
+	)
+	if decision == DecisionAllow {
+		logger.Debug("Authorization policy allowed request")
+		return resultAllow, nil
+	}
+	if a.config.AuditOnly {
+		logger.Info("Authorization policy would deny request")
+		return resultAllow, nil
+	}
+	logger.Info("Authorization policy denied request")
+	return resultDeny, nil
+}
+
+func (a *policyAuthorizer) timerCallback() {
+	for {
+		select {
+		case <-a.stop:
+			return
+		case <-a.ticker.C:
+		}
+		if err := a.reload(); err != nil {
+			a.logger.Error("error while reloading authorization policy, keeping previous policy", tag.Error(err))
+		}
+	}
+}
+
+// reload loads the policy file if it changed since it was last loaded.
+func (a *policyAuthorizer) reload() error {
+	info, err := os.Stat(a.config.File)
+	if err != nil {
+		return err
+	}
+	if a.policy.Load() != nil && info.ModTime().Equal(a.modTime) && info.Size() == a.size {
+		return nil
+	}
+
+	data, err := os.ReadFile(a.config.File)
+	if err != nil {
+		return err
+	}
+	policy, err := parsePolicy(data)
+	if err != nil {
+		return err
+	}
+	a.policy.Store(policy)
+	a.modTime = info.ModTime()
+	a.size = info.Size()
+	a.logger.Info("Loaded authorization policy", tag.NewInt("rules", len(policy.rules)))
+	return nil
+}
+
+// parsePolicy parses and validates the content of a policy file.
+func parsePolicy(data []byte) (*compiledPolicy, error) {
+	var policy Policy
+	if err := yaml.Unmarshal(data, &policy); err != nil {
+		return nil, fmt.Errorf("unable to parse authorization policy: %w", err)
+	}
+
+	compiled := &compiledPolicy{rules: policy.Rules}
+	switch strings.ToLower(policy.DefaultDecision) {
+	case "", policyEffectDeny:
+		compiled.defaultDecision = DecisionDeny
+	case policyEffectAllow:
+		compiled.defaultDecision = DecisionAllow
+	default:
+		return nil, fmt.Errorf("invalid authorization policy default decision: %q", policy.DefaultDecision)
+	}
+	for i, rule := range policy.Rules {
+		switch strings.ToLower(rule.Effect) {
+		case policyEffectAllow, policyEffectDeny:
+		default:
+			return nil, fmt.Errorf("invalid effect %q of authorization policy rule %d (%s)", rule.Effect, i, rule.Name)
+		}
+	}
+	return compiled, nil
+}
+
+// evaluate returns the decision for a call and the name of the rule that made it, or an
+// empty string if the default decision applies.
+func (p *compiledPolicy) evaluate(claims *Claims, target *CallTarget) (Decision, string) {
+	var allowedBy *PolicyRule
+	for i := range p.rules {
+		rule := &p.rules[i]
+		if !rule.matches(claims, target) {
+			continue
+		}
+		if strings.EqualFold(rule.Effect, policyEffectDeny) {
+			return DecisionDeny, rule.Name
+		}
+		if allowedBy == nil {
+			allowedBy = rule
+		}
+	}
+	if allowedBy != nil {
+		return DecisionAllow, allowedBy.Name
+	}
+	if claims != nil && claims.System >= getRequiredRole(api.GetMethodMetadata(target.APIName).Access) {
+		return DecisionAllow, policySystemRole
+	}
+	return p.defaultDecision, ""
+}
+
+func (r *PolicyRule) matches(claims *Claims, target *CallTarget) bool {
+	if len(r.APIs) > 0 && !matchAPI(r.APIs, target.APIName) {
+		return false
+	}
+	if len(r.Namespaces) > 0 && !matchAny(r.Namespaces, target.Namespace) {
+		return false
+	}
+	if len(r.TaskQueues) > 0 {
+		taskQueue, ok := requestTaskQueue(target.Request)
+		if !ok || !matchAny(r.TaskQueues, taskQueue) {
+			return false
+		}
+	}
+	if len(r.WorkflowTypes) > 0 {
+		workflowType, ok := requestWorkflowType(target.Request)
+		if !ok || !matchAny(r.WorkflowTypes, workflowType) {
+			return false
+		}
+	}
+	if len(r.Subjects) > 0 && (claims == nil || !matchAny(r.Subjects, claims.Subject)) {
+		return false
+	}
+	if len(r.Groups) > 0 && !matchClaim(claims, policyGroupsClaim, r.Groups) {
+		return false
+	}
+	for name, patterns := range r.Claims {
+		if !matchClaim(claims, name, patterns) {
+			return false
+		}
+	}
+	return true
+}
+
+func matchAPI(patterns []string, apiName string) bool {
+	method := apiName[strings.LastIndex(apiName, "/")+1:]
+	for _, pattern := range patterns {
+		if strings.Contains(pattern, "/") {
+			if globMatch(pattern, apiName) {
+				return true
+			}
+		} else if globMatch(pattern, method) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchAny(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if globMatch(pattern, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchClaim returns true if the claim has a value, or for a list an element, matching one
+// of the patterns.
+func matchClaim(claims *Claims, name string, patterns []string) bool {
+	if claims == nil || claims.Extensions == nil {
+		return false
+	}
+	extensions := reflect.ValueOf(claims.Extensions)
+	if extensions.Kind() == reflect.Pointer {
+		extensions = extensions.Elem()
+	}
+	if extensions.Kind() != reflect.Map || extensions.Type().Key().Kind() != reflect.String {
+		return false
+	}
+	value := extensions.MapIndex(reflect.ValueOf(name).Convert(extensions.Type().Key()))
+	if !value.IsValid() {
+		return false
+	}
+	for value.Kind() == reflect.Interface {
+		value = value.Elem()
+	}
+	switch value.Kind() {
+	case reflect.String:
+		return matchAny(patterns, value.String())
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < value.Len(); i++ {
+			element := value.Index(i)
+			for element.Kind() == reflect.Interface {
+				element = element.Elem()
+			}
+			if element.Kind() == reflect.String && matchAny(patterns, element.String()) {
+				return true
+			}
+		}
+	default:
+		return matchAny(patterns, fmt.Sprint(value.Interface()))
+	}
+	return false
+}
+
+func requestTaskQueue(request any) (string, bool) {
+	switch r := request.(type) {
+	case hasTaskQueue:
+		if r.GetTaskQueue() == nil {
+			return "", false
+		}
+		return r.GetTaskQueue().GetName(), true
+	case hasTaskQueueName:
+		return r.GetTaskQueue(), true
+	}
+	return "", false
+}
+
+func requestWorkflowType(request any) (string, bool) {
+	if r, ok := request.(hasWorkflowType); ok && r.GetWorkflowType() != nil {
+		return r.GetWorkflowType().GetName(), true
+	}
+	return "", false
+}
+
+// globMatch matches value against a pattern where "*" matches any sequence of characters,
+// including "/", and "?" any single character.
+func globMatch(pattern string, value string) bool {
+	p, v := 0, 0
+	starP, starV := -1, 0
+	for v < len(value) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == value[v]):
+			p++
+			v++
+		case p < len(pattern) && pattern[p] == '*':
+			starP, starV = p, v
+			p++
+		case starP >= 0:
+			p = starP + 1
+			starV++
+			v = starV
+		default:
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}
@@ -0,0 +1,375 @@
+package authorization
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+const testPolicy = `
+defaultDecision: deny
+rules:
+  - name: readers
+    effect: allow
+    apis: ["Describe*", "List*", "Get*"]
+    groups: ["readers", "platform"]
+  - name: payments-workers
+    effect: allow
+    apis: ["Poll*", "Respond*"]
+    namespaces: ["payments-*"]
+    taskQueues: ["payments-*"]
+    subjects: ["svc-payments-*"]
+  - name: payments-starters
+    effect: allow
+    apis: ["/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution"]
+    namespaces: ["payments-*"]
+    workflowTypes: ["Charge", "Refund*"]
+    claims:
+      team: ["payments"]
+  - name: no-operator-for-contractors
+    effect: deny
+    apis: ["/temporal.api.operatorservice.v1.OperatorService/*"]
+    claims:
+      employment: ["contractor"]
+  - name: platform
+    effect: allow
+    groups: ["platform"]
+`
+
+type policyAuthorizerSuite struct {
+	suite.Suite
+	*require.Assertions
+
+	policyFile string
+}
+
+func TestPolicyAuthorizerSuite(t *testing.T) {
+	suite.Run(t, new(policyAuthorizerSuite))
+}
+
+func (s *policyAuthorizerSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.policyFile = filepath.Join(s.T().TempDir(), "policy.yaml")
+	s.writePolicy(testPolicy)
+}
+
+func (s *policyAuthorizerSuite) writePolicy(policy string) {
+	s.NoError(os.WriteFile(s.policyFile, []byte(policy), 0644))
+}
+
+func (s *policyAuthorizerSuite) newAuthorizer(auditOnly bool) *policyAuthorizer {
+	a, err := NewPolicyAuthorizer(config.PolicyAuthorizer{
+		File:            s.policyFile,
+		RefreshInterval: -1,
+		AuditOnly:       auditOnly,
+	}, log.NewNoopLogger())
+	s.NoError(err)
+	return a
+}
+
+func (s *policyAuthorizerSuite) TestAuthorize() {
+	a := s.newAuthorizer(false)
+
+	pollPayments := &CallTarget{
+		APIName:   workflowServiceAPI("PollActivityTaskQueue"),
+		Namespace: "payments-prod",
+		Request: &workflowservice.PollActivityTaskQueueRequest{
+			TaskQueue: &taskqueuepb.TaskQueue{Name: "payments-charges"},
+		},
+	}
+	startCharge := &CallTarget{
+		APIName:   workflowServiceAPI("StartWorkflowExecution"),
+		Namespace: "payments-prod",
+		Request: &workflowservice.StartWorkflowExecutionRequest{
+			WorkflowType: &commonpb.WorkflowType{Name: "Charge"},
+		},
+	}
+	addSearchAttributes := &CallTarget{
+		APIName: "/temporal.api.operatorservice.v1.OperatorService/AddSearchAttributes",
+	}
+
+	testCases := []struct {
+		name     string
+		claims   *Claims
+		target   *CallTarget
+		decision Decision
+	}{
+		{
+			name:     "health check without claims",
+			target:   &CallTarget{APIName: healthpb.Health_Check_FullMethodName},
+			decision: DecisionAllow,
+		},
+		{
+			name:     "no rule matches",
+			claims:   &Claims{Subject: "someone"},
+			target:   pollPayments,
+			decision: DecisionDeny,
+		},
+		{
+			name:     "no claims",
+			target:   pollPayments,
+			decision: DecisionDeny,
+		},
+		{
+			name:     "worker polls its task queue",
+			claims:   &Claims{Subject: "svc-payments-worker"},
+			target:   pollPayments,
+			decision: DecisionAllow,
+		},
+		{
+			name:   "worker polls another task queue",
+			claims: &Claims{Subject: "svc-payments-worker"},
+			target: &CallTarget{
+				APIName:   workflowServiceAPI("PollActivityTaskQueue"),
+				Namespace: "payments-prod",
+				Request: &workflowservice.PollActivityTaskQueueRequest{
+					TaskQueue: &taskqueuepb.TaskQueue{Name: "billing"},
+				},
+			},
+			decision: DecisionDeny,
+		},
+		{
+			name:     "worker starts workflow",
+			claims:   &Claims{Subject: "svc-payments-worker"},
+			target:   startCharge,
+			decision: DecisionDeny,
+		},
+		{
+			name:     "reader group from JWT claims",
+			claims:   &Claims{Extensions: jwt.MapClaims{"groups": []any{"engineering", "readers"}}},
+			target:   &CallTarget{APIName: workflowServiceAPI("DescribeNamespace"), Namespace: "anything"},
+			decision: DecisionAllow,
+		},
+		{
+			name:     "reader group can't write",
+			claims:   &Claims{Extensions: jwt.MapClaims{"groups": []any{"readers"}}},
+			target:   startCharge,
+			decision: DecisionDeny,
+		},
+		{
+			name:     "team claim starts allowed workflow type",
+			claims:   &Claims{Extensions: map[string]string{"team": "payments"}},
+			target:   startCharge,
+			decision: DecisionAllow,
+		},
+		{
+			name:   "team claim starts other workflow type",
+			claims: &Claims{Extensions: map[string]string{"team": "payments"}},
+			target: &CallTarget{
+				APIName:   workflowServiceAPI("StartWorkflowExecution"),
+				Namespace: "payments-prod",
+				Request: &workflowservice.StartWorkflowExecutionRequest{
+					WorkflowType: &commonpb.WorkflowType{Name: "Payout"},
+				},
+			},
+			decision: DecisionDeny,
+		},
+		{
+			name:     "platform group",
+			claims:   &Claims{Extensions: map[string][]string{"groups": {"platform"}}},
+			target:   addSearchAttributes,
+			decision: DecisionAllow,
+		},
+		{
+			name:     "system admin without matching rule",
+			claims:   &Claims{Subject: "internode", System: RoleAdmin},
+			target:   pollPayments,
+			decision: DecisionAllow,
+		},
+		{
+			name:     "system reader can't write",
+			claims:   &Claims{System: RoleReader},
+			target:   startCharge,
+			decision: DecisionDeny,
+		},
+		{
+			name:     "deny rule overrides system role",
+			claims:   &Claims{System: RoleAdmin, Extensions: map[string]string{"employment": "contractor"}},
+			target:   addSearchAttributes,
+			decision: DecisionDeny,
+		},
+		{
+			name:     "deny overrides allow",
+			claims:   &Claims{Extensions: map[string]any{"groups": []string{"platform"}, "employment": "contractor"}},
+			target:   addSearchAttributes,
+			decision: DecisionDeny,
+		},
+	}
+	for _, tc := range testCases {
+		result, err := a.Authorize(context.Background(), tc.claims, tc.target)
+		s.NoError(err)
+		s.Equal(tc.decision, result.Decision, tc.name)
+	}
+}
+
+func (s *policyAuthorizerSuite) TestTLSClaims() {
+	a := s.newAuthorizer(false)
+	mapper, err := NewTLSClaimMapper(&config.TLSClaimMapper{})
+	s.NoError(err)
+	target := &CallTarget{APIName: "/temporal.api.operatorservice.v1.OperatorService/AddSearchAttributes"}
+
+	claims, err := mapper.GetClaims(tlsAuthInfo(&x509.Certificate{
+		Subject: pkix.Name{CommonName: "operator", OrganizationalUnit: []string{"platform"}},
+	}))
+	s.NoError(err)
+	result, err := a.Authorize(context.Background(), claims, target)
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+
+	claims, err = mapper.GetClaims(tlsAuthInfo(&x509.Certificate{
+		Subject: pkix.Name{CommonName: "operator", OrganizationalUnit: []string{"payments"}},
+	}))
+	s.NoError(err)
+	result, err = a.Authorize(context.Background(), claims, target)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+
+func (s *policyAuthorizerSuite) TestAuditOnly() {
+	a := s.newAuthorizer(true)
+
+	result, err := a.Authorize(context.Background(), &Claims{Subject: "someone"}, &CallTarget{
+		APIName:   workflowServiceAPI("StartWorkflowExecution"),
+		Namespace: "payments-prod",
+	})
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+
+	decision, rule := a.policy.Load().evaluate(&Claims{Subject: "someone"}, &CallTarget{
+		APIName:   workflowServiceAPI("StartWorkflowExecution"),
+		Namespace: "payments-prod",
+	})
+	s.Equal(DecisionDeny, decision)
+	s.Empty(rule)
+}
+
+func (s *policyAuthorizerSuite) TestDefaultDecisionAllow() {
+	s.writePolicy(`
+defaultDecision: allow
+rules:
+  - effect: deny
+    apis: ["Terminate*"]
+`)
+	a := s.newAuthorizer(false)
+
+	result, err := a.Authorize(context.Background(), nil, &CallTarget{APIName: workflowServiceAPI("StartWorkflowExecution")})
+	s.NoError(err)
+	s.Equal(DecisionAllow, result.Decision)
+	result, err = a.Authorize(context.Background(), nil, &CallTarget{APIName: workflowServiceAPI("TerminateWorkflowExecution")})
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+}
+
+func (s *policyAuthorizerSuite) TestInvalidPolicy() {
+	for _, policy := range []string{
+		"rules: [",
+		"defaultDecision: maybe",
+		"rules:\n  - effect: permit\n",
+	} {
+		s.writePolicy(policy)
+		_, err := NewPolicyAuthorizer(config.PolicyAuthorizer{File: s.policyFile}, log.NewNoopLogger())
+		s.Error(err, policy)
+	}
+
+	_, err := NewPolicyAuthorizer(config.PolicyAuthorizer{}, log.NewNoopLogger())
+	s.Error(err)
+	_, err = NewPolicyAuthorizer(config.PolicyAuthorizer{File: filepath.Join(s.T().TempDir(), "missing.yaml")}, log.NewNoopLogger())
+	s.Error(err)
+}
+
+func (s *policyAuthorizerSuite) TestReload() {
+	a, err := NewPolicyAuthorizer(config.PolicyAuthorizer{
+		File:            s.policyFile,
+		RefreshInterval: 10 * time.Millisecond,
+	}, log.NewNoopLogger())
+	s.NoError(err)
+	defer a.Close()
+
+	target := &CallTarget{APIName: workflowServiceAPI("StartWorkflowExecution")}
+	claims := &Claims{Subject: "someone"}
+	result, err := a.Authorize(context.Background(), claims, target)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+
+	// invalid policies are not loaded
+	s.writePolicy("defaultDecision: maybe")
+	s.bumpModTime(time.Second)
+	time.Sleep(50 * time.Millisecond)
+	result, err = a.Authorize(context.Background(), claims, target)
+	s.NoError(err)
+	s.Equal(DecisionDeny, result.Decision)
+
+	s.writePolicy("defaultDecision: allow")
+	s.bumpModTime(2 * time.Second)
+	s.Eventually(func() bool {
+		result, err := a.Authorize(context.Background(), claims, target)
+		return err == nil && result.Decision == DecisionAllow
+	}, time.Second, 10*time.Millisecond)
+}
+
+func (s *policyAuthorizerSuite) bumpModTime(d time.Duration) {
+	t := time.Now().Add(d)
+	s.NoError(os.Chtimes(s.policyFile, t, t))
+}
+
+func (s *policyAuthorizerSuite) TestNewAuthorizerFromConfig() {
+	cfg := &config.Authorization{
+		Authorizer:       "policy",
+		PolicyAuthorizer: config.PolicyAuthorizer{File: s.policyFile, RefreshInterval: -1},
+	}
+	auth, err := NewAuthorizerFromConfig(cfg, log.NewNoopLogger())
+	s.NoError(err)
+	s.IsType(&policyAuthorizer{}, auth)
+	auth, err = GetAuthorizerFromConfig(cfg)
+	s.NoError(err)
+	s.IsType(&policyAuthorizer{}, auth)
+
+	auth, err = NewAuthorizerFromConfig(&config.Authorization{Authorizer: "policy"}, log.NewNoopLogger())
+	s.Error(err)
+	s.Nil(auth)
+}
+
+func TestGlobMatch(t *testing.T) {
+	testCases := []struct {
+		pattern string
+		value   string
+		match   bool
+	}{
+		{"*", "", true},
+		{"*", "a/b", true},
+		{"", "", true},
+		{"", "a", false},
+		{"abc", "abc", true},
+		{"abc", "abd", false},
+		{"a?c", "abc", true},
+		{"a?c", "ac", false},
+		{"payments-*", "payments-prod", true},
+		{"payments-*", "billing-prod", false},
+		{"*-prod", "payments-prod", true},
+		{"*pay*prod", "x-payments-prod", true},
+		{"a*b*c", "abbbc", true},
+		{"a*b*c", "abbbd", false},
+		{"/temporal.api.*/Start*", "/temporal.api.workflowservice.v1.WorkflowService/StartWorkflowExecution", true},
+	}
+	for _, tc := range testCases {
+		require.Equal(t, tc.match, globMatch(tc.pattern, tc.value), "%q %q", tc.pattern, tc.value)
+	}
+}
+
+func workflowServiceAPI(method string) string {
+	return "/temporal.api.workflowservice.v1.WorkflowService/" + method
+}
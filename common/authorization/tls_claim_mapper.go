@@ -0,0 +1,275 @@
+package authorization
+
+import (
+	"crypto/x509/pkix"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v4"
+	"go.temporal.io/server/common/config"
+)
+
+const (
+	tlsSubjectSourceCommonName = "commonname"
+	tlsSubjectSourceDNSName    = "dnsname"
+	tlsSubjectSourceURI        = "uri"
+	tlsSubjectSourceEmail      = "email"
+)
+
+type (
+	// tlsClaimMapper derives claims from the client certificate of mTLS connections. The
+	// subject is taken from a configured certificate field, and roles are granted by rules that
+	// match certificate fields.
+	tlsClaimMapper struct {
+		subjectSource string
+		rules         []config.TLSClaimMappingRule
+	}
+
+	// tlsCertificateFields are the fields of a client certificate that rules match.
+	tlsCertificateFields struct {
+		commonName          string
+		organizations       []string
+		organizationalUnits []string
+		dnsNames            []string
+		uris                []string
+		emails              []string
+	}
+
+	// compositeClaimMapper combines the claims of several claim mappers.
+	compositeClaimMapper struct {
+		mappers []ClaimMapper
+	}
+)
+
+var _ ClaimMapper = (*tlsClaimMapper)(nil)
+var _ ClaimMapper = (*compositeClaimMapper)(nil)
+var _ ClaimMapperWithAuthInfoRequired = (*compositeClaimMapper)(nil)
+
+// NewTLSClaimMapper creates a claim mapper which derives claims from client certificates.
+func NewTLSClaimMapper(cfg *config.TLSClaimMapper) (ClaimMapper, error) {
+	subjectSource := strings.ToLower(cfg.SubjectSource)
+	switch subjectSource {
+	case "":
+		subjectSource = tlsSubjectSourceCommonName
+	case tlsSubjectSourceCommonName, tlsSubjectSourceDNSName, tlsSubjectSourceURI, tlsSubjectSourceEmail:
+	default:
+		return nil, fmt.Errorf("unknown TLS claim mapper subject source: %s", cfg.SubjectSource)
+	}
+	for i, rule := range cfg.Rules {
+		for _, permission := range rule.Permissions {
+			if !validPermission(permission) {
+				return nil, fmt.Errorf("invalid permission %q in TLS claim mapper rule %d", permission, i)
+			}
+		}
+	}
+	return &tlsClaimMapper{subjectSource: subjectSource, rules: cfg.Rules}, nil
+}
+
+// validPermission returns true if the permission is "<namespace>:<role>" with a known role.
+func validPermission(permission string) bool {
+	parts := strings.Split(permission, ":")
+	return len(parts) == 2 && parts[0] != "" && permissionToRole(parts[1]) != RoleUndefined
+}
+
+func (m *tlsClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
+	claims := Claims{}
+
+	fields, ok := getTLSCertificateFields(authInfo)
+	if !ok {
+		return &claims, nil
+	}
+
+	claims.Subject = fields.subject(m.subjectSource)
+	claims.Extensions = fields.extensions()
+	for i := range m.rules {
+		rule := &m.rules[i]
+		if !fields.matches(rule) {
+			continue
+		}
+		for _, permission := range rule.Permissions {
+			addPermission(&claims, permission)
+		}
+	}
+	return &claims, nil
+}
+
+func getTLSCertificateFields(authInfo *AuthInfo) (*tlsCertificateFields, bool) {
+	if cert := PeerCert(authInfo.TLSConnection); cert != nil {
+		fields := newTLSCertificateFields(&cert.Subject)
+		fields.dnsNames = cert.DNSNames
+		fields.emails = cert.EmailAddresses
+		for _, uri := range cert.URIs {
+			fields.uris = append(fields.uris, uri.String())
+		}
+		return fields, true
+	}
+	// without the connection, only the subject of the certificate is known
+	if authInfo.TLSSubject != nil {
+		return newTLSCertificateFields(authInfo.TLSSubject), true
+	}
+	return nil, false
+}
+
+func newTLSCertificateFields(subject *pkix.Name) *tlsCertificateFields {
+	return &tlsCertificateFields{
+		commonName:          subject.CommonName,
+		organizations:       subject.Organization,
+		organizationalUnits: subject.OrganizationalUnit,
+	}
+}
+
+func (f *tlsCertificateFields) subject(source string) string {
+	first := func(values []string) string {
+		if len(values) == 0 {
+			return ""
+		}
+		return values[0]
+	}
+	switch source {
+	case tlsSubjectSourceDNSName:
+		return first(f.dnsNames)
+	case tlsSubjectSourceURI:
+		return first(f.uris)
+	case tlsSubjectSourceEmail:
+		return first(f.emails)
+	default:
+		return f.commonName
+	}
+}
+
+// extensions returns the certificate fields as claims for the authorizer. The organizational
+// units are also the caller's groups.
+func (f *tlsCertificateFields) extensions() map[string]any {
+	return map[string]any{
+		"commonName":           f.commonName,
+		"organization":         f.organizations,
+		"organizationalUnit":   f.organizationalUnits,
+		"dnsName":              f.dnsNames,
+		"uri":                  f.uris,
+		"email":                f.emails,
+		defaultGroupsClaimName: f.organizationalUnits,
+	}
+}
+
+func (f *tlsCertificateFields) matches(rule *config.TLSClaimMappingRule) bool {
+	matchField := func(pattern string, values ...string) bool {
+		if pattern == "" {
+			return true
+		}
+		for _, value := range values {
+			if globMatch(pattern, value) {
+				return true
+			}
+		}
+		return false
+	}
+	return matchField(rule.CommonName, f.commonName) &&
+		matchField(rule.Organization, f.organizations...) &&
+		matchField(rule.OrganizationalUnit, f.organizationalUnits...) &&
+		matchField(rule.DNSName, f.dnsNames...) &&
+		matchField(rule.URI, f.uris...) &&
+		matchField(rule.Email, f.emails...)
+}
+
+// NewCompositeClaimMapper creates a claim mapper which combines the claims of mappers: the
+// subject is the first non-empty one, roles are the union of all roles, and the extensions of
+// all mappers are merged, see mergeExtensions. If any mapper fails, e.g. because a JWT token is
+// not valid, the call fails.
+func NewCompositeClaimMapper(mappers ...ClaimMapper) ClaimMapper {
+	return &compositeClaimMapper{mappers: mappers}
+}
+
+func (m *compositeClaimMapper) GetClaims(authInfo *AuthInfo) (*Claims, error) {
+	claims := Claims{}
+	for _, mapper := range m.mappers {
+		mapped, err := mapper.GetClaims(authInfo)
+		if err != nil {
+			return nil, err
+		}
+		if mapped == nil {
+			continue
+		}
+		if claims.Subject == "" {
+			claims.Subject = mapped.Subject
+		}
+		claims.Extensions = mergeExtensions(claims.Extensions, mapped.Extensions)
+		claims.System |= mapped.System
+		for namespace, role := range mapped.Namespaces {
+			if claims.Namespaces == nil {
+				claims.Namespaces = make(map[string]Role)
+			}
+			claims.Namespaces[namespace] |= role
+		}
+	}
+	return &claims, nil
+}
+
+// mergeExtensions merges the extensions of two mappers. Extensions which are maps with string
+// keys, like the claims of a JWT and the certificate fields of the TLS claim mapper, are merged
+// into a new map: for a claim set by both, the first value is kept, except for the groups
+// claim, which holds the groups of both. Other extensions can't be merged, the first is kept.
+func mergeExtensions(first any, second any) any {
+	if first == nil {
+		return second
+	}
+	if second == nil {
+		return first
+	}
+	firstMap, ok := extensionsMap(first)
+	if !ok {
+		return first
+	}
+	secondMap, ok := extensionsMap(second)
+	if !ok {
+		return first
+	}
+	merged := make(map[string]any, len(firstMap)+len(secondMap))
+	for name, value := range secondMap {
+		merged[name] = value
+	}
+	for name, value := range firstMap {
+		merged[name] = value
+	}
+	if firstGroups, ok := firstMap[defaultGroupsClaimName]; ok {
+		if secondGroups, ok := secondMap[defaultGroupsClaimName]; ok {
+			merged[defaultGroupsClaimName] = slices.Concat(claimValues(firstGroups), claimValues(secondGroups))
+		}
+	}
+	return merged
+}
+
+func extensionsMap(extensions any) (map[string]any, bool) {
+	switch e := extensions.(type) {
+	case map[string]any:
+		return e, true
+	case jwt.MapClaims:
+		return e, true
+	}
+	return nil, false
+}
+
+// claimValues returns a claim which may be a list or a single value as a list.
+func claimValues(claim any) []any {
+	switch c := claim.(type) {
+	case []any:
+		return c
+	case []string:
+		values := make([]any, len(c))
+		for i, v := range c {
+			values[i] = v
+		}
+		return values
+	}
+	return []any{claim}
+}
+
+// AuthInfoRequired returns false if any of the mappers can run without auth info.
+func (m *compositeClaimMapper) AuthInfoRequired() bool {
+	for _, mapper := range m.mappers {
+		if cm, ok := mapper.(ClaimMapperWithAuthInfoRequired); ok && !cm.AuthInfoRequired() {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,233 @@
+package authorization
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/server/common/config"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/primitives"
+	"google.golang.org/grpc/credentials"
+)
+
+type tlsClaimMapperSuite struct {
+	suite.Suite
+	*require.Assertions
+
+	config *config.TLSClaimMapper
+}
+
+func TestTLSClaimMapperSuite(t *testing.T) {
+	suite.Run(t, new(tlsClaimMapperSuite))
+}
+
+func (s *tlsClaimMapperSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.config = &config.TLSClaimMapper{
+		Rules: []config.TLSClaimMappingRule{
+			{
+				OrganizationalUnit: "payments",
+				Permissions:        []string{"payments:write", "payments:worker"},
+			},
+			{
+				URI:         "spiffe://example.com/ops/*",
+				Permissions: []string{primitives.SystemLocalNamespace + ":admin"},
+			},
+			{
+				CommonName:   "*.reporting.example.com",
+				Organization: "Example",
+				Permissions:  []string{"payments:read", "billing:read"},
+			},
+		},
+	}
+}
+
+func (s *tlsClaimMapperSuite) newMapper() ClaimMapper {
+	mapper, err := NewTLSClaimMapper(s.config)
+	s.NoError(err)
+	return mapper
+}
+
+func tlsAuthInfo(cert *x509.Certificate) *AuthInfo {
+	return &AuthInfo{
+		TLSSubject: &cert.Subject,
+		TLSConnection: &credentials.TLSInfo{
+			State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+		},
+	}
+}
+
+func (s *tlsClaimMapperSuite) TestOrganizationalUnit() {
+	claims, err := s.newMapper().GetClaims(tlsAuthInfo(&x509.Certificate{
+		Subject: pkix.Name{CommonName: "payments-worker", OrganizationalUnit: []string{"platform", "payments"}},
+	}))
+	s.NoError(err)
+	s.Equal("payments-worker", claims.Subject)
+	s.Equal(RoleUndefined, claims.System)
+	s.Equal(map[string]Role{"payments": RoleWriter | RoleWorker}, claims.Namespaces)
+	s.Equal([]string{"platform", "payments"}, claims.Extensions.(map[string]any)["groups"])
+}
+
+func (s *tlsClaimMapperSuite) TestURI() {
+	uri, err := url.Parse("spiffe://example.com/ops/oncall")
+	s.NoError(err)
+	s.config.SubjectSource = "uri"
+
+	claims, err := s.newMapper().GetClaims(tlsAuthInfo(&x509.Certificate{
+		Subject: pkix.Name{CommonName: "oncall"},
+		URIs:    []*url.URL{uri},
+	}))
+	s.NoError(err)
+	s.Equal("spiffe://example.com/ops/oncall", claims.Subject)
+	s.Equal(RoleAdmin, claims.System)
+	s.Empty(claims.Namespaces)
+}
+
+func (s *tlsClaimMapperSuite) TestAllFieldsOfRuleMustMatch() {
+	mapper := s.newMapper()
+
+	claims, err := mapper.GetClaims(tlsAuthInfo(&x509.Certificate{
+		Subject: pkix.Name{CommonName: "a.reporting.example.com", Organization: []string{"Example"}},
+	}))
+	s.NoError(err)
+	s.Equal(map[string]Role{"payments": RoleReader, "billing": RoleReader}, claims.Namespaces)
+
+	claims, err = mapper.GetClaims(tlsAuthInfo(&x509.Certificate{
+		Subject: pkix.Name{CommonName: "a.reporting.example.com", Organization: []string{"Other"}},
+	}))
+	s.NoError(err)
+	s.Empty(claims.Namespaces)
+}
+
+func (s *tlsClaimMapperSuite) TestSubjectSources() {
+	cert := &x509.Certificate{
+		Subject:        pkix.Name{CommonName: "cn"},
+		DNSNames:       []string{"dns-1", "dns-2"},
+		EmailAddresses: []string{"someone@example.com"},
+	}
+	for source, subject := range map[string]string{
+		"":           "cn",
+		"commonName": "cn",
+		"dnsName":    "dns-1",
+		"email":      "someone@example.com",
+		"uri":        "",
+	} {
+		s.config.SubjectSource = source
+		claims, err := s.newMapper().GetClaims(tlsAuthInfo(cert))
+		s.NoError(err)
+		s.Equal(subject, claims.Subject, source)
+	}
+}
+
+func (s *tlsClaimMapperSuite) TestSubjectOnly() {
+	claims, err := s.newMapper().GetClaims(&AuthInfo{
+		TLSSubject: &pkix.Name{CommonName: "worker", OrganizationalUnit: []string{"payments"}},
+	})
+	s.NoError(err)
+	s.Equal("worker", claims.Subject)
+	s.Equal(map[string]Role{"payments": RoleWriter | RoleWorker}, claims.Namespaces)
+}
+
+func (s *tlsClaimMapperSuite) TestNoCertificate() {
+	claims, err := s.newMapper().GetClaims(&AuthInfo{AuthToken: "Bearer token"})
+	s.NoError(err)
+	s.Equal(&Claims{}, claims)
+}
+
+func (s *tlsClaimMapperSuite) TestInvalidConfig() {
+	_, err := NewTLSClaimMapper(&config.TLSClaimMapper{SubjectSource: "serialNumber"})
+	s.Error(err)
+	_, err = NewTLSClaimMapper(&config.TLSClaimMapper{
+		Rules: []config.TLSClaimMappingRule{{CommonName: "*", Permissions: []string{"admin"}}},
+	})
+	s.Error(err)
+	_, err = NewTLSClaimMapper(&config.TLSClaimMapper{
+		Rules: []config.TLSClaimMappingRule{{CommonName: "*", Permissions: []string{"payments:wirter"}}},
+	})
+	s.ErrorContains(err, "payments:wirter")
+}
+
+func (s *tlsClaimMapperSuite) TestComposite() {
+	tokenGenerator := newTokenGenerator()
+	jwtMapper := NewDefaultJWTClaimMapper(tokenGenerator, &config.Authorization{}, log.NewNoopLogger())
+	mapper := NewCompositeClaimMapper(jwtMapper, s.newMapper())
+	cert := &x509.Certificate{
+		Subject: pkix.Name{CommonName: "payments-worker", OrganizationalUnit: []string{"payments"}},
+	}
+
+	// certificate only
+	claims, err := mapper.GetClaims(tlsAuthInfo(cert))
+	s.NoError(err)
+	s.Equal("payments-worker", claims.Subject)
+	s.Equal(map[string]Role{"payments": RoleWriter | RoleWorker}, claims.Namespaces)
+
+	// token only
+	token, err := tokenGenerator.generateRSAToken(testSubject, []string{"payments:read", "default:admin"}, errorTestOptionNoError)
+	s.NoError(err)
+	claims, err = mapper.GetClaims(&AuthInfo{AuthToken: AddBearer(token)})
+	s.NoError(err)
+	s.Equal(testSubject, claims.Subject)
+	s.Equal(map[string]Role{"payments": RoleReader, "default": RoleAdmin}, claims.Namespaces)
+
+	// both, roles are combined and the token subject takes precedence
+	authInfo := tlsAuthInfo(cert)
+	authInfo.AuthToken = AddBearer(token)
+	claims, err = mapper.GetClaims(authInfo)
+	s.NoError(err)
+	s.Equal(testSubject, claims.Subject)
+	s.Equal(map[string]Role{"payments": RoleReader | RoleWriter | RoleWorker, "default": RoleAdmin}, claims.Namespaces)
+	// the token claims and the certificate fields are both available to the authorizer
+	s.True(matchClaim(claims, "sub", []string{testSubject}))
+	s.True(matchClaim(claims, "commonName", []string{"payments-worker"}))
+	s.True(matchClaim(claims, "organizationalUnit", []string{"payments"}))
+	s.True(matchClaim(claims, defaultGroupsClaimName, []string{"payments"}))
+
+	// an invalid token fails even with a valid certificate
+	authInfo.AuthToken = "Bearer invalid"
+	_, err = mapper.GetClaims(authInfo)
+	s.Error(err)
+}
+
+func (s *tlsClaimMapperSuite) TestMergeExtensions() {
+	tokenClaims := jwt.MapClaims{"sub": "token-subject", "commonName": "from-token", "groups": []any{"admins"}}
+	certFields := map[string]any{"commonName": "from-cert", "email": []string{"a@example.com"}, "groups": []string{"payments"}}
+
+	merged := mergeExtensions(tokenClaims, certFields)
+	s.Equal(map[string]any{
+		"sub":        "token-subject",
+		"commonName": "from-token",
+		"email":      []string{"a@example.com"},
+		"groups":     []any{"admins", "payments"},
+	}, merged)
+	// the claims of the token are not changed
+	s.Equal([]any{"admins"}, tokenClaims["groups"])
+
+	s.Equal(certFields, mergeExtensions(nil, certFields))
+	s.Equal(tokenClaims, mergeExtensions(tokenClaims, nil))
+	s.Equal("opaque", mergeExtensions("opaque", certFields))
+}
+
+func (s *tlsClaimMapperSuite) TestGetClaimMapperFromConfig() {
+	cfg := &config.Authorization{ClaimMapper: "tls", TLSClaimMapper: *s.config}
+	cm, err := GetClaimMapperFromConfig(cfg, log.NewNoopLogger())
+	s.NoError(err)
+	s.IsType(&tlsClaimMapper{}, cm)
+
+	cfg.ClaimMapper = "default, tls"
+	cm, err = GetClaimMapperFromConfig(cfg, log.NewNoopLogger())
+	s.NoError(err)
+	s.IsType(&compositeClaimMapper{}, cm)
+	s.Len(cm.(*compositeClaimMapper).mappers, 2)
+
+	for _, name := range []string{"default,", "default,foo", "tls,"} {
+		cfg.ClaimMapper = name
+		_, err = GetClaimMapperFromConfig(cfg, log.NewNoopLogger())
+		s.Error(err, name)
+	}
+}
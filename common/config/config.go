@@ -47,6 +47,10 @@ type (
 		// DynamicConfigClient is the config for setting up the file based dynamic config client
 		// Filepath should be relative to the root directory
 		DynamicConfigClient *dynamicconfig.FileBasedClientConfig `yaml:"dynamicConfigClient"`
+		// PersistedDynamicConfig enables dynamic config values stored in the cluster metadata
+		// store, which are set through the admin API and take precedence over the values of
+		// the dynamic config client
+		PersistedDynamicConfig *PersistedDynamicConfig `yaml:"persistedDynamicConfig"`
 		// NamespaceDefaults is the default config for every namespace
 		NamespaceDefaults NamespaceDefaults `yaml:"namespaceDefaults"`
 		// ExporterConfig allows the specification of process-wide OTEL exporters
@@ -59,6 +63,12 @@ type (
 		RPC RPC `yaml:"rpc"`
 	}
 
+	// PersistedDynamicConfig contains the config for dynamic config values stored in persistence
+	PersistedDynamicConfig struct {
+		// PollInterval is how often values are reloaded from persistence. Defaults to 10s.
+		PollInterval time.Duration `yaml:"pollInterval"`
+	}
+
 	// PProf contains the config items for the pprof utility
 	PProf struct {
 		// Port is the port on which the PProf will bind to
@@ -266,6 +276,23 @@ type (
 		DataStores map[string]DataStore `yaml:"datastores"`
 		// TransactionSizeLimit is the largest allowed transaction size
 		TransactionSizeLimit dynamicconfig.IntPropertyFn `yaml:"-" json:"-"`
+		// HistoryCompression is the algorithm history events and mutable state are compressed with
+		HistoryCompression dynamicconfig.StringPropertyFnWithNamespaceIDFilter `yaml:"-" json:"-"`
+		// Encryption contains the config for encryption at rest of history events, mutable state
+		// and visibility memos
+		Encryption *PersistenceEncryption `yaml:"encryption"`
+	}
+
+	// PersistenceEncryption is the config for encryption at rest of persisted data.
+	PersistenceEncryption struct {
+		// ActiveKeyID is the ID of the key new data is encrypted with. New data is not encrypted
+		// if it is empty, but existing data can still be decrypted with the keys in KeyFiles.
+		ActiveKeyID string `yaml:"activeKeyId"`
+		// KeyFiles maps key IDs to files containing base64 encoded AES keys of 16, 24 or 32
+		// bytes. A key must be kept as long as there is data encrypted with it: history, mutable
+		// state and visibility memos are re-encrypted with the active key by the history
+		// re-encryption scanner.
+		KeyFiles map[string]string `yaml:"keyFiles"`
 	}
 
 	// DataStore is the configuration for a single datastore
@@ -499,6 +526,10 @@ type (
 	FilestoreArchiver struct {
 		FileMode string `yaml:"fileMode"`
 		DirMode  string `yaml:"dirMode"`
+		// VisibilityFormat is the format of archived visibility records, either "json" (the
+		// default, one file per record) or "segment" (compressed, time-partitioned segment
+		// files with an index, which support the full visibility query grammar).
+		VisibilityFormat string `yaml:"visibilityFormat"`
 	}
 
 	// GstorageArchiver contain the config for google storage archiver
@@ -577,21 +608,79 @@ type (
 		// Signing key provider for validating JWT tokens
 		JWTKeyProvider       JWTKeyProvider `yaml:"jwtKeyProvider"`
 		PermissionsClaimName string         `yaml:"permissionsClaimName"`
-		// Empty string for noopAuthorizer or "default" for defaultAuthorizer
+		// Audience, if set, must be an audience of JWT tokens
+		Audience string `yaml:"audience"`
+		// Name of the JWT claim with the groups of the subject. Defaults to "groups".
+		GroupsClaimName string `yaml:"groupsClaimName"`
+		// GroupPermissions maps groups of the groups claim to permissions, which have the same
+		// format as the permissions claim, e.g. "default:write".
+		GroupPermissions map[string][]string `yaml:"groupPermissions"`
+		// Empty string for noopAuthorizer, "default" for defaultAuthorizer, or "policy" for
+		// policyAuthorizer
 		Authorizer string `yaml:"authorizer"`
-		// Empty string for noopClaimMapper or "default" for defaultJWTClaimMapper
+		// Policy authorizer config, used if Authorizer is "policy"
+		PolicyAuthorizer PolicyAuthorizer `yaml:"policyAuthorizer"`
+		// Empty string for noopClaimMapper, "default" for defaultJWTClaimMapper, or "tls" for
+		// tlsClaimMapper. A comma separated list, e.g. "default,tls", combines the claims of
+		// several claim mappers so that either credential is accepted.
 		ClaimMapper string `yaml:"claimMapper"`
+		// TLS claim mapper config, used if ClaimMapper includes "tls"
+		TLSClaimMapper TLSClaimMapper `yaml:"tlsClaimMapper"`
 		// Name of main auth header to pass to ClaimMapper (as `AuthToken`). Defaults to `authorization`.
 		AuthHeaderName string `yaml:"authHeaderName"`
 		// Name of extra auth header to pass to ClaimMapper (as `ExtraData`). Defaults to `authorization-extras`.
 		AuthExtraHeaderName string `yaml:"authExtraHeaderName"`
 	}
 
+	// PolicyAuthorizer is the config for the authorizer that evaluates the rules of a policy file
+	PolicyAuthorizer struct {
+		// File is the path of the policy file
+		File string `yaml:"file"`
+		// RefreshInterval is how often the policy file is checked for changes. Defaults to 10s,
+		// a negative value disables reloading.
+		RefreshInterval time.Duration `yaml:"refreshInterval"`
+		// AuditOnly logs the decisions of the policy but allows all calls
+		AuditOnly bool `yaml:"auditOnly"`
+	}
+
+	// TLSClaimMapper is the config for the claim mapper that derives claims from client certificates
+	TLSClaimMapper struct {
+		// SubjectSource is the certificate field used as the subject of the claims: "commonName"
+		// (the default), "dnsName", "uri", or "email". For SAN fields, the first value is used.
+		SubjectSource string `yaml:"subjectSource"`
+		// Rules grant permissions to certificates. A certificate gets the permissions of every
+		// rule it matches.
+		Rules []TLSClaimMappingRule `yaml:"rules"`
+	}
+
+	// TLSClaimMappingRule grants permissions to certificates that match all of its non-empty
+	// fields. Fields are glob patterns, where "*" matches any sequence of characters and "?" any
+	// single character. Patterns for multi-valued fields match if any value matches.
+	TLSClaimMappingRule struct {
+		CommonName         string `yaml:"commonName"`
+		Organization       string `yaml:"organization"`
+		OrganizationalUnit string `yaml:"organizationalUnit"`
+		DNSName            string `yaml:"dnsName"`
+		URI                string `yaml:"uri"`
+		Email              string `yaml:"email"`
+		// Permissions have the same format as the permissions claim of JWT tokens:
+		// "<namespace>:<read|write|worker|admin>", with "temporal-system" as namespace for
+		// system level permissions.
+		Permissions []string `yaml:"permissions"`
+	}
+
 	// @@@SNIPSTART temporal-common-service-config-jwtkeyprovider
 	// Contains the config for signing key provider for validating JWT tokens
 	JWTKeyProvider struct {
 		KeySourceURIs   []string      `yaml:"keySourceURIs"`
 		RefreshInterval time.Duration `yaml:"refreshInterval"`
+		// Issuer is the URL of an OpenID Connect issuer. If set, its JWKS URI is discovered
+		// from <issuer>/.well-known/openid-configuration and used as a key source, and the
+		// "iss" claim of tokens must match it.
+		Issuer string `yaml:"issuer"`
+		// HMACKeys are base64 encoded keys for HMAC signed tokens, by key ID. Symmetric keys
+		// of key sources are ignored, HMAC keys are only taken from here.
+		HMACKeys map[string]string `yaml:"hmacKeys"`
 	}
 	// @@@SNIPEND
 )
@@ -656,9 +745,11 @@ func (r *GroupTLS) IsClientEnabled() bool {
 		r.Client.ForceTLS
 }
 
+// HasSourceURIsConfigured returns true if keys are retrieved from key source URIs or an
+// OpenID Connect issuer.
 func (p *JWTKeyProvider) HasSourceURIsConfigured() bool {
-	if len(p.KeySourceURIs) == 0 {
-		return false
+	if strings.TrimSpace(p.Issuer) != "" {
+		return true
 	}
 	for _, uri := range p.KeySourceURIs {
 		if strings.TrimSpace(uri) != "" {
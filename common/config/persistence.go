@@ -93,6 +93,12 @@ func (c *Persistence) Validate() error {
 		}
 	}
 
+	if c.Encryption != nil {
+		if err := c.Encryption.Validate(); err != nil {
+			return fmt.Errorf("%w: encryption: %s", ErrPersistenceConfig, err.Error())
+		}
+	}
+
 	for _, st := range stores {
 		ds, ok := c.DataStores[st]
 		if !ok {
@@ -105,6 +111,17 @@ func (c *Persistence) Validate() error {
 	return nil
 }
 
+// Validate validates the persistence encryption config
+func (c *PersistenceEncryption) Validate() error {
+	if c.ActiveKeyID == "" {
+		return nil
+	}
+	if _, ok := c.KeyFiles[c.ActiveKeyID]; !ok {
+		return fmt.Errorf("missing key file for active key %q", c.ActiveKeyID)
+	}
+	return nil
+}
+
 // VisibilityConfigExist returns whether user specified visibilityStore in config
 func (c *Persistence) VisibilityConfigExist() bool {
 	return c.VisibilityStore != ""
@@ -202,23 +202,34 @@ func (c *Collection) throttleLog() bool {
 }
 
 func findMatch[T any](cvs []ConstrainedValue, defaultCVs []TypedConstrainedValue[T], precedence []Constraints) (any, error) {
+	val, _, _, err := findMatchWithConstraints(cvs, defaultCVs, precedence)
+	return val, err
+}
+
+// findMatchWithConstraints is findMatch that also returns the constraints of the matching value
+// and whether the value is one of defaultCVs.
+func findMatchWithConstraints[T any](
+	cvs []ConstrainedValue,
+	defaultCVs []TypedConstrainedValue[T],
+	precedence []Constraints,
+) (any, Constraints, bool, error) {
 	if len(cvs)+len(defaultCVs) == 0 {
-		return nil, errKeyNotPresent
+		return nil, Constraints{}, false, errKeyNotPresent
 	}
 	for _, m := range precedence {
 		for _, cv := range cvs {
 			if m == cv.Constraints {
-				return cv.Value, nil
+				return cv.Value, m, false, nil
 			}
 		}
 		for _, cv := range defaultCVs {
 			if m == cv.Constraints {
-				return cv.Value, nil
+				return cv.Value, m, true, nil
 			}
 		}
 	}
 	// key is present but no constraint section matches
-	return nil, errNoMatchingConstraint
+	return nil, Constraints{}, false, errNoMatchingConstraint
 }
 
 // matchAndConvert can't be a method of Collection because methods can't be generic, but we can
@@ -138,6 +138,14 @@ for signal / start / signal with start API if namespace is not active`,
 		primitives.DefaultTransactionSizeLimit,
 		`TransactionSizeLimit is the largest allowed transaction size to persistence`,
 	)
+	HistoryCompression = NewNamespaceIDStringSetting(
+		"system.historyCompression",
+		"none",
+		`HistoryCompression is the algorithm history events and mutable state of a namespace are compressed
+with before they are written to persistence: "none", "zstd" or "brotli". Blobs are only compressed if they get
+smaller, and compressed blobs can be read regardless of this setting, so it can be changed at any time.
+Unknown values disable compression.`,
+	)
 	DisallowQuery = NewNamespaceBoolSetting(
 		"system.disallowQuery",
 		false,
@@ -1291,6 +1299,19 @@ second per poller by one physical queue manager`,
 		5,
 		`Number of simple priority levels (requires new matcher)`,
 	)
+	MatchingFairnessKeyWeights = NewTaskQueueTypedSetting(
+		"matching.fairnessKeyWeights",
+		map[string]float64(nil),
+		`Map of fairness key to dispatch weight. Within a priority level, keys are dispatched in proportion
+to their weights. Keys not in the map have weight 1. Only tasks loaded in memory are ordered by key: the backlog
+is read from persistence in task order, matching.getTasksBatchSize tasks at a time (requires new matcher)`,
+	)
+	MatchingFairnessKeyRateLimit = NewTaskQueueFloatSetting(
+		"matching.fairnessKeyRateLimit",
+		0,
+		`Maximum dispatch rate per fairness key, in tasks per second per task queue. The rate is divided
+equally across partitions. Zero means no per-key limit (requires new matcher)`,
+	)
 	MatchingBacklogTaskForwardTimeout = NewTaskQueueDurationSetting(
 		"matching.backlogTaskForwardTimeout",
 		60*time.Second,
@@ -2110,6 +2131,13 @@ When the this config is zero or lower we will only update shard info at most onc
 		enumspb.ENCODING_TYPE_PROTO3.String(),
 		`DefaultEventEncoding is the encoding type for history events`,
 	)
+	FairnessKeySearchAttribute = NewNamespaceStringSetting(
+		"history.fairnessKeySearchAttribute",
+		"",
+		`FairnessKeySearchAttribute is the name of a keyword search attribute. When set, workflow and
+activity tasks carry the workflow's value of it as their fairness key, and the new matcher shares
+dispatch fairly between keys (see matching.fairnessKeyWeights). Empty means all tasks share one key`,
+	)
 	DefaultActivityRetryPolicy = NewNamespaceTypedSetting(
 		"history.defaultActivityRetryPolicy",
 		retrypolicy.DefaultDefaultRetrySettings,
@@ -2587,6 +2615,13 @@ Should be at least WorkerESProcessorFlushInterval+<time to process request>.`,
 		false,
 		`ExecutionsScannerEnabled indicates if executions scanner should be started as part of worker.Scanner`,
 	)
+	HistoryReencryptionScannerEnabled = NewGlobalBoolSetting(
+		"worker.historyReencryptionScannerEnabled",
+		false,
+		`HistoryReencryptionScannerEnabled indicates if the history re-encryption scanner should be started as part of
+worker.Scanner. The scanner rewrites the history nodes, mutable states and visibility memos that are not encrypted with
+the active persistence encryption key.`,
+	)
 	HistoryScannerDataMinAge = NewGlobalDurationSetting(
 		"worker.historyScannerDataMinAge",
 		60*24*time.Hour,
@@ -2613,6 +2648,13 @@ If the service configures with archival feature enabled, update worker.historySc
 		5,
 		`BatcherConcurrency controls the concurrency of one batch operation`,
 	)
+	BatcherMaxItemResults = NewNamespaceIntSetting(
+		"worker.batcherMaxItemResults",
+		1000,
+		`BatcherMaxItemResults is the max number of per-workflow results kept by one batch operation that
+reports them. Successes are dropped before failures once the limit is reached. Results are kept in
+activity heartbeats, so they count towards the heartbeat size limit.`,
+	)
 	WorkerParentCloseMaxConcurrentActivityExecutionSize = NewGlobalIntSetting(
 		"worker.ParentCloseMaxConcurrentActivityExecutionSize",
 		1000,
@@ -2669,6 +2711,15 @@ If the service configures with archival feature enabled, update worker.historySc
 		5*time.Second,
 		`How long to sleep within a local activity before pushing to workflow level sleep (don't make this
 close to or more than the workflow task timeout)`,
+	)
+	SchedulerMigrationMode = NewNamespaceStringSetting(
+		"component.scheduler.migrationMode",
+		"disabled",
+		`Controls migration of a namespace's schedules between the scheduler workflow and the
+state machine scheduler. One of "disabled", "verify" (convert and verify schedules, without
+migrating them), "enabled" (migrate scheduler workflows to the state machine scheduler), or
+"rollback" (migrate state machine schedulers back to scheduler workflows). Scheduler workflows
+only check for migration while this isn't "disabled".`,
 	)
 	WorkerDeleteNamespaceActivityLimits = NewGlobalTypedSetting(
 		"worker.deleteNamespaceActivityLimitsConfig",
@@ -2704,8 +2755,8 @@ WorkerActivitiesPerSecond, MaxConcurrentActivityTaskPollers.
 
 	WorkflowRulesAPIsEnabled = NewNamespaceBoolSetting(
 		"frontend.workflowRulesAPIsEnabled",
-		false,
-		`WorkflowRulesAPIsEnabled is a "feature enable" flag. `,
+		true,
+		`WorkflowRulesAPIsEnabled controls whether the workflow rules APIs are available for the namespace.`,
 	)
 
 	MaxWorkflowRulesPerNamespace = NewNamespaceIntSetting(
@@ -2714,6 +2765,18 @@ WorkerActivitiesPerSecond, MaxConcurrentActivityTaskPollers.
 		`Maximum number of workflow rules in a given namespace`,
 	)
 
+	MaxScheduleExclusionCalendarsPerNamespace = NewNamespaceIntSetting(
+		"frontend.maxScheduleExclusionCalendarsPerNamespace",
+		10,
+		`Maximum number of schedule exclusion calendars in a given namespace`,
+	)
+
+	MaxScheduleExclusionCalendarDates = NewNamespaceIntSetting(
+		"frontend.maxScheduleExclusionCalendarDates",
+		1000,
+		`Maximum number of dates in a schedule exclusion calendar`,
+	)
+
 	SlowRequestLoggingThreshold = NewGlobalDurationSetting(
 		"rpc.slowRequestLoggingThreshold",
 		5*time.Second,
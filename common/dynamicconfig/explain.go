@@ -0,0 +1,156 @@
+package dynamicconfig
+
+import (
+	"reflect"
+	"slices"
+	"strings"
+)
+
+type (
+	// Explanation describes how the value of a setting is determined for a set of constraints.
+	Explanation struct {
+		Key         Key
+		Description string
+		Precedence  Precedence
+		// Default is the default value of the setting. It is nil if the setting has
+		// ConstrainedDefaults instead.
+		Default             any
+		ConstrainedDefaults []ConstrainedValue
+		// PrecedenceConstraints are the constraints that are checked, in order, to find the value.
+		PrecedenceConstraints []Constraints
+		// Values are all values of the key returned by the client.
+		Values []ConstrainedValue
+		// MatchingValues are the values whose constraints are in PrecedenceConstraints, in
+		// precedence order. The first one is used unless it can't be converted.
+		MatchingValues []ConstrainedValue
+		// MatchedConstraints are the constraints of the value that was found. The default
+		// matches global constraints. It is nil if the setting has ConstrainedDefaults and
+		// neither a value nor a constrained default matched.
+		MatchedConstraints *Constraints
+		// MatchedDefault is true if the value that was found is the default or one of
+		// ConstrainedDefaults.
+		MatchedDefault bool
+		// Value is the typed value of the setting, as returned by the property function.
+		Value any
+		// ConvertError is the error converting the value that was found, in which case Value
+		// is the default.
+		ConvertError error
+	}
+
+	// Deviation is a key with values that differ from the defaults of its setting.
+	Deviation struct {
+		Key    Key
+		Values []ConstrainedValue
+	}
+)
+
+// Explain returns how the value of key is determined from the values of client, for a setting
+// read with arguments taken from the fields of cs. Fields of cs that are not used by the
+// precedence of the setting are ignored.
+func Explain(client Client, key Key, cs Constraints) (*Explanation, error) {
+	setting := queryRegistry(key)
+	if setting == nil {
+		return nil, unregisteredKeyError(key)
+	}
+	return setting.explain(client.GetValue(setting.Key()), cs), nil
+}
+
+// ListDeviations returns the keys of registered settings that have values in client which
+// differ from the defaults of the setting, sorted by key. Only values that can be matched by
+// the precedence of the setting are considered.
+func ListDeviations(client Client) []Deviation {
+	var deviations []Deviation
+	for _, setting := range globalRegistry.settings {
+		var values []ConstrainedValue
+		for _, cv := range client.GetValue(setting.Key()) {
+			if setting.deviates(cv) {
+				values = append(values, cv)
+			}
+		}
+		if len(values) > 0 {
+			deviations = append(deviations, Deviation{Key: setting.Key(), Values: values})
+		}
+	}
+	slices.SortFunc(deviations, func(a, b Deviation) int {
+		return strings.Compare(strings.ToLower(a.Key.String()), strings.ToLower(b.Key.String()))
+	})
+	return deviations
+}
+
+func explain[T any](
+	key Key,
+	precedence Precedence,
+	description string,
+	def T,
+	cdef *[]TypedConstrainedValue[T],
+	convert func(value any) (T, error),
+	cvs []ConstrainedValue,
+	cs Constraints,
+) *Explanation {
+	prec := precedenceConstraints(precedence, cs)
+	e := &Explanation{
+		Key:                   key,
+		Description:           description,
+		Precedence:            precedence,
+		PrecedenceConstraints: prec,
+		Values:                cvs,
+	}
+	defaultCVs := []TypedConstrainedValue[T]{{Value: def}}
+	if cdef != nil {
+		defaultCVs = *cdef
+		for _, cv := range defaultCVs {
+			e.ConstrainedDefaults = append(e.ConstrainedDefaults, ConstrainedValue{Constraints: cv.Constraints, Value: cv.Value})
+		}
+	} else {
+		e.Default = def
+	}
+	for _, m := range prec {
+		for _, cv := range cvs {
+			if cv.Constraints == m {
+				e.MatchingValues = append(e.MatchingValues, cv)
+			}
+		}
+	}
+
+	// this follows matchAndConvertCvs
+	val, matched, matchedDefault, matchErr := findMatchWithConstraints(cvs, defaultCVs, prec)
+	if matchErr != nil {
+		val = def
+	} else {
+		e.MatchedConstraints = &matched
+		e.MatchedDefault = matchedDefault
+	}
+	typedVal, convertErr := convert(val)
+	if convertErr != nil && matchErr == nil {
+		e.ConvertError = convertErr
+		typedVal, _ = convert(def)
+	}
+	e.Value = typedVal
+	return e
+}
+
+// deviates returns true if cv can be matched by the precedence of the setting and its value
+// differs from the default for its constraints.
+func deviates[T any](
+	precedence Precedence,
+	def T,
+	cdef *[]TypedConstrainedValue[T],
+	convert func(value any) (T, error),
+	cv ConstrainedValue,
+) bool {
+	if !matchable(precedence, cv.Constraints) {
+		return false
+	}
+	typedVal, err := convert(cv.Value)
+	if err != nil {
+		return true
+	}
+	defVal := any(def)
+	if cdef != nil {
+		var matchErr error
+		if defVal, matchErr = findMatch(nil, *cdef, precedenceConstraints(precedence, cv.Constraints)); matchErr != nil {
+			defVal = def
+		}
+	}
+	return !reflect.DeepEqual(typedVal, defVal)
+}
@@ -0,0 +1,159 @@
+package dynamicconfig_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+type explainSuite struct {
+	suite.Suite
+	*require.Assertions
+}
+
+func TestExplainSuite(t *testing.T) {
+	suite.Run(t, new(explainSuite))
+}
+
+func (s *explainSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	dynamicconfig.ResetRegistryForTest()
+}
+
+func (s *explainSuite) TestExplain_MatchedValue() {
+	dynamicconfig.NewTaskQueueIntSetting(testGetIntPropertyKey, 5, "description")
+	client := dynamicconfig.StaticClient{
+		testGetIntPropertyKey: []dynamicconfig.ConstrainedValue{
+			{Value: 10},
+			{Constraints: dynamicconfig.Constraints{Namespace: "other-ns"}, Value: 20},
+			{Constraints: dynamicconfig.Constraints{Namespace: "ns"}, Value: 30},
+			{Constraints: dynamicconfig.Constraints{Namespace: "ns", TaskQueueName: "tq"}, Value: 40},
+		},
+	}
+
+	e, err := dynamicconfig.Explain(client, testGetIntPropertyKey, dynamicconfig.Constraints{
+		Namespace:     "ns",
+		TaskQueueName: "tq",
+		TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+		ShardID:       12, // ignored
+	})
+	s.NoError(err)
+	s.Equal("description", e.Description)
+	s.Equal(dynamicconfig.PrecedenceTaskQueue, e.Precedence)
+	s.Equal("TaskQueue", e.Precedence.String())
+	s.Equal(5, e.Default)
+	s.Len(e.PrecedenceConstraints, 5)
+	s.Len(e.Values, 4)
+	s.Equal([]dynamicconfig.ConstrainedValue{
+		{Constraints: dynamicconfig.Constraints{Namespace: "ns", TaskQueueName: "tq"}, Value: 40},
+		{Constraints: dynamicconfig.Constraints{Namespace: "ns"}, Value: 30},
+		{Value: 10},
+	}, e.MatchingValues)
+	s.Equal(&dynamicconfig.Constraints{Namespace: "ns", TaskQueueName: "tq"}, e.MatchedConstraints)
+	s.False(e.MatchedDefault)
+	s.Equal(40, e.Value)
+	s.NoError(e.ConvertError)
+}
+
+func (s *explainSuite) TestExplain_Default() {
+	dynamicconfig.NewNamespaceDurationSetting(testGetDurationPropertyKey, time.Minute, "")
+	client := dynamicconfig.StaticClient{
+		testGetDurationPropertyKey: []dynamicconfig.ConstrainedValue{
+			{Constraints: dynamicconfig.Constraints{Namespace: "other-ns"}, Value: "5s"},
+		},
+	}
+
+	e, err := dynamicconfig.Explain(client, testGetDurationPropertyKey, dynamicconfig.Constraints{Namespace: "ns"})
+	s.NoError(err)
+	s.Empty(e.MatchingValues)
+	s.Equal(&dynamicconfig.Constraints{}, e.MatchedConstraints)
+	s.True(e.MatchedDefault)
+	s.Equal(time.Minute, e.Value)
+}
+
+func (s *explainSuite) TestExplain_ConstrainedDefault() {
+	dynamicconfig.NewNamespaceIntSettingWithConstrainedDefault(testGetIntPropertyKey, []dynamicconfig.TypedConstrainedValue[int]{
+		{Constraints: dynamicconfig.Constraints{Namespace: "ns"}, Value: 3},
+		{Value: 1},
+	}, "")
+
+	e, err := dynamicconfig.Explain(dynamicconfig.NewNoopClient(), testGetIntPropertyKey, dynamicconfig.Constraints{Namespace: "ns"})
+	s.NoError(err)
+	s.Nil(e.Default)
+	s.Len(e.ConstrainedDefaults, 2)
+	s.Equal(&dynamicconfig.Constraints{Namespace: "ns"}, e.MatchedConstraints)
+	s.True(e.MatchedDefault)
+	s.Equal(3, e.Value)
+}
+
+func (s *explainSuite) TestExplain_ConvertError() {
+	dynamicconfig.NewGlobalIntSetting(testGetIntPropertyKey, 5, "")
+	client := dynamicconfig.StaticClient{testGetIntPropertyKey: "not a number"}
+
+	e, err := dynamicconfig.Explain(client, testGetIntPropertyKey, dynamicconfig.Constraints{})
+	s.NoError(err)
+	s.Equal(&dynamicconfig.Constraints{}, e.MatchedConstraints)
+	s.Error(e.ConvertError)
+	s.Equal(5, e.Value)
+}
+
+func (s *explainSuite) TestExplain_NoMatchingConstrainedDefault() {
+	dynamicconfig.NewNamespaceIntSettingWithConstrainedDefault(testGetIntPropertyKey, []dynamicconfig.TypedConstrainedValue[int]{
+		{Constraints: dynamicconfig.Constraints{Namespace: "ns"}, Value: 3},
+	}, "")
+
+	e, err := dynamicconfig.Explain(dynamicconfig.NewNoopClient(), testGetIntPropertyKey, dynamicconfig.Constraints{Namespace: "other-ns"})
+	s.NoError(err)
+	s.Nil(e.MatchedConstraints)
+	s.False(e.MatchedDefault)
+	s.Equal(0, e.Value)
+}
+
+func (s *explainSuite) TestExplain_UnregisteredKey() {
+	_, err := dynamicconfig.Explain(dynamicconfig.NewNoopClient(), testGetIntPropertyKey, dynamicconfig.Constraints{})
+	s.ErrorContains(err, `unregistered key "testGetIntPropertyKey"`)
+}
+
+func (s *explainSuite) TestListDeviations() {
+	dynamicconfig.NewGlobalIntSetting(testGetIntPropertyKey, 5, "")
+	dynamicconfig.NewNamespaceBoolSetting(testGetBoolPropertyKey, true, "")
+	dynamicconfig.NewGlobalDurationSetting(testGetDurationPropertyKey, time.Second, "")
+	dynamicconfig.NewNamespaceIntSettingWithConstrainedDefault(testGetIntPropertyFilteredByNamespaceKey, []dynamicconfig.TypedConstrainedValue[int]{
+		{Constraints: dynamicconfig.Constraints{Namespace: "ns"}, Value: 3},
+		{Value: 1},
+	}, "")
+	dynamicconfig.NewGlobalStringSetting(testGetStringPropertyKey, "", "")
+	client := dynamicconfig.StaticClient{
+		// same as the default
+		testGetIntPropertyKey: 5,
+		testGetBoolPropertyKey: []dynamicconfig.ConstrainedValue{
+			{Value: true},
+			{Constraints: dynamicconfig.Constraints{Namespace: "ns"}, Value: false},
+		},
+		// same as the default after conversion
+		testGetDurationPropertyKey: "1s",
+		testGetIntPropertyFilteredByNamespaceKey: []dynamicconfig.ConstrainedValue{
+			{Constraints: dynamicconfig.Constraints{Namespace: "ns"}, Value: 3},
+			{Constraints: dynamicconfig.Constraints{Namespace: "other-ns"}, Value: 3},
+		},
+		// never matched by a global setting
+		testGetStringPropertyKey: []dynamicconfig.ConstrainedValue{
+			{Constraints: dynamicconfig.Constraints{Namespace: "ns"}, Value: "value"},
+		},
+	}
+
+	s.Equal([]dynamicconfig.Deviation{
+		{
+			Key:    testGetBoolPropertyKey,
+			Values: []dynamicconfig.ConstrainedValue{{Constraints: dynamicconfig.Constraints{Namespace: "ns"}, Value: false}},
+		},
+		{
+			Key:    testGetIntPropertyFilteredByNamespaceKey,
+			Values: []dynamicconfig.ConstrainedValue{{Constraints: dynamicconfig.Constraints{Namespace: "other-ns"}, Value: 3}},
+		},
+	}, dynamicconfig.ListDeviations(client))
+}
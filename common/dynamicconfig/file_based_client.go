@@ -60,17 +60,28 @@ type (
 		path string
 	}
 
+	yamlConstrainedValue struct {
+		Constraints map[string]any
+		Value       any
+	}
+
 	// Results of processing and loading dynamic config file contents.
 	// Warnings should be reported but not block using the new values.
 	// Errors should abort the loading process.
 	LoadResult struct {
 		Warnings []error
 		Errors   []error
+
+		// validating reports invalid values and constraints as errors instead of warnings.
+		validating bool
 	}
 )
 
+// ValidateFile checks the contents of a dynamic config file. Unlike loading the file, values
+// that fail validation and constraints that aren't valid for a key are errors.
 func ValidateFile(contents []byte) *LoadResult {
-	_, lr := loadFile(contents)
+	lr := &LoadResult{validating: true}
+	loadFile(contents, lr)
 	return lr
 }
 
@@ -165,7 +176,8 @@ func (fc *fileBasedClient) Update() error {
 		return fmt.Errorf("dynamic config file: %s: %w", fc.config.Filepath, err)
 	}
 
-	newValues, lr := loadFile(contents)
+	lr := &LoadResult{}
+	newValues := loadFile(contents, lr)
 	for _, e := range lr.Errors {
 		fc.logger.Warn("dynamic config error", tag.Error(e))
 	}
@@ -197,52 +209,69 @@ func (fc *fileBasedClient) Update() error {
 	return nil
 }
 
-func loadFile(contents []byte) (configValueMap, *LoadResult) {
-	lr := &LoadResult{}
-
-	var yamlValues map[string][]struct {
-		Constraints map[string]any
-		Value       any
-	}
+func loadFile(contents []byte, lr *LoadResult) configValueMap {
+	var yamlValues map[string][]yamlConstrainedValue
 	if err := yaml.Unmarshal(contents, &yamlValues); err != nil {
-		return nil, lr.errorf("decode error: %w", err)
+		lr.errorf("decode error: %w", err)
+		return nil
 	}
 
 	newValues := make(configValueMap, len(yamlValues))
 	for key, yamlCV := range yamlValues {
-		precedence := PrecedenceUnknown
-		setting := queryRegistry(Key(key))
-		if setting == nil {
-			lr.warnf("unregistered key %q", key)
-		} else {
-			precedence = setting.Precedence()
+		newValues[strings.ToLower(key)] = loadValues(key, yamlCV, lr)
+	}
+
+	return newValues
+}
+
+// LoadValues parses the values of a single key, given in the format of the values of a key in
+// the dynamic config file, i.e. a list of values with constraints.
+func LoadValues(key string, contents []byte) ([]ConstrainedValue, *LoadResult) {
+	lr := &LoadResult{}
+
+	var yamlCV []yamlConstrainedValue
+	if err := yaml.Unmarshal(contents, &yamlCV); err != nil {
+		return nil, lr.errorf("decode error: %w", err)
+	}
+	return loadValues(key, yamlCV, lr), lr
+}
+
+func loadValues(key string, yamlCV []yamlConstrainedValue, lr *LoadResult) []ConstrainedValue {
+	precedence := PrecedenceUnknown
+	setting := queryRegistry(Key(key))
+	if setting == nil {
+		lr.warn(unregisteredKeyError(Key(key)))
+	} else {
+		precedence = setting.Precedence()
+	}
+
+	cvs := make([]ConstrainedValue, len(yamlCV))
+	for i, cv := range yamlCV {
+		// yaml will unmarshal map into map[interface{}]interface{} instead of map[string]interface{}
+		// manually convert key type to string for all values here
+		val, err := convertKeyTypeToString(cv.Value)
+		if err != nil {
+			lr.error(err)
+			continue
 		}
 
-		cvs := make([]ConstrainedValue, len(yamlCV))
-		for i, cv := range yamlCV {
-			// yaml will unmarshal map into map[interface{}]interface{} instead of map[string]interface{}
-			// manually convert key type to string for all values here
-			val, err := convertKeyTypeToString(cv.Value)
-			if err != nil {
-				lr.error(err)
-				continue
+		// try validating if known setting
+		if setting != nil {
+			if valErr := setting.Validate(val); valErr != nil {
+				lr.invalidf("validation failed: key %q value %v: %w", key, cv.Value, valErr)
 			}
+		}
 
-			// try validating if known setting
-			if setting != nil {
-				if valErr := setting.Validate(val); valErr != nil {
-					// TODO: raise this to error level
-					lr.warnf("validation failed: key %q value %v: %w", key, cv.Value, valErr)
-				}
+		cvs[i].Value = val
+		cvs[i].Constraints = convertYamlConstraints(key, cv.Constraints, precedence, lr)
+		for _, prev := range cvs[:i] {
+			if prev.Constraints == cvs[i].Constraints {
+				lr.warnf("duplicate constraints %v for dynamic config key %q, only the first value is used", cv.Constraints, key)
+				break
 			}
-
-			cvs[i].Value = val
-			cvs[i].Constraints = convertYamlConstraints(key, cv.Constraints, precedence, lr)
 		}
-		newValues[strings.ToLower(key)] = cvs
 	}
-
-	return newValues, lr
+	return cvs
 }
 
 func (fc *fileBasedClient) validateStaticConfig(config *FileBasedClientConfig) error {
@@ -408,6 +437,7 @@ func convertKeyTypeToStringSlice(s []interface{}) ([]interface{}, error) {
 
 func convertYamlConstraints(key string, m map[string]any, precedence Precedence, lr *LoadResult) Constraints {
 	var cs Constraints
+	allValid := true
 	for k, v := range m {
 		validConstraint := true
 		switch strings.ToLower(k) {
@@ -488,11 +518,16 @@ func convertYamlConstraints(key string, m map[string]any, precedence Precedence,
 
 		// don't log error for PrecedenceUnknown, we would already have logged for an
 		// unregistered key above
-		// TODO: raise this to error level
 		if !validConstraint && precedence != PrecedenceUnknown {
-			lr.warnf("constraint %q isn't valid for dynamic config key %q", k, key)
+			lr.invalidf("constraint %q isn't valid for dynamic config key %q", k, key)
+			allValid = false
 		}
 	}
+	// every constraint may be valid on its own but the combination may still never be checked,
+	// e.g. a task queue type without a task queue name
+	if allValid && precedence != PrecedenceUnknown && !matchable(precedence, cs) {
+		lr.warnf("combination of constraints %v never matches for dynamic config key %q", m, key)
+	}
 	return cs
 }
 
@@ -525,3 +560,13 @@ func (lr *LoadResult) error(err error) *LoadResult {
 func (lr *LoadResult) errorf(format string, args ...any) *LoadResult {
 	return lr.error(fmt.Errorf(format, args...))
 }
+
+// invalidf reports an invalid value or constraint. These are only errors when validating a
+// file: a running server still loads the file, where invalid values fall back to the default
+// and invalid constraints never match, rather than dropping every other change to it.
+func (lr *LoadResult) invalidf(format string, args ...any) *LoadResult {
+	if lr.validating {
+		return lr.errorf(format, args...)
+	}
+	return lr.warnf(format, args...)
+}
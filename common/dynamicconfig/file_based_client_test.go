@@ -554,33 +554,93 @@ testGetFloat64PropertyKey:
 	s.ErrorContains(lr.Warnings[0], `unregistered key "testGetFloat64PropertyKey"`)
 }
 
-func (s *fileBasedClientSuite) TestWarnValidationInt() {
+func (s *fileBasedClientSuite) TestWarnUnregisteredKeySuggestion() {
 	dynamicconfig.NewGlobalIntSetting(testGetIntPropertyKey, 0, "")
 
+	lr := dynamicconfig.ValidateFile([]byte(`
+testgetintpropertykye:
+- value: 2000
+`))
+	s.Empty(lr.Errors)
+	s.Equal(1, len(lr.Warnings))
+	s.ErrorContains(lr.Warnings[0], `unregistered key "testgetintpropertykye", did you mean "testGetIntPropertyKey"?`)
+}
+
+func (s *fileBasedClientSuite) TestWarnUnmatchableConstraints() {
+	dynamicconfig.NewTaskQueueIntSetting(testGetIntPropertyKey, 0, "")
+
 	lr := dynamicconfig.ValidateFile([]byte(`
 testGetIntPropertyKey:
-- value: not a number
+- value: 1
+  constraints:
+    taskQueueName: tq
+- value: 2
+  constraints:
+    taskQueueName: tq
+    taskType: Workflow
+`))
+	s.Empty(lr.Errors)
+	s.Equal(1, len(lr.Warnings))
+	s.ErrorContains(lr.Warnings[0], `never matches for dynamic config key "testGetIntPropertyKey"`)
+}
+
+func (s *fileBasedClientSuite) TestWarnDuplicateConstraints() {
+	dynamicconfig.NewNamespaceIntSetting(testGetIntPropertyKey, 0, "")
+
+	lr := dynamicconfig.ValidateFile([]byte(`
+testGetIntPropertyKey:
+- value: 1
+  constraints:
+    namespace: samples-namespace
+- value: 2
+  constraints:
+    namespace: samples-namespace
 `))
 	s.Empty(lr.Errors)
 	s.Equal(1, len(lr.Warnings))
-	s.ErrorContains(lr.Warnings[0], `validation failed: key "testGetIntPropertyKey" value not a number: value type is not int`)
+	s.ErrorContains(lr.Warnings[0], "duplicate constraints")
 }
 
-func (s *fileBasedClientSuite) TestWarnConstraint() {
+func (s *fileBasedClientSuite) TestErrorValidationInt() {
+	dynamicconfig.NewGlobalIntSetting(testGetIntPropertyKey, 0, "")
+
+	lr := dynamicconfig.ValidateFile([]byte(`
+testGetIntPropertyKey:
+- value: not a number
+`))
+	s.Empty(lr.Warnings)
+	s.Equal(1, len(lr.Errors))
+	s.ErrorContains(lr.Errors[0], `validation failed: key "testGetIntPropertyKey" value not a number: value type is not int`)
+}
+
+func (s *fileBasedClientSuite) TestErrorConstraint() {
 	dynamicconfig.NewGlobalIntSetting(testGetIntPropertyKey, 0, "")
 
 	lr := dynamicconfig.ValidateFile([]byte(`
 testGetIntPropertyKey:
+- value: 5005
+  constraints:
+    namespace: samples-namespace
+`))
+	s.Empty(lr.Warnings)
+	s.Equal(1, len(lr.Errors))
+	s.ErrorContains(lr.Errors[0], `constraint "namespace" isn't valid for dynamic config key "testGetIntPropertyKey"`)
+}
+
+func (s *fileBasedClientSuite) TestWarnInvalidWhenLoading() {
+	dynamicconfig.NewGlobalIntSetting(testGetIntPropertyKey, 0, "")
+
+	_, lr := dynamicconfig.LoadValues(testGetIntPropertyKey, []byte(`
+- value: not a number
 - value: 5005
   constraints:
     namespace: samples-namespace
 `))
 	s.Empty(lr.Errors)
-	s.Equal(1, len(lr.Warnings))
-	s.ErrorContains(lr.Warnings[0], `constraint "namespace" isn't valid for dynamic config key "testGetIntPropertyKey"`)
+	s.Equal(2, len(lr.Warnings))
 }
 
-func (s *fileBasedClientSuite) TestWarnMultiple() {
+func (s *fileBasedClientSuite) TestMultiple() {
 	dynamicconfig.NewGlobalIntSetting(testGetIntPropertyKey, 0, "")
 
 	lr := dynamicconfig.ValidateFile([]byte(`
@@ -590,9 +650,26 @@ testGetIntPropertyKey:
 - value: not a number
   constraints:
     namespace: samples-namespace
+`))
+	s.Equal(2, len(lr.Errors))
+	s.Equal(1, len(lr.Warnings))
+}
+
+func (s *fileBasedClientSuite) TestLoadValues() {
+	dynamicconfig.NewNamespaceIntSetting(testGetIntPropertyKey, 0, "")
+
+	cvs, lr := dynamicconfig.LoadValues(testGetIntPropertyKey, []byte(`
+- value: 10
+- value: 20
+  constraints:
+    namespace: samples-namespace
 `))
 	s.Empty(lr.Errors)
-	s.Equal(3, len(lr.Warnings))
+	s.Empty(lr.Warnings)
+	s.Equal([]dynamicconfig.ConstrainedValue{
+		{Value: 10},
+		{Constraints: dynamicconfig.Constraints{Namespace: "samples-namespace"}, Value: 20},
+	}, cvs)
 }
 
 func (s *fileBasedClientSuite) TestErrorYamlDecode() {
@@ -0,0 +1,325 @@
+package persistedconfig
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/headers"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/persistence"
+	expmaps "golang.org/x/exp/maps"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	defaultPollInterval = 10 * time.Second
+	// maxChanges is the number of changes kept in the audit trail.
+	maxChanges = 100
+	// maxSaveAttempts is the number of attempts to save the cluster metadata record when it is
+	// modified concurrently.
+	maxSaveAttempts    = 5
+	persistenceTimeout = 10 * time.Second
+)
+
+var (
+	_ dynamicconfig.Client          = (*Client)(nil)
+	_ dynamicconfig.NotifyingClient = (*Client)(nil)
+
+	errNotStarted = errors.New("persisted dynamic config client is not started")
+)
+
+type (
+	// Client is a dynamicconfig.Client that serves dynamic config values stored in the record
+	// of the current cluster in the cluster metadata store, on top of the values of a base
+	// client. A persisted value replaces base values of the same key with the same constraints.
+	//
+	// Persisted values are reloaded periodically, and immediately after they are changed
+	// through this client.
+	Client struct {
+		base         dynamicconfig.Client
+		pollInterval time.Duration
+		logger       log.Logger
+
+		status      int32
+		manager     persistence.ClusterMetadataManager
+		clusterName string
+		stopCh      chan struct{}
+		baseCancel  func()
+
+		// values holds the persisted values by lower case key
+		values atomic.Pointer[map[string][]dynamicconfig.ConstrainedValue]
+		// refreshLock serializes refreshes, so that subscribers are not notified concurrently
+		refreshLock sync.Mutex
+
+		subscriptionLock sync.Mutex
+		subscriptionIdx  int
+		subscriptions    map[int]dynamicconfig.ClientUpdateFunc
+	}
+)
+
+// NewClient creates a client that serves persisted values on top of the values of base. It
+// serves only the values of base until Start is called.
+func NewClient(base dynamicconfig.Client, pollInterval time.Duration, logger log.Logger) *Client {
+	if pollInterval <= 0 {
+		pollInterval = defaultPollInterval
+	}
+	c := &Client{
+		base:          base,
+		pollInterval:  pollInterval,
+		logger:        logger,
+		status:        common.DaemonStatusInitialized,
+		stopCh:        make(chan struct{}),
+		subscriptions: make(map[int]dynamicconfig.ClientUpdateFunc),
+	}
+	c.values.Store(&map[string][]dynamicconfig.ConstrainedValue{})
+	return c
+}
+
+// Start loads the persisted values of the cluster with the given name and starts reloading
+// them periodically.
+func (c *Client) Start(manager persistence.ClusterMetadataManager, clusterName string) error {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusInitialized, common.DaemonStatusStarted) {
+		return nil
+	}
+	c.manager = manager
+	c.clusterName = clusterName
+
+	if notifying, ok := c.base.(dynamicconfig.NotifyingClient); ok {
+		c.baseCancel = notifying.Subscribe(c.forwardBaseUpdate)
+	}
+
+	ctx, cancel := c.newContext()
+	defer cancel()
+	if err := c.Refresh(ctx); err != nil {
+		return fmt.Errorf("unable to load persisted dynamic config: %w", err)
+	}
+
+	go c.pollLoop()
+	return nil
+}
+
+// Stop stops reloading persisted values.
+func (c *Client) Stop() {
+	if !atomic.CompareAndSwapInt32(&c.status, common.DaemonStatusStarted, common.DaemonStatusStopped) {
+		return
+	}
+	close(c.stopCh)
+	if c.baseCancel != nil {
+		c.baseCancel()
+	}
+}
+
+func (c *Client) GetValue(key dynamicconfig.Key) []dynamicconfig.ConstrainedValue {
+	persisted := (*c.values.Load())[strings.ToLower(key.String())]
+	return mergeValues(persisted, c.base.GetValue(key))
+}
+
+func (c *Client) Subscribe(f dynamicconfig.ClientUpdateFunc) (cancel func()) {
+	c.subscriptionLock.Lock()
+	defer c.subscriptionLock.Unlock()
+
+	c.subscriptionIdx++
+	id := c.subscriptionIdx
+	c.subscriptions[id] = f
+
+	return func() {
+		c.subscriptionLock.Lock()
+		defer c.subscriptionLock.Unlock()
+		delete(c.subscriptions, id)
+	}
+}
+
+// Load returns the persisted dynamic config of the current cluster.
+func (c *Client) Load(ctx context.Context) (*persistencespb.DynamicConfig, error) {
+	if atomic.LoadInt32(&c.status) != common.DaemonStatusStarted {
+		return nil, errNotStarted
+	}
+	resp, err := c.manager.GetClusterMetadata(ctx, &persistence.GetClusterMetadataRequest{ClusterName: c.clusterName})
+	if err != nil {
+		return nil, err
+	}
+	if resp.GetDynamicConfig() == nil {
+		return &persistencespb.DynamicConfig{}, nil
+	}
+	return resp.GetDynamicConfig(), nil
+}
+
+// Set replaces the persisted values of change.Key with change.Values, or removes the key if
+// change.Values is empty, and records the change in the audit trail. The values are validated
+// against the registered setting of the key.
+func (c *Client) Set(ctx context.Context, change *persistencespb.DynamicConfigChange) error {
+	if atomic.LoadInt32(&c.status) != common.DaemonStatusStarted {
+		return errNotStarted
+	}
+	key := dynamicconfig.Key(change.GetKey())
+	if err := dynamicconfig.ValidateConstrainedValues(key, ConstrainedValuesFromProto(change.GetValues())); err != nil {
+		return serviceerror.NewInvalidArgument(err.Error())
+	}
+	change.ChangeTime = timestamppb.Now()
+
+	var err error
+	for attempt := 0; attempt < maxSaveAttempts; attempt++ {
+		var applied bool
+		if applied, err = c.trySet(ctx, change); applied {
+			break
+		} else if err == nil {
+			err = errors.New("cluster metadata record was modified concurrently")
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	if err != nil {
+		return err
+	}
+
+	c.logger.Info("Persisted dynamic config changed.",
+		tag.Key(change.GetKey()),
+		tag.Value(change.GetValues()),
+		tag.NewStringTag("identity", change.GetIdentity()),
+		tag.NewStringTag("subject", change.GetSubject()),
+		tag.NewStringTag("reason", change.GetReason()),
+	)
+	return c.Refresh(ctx)
+}
+
+func (c *Client) trySet(ctx context.Context, change *persistencespb.DynamicConfigChange) (bool, error) {
+	resp, err := c.manager.GetClusterMetadata(ctx, &persistence.GetClusterMetadataRequest{ClusterName: c.clusterName})
+	if err != nil {
+		return false, err
+	}
+	record := resp.ClusterMetadata
+	if record.DynamicConfig == nil {
+		record.DynamicConfig = &persistencespb.DynamicConfig{}
+	}
+	dc := record.DynamicConfig
+	if dc.Values == nil {
+		dc.Values = make(map[string]*persistencespb.DynamicConfigValues)
+	}
+	key := strings.ToLower(change.GetKey())
+	if len(change.GetValues()) == 0 {
+		delete(dc.Values, key)
+	} else {
+		dc.Values[key] = &persistencespb.DynamicConfigValues{Values: change.GetValues()}
+	}
+	dc.Changes = append(dc.Changes, change)
+	if len(dc.Changes) > maxChanges {
+		dc.Changes = dc.Changes[len(dc.Changes)-maxChanges:]
+	}
+
+	return c.manager.SaveClusterMetadata(ctx, &persistence.SaveClusterMetadataRequest{
+		ClusterMetadata: record,
+		Version:         resp.Version,
+	})
+}
+
+// Refresh reloads the persisted values and notifies subscribers of changed keys.
+func (c *Client) Refresh(ctx context.Context) error {
+	c.refreshLock.Lock()
+	defer c.refreshLock.Unlock()
+
+	dc, err := c.Load(ctx)
+	if err != nil {
+		return err
+	}
+	newValues := make(map[string][]dynamicconfig.ConstrainedValue, len(dc.GetValues()))
+	for key, values := range dc.GetValues() {
+		newValues[key] = ConstrainedValuesFromProto(values.GetValues())
+	}
+	oldValues := *c.values.Swap(&newValues)
+
+	changed := make(map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue)
+	for key, values := range newValues {
+		if !slices.EqualFunc(values, oldValues[key], equalConstrainedValues) {
+			changed[dynamicconfig.Key(key)] = nil
+		}
+	}
+	for key := range oldValues {
+		if _, ok := newValues[key]; !ok {
+			changed[dynamicconfig.Key(key)] = nil
+		}
+	}
+	c.notify(changed)
+	return nil
+}
+
+func (c *Client) pollLoop() {
+	ticker := time.NewTicker(c.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			ctx, cancel := c.newContext()
+			if err := c.Refresh(ctx); err != nil {
+				c.logger.Warn("Unable to reload persisted dynamic config.", tag.Error(err))
+			}
+			cancel()
+		case <-c.stopCh:
+			return
+		}
+	}
+}
+
+func (c *Client) forwardBaseUpdate(changed map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue) {
+	c.refreshLock.Lock()
+	defer c.refreshLock.Unlock()
+	c.notify(changed)
+}
+
+// notify calls subscribers with the merged values of the changed keys. Must be called with
+// refreshLock held.
+func (c *Client) notify(changed map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue) {
+	if len(changed) == 0 {
+		return
+	}
+	merged := make(map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue, len(changed))
+	for key := range changed {
+		merged[key] = c.GetValue(key)
+	}
+
+	c.subscriptionLock.Lock()
+	subscriptions := expmaps.Values(c.subscriptions)
+	c.subscriptionLock.Unlock()
+
+	for _, update := range subscriptions {
+		update(merged)
+	}
+}
+
+func (c *Client) newContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithTimeout(context.Background(), persistenceTimeout)
+	return headers.SetCallerInfo(ctx, headers.SystemBackgroundCallerInfo), cancel
+}
+
+// mergeValues returns the persisted values followed by the base values whose constraints are
+// not persisted.
+func mergeValues(persisted, base []dynamicconfig.ConstrainedValue) []dynamicconfig.ConstrainedValue {
+	if len(persisted) == 0 {
+		return base
+	}
+	merged := slices.Clone(persisted)
+	for _, cv := range base {
+		if !slices.ContainsFunc(persisted, func(p dynamicconfig.ConstrainedValue) bool {
+			return p.Constraints == cv.Constraints
+		}) {
+			merged = append(merged, cv)
+		}
+	}
+	return merged
+}
+
+func equalConstrainedValues(a, b dynamicconfig.ConstrainedValue) bool {
+	return a.Constraints == b.Constraints && reflect.DeepEqual(a.Value, b.Value)
+}
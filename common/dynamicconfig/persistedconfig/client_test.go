@@ -0,0 +1,199 @@
+package persistedconfig_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	"go.temporal.io/api/serviceerror"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/dynamicconfig/persistedconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/persistence"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	testClusterName = "active"
+	testIntKey      = "testPersistedIntKey"
+)
+
+type (
+	clientSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller *gomock.Controller
+		manager    *persistence.MockClusterMetadataManager
+		base       *dynamicconfig.MemoryClient
+		client     *persistedconfig.Client
+
+		record  *persistencespb.ClusterMetadata
+		version int64
+		// conflicts is the number of saves that fail because another host saved the record first
+		conflicts int
+	}
+)
+
+func TestClientSuite(t *testing.T) {
+	suite.Run(t, new(clientSuite))
+}
+
+func (s *clientSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	dynamicconfig.ResetRegistryForTest()
+	dynamicconfig.NewNamespaceIntSetting(testIntKey, 0, "")
+
+	s.controller = gomock.NewController(s.T())
+	s.manager = persistence.NewMockClusterMetadataManager(s.controller)
+	s.record = &persistencespb.ClusterMetadata{ClusterName: testClusterName}
+	s.version = 1
+	s.manager.EXPECT().GetClusterMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.GetClusterMetadataRequest) (*persistence.GetClusterMetadataResponse, error) {
+			s.Equal(testClusterName, request.ClusterName)
+			return &persistence.GetClusterMetadataResponse{
+				ClusterMetadata: proto.Clone(s.record).(*persistencespb.ClusterMetadata),
+				Version:         s.version,
+			}, nil
+		}).AnyTimes()
+	s.manager.EXPECT().SaveClusterMetadata(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.SaveClusterMetadataRequest) (bool, error) {
+			if s.conflicts > 0 {
+				s.conflicts--
+				s.version++
+			}
+			if request.Version != s.version {
+				return false, nil
+			}
+			s.record = request.ClusterMetadata
+			s.version++
+			return true, nil
+		}).AnyTimes()
+
+	s.base = dynamicconfig.NewMemoryClient()
+	s.client = persistedconfig.NewClient(s.base, 0, log.NewNoopLogger())
+	s.NoError(s.client.Start(s.manager, testClusterName))
+}
+
+func (s *clientSuite) TearDownTest() {
+	s.client.Stop()
+}
+
+func (s *clientSuite) TestGetValue_BaseOnly() {
+	s.base.OverrideValue(testIntKey, 10)
+	s.Equal([]dynamicconfig.ConstrainedValue{{Value: 10}}, s.client.GetValue(testIntKey))
+}
+
+func (s *clientSuite) TestSet_OverridesBaseValueWithSameConstraints() {
+	s.base.OverrideValue(testIntKey, 10)
+
+	s.NoError(s.client.Set(context.Background(), s.newChange(
+		newValue(dynamicconfig.Constraints{}, 20),
+		newValue(dynamicconfig.Constraints{Namespace: "ns"}, 30),
+	)))
+
+	s.Equal([]dynamicconfig.ConstrainedValue{
+		{Value: 20},
+		{Constraints: dynamicconfig.Constraints{Namespace: "ns"}, Value: 30},
+	}, s.client.GetValue(testIntKey))
+}
+
+func (s *clientSuite) TestSet_Remove() {
+	s.base.OverrideValue(testIntKey, 10)
+	s.NoError(s.client.Set(context.Background(), s.newChange(newValue(dynamicconfig.Constraints{}, 20))))
+	s.NoError(s.client.Set(context.Background(), s.newChange()))
+
+	s.Equal([]dynamicconfig.ConstrainedValue{{Value: 10}}, s.client.GetValue(testIntKey))
+	dc, err := s.client.Load(context.Background())
+	s.NoError(err)
+	s.Empty(dc.GetValues())
+	s.Len(dc.GetChanges(), 2)
+}
+
+func (s *clientSuite) TestSet_Invalid() {
+	err := s.client.Set(context.Background(), s.newChange(newValue(dynamicconfig.Constraints{ShardID: 1}, 20)))
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+
+	change := s.newChange(newValue(dynamicconfig.Constraints{}, 20))
+	change.Key = "unknownKey"
+	s.ErrorAs(s.client.Set(context.Background(), change), &invalidArgument)
+
+	s.Nil(s.record.GetDynamicConfig())
+}
+
+func (s *clientSuite) TestSet_ConcurrentModification() {
+	s.conflicts = 2
+
+	s.NoError(s.client.Set(context.Background(), s.newChange(newValue(dynamicconfig.Constraints{}, 20))))
+	s.Equal([]dynamicconfig.ConstrainedValue{{Value: 20}}, s.client.GetValue(testIntKey))
+	s.Zero(s.conflicts)
+
+	s.conflicts = 5
+	s.Error(s.client.Set(context.Background(), s.newChange(newValue(dynamicconfig.Constraints{}, 30))))
+	s.Equal([]dynamicconfig.ConstrainedValue{{Value: 20}}, s.client.GetValue(testIntKey))
+}
+
+func (s *clientSuite) TestSet_AuditTrailIsCapped() {
+	for i := 0; i < 110; i++ {
+		change := s.newChange(newValue(dynamicconfig.Constraints{}, i))
+		change.Reason = strconv.Itoa(i)
+		s.NoError(s.client.Set(context.Background(), change))
+	}
+
+	dc, err := s.client.Load(context.Background())
+	s.NoError(err)
+	s.Len(dc.GetChanges(), 100)
+	s.Equal("10", dc.GetChanges()[0].GetReason())
+	s.Equal("109", dc.GetChanges()[99].GetReason())
+	s.NotNil(dc.GetChanges()[99].GetChangeTime())
+}
+
+func (s *clientSuite) TestSubscribe() {
+	var updates []map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue
+	cancel := s.client.Subscribe(func(changed map[dynamicconfig.Key][]dynamicconfig.ConstrainedValue) {
+		updates = append(updates, changed)
+	})
+	defer cancel()
+
+	s.NoError(s.client.Set(context.Background(), s.newChange(newValue(dynamicconfig.Constraints{}, 20))))
+	s.Len(updates, 1)
+	s.Equal([]dynamicconfig.ConstrainedValue{{Value: 20}}, updates[0][dynamicconfig.Key("testpersistedintkey")])
+
+	// a refresh without changes doesn't notify subscribers
+	s.NoError(s.client.Refresh(context.Background()))
+	s.Len(updates, 1)
+
+	s.base.OverrideValue(testIntKey, 10)
+	s.Len(updates, 2)
+	s.Equal([]dynamicconfig.ConstrainedValue{{Value: 20}}, updates[1][testIntKey])
+}
+
+func (s *clientSuite) TestNotStarted() {
+	client := persistedconfig.NewClient(s.base, 0, log.NewNoopLogger())
+	s.Error(client.Set(context.Background(), s.newChange()))
+	_, err := client.Load(context.Background())
+	s.Error(err)
+}
+
+func (s *clientSuite) newChange(values ...*persistencespb.DynamicConfigConstrainedValue) *persistencespb.DynamicConfigChange {
+	return &persistencespb.DynamicConfigChange{
+		Key:      testIntKey,
+		Values:   values,
+		Identity: "test-identity",
+	}
+}
+
+func newValue(constraints dynamicconfig.Constraints, value int) *persistencespb.DynamicConfigConstrainedValue {
+	values, err := persistedconfig.ConstrainedValuesToProto([]dynamicconfig.ConstrainedValue{
+		{Constraints: constraints, Value: value},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return values[0]
+}
@@ -0,0 +1,97 @@
+package persistedconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// ConstrainedValuesFromProto converts persisted constrained values to the values returned by a
+// dynamicconfig.Client. Integral numbers are returned as int, since the JSON-like values of
+// structpb don't distinguish between ints and floats.
+func ConstrainedValuesFromProto(values []*persistencespb.DynamicConfigConstrainedValue) []dynamicconfig.ConstrainedValue {
+	cvs := make([]dynamicconfig.ConstrainedValue, 0, len(values))
+	for _, v := range values {
+		cvs = append(cvs, dynamicconfig.ConstrainedValue{
+			Constraints: ConstraintsFromProto(v.GetConstraints()),
+			Value:       valueFromProto(v.GetValue()),
+		})
+	}
+	return cvs
+}
+
+// ConstrainedValuesToProto converts constrained values to their persisted form. Durations are
+// converted to strings, and values of other types that structpb doesn't support are converted
+// through JSON.
+func ConstrainedValuesToProto(cvs []dynamicconfig.ConstrainedValue) ([]*persistencespb.DynamicConfigConstrainedValue, error) {
+	values := make([]*persistencespb.DynamicConfigConstrainedValue, 0, len(cvs))
+	for _, cv := range cvs {
+		value, err := ValueToProto(cv.Value)
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, &persistencespb.DynamicConfigConstrainedValue{
+			Constraints: ConstraintsToProto(cv.Constraints),
+			Value:       value,
+		})
+	}
+	return values, nil
+}
+
+// ValueToProto converts a dynamic config value to a structpb value.
+func ValueToProto(v any) (*structpb.Value, error) {
+	if d, ok := v.(time.Duration); ok {
+		return structpb.NewStringValue(d.String()), nil
+	}
+	if value, err := structpb.NewValue(v); err == nil {
+		return value, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("unable to convert dynamic config value %v: %w", v, err)
+	}
+	var generic any
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return nil, fmt.Errorf("unable to convert dynamic config value %v: %w", v, err)
+	}
+	return structpb.NewValue(generic)
+}
+
+func valueFromProto(value *structpb.Value) any {
+	v := value.AsInterface()
+	if f, ok := v.(float64); ok && f == math.Trunc(f) && math.Abs(f) < 1<<53 {
+		return int(f)
+	}
+	return v
+}
+
+// ConstraintsFromProto converts persisted constraints to dynamicconfig.Constraints.
+func ConstraintsFromProto(c *persistencespb.DynamicConfigConstraints) dynamicconfig.Constraints {
+	return dynamicconfig.Constraints{
+		Namespace:     c.GetNamespace(),
+		NamespaceID:   c.GetNamespaceId(),
+		TaskQueueName: c.GetTaskQueueName(),
+		TaskQueueType: c.GetTaskQueueType(),
+		ShardID:       c.GetShardId(),
+		TaskType:      c.GetTaskType(),
+		Destination:   c.GetDestination(),
+	}
+}
+
+// ConstraintsToProto converts dynamicconfig.Constraints to their persisted form.
+func ConstraintsToProto(c dynamicconfig.Constraints) *persistencespb.DynamicConfigConstraints {
+	return &persistencespb.DynamicConfigConstraints{
+		Namespace:     c.Namespace,
+		NamespaceId:   c.NamespaceID,
+		TaskQueueName: c.TaskQueueName,
+		TaskQueueType: c.TaskQueueType,
+		ShardId:       c.ShardID,
+		TaskType:      c.TaskType,
+		Destination:   c.Destination,
+	}
+}
@@ -31,5 +31,7 @@ type (
 
 		// for internal use:
 		dispatchUpdate(*Collection, any, []ConstrainedValue)
+		explain(cvs []ConstrainedValue, cs Constraints) *Explanation
+		deviates(cv ConstrainedValue) bool
 	}
 )
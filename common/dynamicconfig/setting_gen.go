@@ -21,6 +21,67 @@ const (
 	PrecedenceDestination
 )
 
+func (p Precedence) String() string {
+	switch p {
+	case PrecedenceGlobal:
+		return "Global"
+	case PrecedenceNamespace:
+		return "Namespace"
+	case PrecedenceNamespaceID:
+		return "NamespaceID"
+	case PrecedenceTaskQueue:
+		return "TaskQueue"
+	case PrecedenceShardID:
+		return "ShardID"
+	case PrecedenceTaskType:
+		return "TaskType"
+	case PrecedenceDestination:
+		return "Destination"
+	default:
+		return "Unknown"
+	}
+}
+
+// precedenceConstraints returns the constraints that are checked, in order, when a setting
+// with precedence p is read with arguments taken from the fields of cs.
+func precedenceConstraints(p Precedence, cs Constraints) []Constraints {
+	switch p {
+	case PrecedenceGlobal:
+		return []Constraints{{}}
+	case PrecedenceNamespace:
+		namespace := cs.Namespace
+		return []Constraints{{Namespace: namespace}, {}}
+	case PrecedenceNamespaceID:
+		namespaceID := namespace.ID(cs.NamespaceID)
+		return []Constraints{{NamespaceID: namespaceID.String()}, {}}
+	case PrecedenceTaskQueue:
+		namespace, taskQueue, taskQueueType := cs.Namespace, cs.TaskQueueName, cs.TaskQueueType
+		return []Constraints{
+			{Namespace: namespace, TaskQueueName: taskQueue, TaskQueueType: taskQueueType},
+			{Namespace: namespace, TaskQueueName: taskQueue},
+			{TaskQueueName: taskQueue},
+			{Namespace: namespace},
+			{},
+		}
+	case PrecedenceShardID:
+		shardID := cs.ShardID
+		return []Constraints{{ShardID: shardID}, {}}
+	case PrecedenceTaskType:
+		taskType := cs.TaskType
+		return []Constraints{{TaskType: taskType}, {}}
+	case PrecedenceDestination:
+		namespace, destination := cs.Namespace, cs.Destination
+		return []Constraints{
+			{Namespace: namespace, Destination: destination},
+			{Destination: destination},
+			{Namespace: namespace},
+			{},
+		}
+	default:
+		return nil
+	}
+}
+
 type GlobalBoolSetting = GlobalTypedSetting[bool]
 
 func NewGlobalBoolSetting(key Key, def bool, description string) GlobalBoolSetting {
@@ -740,6 +801,14 @@ func (s GlobalTypedSetting[T]) Validate(v any) error {
 	return err
 }
 
+func (s GlobalTypedSetting[T]) explain(cvs []ConstrainedValue, cs Constraints) *Explanation {
+	return explain(s.key, PrecedenceGlobal, s.description, s.def, s.cdef, s.convert, cvs, cs)
+}
+
+func (s GlobalTypedSetting[T]) deviates(cv ConstrainedValue) bool {
+	return deviates(PrecedenceGlobal, s.def, s.cdef, s.convert, cv)
+}
+
 func (s GlobalTypedSetting[T]) WithDefault(v T) GlobalTypedSetting[T] {
 	newS := s
 	newS.def = v
@@ -834,6 +903,14 @@ func (s NamespaceTypedSetting[T]) Validate(v any) error {
 	return err
 }
 
+func (s NamespaceTypedSetting[T]) explain(cvs []ConstrainedValue, cs Constraints) *Explanation {
+	return explain(s.key, PrecedenceNamespace, s.description, s.def, s.cdef, s.convert, cvs, cs)
+}
+
+func (s NamespaceTypedSetting[T]) deviates(cv ConstrainedValue) bool {
+	return deviates(PrecedenceNamespace, s.def, s.cdef, s.convert, cv)
+}
+
 func (s NamespaceTypedSetting[T]) WithDefault(v T) NamespaceTypedSetting[T] {
 	newS := s
 	newS.def = v
@@ -928,6 +1005,14 @@ func (s NamespaceIDTypedSetting[T]) Validate(v any) error {
 	return err
 }
 
+func (s NamespaceIDTypedSetting[T]) explain(cvs []ConstrainedValue, cs Constraints) *Explanation {
+	return explain(s.key, PrecedenceNamespaceID, s.description, s.def, s.cdef, s.convert, cvs, cs)
+}
+
+func (s NamespaceIDTypedSetting[T]) deviates(cv ConstrainedValue) bool {
+	return deviates(PrecedenceNamespaceID, s.def, s.cdef, s.convert, cv)
+}
+
 func (s NamespaceIDTypedSetting[T]) WithDefault(v T) NamespaceIDTypedSetting[T] {
 	newS := s
 	newS.def = v
@@ -1022,6 +1107,14 @@ func (s TaskQueueTypedSetting[T]) Validate(v any) error {
 	return err
 }
 
+func (s TaskQueueTypedSetting[T]) explain(cvs []ConstrainedValue, cs Constraints) *Explanation {
+	return explain(s.key, PrecedenceTaskQueue, s.description, s.def, s.cdef, s.convert, cvs, cs)
+}
+
+func (s TaskQueueTypedSetting[T]) deviates(cv ConstrainedValue) bool {
+	return deviates(PrecedenceTaskQueue, s.def, s.cdef, s.convert, cv)
+}
+
 func (s TaskQueueTypedSetting[T]) WithDefault(v T) TaskQueueTypedSetting[T] {
 	newS := s
 	newS.def = v
@@ -1128,6 +1221,14 @@ func (s ShardIDTypedSetting[T]) Validate(v any) error {
 	return err
 }
 
+func (s ShardIDTypedSetting[T]) explain(cvs []ConstrainedValue, cs Constraints) *Explanation {
+	return explain(s.key, PrecedenceShardID, s.description, s.def, s.cdef, s.convert, cvs, cs)
+}
+
+func (s ShardIDTypedSetting[T]) deviates(cv ConstrainedValue) bool {
+	return deviates(PrecedenceShardID, s.def, s.cdef, s.convert, cv)
+}
+
 func (s ShardIDTypedSetting[T]) WithDefault(v T) ShardIDTypedSetting[T] {
 	newS := s
 	newS.def = v
@@ -1222,6 +1323,14 @@ func (s TaskTypeTypedSetting[T]) Validate(v any) error {
 	return err
 }
 
+func (s TaskTypeTypedSetting[T]) explain(cvs []ConstrainedValue, cs Constraints) *Explanation {
+	return explain(s.key, PrecedenceTaskType, s.description, s.def, s.cdef, s.convert, cvs, cs)
+}
+
+func (s TaskTypeTypedSetting[T]) deviates(cv ConstrainedValue) bool {
+	return deviates(PrecedenceTaskType, s.def, s.cdef, s.convert, cv)
+}
+
 func (s TaskTypeTypedSetting[T]) WithDefault(v T) TaskTypeTypedSetting[T] {
 	newS := s
 	newS.def = v
@@ -1316,6 +1425,14 @@ func (s DestinationTypedSetting[T]) Validate(v any) error {
 	return err
 }
 
+func (s DestinationTypedSetting[T]) explain(cvs []ConstrainedValue, cs Constraints) *Explanation {
+	return explain(s.key, PrecedenceDestination, s.description, s.def, s.cdef, s.convert, cvs, cs)
+}
+
+func (s DestinationTypedSetting[T]) deviates(cv ConstrainedValue) bool {
+	return deviates(PrecedenceDestination, s.def, s.cdef, s.convert, cv)
+}
+
 func (s DestinationTypedSetting[T]) WithDefault(v T) DestinationTypedSetting[T] {
 	newS := s
 	newS.def = v
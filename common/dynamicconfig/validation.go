@@ -0,0 +1,136 @@
+package dynamicconfig
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	enumspb "go.temporal.io/api/enums/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+)
+
+const (
+	// maxSuggestions is the number of registered keys suggested for an unregistered key.
+	maxSuggestions = 3
+)
+
+// ValidateConstrainedValues checks that key is a registered setting, that every value can be
+// converted to the type of the setting, and that the constraints of every value are checked by
+// the precedence of the setting.
+func ValidateConstrainedValues(key Key, cvs []ConstrainedValue) error {
+	setting := queryRegistry(key)
+	if setting == nil {
+		return unregisteredKeyError(key)
+	}
+	var errs []error
+	for i, cv := range cvs {
+		if err := setting.Validate(cv.Value); err != nil {
+			errs = append(errs, fmt.Errorf("invalid value %v: %w", cv.Value, err))
+		}
+		if !matchable(setting.Precedence(), cv.Constraints) {
+			errs = append(errs, fmt.Errorf("constraints %+v are not valid for key %q", cv.Constraints, key))
+		}
+		for _, prev := range cvs[:i] {
+			if prev.Constraints == cv.Constraints {
+				errs = append(errs, fmt.Errorf("duplicate constraints %+v", cv.Constraints))
+				break
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// matchable returns true if a value with constraints cs can be matched by a setting with
+// precedence p. Since settings are read with non-empty arguments, e.g. a namespace, the fields
+// that are not set in cs are filled with placeholders before checking whether cs is one of the
+// constraints checked by the precedence. E.g. a task queue name without a namespace is
+// matchable for PrecedenceTaskQueue, but a task queue name and type without a namespace is not.
+func matchable(p Precedence, cs Constraints) bool {
+	filled := cs
+	if filled.Namespace == "" {
+		filled.Namespace = "-"
+	}
+	if filled.NamespaceID == "" {
+		filled.NamespaceID = "-"
+	}
+	if filled.TaskQueueName == "" {
+		filled.TaskQueueName = "-"
+	}
+	if filled.TaskQueueType == enumspb.TASK_QUEUE_TYPE_UNSPECIFIED {
+		filled.TaskQueueType = enumspb.TASK_QUEUE_TYPE_WORKFLOW
+	}
+	if filled.ShardID == 0 {
+		filled.ShardID = 1
+	}
+	if filled.TaskType == enumsspb.TASK_TYPE_UNSPECIFIED {
+		filled.TaskType = enumsspb.TASK_TYPE_TRANSFER_ACTIVITY_TASK
+	}
+	if filled.Destination == "" {
+		filled.Destination = "-"
+	}
+	return slices.Contains(precedenceConstraints(p, filled), cs)
+}
+
+// unregisteredKeyError returns an error for an unregistered key that suggests registered keys
+// with similar names.
+func unregisteredKeyError(key Key) error {
+	suggestions := suggestKeys(key)
+	if len(suggestions) == 0 {
+		return fmt.Errorf("unregistered key %q", key)
+	}
+	quoted := make([]string, len(suggestions))
+	for i, s := range suggestions {
+		quoted[i] = fmt.Sprintf("%q", s)
+	}
+	return fmt.Errorf("unregistered key %q, did you mean %s?", key, strings.Join(quoted, " or "))
+}
+
+// suggestKeys returns the registered keys closest to key by edit distance, ignoring case. Keys
+// are only suggested if at most about a quarter of their characters differ.
+func suggestKeys(key Key) []Key {
+	type candidate struct {
+		key      Key
+		distance int
+	}
+	lower := strings.ToLower(key.String())
+	maxDistance := len(lower)/4 + 1
+	var candidates []candidate
+	for registered, setting := range globalRegistry.settings {
+		if d := editDistance(lower, registered); d <= maxDistance {
+			candidates = append(candidates, candidate{key: setting.Key(), distance: d})
+		}
+	}
+	slices.SortFunc(candidates, func(a, b candidate) int {
+		if a.distance != b.distance {
+			return a.distance - b.distance
+		}
+		return strings.Compare(a.key.String(), b.key.String())
+	})
+	var keys []Key
+	for i := 0; i < len(candidates) && i < maxSuggestions; i++ {
+		keys = append(keys, candidates[i].key)
+	}
+	return keys
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	cur := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		cur[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
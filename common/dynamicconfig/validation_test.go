@@ -0,0 +1,89 @@
+package dynamicconfig_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+)
+
+type validationSuite struct {
+	suite.Suite
+	*require.Assertions
+}
+
+func TestValidationSuite(t *testing.T) {
+	suite.Run(t, new(validationSuite))
+}
+
+func (s *validationSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	dynamicconfig.ResetRegistryForTest()
+}
+
+func (s *validationSuite) TestValid() {
+	dynamicconfig.NewTaskQueueIntSetting(testGetIntPropertyKey, 0, "")
+
+	err := dynamicconfig.ValidateConstrainedValues(testGetIntPropertyKey, []dynamicconfig.ConstrainedValue{
+		{Value: 1},
+		{Constraints: dynamicconfig.Constraints{Namespace: "ns"}, Value: 2},
+		{Constraints: dynamicconfig.Constraints{Namespace: "ns", TaskQueueName: "tq"}, Value: 3},
+		{Constraints: dynamicconfig.Constraints{TaskQueueName: "tq"}, Value: 4},
+		{Constraints: dynamicconfig.Constraints{Namespace: "ns", TaskQueueName: "tq", TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW}, Value: 5},
+	})
+	s.NoError(err)
+}
+
+func (s *validationSuite) TestUnregisteredKey() {
+	err := dynamicconfig.ValidateConstrainedValues(testGetIntPropertyKey, []dynamicconfig.ConstrainedValue{{Value: 1}})
+	s.ErrorContains(err, `unregistered key "testGetIntPropertyKey"`)
+}
+
+func (s *validationSuite) TestInvalidValue() {
+	dynamicconfig.NewGlobalIntSetting(testGetIntPropertyKey, 0, "")
+
+	err := dynamicconfig.ValidateConstrainedValues(testGetIntPropertyKey, []dynamicconfig.ConstrainedValue{{Value: "not a number"}})
+	s.ErrorContains(err, "invalid value not a number")
+}
+
+func (s *validationSuite) TestInvalidConstraints() {
+	dynamicconfig.NewNamespaceIntSetting(testGetIntPropertyKey, 0, "")
+
+	err := dynamicconfig.ValidateConstrainedValues(testGetIntPropertyKey, []dynamicconfig.ConstrainedValue{
+		{Constraints: dynamicconfig.Constraints{Namespace: "ns", ShardID: 1}, Value: 1},
+	})
+	s.ErrorContains(err, `are not valid for key "testGetIntPropertyKey"`)
+}
+
+func (s *validationSuite) TestUnmatchableConstraints() {
+	dynamicconfig.NewTaskQueueIntSetting(testGetIntPropertyKey, 0, "")
+
+	// settings are read with a namespace, so a task queue type without a namespace never matches
+	err := dynamicconfig.ValidateConstrainedValues(testGetIntPropertyKey, []dynamicconfig.ConstrainedValue{
+		{Constraints: dynamicconfig.Constraints{TaskQueueName: "tq", TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW}, Value: 1},
+	})
+	s.ErrorContains(err, `are not valid for key "testGetIntPropertyKey"`)
+}
+
+func (s *validationSuite) TestSuggestKeys() {
+	dynamicconfig.NewGlobalIntSetting(testGetIntPropertyKey, 0, "")
+	dynamicconfig.NewGlobalIntSetting(testGetFloat64PropertyKey, 0, "")
+
+	err := dynamicconfig.ValidateConstrainedValues("testGetIntPropertKey", []dynamicconfig.ConstrainedValue{{Value: 1}})
+	s.EqualError(err, `unregistered key "testGetIntPropertKey", did you mean "testGetIntPropertyKey"?`)
+
+	err = dynamicconfig.ValidateConstrainedValues("somethingElse", []dynamicconfig.ConstrainedValue{{Value: 1}})
+	s.EqualError(err, `unregistered key "somethingElse"`)
+}
+
+func (s *validationSuite) TestDuplicateConstraints() {
+	dynamicconfig.NewNamespaceIntSetting(testGetIntPropertyKey, 0, "")
+
+	err := dynamicconfig.ValidateConstrainedValues(testGetIntPropertyKey, []dynamicconfig.ConstrainedValue{
+		{Constraints: dynamicconfig.Constraints{Namespace: "ns"}, Value: 1},
+		{Constraints: dynamicconfig.Constraints{Namespace: "ns"}, Value: 2},
+	})
+	s.ErrorContains(err, "duplicate constraints")
+}
@@ -12,12 +12,13 @@ var (
 	WorkflowActionWorkflowContinueAsNew = workflowAction("add-workflow-continue-as-new-event")
 
 	// workflow cancellation / sign / update-options
-	WorkflowActionWorkflowCancelRequested        = workflowAction("add-workflow-cancel-requested-event")
-	WorkflowActionWorkflowSignaled               = workflowAction("add-workflow-signaled-event")
-	WorkflowActionWorkflowRecordMarker           = workflowAction("add-workflow-marker-record-event")
-	WorkflowActionUpsertWorkflowSearchAttributes = workflowAction("add-workflow-upsert-search-attributes-event")
-	WorkflowActionWorkflowPropertiesModified     = workflowAction("add-workflow-properties-modified-event")
-	WorkflowActionWorkflowOptionsUpdated         = workflowAction("add-workflow-options-updated-event")
+	WorkflowActionWorkflowCancelRequested              = workflowAction("add-workflow-cancel-requested-event")
+	WorkflowActionWorkflowSignaled                     = workflowAction("add-workflow-signaled-event")
+	WorkflowActionWorkflowRecordMarker                 = workflowAction("add-workflow-marker-record-event")
+	WorkflowActionUpsertWorkflowSearchAttributes       = workflowAction("add-workflow-upsert-search-attributes-event")
+	WorkflowActionWorkflowPropertiesModified           = workflowAction("add-workflow-properties-modified-event")
+	WorkflowActionWorkflowPropertiesModifiedExternally = workflowAction("add-workflow-properties-modified-externally-event")
+	WorkflowActionWorkflowOptionsUpdated               = workflowAction("add-workflow-options-updated-event")
 
 	// workflow update
 	WorkflowActionUpdateAccepted  = workflowAction("add-workflow-update-accepted-event")
@@ -46,6 +47,8 @@ var (
 	WorkflowActionActivityTaskCancelFailed    = workflowAction("add-activitytask-cancel-failed-event")
 	WorkflowActionActivityTaskRetry           = workflowAction("add-activitytask-retry-event")
 
+	WorkflowActionActivityPropertiesModifiedExternally = workflowAction("add-activity-properties-modified-externally-event")
+
 	// timer
 	WorkflowActionTimerStarted      = workflowAction("add-timer-started-event")
 	WorkflowActionTimerFired        = workflowAction("add-timer-fired-event")
@@ -27,6 +27,7 @@ const (
 	resourceExhaustedScopeTag   = "resource_exhausted_scope"
 	PartitionTagName            = "partition"
 	PriorityTagName             = "priority"
+	compressionTagName          = "compression"
 )
 
 // This package should hold all the metrics and tags for temporal
@@ -567,8 +568,10 @@ const (
 
 // Schedule action types
 const (
-	ScheduleActionTypeTag       = "schedule_action"
-	ScheduleActionStartWorkflow = "start_workflow"
+	ScheduleActionTypeTag        = "schedule_action"
+	ScheduleActionStartWorkflow  = "start_workflow"
+	ScheduleActionSignalWorkflow = "signal_workflow"
+	ScheduleActionNexusOperation = "nexus_operation"
 )
 
 var (
@@ -1177,6 +1180,14 @@ var (
 		"schedule_action_dropped",
 		WithDescription("The number of schedule actions that failed to start"),
 	)
+	ScheduleMigrations = NewCounterDef(
+		"schedule_migrations",
+		WithDescription("The number of schedules migrated between the scheduler workflow and the state machine scheduler"),
+	)
+	ScheduleMigrationVerificationFailures = NewCounterDef(
+		"schedule_migration_verification_failures",
+		WithDescription("The number of migrated schedules whose next action times didn't match the original schedule's"),
+	)
 
 	// Force replication
 	EncounterZombieWorkflowCount        = NewCounterDef("encounter_zombie_workflow_count")
@@ -1208,6 +1219,14 @@ var (
 		"persistence_latency",
 		WithDescription("Persistence latency, keyed by `operation`"),
 	)
+	PersistenceUncompressedBlobSize = NewBytesHistogramDef(
+		"persistence_uncompressed_blob_size",
+		WithDescription("Size of history and mutable state blobs before compression, keyed by `compression`"),
+	)
+	PersistenceCompressedBlobSize = NewBytesHistogramDef(
+		"persistence_compressed_blob_size",
+		WithDescription("Size of history and mutable state blobs after compression, keyed by `compression`. The compression ratio is the ratio of its sum to the sum of persistence_uncompressed_blob_size"),
+	)
 	PersistenceShardRPS                    = NewDimensionlessHistogramDef("persistence_shard_rps")
 	PersistenceErrResourceExhaustedCounter = NewCounterDef("persistence_errors_resource_exhausted")
 	VisibilityPersistenceRequests          = NewCounterDef("visibility_persistence_requests")
@@ -340,6 +340,10 @@ func ActionType(value string) Tag {
 	return &tagImpl{key: actionType, value: value}
 }
 
+func CompressionTag(value string) Tag {
+	return &tagImpl{key: compressionTagName, value: value}
+}
+
 func OperationTag(value string) Tag {
 	return &tagImpl{key: OperationTagName, value: value}
 }
@@ -11,6 +11,7 @@ import (
 	rulespb "go.temporal.io/api/rules/v1"
 	"go.temporal.io/api/serviceerror"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/util"
 	expmaps "golang.org/x/exp/maps"
@@ -292,6 +293,13 @@ func (ns *Namespace) GetWorkflowRule(ruleID string) (*rulespb.WorkflowRule, bool
 	return result, ok
 }
 
+// GetScheduleExclusionCalendar returns the named exclusion calendar that schedules in this
+// namespace can reference.
+func (ns *Namespace) GetScheduleExclusionCalendar(name string) (*schedulespb.ExclusionCalendar, bool) {
+	result, ok := ns.config.GetScheduleExclusionCalendars()[name]
+	return result, ok
+}
+
 // Error returns the reason associated with this bad binary.
 func (e BadBinaryError) Error() string {
 	return e.info.Reason
@@ -8,9 +8,11 @@ import (
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/cassandra"
+	"go.temporal.io/server/common/persistence/encryption"
 	"go.temporal.io/server/common/persistence/faultinjection"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/sql"
@@ -186,6 +188,14 @@ func DataStoreFactoryProvider(
 		dataStoreFactory = telemetry.NewTelemetryDataStoreFactory(dataStoreFactory, logger, tracer)
 	}
 
+	if cfg.Encryption != nil {
+		encryptor, err := serialization.NewLocalKeyEncryptor(cfg.Encryption.ActiveKeyID, cfg.Encryption.KeyFiles)
+		if err != nil {
+			logger.Fatal("invalid config: unable to load persistence encryption keys", tag.Error(err))
+		}
+		dataStoreFactory = encryption.NewDataStoreFactory(dataStoreFactory, encryptor)
+	}
+
 	return dataStoreFactory
 }
 
@@ -744,6 +744,8 @@ type (
 	AppendHistoryNodesRequest struct {
 		// The shard to get history node data
 		ShardID int32
+		// The namespace of the branch, which decides how the events are compressed
+		NamespaceID string
 		// true if this is the first append request to the branch
 		IsNewBranch bool
 		// the info for clean up data in background
@@ -768,6 +770,8 @@ type (
 	AppendRawHistoryNodesRequest struct {
 		// The shard to get history node data
 		ShardID int32
+		// The namespace of the branch, which decides how the events are compressed
+		NamespaceID string
 		// true if this is the first append request to the branch
 		IsNewBranch bool
 		// the info for clean up data in background
@@ -0,0 +1,40 @@
+package encryption
+
+import (
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+type (
+	// DataStoreFactory wraps a persistence.DataStoreFactory to encrypt history events and
+	// mutable state before they are written to the execution store, and decrypt them after
+	// they are read. Other stores are not wrapped.
+	DataStoreFactory struct {
+		persistence.DataStoreFactory
+		encryptor serialization.Encryptor
+
+		executionStore persistence.ExecutionStore
+	}
+)
+
+// NewDataStoreFactory returns a DataStoreFactory that encrypts data with encryptor.
+func NewDataStoreFactory(
+	baseFactory persistence.DataStoreFactory,
+	encryptor serialization.Encryptor,
+) *DataStoreFactory {
+	return &DataStoreFactory{
+		DataStoreFactory: baseFactory,
+		encryptor:        encryptor,
+	}
+}
+
+func (d *DataStoreFactory) NewExecutionStore() (persistence.ExecutionStore, error) {
+	if d.executionStore == nil {
+		baseStore, err := d.DataStoreFactory.NewExecutionStore()
+		if err != nil {
+			return nil, err
+		}
+		d.executionStore = newExecutionStore(baseStore, d.encryptor)
+	}
+	return d.executionStore, nil
+}
@@ -0,0 +1,671 @@
+package encryption
+
+import (
+	"context"
+	"errors"
+	"math"
+
+	commonpb "go.temporal.io/api/common/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
+)
+
+type (
+	// HistoryReencrypter is implemented by execution stores that encrypt history events.
+	HistoryReencrypter interface {
+		// ReencryptHistoryBranch rewrites the history nodes of a branch that are not encrypted
+		// with the active key, and returns the number of nodes rewritten. Nodes inherited from
+		// ancestor branches are not rewritten, they are re-encrypted with the ancestor branch.
+		ReencryptHistoryBranch(
+			ctx context.Context,
+			shardID int32,
+			branchToken []byte,
+			branchInfo *persistencespb.HistoryBranch,
+		) (int, error)
+	}
+
+	// MutableStateReencrypter is implemented by execution stores that encrypt mutable state.
+	MutableStateReencrypter interface {
+		// ReencryptMutableState rewrites the mutable state of an execution if any of its records
+		// is not encrypted with the active key, and returns whether it was rewritten. The write
+		// is conditional on the mutable state being unchanged since it was read, and on rangeID
+		// being the shard's range ID. Executions with buffered events are not rewritten, as the
+		// rewrite would drop the events, nor are records without a DB record version, which can't
+		// be updated conditionally.
+		ReencryptMutableState(
+			ctx context.Context,
+			shardID int32,
+			rangeID int64,
+			namespaceID string,
+			workflowID string,
+			runID string,
+		) (bool, error)
+	}
+
+	// Reencrypter is implemented by execution stores that encrypt data at rest.
+	Reencrypter interface {
+		HistoryReencrypter
+		MutableStateReencrypter
+		// ActiveKeyID returns the ID of the key data is re-encrypted with, or an empty string
+		// if data is re-encrypted without encryption.
+		ActiveKeyID() string
+	}
+
+	// executionStore encrypts the history events and the mutable state blobs that contain
+	// payloads. The execution state and the tasks are not encrypted because they don't contain
+	// payloads and are read by the stores themselves.
+	executionStore struct {
+		persistence.ExecutionStore
+		encryptor serialization.Encryptor
+	}
+)
+
+const reencryptHistoryBranchPageSize = 100
+
+var _ Reencrypter = (*executionStore)(nil)
+
+func newExecutionStore(
+	baseStore persistence.ExecutionStore,
+	encryptor serialization.Encryptor,
+) *executionStore {
+	return &executionStore{
+		ExecutionStore: baseStore,
+		encryptor:      encryptor,
+	}
+}
+
+func (s *executionStore) CreateWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalCreateWorkflowExecutionRequest,
+) (*persistence.InternalCreateWorkflowExecutionResponse, error) {
+	encrypted := *request
+	var err error
+	if encrypted.NewWorkflowSnapshot, err = s.encryptSnapshot(request.NewWorkflowSnapshot); err != nil {
+		return nil, err
+	}
+	if encrypted.NewWorkflowNewEvents, err = s.encryptHistoryNodes(request.NewWorkflowNewEvents); err != nil {
+		return nil, err
+	}
+	return s.ExecutionStore.CreateWorkflowExecution(ctx, &encrypted)
+}
+
+func (s *executionStore) UpdateWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalUpdateWorkflowExecutionRequest,
+) error {
+	encrypted := *request
+	var err error
+	if encrypted.UpdateWorkflowMutation, err = s.encryptMutation(request.UpdateWorkflowMutation); err != nil {
+		return err
+	}
+	if encrypted.UpdateWorkflowNewEvents, err = s.encryptHistoryNodes(request.UpdateWorkflowNewEvents); err != nil {
+		return err
+	}
+	if request.NewWorkflowSnapshot != nil {
+		snapshot, err := s.encryptSnapshot(*request.NewWorkflowSnapshot)
+		if err != nil {
+			return err
+		}
+		encrypted.NewWorkflowSnapshot = &snapshot
+	}
+	if encrypted.NewWorkflowNewEvents, err = s.encryptHistoryNodes(request.NewWorkflowNewEvents); err != nil {
+		return err
+	}
+	return s.ExecutionStore.UpdateWorkflowExecution(ctx, &encrypted)
+}
+
+func (s *executionStore) ConflictResolveWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalConflictResolveWorkflowExecutionRequest,
+) error {
+	encrypted := *request
+	var err error
+	if encrypted.ResetWorkflowSnapshot, err = s.encryptSnapshot(request.ResetWorkflowSnapshot); err != nil {
+		return err
+	}
+	if encrypted.ResetWorkflowEventsNewEvents, err = s.encryptHistoryNodes(request.ResetWorkflowEventsNewEvents); err != nil {
+		return err
+	}
+	if request.NewWorkflowSnapshot != nil {
+		snapshot, err := s.encryptSnapshot(*request.NewWorkflowSnapshot)
+		if err != nil {
+			return err
+		}
+		encrypted.NewWorkflowSnapshot = &snapshot
+	}
+	if encrypted.NewWorkflowEventsNewEvents, err = s.encryptHistoryNodes(request.NewWorkflowEventsNewEvents); err != nil {
+		return err
+	}
+	if request.CurrentWorkflowMutation != nil {
+		mutation, err := s.encryptMutation(*request.CurrentWorkflowMutation)
+		if err != nil {
+			return err
+		}
+		encrypted.CurrentWorkflowMutation = &mutation
+	}
+	if encrypted.CurrentWorkflowEventsNewEvents, err = s.encryptHistoryNodes(request.CurrentWorkflowEventsNewEvents); err != nil {
+		return err
+	}
+	return s.ExecutionStore.ConflictResolveWorkflowExecution(ctx, &encrypted)
+}
+
+func (s *executionStore) SetWorkflowExecution(
+	ctx context.Context,
+	request *persistence.InternalSetWorkflowExecutionRequest,
+) error {
+	encrypted := *request
+	var err error
+	if encrypted.SetWorkflowSnapshot, err = s.encryptSnapshot(request.SetWorkflowSnapshot); err != nil {
+		return err
+	}
+	return s.ExecutionStore.SetWorkflowExecution(ctx, &encrypted)
+}
+
+func (s *executionStore) GetWorkflowExecution(
+	ctx context.Context,
+	request *persistence.GetWorkflowExecutionRequest,
+) (*persistence.InternalGetWorkflowExecutionResponse, error) {
+	response, err := s.ExecutionStore.GetWorkflowExecution(ctx, request)
+	if response == nil {
+		return nil, err
+	}
+	if decryptErr := s.decryptExecutionInfo(response.State); decryptErr != nil {
+		return nil, decryptErr
+	}
+	// return the records that can be decrypted along with the error, like the stores do for
+	// records they can't read, so that RebuildMutableState can still make use of them
+	if decryptErr := s.decryptRecords(response.State); decryptErr != nil && err == nil {
+		err = decryptErr
+	}
+	return response, err
+}
+
+func (s *executionStore) ListConcreteExecutions(
+	ctx context.Context,
+	request *persistence.ListConcreteExecutionsRequest,
+) (*persistence.InternalListConcreteExecutionsResponse, error) {
+	response, err := s.ExecutionStore.ListConcreteExecutions(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	for _, state := range response.States {
+		if err := s.decryptMutableState(state); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+func (s *executionStore) AppendHistoryNodes(
+	ctx context.Context,
+	request *persistence.InternalAppendHistoryNodesRequest,
+) error {
+	encrypted, err := s.encryptHistoryNode(request)
+	if err != nil {
+		return err
+	}
+	return s.ExecutionStore.AppendHistoryNodes(ctx, encrypted)
+}
+
+func (s *executionStore) ReadHistoryBranch(
+	ctx context.Context,
+	request *persistence.InternalReadHistoryBranchRequest,
+) (*persistence.InternalReadHistoryBranchResponse, error) {
+	response, err := s.ExecutionStore.ReadHistoryBranch(ctx, request)
+	if err != nil {
+		return nil, err
+	}
+	for i := range response.Nodes {
+		if response.Nodes[i].Events, err = s.encryptor.Decrypt(response.Nodes[i].Events); err != nil {
+			return nil, err
+		}
+	}
+	return response, nil
+}
+
+func (s *executionStore) ReencryptHistoryBranch(
+	ctx context.Context,
+	shardID int32,
+	branchToken []byte,
+	branchInfo *persistencespb.HistoryBranch,
+) (int, error) {
+	var rewritten []persistence.InternalHistoryNode
+	request := &persistence.InternalReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		BranchID:    branchInfo.GetBranchId(),
+		MinNodeID:   persistence.GetBeginNodeID(branchInfo),
+		MaxNodeID:   math.MaxInt64,
+		PageSize:    reencryptHistoryBranchPageSize,
+		ShardID:     shardID,
+	}
+	for {
+		response, err := s.ExecutionStore.ReadHistoryBranch(ctx, request)
+		if err != nil {
+			return len(rewritten), err
+		}
+		for _, node := range response.Nodes {
+			if !s.needsReencryption(node.Events) {
+				continue
+			}
+			if node.Events, err = s.encryptor.Decrypt(node.Events); err != nil {
+				return len(rewritten), err
+			}
+			if node.Events, err = s.encryptor.Encrypt(node.Events); err != nil {
+				return len(rewritten), err
+			}
+			if err := s.ExecutionStore.AppendHistoryNodes(ctx, &persistence.InternalAppendHistoryNodesRequest{
+				BranchToken: branchToken,
+				BranchInfo:  branchInfo,
+				Node:        node,
+				ShardID:     shardID,
+			}); err != nil {
+				return len(rewritten), err
+			}
+			rewritten = append(rewritten, node)
+		}
+		if len(response.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = response.NextPageToken
+	}
+	if len(rewritten) == 0 {
+		return 0, nil
+	}
+
+	// The branch may have been deleted while its nodes were rewritten, in which case the
+	// rewritten nodes would never be deleted.
+	exists, err := s.historyBranchExists(ctx, shardID, branchToken, branchInfo)
+	if err != nil || exists {
+		return len(rewritten), err
+	}
+	for _, node := range rewritten {
+		if err := s.ExecutionStore.DeleteHistoryNodes(ctx, &persistence.InternalDeleteHistoryNodesRequest{
+			BranchToken:   branchToken,
+			ShardID:       shardID,
+			BranchInfo:    branchInfo,
+			NodeID:        node.NodeID,
+			TransactionID: node.TransactionID,
+		}); err != nil {
+			return len(rewritten), err
+		}
+	}
+	return len(rewritten), nil
+}
+
+func (s *executionStore) ReencryptMutableState(
+	ctx context.Context,
+	shardID int32,
+	rangeID int64,
+	namespaceID string,
+	workflowID string,
+	runID string,
+) (bool, error) {
+	response, err := s.ExecutionStore.GetWorkflowExecution(ctx, &persistence.GetWorkflowExecutionRequest{
+		ShardID:     shardID,
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+		RunID:       runID,
+	})
+	if err != nil {
+		return false, err
+	}
+	state := response.State
+	if !s.mutableStateNeedsReencryption(state) || len(state.BufferedEvents) != 0 || state.DBRecordVersion == 0 {
+		return false, nil
+	}
+	if err := s.decryptMutableState(state); err != nil {
+		return false, err
+	}
+
+	snapshot, err := mutableStateSnapshot(namespaceID, workflowID, runID, state)
+	if err != nil {
+		return false, err
+	}
+	if err := s.SetWorkflowExecution(ctx, &persistence.InternalSetWorkflowExecutionRequest{
+		ShardID:             shardID,
+		RangeID:             rangeID,
+		SetWorkflowSnapshot: snapshot,
+	}); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (s *executionStore) ActiveKeyID() string {
+	return s.encryptor.ActiveKeyID()
+}
+
+func (s *executionStore) mutableStateNeedsReencryption(
+	state *persistence.InternalWorkflowMutableState,
+) bool {
+	if s.needsReencryption(state.ExecutionInfo) {
+		return true
+	}
+	for _, blobs := range []map[int64]*commonpb.DataBlob{
+		state.ActivityInfos,
+		state.ChildExecutionInfos,
+		state.RequestCancelInfos,
+		state.SignalInfos,
+	} {
+		for _, blob := range blobs {
+			if s.needsReencryption(blob) {
+				return true
+			}
+		}
+	}
+	for _, blob := range state.TimerInfos {
+		if s.needsReencryption(blob) {
+			return true
+		}
+	}
+	for _, node := range state.ChasmNodes {
+		if s.needsReencryption(node.Data) || s.needsReencryption(node.CassandraBlob) {
+			return true
+		}
+	}
+	return false
+}
+
+// mutableStateSnapshot returns a snapshot that writes state back as it is, conditional on the
+// DB record version of state.
+func mutableStateSnapshot(
+	namespaceID string,
+	workflowID string,
+	runID string,
+	state *persistence.InternalWorkflowMutableState,
+) (persistence.InternalWorkflowSnapshot, error) {
+	executionInfo, err := serialization.WorkflowExecutionInfoFromBlob(state.ExecutionInfo.GetData(), state.ExecutionInfo.GetEncodingType().String())
+	if err != nil {
+		return persistence.InternalWorkflowSnapshot{}, err
+	}
+	executionState, err := serialization.WorkflowExecutionStateFromBlob(state.ExecutionState.GetData(), state.ExecutionState.GetEncodingType().String())
+	if err != nil {
+		return persistence.InternalWorkflowSnapshot{}, err
+	}
+	// same as the execution manager's
+	lastWriteVersion := common.EmptyVersion
+	if executionInfo.GetVersionHistories() != nil {
+		versionHistory, err := versionhistory.GetCurrentVersionHistory(executionInfo.GetVersionHistories())
+		if err != nil {
+			return persistence.InternalWorkflowSnapshot{}, err
+		}
+		lastItem, err := versionhistory.GetLastVersionHistoryItem(versionHistory)
+		if err != nil {
+			return persistence.InternalWorkflowSnapshot{}, err
+		}
+		lastWriteVersion = lastItem.GetVersion()
+	}
+
+	signalRequestedIDs := make(map[string]struct{}, len(state.SignalRequestedIDs))
+	for _, id := range state.SignalRequestedIDs {
+		signalRequestedIDs[id] = struct{}{}
+	}
+	return persistence.InternalWorkflowSnapshot{
+		NamespaceID: namespaceID,
+		WorkflowID:  workflowID,
+		RunID:       runID,
+
+		ExecutionInfo:      executionInfo,
+		ExecutionInfoBlob:  state.ExecutionInfo,
+		ExecutionState:     executionState,
+		ExecutionStateBlob: state.ExecutionState,
+		LastWriteVersion:   lastWriteVersion,
+		NextEventID:        state.NextEventID,
+		DBRecordVersion:    state.DBRecordVersion + 1,
+
+		ActivityInfos:       state.ActivityInfos,
+		TimerInfos:          state.TimerInfos,
+		ChildExecutionInfos: state.ChildExecutionInfos,
+		RequestCancelInfos:  state.RequestCancelInfos,
+		SignalInfos:         state.SignalInfos,
+		ChasmNodes:          state.ChasmNodes,
+		SignalRequestedIDs:  signalRequestedIDs,
+
+		Condition: state.NextEventID,
+
+		Checksum: state.Checksum,
+	}, nil
+}
+
+func (s *executionStore) needsReencryption(blob *commonpb.DataBlob) bool {
+	if len(blob.GetData()) == 0 {
+		return false
+	}
+	keyID, encrypted := serialization.EncryptionKeyID(blob)
+	if !encrypted {
+		return s.encryptor.ActiveKeyID() != ""
+	}
+	return keyID != s.encryptor.ActiveKeyID()
+}
+
+func (s *executionStore) historyBranchExists(
+	ctx context.Context,
+	shardID int32,
+	branchToken []byte,
+	branchInfo *persistencespb.HistoryBranch,
+) (bool, error) {
+	response, err := s.ExecutionStore.GetHistoryTreeContainingBranch(ctx, &persistence.InternalGetHistoryTreeContainingBranchRequest{
+		BranchToken: branchToken,
+		ShardID:     shardID,
+	})
+	if err != nil {
+		return false, err
+	}
+	serializer := serialization.NewSerializer()
+	for _, blob := range response.TreeInfos {
+		treeInfo, err := serializer.HistoryTreeInfoFromBlob(blob)
+		if err != nil {
+			return false, err
+		}
+		if treeInfo.GetBranchInfo().GetBranchId() == branchInfo.GetBranchId() {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *executionStore) encryptSnapshot(
+	snapshot persistence.InternalWorkflowSnapshot,
+) (persistence.InternalWorkflowSnapshot, error) {
+	var err error
+	if snapshot.ExecutionInfoBlob, err = s.encryptor.Encrypt(snapshot.ExecutionInfoBlob); err != nil {
+		return snapshot, err
+	}
+	if snapshot.ActivityInfos, err = encryptBlobs(s.encryptor, snapshot.ActivityInfos); err != nil {
+		return snapshot, err
+	}
+	if snapshot.TimerInfos, err = encryptBlobs(s.encryptor, snapshot.TimerInfos); err != nil {
+		return snapshot, err
+	}
+	if snapshot.ChildExecutionInfos, err = encryptBlobs(s.encryptor, snapshot.ChildExecutionInfos); err != nil {
+		return snapshot, err
+	}
+	if snapshot.RequestCancelInfos, err = encryptBlobs(s.encryptor, snapshot.RequestCancelInfos); err != nil {
+		return snapshot, err
+	}
+	if snapshot.SignalInfos, err = encryptBlobs(s.encryptor, snapshot.SignalInfos); err != nil {
+		return snapshot, err
+	}
+	if snapshot.ChasmNodes, err = s.encryptChasmNodes(snapshot.ChasmNodes); err != nil {
+		return snapshot, err
+	}
+	return snapshot, nil
+}
+
+func (s *executionStore) encryptMutation(
+	mutation persistence.InternalWorkflowMutation,
+) (persistence.InternalWorkflowMutation, error) {
+	var err error
+	if mutation.ExecutionInfoBlob, err = s.encryptor.Encrypt(mutation.ExecutionInfoBlob); err != nil {
+		return mutation, err
+	}
+	if mutation.UpsertActivityInfos, err = encryptBlobs(s.encryptor, mutation.UpsertActivityInfos); err != nil {
+		return mutation, err
+	}
+	if mutation.UpsertTimerInfos, err = encryptBlobs(s.encryptor, mutation.UpsertTimerInfos); err != nil {
+		return mutation, err
+	}
+	if mutation.UpsertChildExecutionInfos, err = encryptBlobs(s.encryptor, mutation.UpsertChildExecutionInfos); err != nil {
+		return mutation, err
+	}
+	if mutation.UpsertRequestCancelInfos, err = encryptBlobs(s.encryptor, mutation.UpsertRequestCancelInfos); err != nil {
+		return mutation, err
+	}
+	if mutation.UpsertSignalInfos, err = encryptBlobs(s.encryptor, mutation.UpsertSignalInfos); err != nil {
+		return mutation, err
+	}
+	if mutation.UpsertChasmNodes, err = s.encryptChasmNodes(mutation.UpsertChasmNodes); err != nil {
+		return mutation, err
+	}
+	if mutation.NewBufferedEvents, err = s.encryptor.Encrypt(mutation.NewBufferedEvents); err != nil {
+		return mutation, err
+	}
+	return mutation, nil
+}
+
+func (s *executionStore) encryptChasmNodes(
+	nodes map[string]persistence.InternalChasmNode,
+) (map[string]persistence.InternalChasmNode, error) {
+	if nodes == nil {
+		return nil, nil
+	}
+	encrypted := make(map[string]persistence.InternalChasmNode, len(nodes))
+	for path, node := range nodes {
+		var err error
+		if node.Data, err = s.encryptor.Encrypt(node.Data); err != nil {
+			return nil, err
+		}
+		if node.CassandraBlob, err = s.encryptor.Encrypt(node.CassandraBlob); err != nil {
+			return nil, err
+		}
+		encrypted[path] = node
+	}
+	return encrypted, nil
+}
+
+func (s *executionStore) encryptHistoryNodes(
+	requests []*persistence.InternalAppendHistoryNodesRequest,
+) ([]*persistence.InternalAppendHistoryNodesRequest, error) {
+	if requests == nil {
+		return nil, nil
+	}
+	encrypted := make([]*persistence.InternalAppendHistoryNodesRequest, len(requests))
+	for i, request := range requests {
+		var err error
+		if encrypted[i], err = s.encryptHistoryNode(request); err != nil {
+			return nil, err
+		}
+	}
+	return encrypted, nil
+}
+
+func (s *executionStore) encryptHistoryNode(
+	request *persistence.InternalAppendHistoryNodesRequest,
+) (*persistence.InternalAppendHistoryNodesRequest, error) {
+	encrypted := *request
+	var err error
+	if encrypted.Node.Events, err = s.encryptor.Encrypt(request.Node.Events); err != nil {
+		return nil, err
+	}
+	return &encrypted, nil
+}
+
+func (s *executionStore) decryptMutableState(
+	state *persistence.InternalWorkflowMutableState,
+) error {
+	if err := s.decryptExecutionInfo(state); err != nil {
+		return err
+	}
+	return s.decryptRecords(state)
+}
+
+func (s *executionStore) decryptExecutionInfo(
+	state *persistence.InternalWorkflowMutableState,
+) error {
+	if state == nil {
+		return nil
+	}
+	executionInfo, err := s.encryptor.Decrypt(state.ExecutionInfo)
+	if err != nil {
+		return err
+	}
+	state.ExecutionInfo = executionInfo
+	return nil
+}
+
+// decryptRecords decrypts the records of state other than the execution info. Records that
+// can't be decrypted are dropped from state, and their errors are returned.
+func (s *executionStore) decryptRecords(
+	state *persistence.InternalWorkflowMutableState,
+) error {
+	if state == nil {
+		return nil
+	}
+	errs := []error{
+		decryptBlobs(s.encryptor, state.ActivityInfos),
+		decryptBlobs(s.encryptor, state.TimerInfos),
+		decryptBlobs(s.encryptor, state.ChildExecutionInfos),
+		decryptBlobs(s.encryptor, state.RequestCancelInfos),
+		decryptBlobs(s.encryptor, state.SignalInfos),
+	}
+	for path, node := range state.ChasmNodes {
+		var dataErr, blobErr error
+		node.Data, dataErr = s.encryptor.Decrypt(node.Data)
+		node.CassandraBlob, blobErr = s.encryptor.Decrypt(node.CassandraBlob)
+		if dataErr != nil || blobErr != nil {
+			errs = append(errs, dataErr, blobErr)
+			delete(state.ChasmNodes, path)
+			continue
+		}
+		state.ChasmNodes[path] = node
+	}
+	bufferedEvents := state.BufferedEvents[:0]
+	for _, blob := range state.BufferedEvents {
+		decrypted, err := s.encryptor.Decrypt(blob)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		bufferedEvents = append(bufferedEvents, decrypted)
+	}
+	state.BufferedEvents = bufferedEvents
+	return errors.Join(errs...)
+}
+
+func encryptBlobs[K comparable](
+	encryptor serialization.Encryptor,
+	blobs map[K]*commonpb.DataBlob,
+) (map[K]*commonpb.DataBlob, error) {
+	if blobs == nil {
+		return nil, nil
+	}
+	encrypted := make(map[K]*commonpb.DataBlob, len(blobs))
+	for key, blob := range blobs {
+		var err error
+		if encrypted[key], err = encryptor.Encrypt(blob); err != nil {
+			return nil, err
+		}
+	}
+	return encrypted, nil
+}
+
+// decryptBlobs decrypts blobs in place, dropping the ones that can't be decrypted.
+func decryptBlobs[K comparable](
+	encryptor serialization.Encryptor,
+	blobs map[K]*commonpb.DataBlob,
+) error {
+	var errs []error
+	for key, blob := range blobs {
+		decrypted, err := encryptor.Decrypt(blob)
+		if err != nil {
+			errs = append(errs, err)
+			delete(blobs, key)
+			continue
+		}
+		blobs[key] = decrypted
+	}
+	return errors.Join(errs...)
+}
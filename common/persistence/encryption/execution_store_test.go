@@ -0,0 +1,281 @@
+package encryption
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/mock"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.uber.org/mock/gomock"
+)
+
+type (
+	executionStoreSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller *gomock.Controller
+		baseStore  *mock.MockExecutionStore
+		keys       map[string][]byte
+	}
+)
+
+func TestExecutionStoreSuite(t *testing.T) {
+	suite.Run(t, new(executionStoreSuite))
+}
+
+func (s *executionStoreSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.controller = gomock.NewController(s.T())
+	s.baseStore = mock.NewMockExecutionStore(s.controller)
+	s.keys = map[string][]byte{
+		"key-1": bytes.Repeat([]byte{1}, 32),
+		"key-2": bytes.Repeat([]byte{2}, 32),
+	}
+}
+
+func (s *executionStoreSuite) newStore(activeKeyID string) *executionStore {
+	encryptor, err := serialization.NewEncryptor(activeKeyID, s.keys)
+	s.NoError(err)
+	return newExecutionStore(s.baseStore, encryptor)
+}
+
+func (s *executionStoreSuite) TestCreateAndGetWorkflowExecution() {
+	store := s.newStore("key-1")
+	executionInfo := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("execution info")}
+	activityInfo := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("activity info")}
+	events := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("events")}
+	request := &persistence.InternalCreateWorkflowExecutionRequest{
+		NewWorkflowSnapshot: persistence.InternalWorkflowSnapshot{
+			ExecutionInfoBlob: executionInfo,
+			ActivityInfos:     map[int64]*commonpb.DataBlob{5: activityInfo},
+		},
+		NewWorkflowNewEvents: []*persistence.InternalAppendHistoryNodesRequest{
+			{Node: persistence.InternalHistoryNode{NodeID: 1, Events: events}},
+		},
+	}
+
+	var stored *persistence.InternalCreateWorkflowExecutionRequest
+	s.baseStore.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.InternalCreateWorkflowExecutionRequest) (*persistence.InternalCreateWorkflowExecutionResponse, error) {
+			stored = request
+			return &persistence.InternalCreateWorkflowExecutionResponse{}, nil
+		},
+	)
+	_, err := store.CreateWorkflowExecution(context.Background(), request)
+	s.NoError(err)
+
+	// the request is not modified
+	s.Equal(executionInfo, request.NewWorkflowSnapshot.ExecutionInfoBlob)
+	s.Equal(activityInfo, request.NewWorkflowSnapshot.ActivityInfos[5])
+	s.Equal(events, request.NewWorkflowNewEvents[0].Node.Events)
+
+	s.True(serialization.IsEncryptedBlob(stored.NewWorkflowSnapshot.ExecutionInfoBlob))
+	s.True(serialization.IsEncryptedBlob(stored.NewWorkflowSnapshot.ActivityInfos[5]))
+	s.True(serialization.IsEncryptedBlob(stored.NewWorkflowNewEvents[0].Node.Events))
+
+	s.baseStore.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.InternalGetWorkflowExecutionResponse{
+		State: &persistence.InternalWorkflowMutableState{
+			ExecutionInfo: stored.NewWorkflowSnapshot.ExecutionInfoBlob,
+			ActivityInfos: stored.NewWorkflowSnapshot.ActivityInfos,
+		},
+	}, nil)
+	response, err := store.GetWorkflowExecution(context.Background(), &persistence.GetWorkflowExecutionRequest{})
+	s.NoError(err)
+	s.Equal(executionInfo.Data, response.State.ExecutionInfo.Data)
+	s.Equal(activityInfo.Data, response.State.ActivityInfos[5].Data)
+}
+
+func (s *executionStoreSuite) TestGetWorkflowExecution_PartiallyDecrypted() {
+	writer := s.newStore("key-1")
+	encrypt := func(data string) *commonpb.DataBlob {
+		blob, err := writer.encryptor.Encrypt(&commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte(data)})
+		s.NoError(err)
+		return blob
+	}
+	executionInfo := encrypt("execution info")
+	activityInfo := encrypt("activity info")
+	timerInfo, err := s.newStore("key-2").encryptor.Encrypt(&commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("timer info")})
+	s.NoError(err)
+	// the key of the timer info is gone
+	delete(s.keys, "key-2")
+	store := s.newStore("key-1")
+
+	s.baseStore.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.InternalGetWorkflowExecutionResponse{
+		State: &persistence.InternalWorkflowMutableState{
+			ExecutionInfo: executionInfo,
+			ActivityInfos: map[int64]*commonpb.DataBlob{5: activityInfo},
+			TimerInfos:    map[string]*commonpb.DataBlob{"timer": timerInfo},
+		},
+	}, nil)
+	response, err := store.GetWorkflowExecution(context.Background(), &persistence.GetWorkflowExecutionRequest{})
+	s.Error(err)
+	s.NotNil(response)
+	s.Equal([]byte("execution info"), response.State.ExecutionInfo.Data)
+	s.Equal([]byte("activity info"), response.State.ActivityInfos[5].Data)
+	s.Empty(response.State.TimerInfos)
+}
+
+func (s *executionStoreSuite) TestReencryptHistoryBranch() {
+	oldStore := s.newStore("key-1")
+	store := s.newStore("key-2")
+	branchToken := []byte("branch token")
+	branchInfo := &persistencespb.HistoryBranch{TreeId: "tree", BranchId: "branch"}
+
+	oldEvents, err := oldStore.encryptor.Encrypt(&commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("old")})
+	s.NoError(err)
+	newEvents, err := store.encryptor.Encrypt(&commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("new")})
+	s.NoError(err)
+	plainEvents := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("plain")}
+
+	s.baseStore.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).Return(&persistence.InternalReadHistoryBranchResponse{
+		Nodes: []persistence.InternalHistoryNode{
+			{NodeID: 1, TransactionID: 1, Events: oldEvents},
+			{NodeID: 3, TransactionID: 2, Events: newEvents},
+			{NodeID: 5, TransactionID: 3, Events: plainEvents},
+		},
+	}, nil)
+	var appended []int64
+	s.baseStore.EXPECT().AppendHistoryNodes(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.InternalAppendHistoryNodesRequest) error {
+			keyID, ok := serialization.EncryptionKeyID(request.Node.Events)
+			s.True(ok)
+			s.Equal("key-2", keyID)
+			appended = append(appended, request.Node.NodeID)
+			return nil
+		},
+	).Times(2)
+	treeInfo, err := serialization.NewSerializer().HistoryTreeInfoToBlob(&persistencespb.HistoryTreeInfo{BranchInfo: branchInfo}, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	s.baseStore.EXPECT().GetHistoryTreeContainingBranch(gomock.Any(), gomock.Any()).Return(&persistence.InternalGetHistoryTreeContainingBranchResponse{
+		TreeInfos: []*commonpb.DataBlob{treeInfo},
+	}, nil)
+
+	count, err := store.ReencryptHistoryBranch(context.Background(), 1, branchToken, branchInfo)
+	s.NoError(err)
+	s.Equal(2, count)
+	s.Equal([]int64{1, 5}, appended)
+}
+
+func (s *executionStoreSuite) TestReencryptHistoryBranch_BranchDeleted() {
+	oldStore := s.newStore("key-1")
+	store := s.newStore("key-2")
+	branchInfo := &persistencespb.HistoryBranch{TreeId: "tree", BranchId: "branch"}
+
+	oldEvents, err := oldStore.encryptor.Encrypt(&commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("old")})
+	s.NoError(err)
+	s.baseStore.EXPECT().ReadHistoryBranch(gomock.Any(), gomock.Any()).Return(&persistence.InternalReadHistoryBranchResponse{
+		Nodes: []persistence.InternalHistoryNode{{NodeID: 1, TransactionID: 1, Events: oldEvents}},
+	}, nil)
+	s.baseStore.EXPECT().AppendHistoryNodes(gomock.Any(), gomock.Any()).Return(nil)
+	s.baseStore.EXPECT().GetHistoryTreeContainingBranch(gomock.Any(), gomock.Any()).Return(&persistence.InternalGetHistoryTreeContainingBranchResponse{}, nil)
+	s.baseStore.EXPECT().DeleteHistoryNodes(gomock.Any(), &persistence.InternalDeleteHistoryNodesRequest{
+		BranchToken:   []byte("branch token"),
+		ShardID:       1,
+		BranchInfo:    branchInfo,
+		NodeID:        1,
+		TransactionID: 1,
+	}).Return(nil)
+
+	count, err := store.ReencryptHistoryBranch(context.Background(), 1, []byte("branch token"), branchInfo)
+	s.NoError(err)
+	s.Equal(1, count)
+}
+
+func (s *executionStoreSuite) TestReencryptMutableState() {
+	oldStore := s.newStore("key-1")
+	store := s.newStore("key-2")
+	serializer := serialization.NewSerializer()
+	executionInfo, err := serializer.WorkflowExecutionInfoToBlob(&persistencespb.WorkflowExecutionInfo{
+		NamespaceId: "namespace",
+		WorkflowId:  "workflow",
+		VersionHistories: &historyspb.VersionHistories{
+			Histories: []*historyspb.VersionHistory{{Items: []*historyspb.VersionHistoryItem{{EventId: 10, Version: 7}}}},
+		},
+	}, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	executionState, err := serializer.WorkflowExecutionStateToBlob(&persistencespb.WorkflowExecutionState{
+		RunId:  "run",
+		State:  enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+	}, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	oldExecutionInfo, err := oldStore.encryptor.Encrypt(executionInfo)
+	s.NoError(err)
+	activityInfo := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("activity info")}
+	newActivityInfo, err := store.encryptor.Encrypt(activityInfo)
+	s.NoError(err)
+
+	s.baseStore.EXPECT().GetWorkflowExecution(gomock.Any(), &persistence.GetWorkflowExecutionRequest{
+		ShardID:     1,
+		NamespaceID: "namespace",
+		WorkflowID:  "workflow",
+		RunID:       "run",
+	}).Return(&persistence.InternalGetWorkflowExecutionResponse{
+		State: &persistence.InternalWorkflowMutableState{
+			ExecutionInfo:      oldExecutionInfo,
+			ExecutionState:     executionState,
+			ActivityInfos:      map[int64]*commonpb.DataBlob{5: newActivityInfo},
+			SignalRequestedIDs: []string{"signal"},
+			NextEventID:        11,
+			DBRecordVersion:    3,
+		},
+		DBRecordVersion: 3,
+	}, nil)
+	s.baseStore.EXPECT().SetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.InternalSetWorkflowExecutionRequest) error {
+			s.Equal(int32(1), request.ShardID)
+			s.Equal(int64(20), request.RangeID)
+			snapshot := request.SetWorkflowSnapshot
+			keyID, ok := serialization.EncryptionKeyID(snapshot.ExecutionInfoBlob)
+			s.True(ok)
+			s.Equal("key-2", keyID)
+			keyID, ok = serialization.EncryptionKeyID(snapshot.ActivityInfos[5])
+			s.True(ok)
+			s.Equal("key-2", keyID)
+			s.Equal("run", snapshot.ExecutionState.GetRunId())
+			s.Equal(int64(7), snapshot.LastWriteVersion)
+			s.Equal(int64(11), snapshot.Condition)
+			s.Equal(int64(4), snapshot.DBRecordVersion)
+			s.Equal(map[string]struct{}{"signal": {}}, snapshot.SignalRequestedIDs)
+			return nil
+		},
+	)
+
+	rewritten, err := store.ReencryptMutableState(context.Background(), 1, 20, "namespace", "workflow", "run")
+	s.NoError(err)
+	s.True(rewritten)
+}
+
+func (s *executionStoreSuite) TestReencryptMutableState_NotRewritten() {
+	oldStore := s.newStore("key-1")
+	store := s.newStore("key-2")
+	newExecutionInfo, err := store.encryptor.Encrypt(&commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("execution info")})
+	s.NoError(err)
+	oldExecutionInfo, err := oldStore.encryptor.Encrypt(&commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("execution info")})
+	s.NoError(err)
+
+	for name, state := range map[string]*persistence.InternalWorkflowMutableState{
+		"up to date": {ExecutionInfo: newExecutionInfo, DBRecordVersion: 3},
+		"buffered events": {
+			ExecutionInfo:   oldExecutionInfo,
+			BufferedEvents:  []*commonpb.DataBlob{{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("events")}},
+			DBRecordVersion: 3,
+		},
+		"no DB record version": {ExecutionInfo: oldExecutionInfo},
+	} {
+		s.baseStore.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(&persistence.InternalGetWorkflowExecutionResponse{State: state}, nil)
+		rewritten, err := store.ReencryptMutableState(context.Background(), 1, 20, "namespace", "workflow", "run")
+		s.NoError(err, name)
+		s.False(rewritten, name)
+	}
+}
@@ -17,6 +17,7 @@ import (
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/service/history/tasks"
@@ -31,6 +32,8 @@ type (
 		logger                log.Logger
 		pagingTokenSerializer *jsonHistoryTokenSerializer
 		transactionSizeLimit  dynamicconfig.IntPropertyFn
+		historyCompression    dynamicconfig.StringPropertyFnWithNamespaceIDFilter
+		metricsHandler        metrics.Handler
 	}
 )
 
@@ -43,7 +46,12 @@ func NewExecutionManager(
 	eventBlobCache XDCCache,
 	logger log.Logger,
 	transactionSizeLimit dynamicconfig.IntPropertyFn,
+	historyCompression dynamicconfig.StringPropertyFnWithNamespaceIDFilter,
+	metricsHandler metrics.Handler,
 ) ExecutionManager {
+	if metricsHandler == nil {
+		metricsHandler = metrics.NoopMetricsHandler
+	}
 	return &executionManagerImpl{
 		serializer:            serializer,
 		eventBlobCache:        eventBlobCache,
@@ -51,6 +59,8 @@ func NewExecutionManager(
 		logger:                logger,
 		pagingTokenSerializer: newJSONHistoryTokenSerializer(),
 		transactionSizeLimit:  transactionSizeLimit,
+		historyCompression:    historyCompression,
+		metricsHandler:        metricsHandler,
 	}
 }
 
@@ -110,7 +120,11 @@ func (m *executionManagerImpl) CreateWorkflowExecution(
 		NewWorkflowNewEvents:     newWorkflowNewEvents,
 	}
 
-	if _, err := m.persistence.CreateWorkflowExecution(ctx, newRequest); err != nil {
+	compressedRequest, err := m.compressCreateWorkflowExecutionRequest(newRequest)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := m.persistence.CreateWorkflowExecution(ctx, compressedRequest); err != nil {
 		return nil, err
 	}
 	m.addXDCCacheKV(newWorkflowXDCKVs)
@@ -195,7 +209,11 @@ func (m *executionManagerImpl) UpdateWorkflowExecution(
 		NewWorkflowNewEvents:    newWorkflowNewEvents,
 	}
 
-	err = m.persistence.UpdateWorkflowExecution(ctx, newRequest)
+	compressedRequest, err := m.compressUpdateWorkflowExecutionRequest(newRequest)
+	if err != nil {
+		return nil, err
+	}
+	err = m.persistence.UpdateWorkflowExecution(ctx, compressedRequest)
 	switch err.(type) {
 	case nil:
 		m.addXDCCacheKV(updateWorkflowXDCKVs)
@@ -322,7 +340,11 @@ func (m *executionManagerImpl) ConflictResolveWorkflowExecution(
 		CurrentWorkflowEventsNewEvents: currentWorkflowEvents,
 	}
 
-	err = m.persistence.ConflictResolveWorkflowExecution(ctx, newRequest)
+	compressedRequest, err := m.compressConflictResolveWorkflowExecutionRequest(newRequest)
+	if err != nil {
+		return nil, err
+	}
+	err = m.persistence.ConflictResolveWorkflowExecution(ctx, compressedRequest)
 	switch err.(type) {
 	case nil:
 		m.addXDCCacheKV(resetWorkflowXDCKVs)
@@ -383,6 +405,9 @@ func (m *executionManagerImpl) GetWorkflowExecution(
 		// try to utilize resp as much as possible, for RebuildMutableState API
 		return nil, respErr
 	}
+	if err := m.decompressMutableState(response.State); err != nil {
+		return nil, err
+	}
 	state, err := m.toWorkflowMutableState(response.State)
 	if err != nil {
 		return nil, err
@@ -417,7 +442,11 @@ func (m *executionManagerImpl) SetWorkflowExecution(
 		SetWorkflowSnapshot: *serializedWorkflowSnapshot,
 	}
 
-	err = m.persistence.SetWorkflowExecution(ctx, newRequest)
+	compressedRequest, err := m.compressSetWorkflowExecutionRequest(newRequest)
+	if err != nil {
+		return nil, err
+	}
+	err = m.persistence.SetWorkflowExecution(ctx, compressedRequest)
 	if err != nil {
 		return nil, err
 	}
@@ -510,6 +539,7 @@ func (m *executionManagerImpl) serializeWorkflowEvents(
 
 	request := &AppendHistoryNodesRequest{
 		ShardID:           shardID,
+		NamespaceID:       workflowEvents.NamespaceID,
 		BranchToken:       workflowEvents.BranchToken,
 		Events:            workflowEvents.Events,
 		PrevTransactionID: workflowEvents.PrevTxnID,
@@ -794,6 +824,9 @@ func (m *executionManagerImpl) ListConcreteExecutions(
 		PageToken: response.NextPageToken,
 	}
 	for i, s := range response.States {
+		if err := m.decompressMutableState(s); err != nil {
+			return nil, err
+		}
 		state, err := m.toWorkflowMutableState(s)
 		if err != nil {
 			return nil, err
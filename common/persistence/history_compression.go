@@ -0,0 +1,297 @@
+package persistence
+
+import (
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/serialization"
+)
+
+// The history events and the mutable state blobs that contain payloads are compressed right
+// before they are written to the execution store, according to the compression type of their
+// namespace, and decompressed right after they are read. The sizes reported to callers and
+// the blobs put into the XDC cache are the uncompressed ones, so that turning compression on
+// or off doesn't change the history size accounting or what is replicated.
+
+func (m *executionManagerImpl) compressionType(namespaceID string) serialization.CompressionType {
+	if m.historyCompression == nil || namespaceID == "" {
+		return serialization.CompressionTypeNone
+	}
+	compressionType, err := serialization.ParseCompressionType(m.historyCompression(namespace.ID(namespaceID)))
+	if err != nil {
+		return serialization.CompressionTypeNone
+	}
+	return compressionType
+}
+
+func (m *executionManagerImpl) compressBlob(
+	blob *commonpb.DataBlob,
+	compressionType serialization.CompressionType,
+) (*commonpb.DataBlob, error) {
+	if compressionType == serialization.CompressionTypeNone || len(blob.GetData()) == 0 {
+		return blob, nil
+	}
+	compressed, err := serialization.CompressBlob(blob, compressionType)
+	if err != nil {
+		return nil, err
+	}
+	handler := m.metricsHandler.WithTags(metrics.CompressionTag(string(compressionType)))
+	metrics.PersistenceUncompressedBlobSize.With(handler).Record(int64(len(blob.Data)))
+	metrics.PersistenceCompressedBlobSize.With(handler).Record(int64(len(compressed.Data)))
+	return compressed, nil
+}
+
+func (m *executionManagerImpl) compressCreateWorkflowExecutionRequest(
+	request *InternalCreateWorkflowExecutionRequest,
+) (*InternalCreateWorkflowExecutionRequest, error) {
+	compressionType := m.compressionType(request.NewWorkflowSnapshot.NamespaceID)
+	if compressionType == serialization.CompressionTypeNone {
+		return request, nil
+	}
+
+	compressed := *request
+	snapshot, err := m.compressWorkflowSnapshot(&request.NewWorkflowSnapshot, compressionType)
+	if err != nil {
+		return nil, err
+	}
+	compressed.NewWorkflowSnapshot = *snapshot
+	if compressed.NewWorkflowNewEvents, err = m.compressHistoryNodes(request.NewWorkflowNewEvents, compressionType); err != nil {
+		return nil, err
+	}
+	return &compressed, nil
+}
+
+func (m *executionManagerImpl) compressUpdateWorkflowExecutionRequest(
+	request *InternalUpdateWorkflowExecutionRequest,
+) (*InternalUpdateWorkflowExecutionRequest, error) {
+	compressionType := m.compressionType(request.UpdateWorkflowMutation.NamespaceID)
+	if compressionType == serialization.CompressionTypeNone {
+		return request, nil
+	}
+
+	compressed := *request
+	mutation, err := m.compressWorkflowMutation(&request.UpdateWorkflowMutation, compressionType)
+	if err != nil {
+		return nil, err
+	}
+	compressed.UpdateWorkflowMutation = *mutation
+	if compressed.UpdateWorkflowNewEvents, err = m.compressHistoryNodes(request.UpdateWorkflowNewEvents, compressionType); err != nil {
+		return nil, err
+	}
+	if compressed.NewWorkflowSnapshot, err = m.compressWorkflowSnapshot(request.NewWorkflowSnapshot, compressionType); err != nil {
+		return nil, err
+	}
+	if compressed.NewWorkflowNewEvents, err = m.compressHistoryNodes(request.NewWorkflowNewEvents, compressionType); err != nil {
+		return nil, err
+	}
+	return &compressed, nil
+}
+
+func (m *executionManagerImpl) compressConflictResolveWorkflowExecutionRequest(
+	request *InternalConflictResolveWorkflowExecutionRequest,
+) (*InternalConflictResolveWorkflowExecutionRequest, error) {
+	compressionType := m.compressionType(request.ResetWorkflowSnapshot.NamespaceID)
+	if compressionType == serialization.CompressionTypeNone {
+		return request, nil
+	}
+
+	compressed := *request
+	snapshot, err := m.compressWorkflowSnapshot(&request.ResetWorkflowSnapshot, compressionType)
+	if err != nil {
+		return nil, err
+	}
+	compressed.ResetWorkflowSnapshot = *snapshot
+	if compressed.ResetWorkflowEventsNewEvents, err = m.compressHistoryNodes(request.ResetWorkflowEventsNewEvents, compressionType); err != nil {
+		return nil, err
+	}
+	if compressed.NewWorkflowSnapshot, err = m.compressWorkflowSnapshot(request.NewWorkflowSnapshot, compressionType); err != nil {
+		return nil, err
+	}
+	if compressed.NewWorkflowEventsNewEvents, err = m.compressHistoryNodes(request.NewWorkflowEventsNewEvents, compressionType); err != nil {
+		return nil, err
+	}
+	if compressed.CurrentWorkflowMutation, err = m.compressWorkflowMutation(request.CurrentWorkflowMutation, compressionType); err != nil {
+		return nil, err
+	}
+	if compressed.CurrentWorkflowEventsNewEvents, err = m.compressHistoryNodes(request.CurrentWorkflowEventsNewEvents, compressionType); err != nil {
+		return nil, err
+	}
+	return &compressed, nil
+}
+
+func (m *executionManagerImpl) compressSetWorkflowExecutionRequest(
+	request *InternalSetWorkflowExecutionRequest,
+) (*InternalSetWorkflowExecutionRequest, error) {
+	compressionType := m.compressionType(request.SetWorkflowSnapshot.NamespaceID)
+	if compressionType == serialization.CompressionTypeNone {
+		return request, nil
+	}
+
+	compressed := *request
+	snapshot, err := m.compressWorkflowSnapshot(&request.SetWorkflowSnapshot, compressionType)
+	if err != nil {
+		return nil, err
+	}
+	compressed.SetWorkflowSnapshot = *snapshot
+	return &compressed, nil
+}
+
+func (m *executionManagerImpl) compressWorkflowSnapshot(
+	snapshot *InternalWorkflowSnapshot,
+	compressionType serialization.CompressionType,
+) (*InternalWorkflowSnapshot, error) {
+	if snapshot == nil {
+		return nil, nil
+	}
+
+	compressed := *snapshot
+	var err error
+	if compressed.ExecutionInfoBlob, err = m.compressBlob(snapshot.ExecutionInfoBlob, compressionType); err != nil {
+		return nil, err
+	}
+	if compressed.ActivityInfos, err = compressBlobs(m, snapshot.ActivityInfos, compressionType); err != nil {
+		return nil, err
+	}
+	if compressed.TimerInfos, err = compressBlobs(m, snapshot.TimerInfos, compressionType); err != nil {
+		return nil, err
+	}
+	if compressed.ChildExecutionInfos, err = compressBlobs(m, snapshot.ChildExecutionInfos, compressionType); err != nil {
+		return nil, err
+	}
+	if compressed.RequestCancelInfos, err = compressBlobs(m, snapshot.RequestCancelInfos, compressionType); err != nil {
+		return nil, err
+	}
+	if compressed.SignalInfos, err = compressBlobs(m, snapshot.SignalInfos, compressionType); err != nil {
+		return nil, err
+	}
+	return &compressed, nil
+}
+
+func (m *executionManagerImpl) compressWorkflowMutation(
+	mutation *InternalWorkflowMutation,
+	compressionType serialization.CompressionType,
+) (*InternalWorkflowMutation, error) {
+	if mutation == nil {
+		return nil, nil
+	}
+
+	compressed := *mutation
+	var err error
+	if compressed.ExecutionInfoBlob, err = m.compressBlob(mutation.ExecutionInfoBlob, compressionType); err != nil {
+		return nil, err
+	}
+	if compressed.UpsertActivityInfos, err = compressBlobs(m, mutation.UpsertActivityInfos, compressionType); err != nil {
+		return nil, err
+	}
+	if compressed.UpsertTimerInfos, err = compressBlobs(m, mutation.UpsertTimerInfos, compressionType); err != nil {
+		return nil, err
+	}
+	if compressed.UpsertChildExecutionInfos, err = compressBlobs(m, mutation.UpsertChildExecutionInfos, compressionType); err != nil {
+		return nil, err
+	}
+	if compressed.UpsertRequestCancelInfos, err = compressBlobs(m, mutation.UpsertRequestCancelInfos, compressionType); err != nil {
+		return nil, err
+	}
+	if compressed.UpsertSignalInfos, err = compressBlobs(m, mutation.UpsertSignalInfos, compressionType); err != nil {
+		return nil, err
+	}
+	if compressed.NewBufferedEvents, err = m.compressBlob(mutation.NewBufferedEvents, compressionType); err != nil {
+		return nil, err
+	}
+	return &compressed, nil
+}
+
+func (m *executionManagerImpl) compressHistoryNodes(
+	requests []*InternalAppendHistoryNodesRequest,
+	compressionType serialization.CompressionType,
+) ([]*InternalAppendHistoryNodesRequest, error) {
+	if requests == nil {
+		return nil, nil
+	}
+	compressed := make([]*InternalAppendHistoryNodesRequest, len(requests))
+	for i, request := range requests {
+		node := *request
+		var err error
+		if node.Node.Events, err = m.compressBlob(request.Node.Events, compressionType); err != nil {
+			return nil, err
+		}
+		compressed[i] = &node
+	}
+	return compressed, nil
+}
+
+func (m *executionManagerImpl) decompressMutableState(
+	state *InternalWorkflowMutableState,
+) error {
+	if state == nil {
+		return nil
+	}
+	var err error
+	if state.ExecutionInfo, err = serialization.DecompressBlob(state.ExecutionInfo); err != nil {
+		return err
+	}
+	if err = decompressBlobs(state.ActivityInfos); err != nil {
+		return err
+	}
+	if err = decompressBlobs(state.TimerInfos); err != nil {
+		return err
+	}
+	if err = decompressBlobs(state.ChildExecutionInfos); err != nil {
+		return err
+	}
+	if err = decompressBlobs(state.RequestCancelInfos); err != nil {
+		return err
+	}
+	if err = decompressBlobs(state.SignalInfos); err != nil {
+		return err
+	}
+	for i, blob := range state.BufferedEvents {
+		if state.BufferedEvents[i], err = serialization.DecompressBlob(blob); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func decompressHistoryNodes(
+	nodes []InternalHistoryNode,
+) error {
+	for i := range nodes {
+		var err error
+		if nodes[i].Events, err = serialization.DecompressBlob(nodes[i].Events); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func compressBlobs[K comparable](
+	m *executionManagerImpl,
+	blobs map[K]*commonpb.DataBlob,
+	compressionType serialization.CompressionType,
+) (map[K]*commonpb.DataBlob, error) {
+	if blobs == nil {
+		return nil, nil
+	}
+	compressed := make(map[K]*commonpb.DataBlob, len(blobs))
+	for key, blob := range blobs {
+		var err error
+		if compressed[key], err = m.compressBlob(blob, compressionType); err != nil {
+			return nil, err
+		}
+	}
+	return compressed, nil
+}
+
+func decompressBlobs[K comparable](
+	blobs map[K]*commonpb.DataBlob,
+) error {
+	for key, blob := range blobs {
+		decompressed, err := serialization.DecompressBlob(blob)
+		if err != nil {
+			return err
+		}
+		blobs[key] = decompressed
+	}
+	return nil
+}
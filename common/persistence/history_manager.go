@@ -485,10 +485,14 @@ func (m *executionManagerImpl) AppendHistoryNodes(
 		return nil, err
 	}
 
+	size := len(req.Node.Events.Data)
+	if req.Node.Events, err = m.compressBlob(req.Node.Events, m.compressionType(request.NamespaceID)); err != nil {
+		return nil, err
+	}
 	err = m.persistence.AppendHistoryNodes(ctx, req)
 
 	return &AppendHistoryNodesResponse{
-		Size: len(req.Node.Events.Data),
+		Size: size,
 	}, err
 }
 
@@ -503,6 +507,9 @@ func (m *executionManagerImpl) AppendRawHistoryNodes(
 		return nil, err
 	}
 
+	if req.Node.Events, err = m.compressBlob(req.Node.Events, m.compressionType(request.NamespaceID)); err != nil {
+		return nil, err
+	}
 	err = m.persistence.AppendHistoryNodes(ctx, req)
 	return &AppendHistoryNodesResponse{
 		Size: len(request.History.Data),
@@ -655,6 +662,9 @@ func (m *executionManagerImpl) readRawHistoryBranch(
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := decompressHistoryNodes(resp.Nodes); err != nil {
+		return nil, nil, err
+	}
 	token.StoreToken = resp.NextPageToken
 	return resp.Nodes, token, nil
 }
@@ -716,6 +726,9 @@ func (m *executionManagerImpl) readRawHistoryBranchReverse(
 	if err != nil {
 		return nil, nil, err
 	}
+	if err := decompressHistoryNodes(resp.Nodes); err != nil {
+		return nil, nil, err
+	}
 	token.StoreToken = resp.NextPageToken
 	return resp.Nodes, token, nil
 }
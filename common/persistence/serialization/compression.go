@@ -0,0 +1,120 @@
+package serialization
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/andybalholm/brotli"
+	"github.com/klauspost/compress/zstd"
+	commonpb "go.temporal.io/api/common/v1"
+)
+
+// CompressionType is the algorithm history and mutable state blobs are compressed with
+// before they are written to persistence.
+type CompressionType string
+
+const (
+	CompressionTypeNone   CompressionType = "none"
+	CompressionTypeZstd   CompressionType = "zstd"
+	CompressionTypeBrotli CompressionType = "brotli"
+)
+
+// compressedBlobMagic starts the data of compressed blobs. Its header byte is the compression
+// algorithm, and is followed by the compressed data.
+var compressedBlobMagic = blobMagic{0x00, 't', 'c', 'p', 0x01}
+
+const (
+	compressionAlgorithmZstd   byte = 1
+	compressionAlgorithmBrotli byte = 2
+)
+
+var (
+	zstdEncoder, _ = zstd.NewWriter(nil)
+	zstdDecoder, _ = zstd.NewReader(nil)
+)
+
+// ParseCompressionType returns the CompressionType named by value. An empty value is
+// CompressionTypeNone.
+func ParseCompressionType(value string) (CompressionType, error) {
+	switch compressionType := CompressionType(strings.ToLower(value)); compressionType {
+	case "", CompressionTypeNone:
+		return CompressionTypeNone, nil
+	case CompressionTypeZstd, CompressionTypeBrotli:
+		return compressionType, nil
+	default:
+		return CompressionTypeNone, fmt.Errorf("unknown compression type %q", value)
+	}
+}
+
+// IsCompressedBlob returns true if blob was compressed by CompressBlob.
+func IsCompressedBlob(blob *commonpb.DataBlob) bool {
+	return compressedBlobMagic.wraps(blob.GetData())
+}
+
+// CompressBlob returns blob compressed with compressionType. Empty blobs, blobs that are
+// already compressed and blobs that don't get smaller are returned unchanged.
+func CompressBlob(blob *commonpb.DataBlob, compressionType CompressionType) (*commonpb.DataBlob, error) {
+	if compressionType == CompressionTypeNone || len(blob.GetData()) == 0 || IsCompressedBlob(blob) {
+		return blob, nil
+	}
+
+	var data []byte
+	switch compressionType {
+	case CompressionTypeZstd:
+		data = compressedBlobMagic.header(compressionAlgorithmZstd, len(blob.Data))
+		data = zstdEncoder.EncodeAll(blob.Data, data)
+	case CompressionTypeBrotli:
+		data = compressedBlobMagic.header(compressionAlgorithmBrotli, len(blob.Data))
+		buf := bytes.NewBuffer(data)
+		writer := brotli.NewWriter(buf)
+		if _, err := writer.Write(blob.Data); err != nil {
+			return nil, NewSerializationError(blob.EncodingType, err)
+		}
+		if err := writer.Close(); err != nil {
+			return nil, NewSerializationError(blob.EncodingType, err)
+		}
+		data = buf.Bytes()
+	default:
+		return nil, NewSerializationError(blob.EncodingType, fmt.Errorf("unknown compression type %q", compressionType))
+	}
+	if len(data) >= len(blob.Data) {
+		return blob, nil
+	}
+	return &commonpb.DataBlob{
+		EncodingType: blob.EncodingType,
+		Data:         data,
+	}, nil
+}
+
+// DecompressBlob returns blob decompressed. Blobs that are not compressed are returned
+// unchanged.
+func DecompressBlob(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if !IsCompressedBlob(blob) {
+		return blob, nil
+	}
+	algorithm, compressed, ok := compressedBlobMagic.open(blob.Data)
+	if !ok {
+		return nil, NewDeserializationError(blob.EncodingType, errors.New("compressed blob is truncated"))
+	}
+
+	var data []byte
+	var err error
+	switch algorithm {
+	case compressionAlgorithmZstd:
+		data, err = zstdDecoder.DecodeAll(compressed, nil)
+	case compressionAlgorithmBrotli:
+		data, err = io.ReadAll(brotli.NewReader(bytes.NewReader(compressed)))
+	default:
+		err = fmt.Errorf("unknown compression algorithm %d", algorithm)
+	}
+	if err != nil {
+		return nil, NewDeserializationError(blob.EncodingType, err)
+	}
+	return &commonpb.DataBlob{
+		EncodingType: blob.EncodingType,
+		Data:         data,
+	}, nil
+}
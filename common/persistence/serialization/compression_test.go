@@ -0,0 +1,119 @@
+package serialization
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/server/common/testing/protorequire"
+)
+
+type (
+	compressionSuite struct {
+		suite.Suite
+		*require.Assertions
+		protorequire.ProtoAssertions
+	}
+)
+
+func TestCompressionSuite(t *testing.T) {
+	suite.Run(t, new(compressionSuite))
+}
+
+func (s *compressionSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.ProtoAssertions = protorequire.New(s.T())
+}
+
+func (s *compressionSuite) TestCompressDecompress() {
+	events := make([]*historypb.HistoryEvent, 0, 100)
+	for i := int64(1); i <= 100; i++ {
+		events = append(events, &historypb.HistoryEvent{EventId: i, EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED})
+	}
+	blob, err := NewSerializer().SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+
+	for _, compressionType := range []CompressionType{CompressionTypeZstd, CompressionTypeBrotli} {
+		compressed, err := CompressBlob(blob, compressionType)
+		s.NoError(err)
+		s.True(IsCompressedBlob(compressed))
+		s.False(IsCompressedBlob(blob))
+		s.Equal(enumspb.ENCODING_TYPE_PROTO3, compressed.EncodingType)
+		s.Less(len(compressed.Data), len(blob.Data))
+
+		// compressing twice doesn't change the blob
+		again, err := CompressBlob(compressed, compressionType)
+		s.NoError(err)
+		s.Equal(compressed.Data, again.Data)
+
+		decompressed, err := DecompressBlob(compressed)
+		s.NoError(err)
+		s.ProtoEqual(blob, decompressed)
+		deserialized, err := NewSerializer().DeserializeEvents(decompressed)
+		s.NoError(err)
+		protorequire.ProtoSliceEqual(s.T(), events, deserialized)
+	}
+}
+
+func (s *compressionSuite) TestUncompressedPassthrough() {
+	blob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_JSON, Data: []byte(`{"eventId":"1"}`)}
+
+	decompressed, err := DecompressBlob(blob)
+	s.NoError(err)
+	s.ProtoEqual(blob, decompressed)
+
+	// blobs that don't get smaller are not compressed
+	compressed, err := CompressBlob(blob, CompressionTypeZstd)
+	s.NoError(err)
+	s.False(IsCompressedBlob(compressed))
+
+	none, err := CompressBlob(&commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: bytes.Repeat([]byte{1}, 1024)}, CompressionTypeNone)
+	s.NoError(err)
+	s.False(IsCompressedBlob(none))
+	empty, err := CompressBlob(&commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3}, CompressionTypeBrotli)
+	s.NoError(err)
+	s.False(IsCompressedBlob(empty))
+	nilBlob, err := CompressBlob(nil, CompressionTypeZstd)
+	s.NoError(err)
+	s.Nil(nilBlob)
+}
+
+func (s *compressionSuite) TestCorruptedBlob() {
+	compressed, err := CompressBlob(&commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: bytes.Repeat([]byte{1}, 1024)}, CompressionTypeZstd)
+	s.NoError(err)
+	s.True(IsCompressedBlob(compressed))
+
+	var deserializationErr *DeserializationError
+	_, err = DecompressBlob(&commonpb.DataBlob{EncodingType: compressed.EncodingType, Data: compressed.Data[:len(compressedBlobMagic)]})
+	s.ErrorAs(err, &deserializationErr)
+	s.ErrorContains(err, "compressed blob is truncated")
+
+	unknown := bytes.Clone(compressed.Data)
+	unknown[len(compressedBlobMagic)] = 0xff
+	_, err = DecompressBlob(&commonpb.DataBlob{EncodingType: compressed.EncodingType, Data: unknown})
+	s.ErrorContains(err, "unknown compression algorithm 255")
+
+	_, err = DecompressBlob(&commonpb.DataBlob{EncodingType: compressed.EncodingType, Data: compressed.Data[:len(compressed.Data)-2]})
+	s.Error(err)
+}
+
+func (s *compressionSuite) TestParseCompressionType() {
+	for value, expected := range map[string]CompressionType{
+		"":       CompressionTypeNone,
+		"none":   CompressionTypeNone,
+		"zstd":   CompressionTypeZstd,
+		"ZSTD":   CompressionTypeZstd,
+		"brotli": CompressionTypeBrotli,
+	} {
+		compressionType, err := ParseCompressionType(value)
+		s.NoError(err)
+		s.Equal(expected, compressionType)
+	}
+
+	_, err := ParseCompressionType("gzip")
+	s.ErrorContains(err, `unknown compression type "gzip"`)
+}
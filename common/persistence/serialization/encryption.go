@@ -0,0 +1,176 @@
+package serialization
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	commonpb "go.temporal.io/api/common/v1"
+)
+
+type (
+	// Encryptor encrypts data blobs before they are written to persistence and decrypts them
+	// after they are read.
+	Encryptor interface {
+		// ActiveKeyID returns the ID of the key blobs are encrypted with. Blobs are not
+		// encrypted if it is empty.
+		ActiveKeyID() string
+		// Encrypt returns blob encrypted with the active key. Empty blobs and blobs that are
+		// already encrypted are returned unchanged.
+		Encrypt(blob *commonpb.DataBlob) (*commonpb.DataBlob, error)
+		// Decrypt returns blob decrypted with the key it was encrypted with. Blobs that are
+		// not encrypted are returned unchanged.
+		Decrypt(blob *commonpb.DataBlob) (*commonpb.DataBlob, error)
+	}
+
+	localKeyEncryptor struct {
+		activeKeyID string
+		keys        map[string]cipher.AEAD
+	}
+)
+
+// encryptedBlobMagic starts the data of encrypted blobs. Its header byte is the length of the
+// key ID, and the envelope of an encrypted blob is:
+//
+//	magic | key ID length (1 byte) | key ID | nonce | AES-GCM ciphertext and tag
+//
+// The header up to and including the key ID and the encoding type of the blob are
+// authenticated as additional data.
+var encryptedBlobMagic = blobMagic{0x00, 't', 'e', 'k', 0x01}
+
+const maxEncryptionKeyIDLength = 255
+
+var _ Encryptor = (*localKeyEncryptor)(nil)
+
+// NewLocalKeyEncryptor returns an Encryptor with AES-GCM keys read from local files. keyFiles
+// maps key IDs to files containing base64 encoded keys of 16, 24 or 32 bytes. Blobs are
+// encrypted with the key of activeKeyID, or not encrypted if it is empty, and can be
+// decrypted with any of the keys.
+func NewLocalKeyEncryptor(activeKeyID string, keyFiles map[string]string) (Encryptor, error) {
+	keys := make(map[string][]byte, len(keyFiles))
+	for keyID, path := range keyFiles {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read encryption key %q: %w", keyID, err)
+		}
+		key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(contents)))
+		if err != nil {
+			return nil, fmt.Errorf("unable to decode encryption key %q: %w", keyID, err)
+		}
+		keys[keyID] = key
+	}
+	return NewEncryptor(activeKeyID, keys)
+}
+
+// NewEncryptor returns an Encryptor with the given AES-GCM keys by key ID. Blobs are
+// encrypted with the key of activeKeyID, or not encrypted if it is empty, and can be
+// decrypted with any of the keys.
+func NewEncryptor(activeKeyID string, keys map[string][]byte) (Encryptor, error) {
+	e := &localKeyEncryptor{
+		activeKeyID: activeKeyID,
+		keys:        make(map[string]cipher.AEAD, len(keys)),
+	}
+	for keyID, key := range keys {
+		if keyID == "" || len(keyID) > maxEncryptionKeyIDLength {
+			return nil, fmt.Errorf("invalid encryption key ID %q: must be between 1 and %d bytes", keyID, maxEncryptionKeyIDLength)
+		}
+		block, err := aes.NewCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid encryption key %q: %w", keyID, err)
+		}
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, fmt.Errorf("invalid encryption key %q: %w", keyID, err)
+		}
+		e.keys[keyID] = aead
+	}
+	if _, ok := e.keys[activeKeyID]; activeKeyID != "" && !ok {
+		return nil, fmt.Errorf("active encryption key %q not found", activeKeyID)
+	}
+	return e, nil
+}
+
+// IsEncryptedBlob returns true if blob was encrypted by an Encryptor.
+func IsEncryptedBlob(blob *commonpb.DataBlob) bool {
+	return encryptedBlobMagic.wraps(blob.GetData())
+}
+
+// EncryptionKeyID returns the ID of the key blob was encrypted with, or false if blob is not
+// encrypted.
+func EncryptionKeyID(blob *commonpb.DataBlob) (string, bool) {
+	keyID, _, err := parseEncryptedBlobHeader(blob.GetData())
+	if err != nil {
+		return "", false
+	}
+	return keyID, true
+}
+
+func (e *localKeyEncryptor) ActiveKeyID() string {
+	return e.activeKeyID
+}
+
+func (e *localKeyEncryptor) Encrypt(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if e.activeKeyID == "" || len(blob.GetData()) == 0 || IsEncryptedBlob(blob) {
+		return blob, nil
+	}
+	aead := e.keys[e.activeKeyID]
+
+	header := encryptedBlobMagic.header(byte(len(e.activeKeyID)), len(e.activeKeyID))
+	header = append(header, e.activeKeyID...)
+
+	data := make([]byte, len(header)+aead.NonceSize(), len(header)+aead.NonceSize()+len(blob.Data)+aead.Overhead())
+	copy(data, header)
+	nonce := data[len(header):]
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, NewSerializationError(blob.EncodingType, fmt.Errorf("unable to generate nonce: %w", err))
+	}
+	data = aead.Seal(data, nonce, blob.Data, encryptionAdditionalData(header, blob))
+	return &commonpb.DataBlob{
+		EncodingType: blob.EncodingType,
+		Data:         data,
+	}, nil
+}
+
+func (e *localKeyEncryptor) Decrypt(blob *commonpb.DataBlob) (*commonpb.DataBlob, error) {
+	if !IsEncryptedBlob(blob) {
+		return blob, nil
+	}
+	keyID, headerLen, err := parseEncryptedBlobHeader(blob.Data)
+	if err != nil {
+		return nil, NewDeserializationError(blob.EncodingType, err)
+	}
+	aead, ok := e.keys[keyID]
+	if !ok {
+		return nil, NewDeserializationError(blob.EncodingType, fmt.Errorf("encryption key %q not found", keyID))
+	}
+	if len(blob.Data) < headerLen+aead.NonceSize() {
+		return nil, NewDeserializationError(blob.EncodingType, errors.New("encrypted blob is truncated"))
+	}
+	nonce := blob.Data[headerLen : headerLen+aead.NonceSize()]
+	data, err := aead.Open(nil, nonce, blob.Data[headerLen+aead.NonceSize():], encryptionAdditionalData(blob.Data[:headerLen], blob))
+	if err != nil {
+		return nil, NewDeserializationError(blob.EncodingType, fmt.Errorf("unable to decrypt blob with key %q: %w", keyID, err))
+	}
+	return &commonpb.DataBlob{
+		EncodingType: blob.EncodingType,
+		Data:         data,
+	}, nil
+}
+
+func parseEncryptedBlobHeader(data []byte) (string, int, error) {
+	keyIDLen, rest, ok := encryptedBlobMagic.open(data)
+	if !ok || keyIDLen == 0 || len(rest) < int(keyIDLen) {
+		return "", 0, errors.New("invalid encrypted blob header")
+	}
+	return string(rest[:keyIDLen]), len(data) - len(rest) + int(keyIDLen), nil
+}
+
+func encryptionAdditionalData(header []byte, blob *commonpb.DataBlob) []byte {
+	return append(bytes.Clone(header), blob.EncodingType.String()...)
+}
@@ -0,0 +1,181 @@
+package serialization
+
+import (
+	"bytes"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/server/common/testing/protorequire"
+)
+
+type (
+	encryptionSuite struct {
+		suite.Suite
+		*require.Assertions
+		protorequire.ProtoAssertions
+
+		keys map[string][]byte
+	}
+)
+
+func TestEncryptionSuite(t *testing.T) {
+	suite.Run(t, new(encryptionSuite))
+}
+
+func (s *encryptionSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.ProtoAssertions = protorequire.New(s.T())
+
+	s.keys = map[string][]byte{
+		"key-1": bytes.Repeat([]byte{1}, 32),
+		"key-2": bytes.Repeat([]byte{2}, 16),
+	}
+}
+
+func (s *encryptionSuite) TestEncryptDecrypt() {
+	encryptor, err := NewEncryptor("key-1", s.keys)
+	s.NoError(err)
+	events := []*historypb.HistoryEvent{{EventId: 1, EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED}}
+	blob, err := NewSerializer().SerializeEvents(events, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+
+	encrypted, err := encryptor.Encrypt(blob)
+	s.NoError(err)
+	s.True(IsEncryptedBlob(encrypted))
+	s.False(IsEncryptedBlob(blob))
+	s.Equal(enumspb.ENCODING_TYPE_PROTO3, encrypted.EncodingType)
+	s.NotContains(string(encrypted.Data), string(blob.Data))
+	keyID, ok := EncryptionKeyID(encrypted)
+	s.True(ok)
+	s.Equal("key-1", keyID)
+
+	// encrypting twice doesn't change the blob
+	again, err := encryptor.Encrypt(encrypted)
+	s.NoError(err)
+	s.Equal(encrypted.Data, again.Data)
+
+	decrypted, err := encryptor.Decrypt(encrypted)
+	s.NoError(err)
+	s.ProtoEqual(blob, decrypted)
+	deserialized, err := NewSerializer().DeserializeEvents(decrypted)
+	s.NoError(err)
+	protorequire.ProtoSliceEqual(s.T(), events, deserialized)
+}
+
+func (s *encryptionSuite) TestPlaintextPassthrough() {
+	encryptor, err := NewEncryptor("key-1", s.keys)
+	s.NoError(err)
+	blob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_JSON, Data: []byte(`{"eventId":"1"}`)}
+
+	decrypted, err := encryptor.Decrypt(blob)
+	s.NoError(err)
+	s.ProtoEqual(blob, decrypted)
+	_, ok := EncryptionKeyID(blob)
+	s.False(ok)
+
+	empty, err := encryptor.Encrypt(&commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3})
+	s.NoError(err)
+	s.False(IsEncryptedBlob(empty))
+	nilBlob, err := encryptor.Encrypt(nil)
+	s.NoError(err)
+	s.Nil(nilBlob)
+}
+
+func (s *encryptionSuite) TestRotation() {
+	oldEncryptor, err := NewEncryptor("key-1", s.keys)
+	s.NoError(err)
+	newEncryptor, err := NewEncryptor("key-2", s.keys)
+	s.NoError(err)
+	blob := &commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("payload")}
+
+	encrypted, err := oldEncryptor.Encrypt(blob)
+	s.NoError(err)
+	decrypted, err := newEncryptor.Decrypt(encrypted)
+	s.NoError(err)
+	s.ProtoEqual(blob, decrypted)
+
+	reencrypted, err := newEncryptor.Encrypt(decrypted)
+	s.NoError(err)
+	keyID, ok := EncryptionKeyID(reencrypted)
+	s.True(ok)
+	s.Equal("key-2", keyID)
+
+	// decrypt only
+	decryptor, err := NewEncryptor("", s.keys)
+	s.NoError(err)
+	plaintext, err := decryptor.Encrypt(blob)
+	s.NoError(err)
+	s.False(IsEncryptedBlob(plaintext))
+	decrypted, err = decryptor.Decrypt(reencrypted)
+	s.NoError(err)
+	s.ProtoEqual(blob, decrypted)
+
+	// the key is no longer available
+	withoutKey, err := NewEncryptor("key-2", map[string][]byte{"key-2": s.keys["key-2"]})
+	s.NoError(err)
+	_, err = withoutKey.Decrypt(encrypted)
+	var deserializationErr *DeserializationError
+	s.ErrorAs(err, &deserializationErr)
+	s.ErrorContains(err, `encryption key "key-1" not found`)
+}
+
+func (s *encryptionSuite) TestTamperedBlob() {
+	encryptor, err := NewEncryptor("key-1", s.keys)
+	s.NoError(err)
+	encrypted, err := encryptor.Encrypt(&commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("payload")})
+	s.NoError(err)
+
+	tampered := &commonpb.DataBlob{EncodingType: encrypted.EncodingType, Data: bytes.Clone(encrypted.Data)}
+	tampered.Data[len(tampered.Data)-1] ^= 1
+	_, err = encryptor.Decrypt(tampered)
+	s.Error(err)
+
+	// the encoding type is authenticated
+	_, err = encryptor.Decrypt(&commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_JSON, Data: encrypted.Data})
+	s.Error(err)
+
+	_, err = encryptor.Decrypt(&commonpb.DataBlob{EncodingType: encrypted.EncodingType, Data: encrypted.Data[:len(encryptedBlobMagic)+3]})
+	s.Error(err)
+}
+
+func (s *encryptionSuite) TestNewEncryptor_Invalid() {
+	_, err := NewEncryptor("key-3", s.keys)
+	s.ErrorContains(err, `active encryption key "key-3" not found`)
+
+	_, err = NewEncryptor("key-1", map[string][]byte{"key-1": []byte("short")})
+	s.ErrorContains(err, `invalid encryption key "key-1"`)
+
+	_, err = NewEncryptor("", map[string][]byte{"": s.keys["key-1"]})
+	s.ErrorContains(err, "invalid encryption key ID")
+}
+
+func (s *encryptionSuite) TestNewLocalKeyEncryptor() {
+	dir := s.T().TempDir()
+	keyFiles := make(map[string]string, len(s.keys))
+	for keyID, key := range s.keys {
+		keyFiles[keyID] = filepath.Join(dir, keyID)
+		s.NoError(os.WriteFile(keyFiles[keyID], []byte(base64.StdEncoding.EncodeToString(key)+"\n"), 0600))
+	}
+
+	encryptor, err := NewLocalKeyEncryptor("key-2", keyFiles)
+	s.NoError(err)
+	s.Equal("key-2", encryptor.ActiveKeyID())
+	expected, err := NewEncryptor("key-1", s.keys)
+	s.NoError(err)
+	encrypted, err := expected.Encrypt(&commonpb.DataBlob{EncodingType: enumspb.ENCODING_TYPE_PROTO3, Data: []byte("payload")})
+	s.NoError(err)
+	decrypted, err := encryptor.Decrypt(encrypted)
+	s.NoError(err)
+	s.Equal([]byte("payload"), decrypted.Data)
+
+	keyFiles["key-3"] = filepath.Join(dir, "missing")
+	_, err = NewLocalKeyEncryptor("key-2", keyFiles)
+	s.ErrorContains(err, `unable to read encryption key "key-3"`)
+}
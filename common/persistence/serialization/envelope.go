@@ -0,0 +1,32 @@
+package serialization
+
+import "bytes"
+
+// blobMagic starts the data of blobs that were wrapped in an envelope before they were
+// written to persistence, such as compressed or encrypted blobs. Neither proto3 nor JSON data
+// can start with a zero byte, so every magic starts with one: wrapped blobs can then be told
+// apart from plain ones, and blobs written before the feature was enabled can still be read.
+// The magic is followed by one header byte whose meaning is up to the envelope, and then by
+// the rest of the envelope.
+type blobMagic []byte
+
+// wraps returns true if data starts with m.
+func (m blobMagic) wraps(data []byte) bool {
+	return bytes.HasPrefix(data, m)
+}
+
+// header returns m followed by headerByte, with room for size more bytes.
+func (m blobMagic) header(headerByte byte, size int) []byte {
+	data := make([]byte, 0, len(m)+1+size)
+	data = append(data, m...)
+	return append(data, headerByte)
+}
+
+// open returns the header byte and the rest of an envelope started by m, or false if data is
+// not such an envelope or is truncated before the header byte.
+func (m blobMagic) open(data []byte) (byte, []byte, bool) {
+	if !m.wraps(data) || len(data) <= len(m) {
+		return 0, nil, false
+	}
+	return data[len(m)], data[len(m)+1:], true
+}
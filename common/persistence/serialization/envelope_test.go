@@ -0,0 +1,34 @@
+package serialization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+)
+
+func TestBlobMagic_OpenHeader(t *testing.T) {
+	magic := blobMagic{0x00, 't', 'x', 'x', 0x01}
+	data := append(magic.header(7, 3), 'a', 'b', 'c')
+
+	require.True(t, magic.wraps(data))
+	headerByte, rest, ok := magic.open(data)
+	require.True(t, ok)
+	require.Equal(t, byte(7), headerByte)
+	require.Equal(t, []byte("abc"), rest)
+
+	_, _, ok = magic.open(data[:len(magic)])
+	require.False(t, ok)
+	_, _, ok = compressedBlobMagic.open(data)
+	require.False(t, ok)
+}
+
+func TestBlobMagic_PlainBlobsAreNotWrapped(t *testing.T) {
+	blob, err := NewSerializer().SerializeEvents([]*historypb.HistoryEvent{{EventId: 1}}, enumspb.ENCODING_TYPE_PROTO3)
+	require.NoError(t, err)
+
+	for _, magic := range []blobMagic{compressedBlobMagic, encryptedBlobMagic} {
+		require.False(t, magic.wraps(blob.Data))
+	}
+}
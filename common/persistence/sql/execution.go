@@ -720,11 +720,75 @@ func (m *sqlExecutionStore) setWorkflowExecutionTx(
 	)
 }
 
+// listConcreteExecutionsPageToken is used to continue the scan of a shard's executions
+// after the last execution we returned.
+type listConcreteExecutionsPageToken struct {
+	NamespaceID primitives.UUID
+	WorkflowID  string
+	RunID       primitives.UUID
+}
+
+// ListConcreteExecutions scans the executions table of a single shard in primary key order.
+// Similar to the Cassandra implementation, only the execution row itself (execution info,
+// execution state and next event ID) is loaded, the mutable state maps are left empty.
 func (m *sqlExecutionStore) ListConcreteExecutions(
-	_ context.Context,
-	_ *p.ListConcreteExecutionsRequest,
+	ctx context.Context,
+	request *p.ListConcreteExecutionsRequest,
 ) (*p.InternalListConcreteExecutionsResponse, error) {
-	return nil, serviceerror.NewUnimplemented("ListConcreteExecutions is not implemented")
+	pageSize := request.PageSize
+	if pageSize <= 0 {
+		return nil, serviceerror.NewInvalidArgumentf("PageSize must be greater than 0, but was %d", pageSize)
+	}
+
+	page := sqlplugin.ExecutionsPage{
+		ShardID:     request.ShardID,
+		NamespaceID: emptyID,
+		RunID:       emptyID,
+		Limit:       pageSize,
+	}
+	if len(request.PageToken) != 0 {
+		token, err := deserializePageTokenJson[listConcreteExecutionsPageToken](request.PageToken)
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgumentf("ListConcreteExecutions: invalid page token. Error: %v", err)
+		}
+		page.NamespaceID = token.NamespaceID
+		page.WorkflowID = token.WorkflowID
+		page.RunID = token.RunID
+	}
+
+	rows, err := m.Db.PaginateFromExecutions(ctx, page)
+	if err != nil {
+		return nil, serviceerror.NewUnavailablef("ListConcreteExecutions: failed. Error: %v", err)
+	}
+
+	response := &p.InternalListConcreteExecutionsResponse{
+		States: make([]*p.InternalWorkflowMutableState, 0, len(rows)),
+	}
+	for _, row := range rows {
+		response.States = append(response.States, &p.InternalWorkflowMutableState{
+			ExecutionInfo:  p.NewDataBlob(row.Data, row.DataEncoding),
+			ExecutionState: p.NewDataBlob(row.State, row.StateEncoding),
+			NextEventID:    row.NextEventID,
+
+			DBRecordVersion: row.DBRecordVersion,
+		})
+	}
+	if len(rows) < pageSize {
+		// no next page token because there are no more results
+		return response, nil
+	}
+
+	// if we filled the page with rows, then set the next page token
+	lastRow := rows[len(rows)-1]
+	response.NextPageToken, err = serializePageTokenJson(&listConcreteExecutionsPageToken{
+		NamespaceID: lastRow.NamespaceID,
+		WorkflowID:  lastRow.WorkflowID,
+		RunID:       lastRow.RunID,
+	})
+	if err != nil {
+		return nil, serviceerror.NewInternalf("ListConcreteExecutions: failed to serialize page token. Error: %v", err)
+	}
+	return response, nil
 }
 
 func getStartTimeFromState(state *persistencespb.WorkflowExecutionState) *time.Time {
@@ -35,6 +35,16 @@ type (
 		RunID       primitives.UUID
 	}
 
+	// ExecutionsPage is a struct which represents a page of executions within a shard to query.
+	// Rows are returned in primary key order, starting after {NamespaceID, WorkflowID, RunID}.
+	ExecutionsPage struct {
+		ShardID     int32
+		NamespaceID primitives.UUID
+		WorkflowID  string
+		RunID       primitives.UUID
+		Limit       int
+	}
+
 	// CurrentExecutionsRow represents a row in current_executions table
 	CurrentExecutionsRow struct {
 		ShardID          int32
@@ -73,6 +83,9 @@ type (
 		DeleteFromExecutions(ctx context.Context, filter ExecutionsFilter) (sql.Result, error)
 		ReadLockExecutions(ctx context.Context, filter ExecutionsFilter) (int64, int64, error)
 		WriteLockExecutions(ctx context.Context, filter ExecutionsFilter) (int64, int64, error)
+		// PaginateFromExecutions reads up to page.Limit rows of a single shard from executions table,
+		// sorted by their primary key and starting after the execution specified in the page.
+		PaginateFromExecutions(ctx context.Context, page ExecutionsPage) ([]ExecutionsRow, error)
 
 		LockCurrentExecutionsJoinExecutions(ctx context.Context, filter CurrentExecutionsFilter) ([]CurrentExecutionsRow, error)
 
@@ -26,6 +26,13 @@ const (
 	lockExecutionQueryBase = `SELECT db_record_version, next_event_id FROM executions 
  WHERE shard_id = ? AND namespace_id = ? AND workflow_id = ? AND run_id = ?`
 
+	// conceptually this query is WHERE shard_id = ? AND (namespace_id, workflow_id, run_id) > (?, ?, ?)
+	// but mysql doesn't execute it efficiently unless it's spelled out like this
+	paginateExecutionsQuery = `SELECT ` + executionsColumns + ` FROM executions
+ WHERE shard_id = ? AND ((namespace_id = ? AND ((workflow_id = ? AND run_id > ?) OR workflow_id > ?)) OR namespace_id > ?)
+ ORDER BY namespace_id, workflow_id, run_id
+ LIMIT ?`
+
 	writeLockExecutionQuery = lockExecutionQueryBase + ` FOR UPDATE`
 	readLockExecutionQuery  = lockExecutionQueryBase + ` LOCK IN SHARE MODE`
 
@@ -254,6 +261,27 @@ func (mdb *db) WriteLockExecutions(
 	return executionVersion.DBRecordVersion, executionVersion.NextEventID, err
 }
 
+// PaginateFromExecutions reads up to page.Limit rows of a single shard from executions table,
+// sorted by their primary key and starting after the execution specified in the page.
+func (mdb *db) PaginateFromExecutions(
+	ctx context.Context,
+	page sqlplugin.ExecutionsPage,
+) ([]sqlplugin.ExecutionsRow, error) {
+	var rows []sqlplugin.ExecutionsRow
+	err := mdb.SelectContext(ctx,
+		&rows,
+		paginateExecutionsQuery,
+		page.ShardID,
+		page.NamespaceID,
+		page.WorkflowID,
+		page.RunID,
+		page.WorkflowID,
+		page.NamespaceID,
+		page.Limit,
+	)
+	return rows, err
+}
+
 // InsertIntoCurrentExecutions inserts a single row into current_executions table
 func (mdb *db) InsertIntoCurrentExecutions(
 	ctx context.Context,
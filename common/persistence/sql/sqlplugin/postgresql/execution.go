@@ -26,6 +26,11 @@ const (
 	lockExecutionQueryBase = `SELECT db_record_version, next_event_id FROM executions 
  WHERE shard_id = $1 AND namespace_id = $2 AND workflow_id = $3 AND run_id = $4`
 
+	paginateExecutionsQuery = `SELECT ` + executionsColumns + ` FROM executions
+ WHERE shard_id = $1 AND (namespace_id, workflow_id, run_id) > ($2, $3, $4)
+ ORDER BY namespace_id, workflow_id, run_id
+ LIMIT $5`
+
 	writeLockExecutionQuery = lockExecutionQueryBase + ` FOR UPDATE`
 	readLockExecutionQuery  = lockExecutionQueryBase + ` FOR SHARE`
 
@@ -254,6 +259,25 @@ func (pdb *db) WriteLockExecutions(
 	return executionVersion.DBRecordVersion, executionVersion.NextEventID, err
 }
 
+// PaginateFromExecutions reads up to page.Limit rows of a single shard from executions table,
+// sorted by their primary key and starting after the execution specified in the page.
+func (pdb *db) PaginateFromExecutions(
+	ctx context.Context,
+	page sqlplugin.ExecutionsPage,
+) ([]sqlplugin.ExecutionsRow, error) {
+	var rows []sqlplugin.ExecutionsRow
+	err := pdb.SelectContext(ctx,
+		&rows,
+		paginateExecutionsQuery,
+		page.ShardID,
+		page.NamespaceID,
+		page.WorkflowID,
+		page.RunID,
+		page.Limit,
+	)
+	return rows, err
+}
+
 // InsertIntoCurrentExecutions inserts a single row into current_executions table
 func (pdb *db) InsertIntoCurrentExecutions(
 	ctx context.Context,
@@ -26,6 +26,11 @@ const (
 	lockExecutionQueryBase = `SELECT db_record_version, next_event_id FROM executions 
  WHERE shard_id = ? AND namespace_id = ? AND workflow_id = ? AND run_id = ?`
 
+	paginateExecutionsQuery = `SELECT ` + executionsColumns + ` FROM executions
+ WHERE shard_id = ? AND (namespace_id, workflow_id, run_id) > (?, ?, ?)
+ ORDER BY namespace_id, workflow_id, run_id
+ LIMIT ?`
+
 	writeLockExecutionQuery = lockExecutionQueryBase
 	readLockExecutionQuery  = lockExecutionQueryBase
 
@@ -254,6 +259,25 @@ func (mdb *db) WriteLockExecutions(
 	return executionVersion.DBRecordVersion, executionVersion.NextEventID, err
 }
 
+// PaginateFromExecutions reads up to page.Limit rows of a single shard from executions table,
+// sorted by their primary key and starting after the execution specified in the page.
+func (mdb *db) PaginateFromExecutions(
+	ctx context.Context,
+	page sqlplugin.ExecutionsPage,
+) ([]sqlplugin.ExecutionsRow, error) {
+	var rows []sqlplugin.ExecutionsRow
+	err := mdb.conn.SelectContext(ctx,
+		&rows,
+		paginateExecutionsQuery,
+		page.ShardID,
+		page.NamespaceID,
+		page.WorkflowID,
+		page.RunID,
+		page.Limit,
+	)
+	return rows, err
+}
+
 // InsertIntoCurrentExecutions inserts a single row into current_executions table
 func (mdb *db) InsertIntoCurrentExecutions(
 	ctx context.Context,
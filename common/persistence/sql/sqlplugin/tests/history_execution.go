@@ -1,7 +1,9 @@
 package tests
 
 import (
+	"bytes"
 	"math/rand"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -305,6 +307,71 @@ func (s *historyExecutionSuite) TestWriteLock() {
 	s.NoError(tx.Commit())
 }
 
+func (s *historyExecutionSuite) TestInsertPaginate() {
+	shardID := rand.Int31()
+	numExecutions := 5
+	pageSize := 2
+
+	var executions []sqlplugin.ExecutionsRow
+	for i := 0; i < numExecutions; i++ {
+		execution := s.newRandomExecutionRow(shardID, primitives.NewUUID(), shuffle.String(testHistoryExecutionWorkflowID), primitives.NewUUID(), rand.Int63(), rand.Int63())
+		result, err := s.store.InsertIntoExecutions(newExecutionContext(), &execution)
+		s.NoError(err)
+		rowsAffected, err := result.RowsAffected()
+		s.NoError(err)
+		s.Equal(1, int(rowsAffected))
+		executions = append(executions, execution)
+	}
+	sort.Slice(executions, func(i, j int) bool {
+		if c := bytes.Compare(executions[i].NamespaceID, executions[j].NamespaceID); c != 0 {
+			return c < 0
+		}
+		if executions[i].WorkflowID != executions[j].WorkflowID {
+			return executions[i].WorkflowID < executions[j].WorkflowID
+		}
+		return bytes.Compare(executions[i].RunID, executions[j].RunID) < 0
+	})
+
+	// execution in another shard should not be returned
+	otherExecution := s.newRandomExecutionRow(shardID+1, primitives.NewUUID(), shuffle.String(testHistoryExecutionWorkflowID), primitives.NewUUID(), rand.Int63(), rand.Int63())
+	_, err := s.store.InsertIntoExecutions(newExecutionContext(), &otherExecution)
+	s.NoError(err)
+
+	page := sqlplugin.ExecutionsPage{
+		ShardID:     shardID,
+		NamespaceID: primitives.UUID{},
+		RunID:       primitives.UUID{},
+		Limit:       pageSize,
+	}
+	var rows []sqlplugin.ExecutionsRow
+	for {
+		pageRows, err := s.store.PaginateFromExecutions(newExecutionContext(), page)
+		s.NoError(err)
+		s.LessOrEqual(len(pageRows), pageSize)
+		rows = append(rows, pageRows...)
+		if len(pageRows) < pageSize {
+			break
+		}
+		lastRow := pageRows[len(pageRows)-1]
+		page.NamespaceID = lastRow.NamespaceID
+		page.WorkflowID = lastRow.WorkflowID
+		page.RunID = lastRow.RunID
+	}
+	s.Equal(executions, rows)
+}
+
+func (s *historyExecutionSuite) TestPaginate_Empty() {
+	page := sqlplugin.ExecutionsPage{
+		ShardID:     rand.Int31(),
+		NamespaceID: primitives.UUID{},
+		RunID:       primitives.UUID{},
+		Limit:       1,
+	}
+	rows, err := s.store.PaginateFromExecutions(newExecutionContext(), page)
+	s.NoError(err)
+	s.Empty(rows)
+}
+
 func (s *historyExecutionSuite) newRandomExecutionRow(
 	shardID int32,
 	namespaceID primitives.UUID,
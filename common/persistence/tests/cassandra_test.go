@@ -199,6 +199,23 @@ func TestCassandraHistoryStoreSuite(t *testing.T) {
 	suite.Run(t, s)
 }
 
+func TestCassandraHistoryCompressionSuite(t *testing.T) {
+	testData, tearDown := setUpCassandraTest(t)
+	defer tearDown()
+
+	shardStore, err := testData.Factory.NewShardStore()
+	if err != nil {
+		t.Fatalf("unable to create Cassandra DB: %v", err)
+	}
+	executionStore, err := testData.Factory.NewExecutionStore()
+	if err != nil {
+		t.Fatalf("unable to create Cassandra DB: %v", err)
+	}
+
+	s := NewHistoryCompressionSuite(t, shardStore, executionStore, testData.Logger)
+	suite.Run(t, s)
+}
+
 func TestCassandraTaskQueueSuite(t *testing.T) {
 	testData, tearDown := setUpCassandraTest(t)
 	defer tearDown()
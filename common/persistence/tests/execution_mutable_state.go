@@ -22,6 +22,7 @@ import (
 	"go.temporal.io/server/common/debug"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
 	p "go.temporal.io/server/common/persistence"
 	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -72,6 +73,8 @@ func NewExecutionMutableStateSuite(
 			nil,
 			logger,
 			dynamicconfig.GetIntPropertyFn(4*1024*1024),
+			nil,
+			metrics.NoopMetricsHandler,
 		),
 		historyBranchUtil: historyBranchUtil,
 		Logger:            logger,
@@ -2027,6 +2030,42 @@ func (s *ExecutionMutableStateSuite) TestDelete_NotExists() {
 	s.AssertMissingFromDB(s.NamespaceID, s.WorkflowID, s.RunID)
 }
 
+func (s *ExecutionMutableStateSuite) TestListConcreteExecutions() {
+	runIDs := make(map[string]struct{})
+	for i := 0; i < 3; i++ {
+		s.WorkflowID = uuid.New().String()
+		s.RunID = uuid.New().String()
+		s.CreateWorkflow(
+			rand.Int63(),
+			enumsspb.WORKFLOW_EXECUTION_STATE_CREATED,
+			enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+			rand.Int63(),
+		)
+		runIDs[s.RunID] = struct{}{}
+	}
+
+	var pageToken []byte
+	for {
+		resp, err := s.ExecutionManager.ListConcreteExecutions(s.Ctx, &p.ListConcreteExecutionsRequest{
+			ShardID:   s.ShardID,
+			PageSize:  2,
+			PageToken: pageToken,
+		})
+		s.NoError(err)
+		for _, state := range resp.States {
+			if state.ExecutionInfo.NamespaceId != s.NamespaceID {
+				continue
+			}
+			delete(runIDs, state.ExecutionState.RunId)
+		}
+		pageToken = resp.PageToken
+		if len(pageToken) == 0 {
+			break
+		}
+	}
+	s.Empty(runIDs)
+}
+
 func (s *ExecutionMutableStateSuite) CreateWorkflow(
 	lastWriteVersion int64,
 	state enumsspb.WorkflowExecutionState,
@@ -0,0 +1,300 @@
+package tests
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/debug"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	p "go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/testing/protorequire"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	HistoryCompressionSuite struct {
+		suite.Suite
+		*require.Assertions
+		protorequire.ProtoAssertions
+
+		ShardID     int32
+		RangeID     int64
+		NamespaceID string
+
+		ShardManager   p.ShardManager
+		ExecutionStore p.ExecutionStore
+		Logger         log.Logger
+
+		Ctx    context.Context
+		Cancel context.CancelFunc
+	}
+)
+
+func NewHistoryCompressionSuite(
+	t *testing.T,
+	shardStore p.ShardStore,
+	executionStore p.ExecutionStore,
+	logger log.Logger,
+) *HistoryCompressionSuite {
+	serializer := serialization.NewSerializer()
+	return &HistoryCompressionSuite{
+		Assertions:      require.New(t),
+		ProtoAssertions: protorequire.New(t),
+		ShardManager: p.NewShardManager(
+			shardStore,
+			serializer,
+		),
+		ExecutionStore: executionStore,
+		Logger:         logger,
+	}
+}
+
+func (s *HistoryCompressionSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+	s.ProtoAssertions = protorequire.New(s.T())
+	s.Ctx, s.Cancel = context.WithTimeout(context.Background(), 30*time.Second*debug.TimeoutMultiplier)
+
+	s.ShardID++
+	resp, err := s.ShardManager.GetOrCreateShard(s.Ctx, &p.GetOrCreateShardRequest{
+		ShardID: s.ShardID,
+		InitialShardInfo: &persistencespb.ShardInfo{
+			ShardId: s.ShardID,
+			RangeId: 1,
+		},
+	})
+	s.NoError(err)
+	s.RangeID = resp.ShardInfo.RangeId
+	s.NamespaceID = uuid.New()
+}
+
+func (s *HistoryCompressionSuite) TearDownTest() {
+	s.Cancel()
+}
+
+func (s *HistoryCompressionSuite) TestHistory_CompressedToUncompressed() {
+	for _, compressionType := range []serialization.CompressionType{
+		serialization.CompressionTypeZstd,
+		serialization.CompressionTypeBrotli,
+	} {
+		compressed := s.newExecutionManager(compressionType)
+		uncompressed := s.newExecutionManager(serialization.CompressionTypeNone)
+
+		branchToken := s.newHistoryBranch(compressed)
+		events := s.newHistoryEvents(1, 50)
+		_, err := compressed.AppendHistoryNodes(s.Ctx, &p.AppendHistoryNodesRequest{
+			ShardID:       s.ShardID,
+			NamespaceID:   s.NamespaceID,
+			BranchToken:   branchToken,
+			Events:        events,
+			TransactionID: 1,
+			IsNewBranch:   true,
+		})
+		s.NoError(err)
+
+		nodes := s.readStoreHistoryNodes(compressed, branchToken)
+		s.Len(nodes, 1)
+		s.True(serialization.IsCompressedBlob(nodes[0].Events))
+
+		protorequire.ProtoSliceEqual(s.T(), events, s.readHistoryEvents(uncompressed, branchToken))
+		protorequire.ProtoSliceEqual(s.T(), events, s.readHistoryEvents(compressed, branchToken))
+
+		resp, err := compressed.ReadRawHistoryBranch(s.Ctx, &p.ReadHistoryBranchRequest{
+			ShardID:     s.ShardID,
+			BranchToken: branchToken,
+			MinEventID:  common.FirstEventID,
+			MaxEventID:  common.LastEventID,
+			PageSize:    10,
+		})
+		s.NoError(err)
+		s.Len(resp.HistoryEventBlobs, 1)
+		s.False(serialization.IsCompressedBlob(resp.HistoryEventBlobs[0]))
+	}
+}
+
+func (s *HistoryCompressionSuite) TestHistory_UncompressedToCompressed() {
+	compressed := s.newExecutionManager(serialization.CompressionTypeZstd)
+	uncompressed := s.newExecutionManager(serialization.CompressionTypeNone)
+
+	branchToken := s.newHistoryBranch(uncompressed)
+	events := s.newHistoryEvents(1, 50)
+	_, err := uncompressed.AppendHistoryNodes(s.Ctx, &p.AppendHistoryNodesRequest{
+		ShardID:       s.ShardID,
+		NamespaceID:   s.NamespaceID,
+		BranchToken:   branchToken,
+		Events:        events,
+		TransactionID: 1,
+		IsNewBranch:   true,
+	})
+	s.NoError(err)
+
+	moreEvents := s.newHistoryEvents(51, 100)
+	blob, err := serialization.NewSerializer().SerializeEvents(moreEvents, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	_, err = compressed.AppendRawHistoryNodes(s.Ctx, &p.AppendRawHistoryNodesRequest{
+		ShardID:           s.ShardID,
+		NamespaceID:       s.NamespaceID,
+		BranchToken:       branchToken,
+		NodeID:            51,
+		TransactionID:     2,
+		PrevTransactionID: 1,
+		History:           blob,
+	})
+	s.NoError(err)
+
+	nodes := s.readStoreHistoryNodes(compressed, branchToken)
+	s.Len(nodes, 2)
+	s.False(serialization.IsCompressedBlob(nodes[0].Events))
+	s.True(serialization.IsCompressedBlob(nodes[1].Events))
+
+	protorequire.ProtoSliceEqual(s.T(), append(events, moreEvents...), s.readHistoryEvents(compressed, branchToken))
+	protorequire.ProtoSliceEqual(s.T(), append(events, moreEvents...), s.readHistoryEvents(uncompressed, branchToken))
+}
+
+func (s *HistoryCompressionSuite) TestMutableState() {
+	compressed := s.newExecutionManager(serialization.CompressionTypeBrotli)
+	uncompressed := s.newExecutionManager(serialization.CompressionTypeNone)
+
+	workflowID := uuid.New()
+	runID := uuid.New()
+	branchToken := RandomBranchToken(s.NamespaceID, workflowID, runID, compressed.GetHistoryBranchUtil())
+	snapshot, events := RandomSnapshot(
+		s.T(),
+		s.NamespaceID,
+		workflowID,
+		runID,
+		common.FirstEventID,
+		rand.Int63(),
+		enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		rand.Int63(),
+		branchToken,
+	)
+	snapshot.ExecutionInfo.WorkflowTypeName = strings.Repeat("workflow-type", 100)
+	_, err := compressed.CreateWorkflowExecution(s.Ctx, &p.CreateWorkflowExecutionRequest{
+		ShardID:             s.ShardID,
+		RangeID:             s.RangeID,
+		Mode:                p.CreateWorkflowModeBrandNew,
+		NewWorkflowSnapshot: *snapshot,
+		NewWorkflowEvents:   events,
+	})
+	s.NoError(err)
+
+	request := &p.GetWorkflowExecutionRequest{
+		ShardID:     s.ShardID,
+		NamespaceID: s.NamespaceID,
+		WorkflowID:  workflowID,
+		RunID:       runID,
+	}
+	storeResp, err := s.ExecutionStore.GetWorkflowExecution(s.Ctx, request)
+	s.NoError(err)
+	s.True(serialization.IsCompressedBlob(storeResp.State.ExecutionInfo))
+
+	for _, executionManager := range []p.ExecutionManager{compressed, uncompressed} {
+		resp, err := executionManager.GetWorkflowExecution(s.Ctx, request)
+		s.NoError(err)
+		s.ProtoEqual(snapshot.ExecutionInfo, resp.State.ExecutionInfo)
+		s.Len(resp.State.ActivityInfos, len(snapshot.ActivityInfos))
+		for scheduledEventID, activityInfo := range snapshot.ActivityInfos {
+			s.ProtoEqual(activityInfo, resp.State.ActivityInfos[scheduledEventID])
+		}
+		s.Len(resp.State.TimerInfos, len(snapshot.TimerInfos))
+		for timerID, timerInfo := range snapshot.TimerInfos {
+			s.ProtoEqual(timerInfo, resp.State.TimerInfos[timerID])
+		}
+		protorequire.ProtoSliceEqual(s.T(), events[0].Events, s.readHistoryEvents(executionManager, events[0].BranchToken))
+	}
+}
+
+func (s *HistoryCompressionSuite) newExecutionManager(
+	compressionType serialization.CompressionType,
+) p.ExecutionManager {
+	return p.NewExecutionManager(
+		s.ExecutionStore,
+		serialization.NewSerializer(),
+		nil,
+		s.Logger,
+		dynamicconfig.GetIntPropertyFn(4*1024*1024),
+		dynamicconfig.GetStringPropertyFnFilteredByNamespaceID(string(compressionType)),
+		metrics.NoopMetricsHandler,
+	)
+}
+
+func (s *HistoryCompressionSuite) newHistoryBranch(
+	executionManager p.ExecutionManager,
+) []byte {
+	branchToken, err := executionManager.GetHistoryBranchUtil().NewHistoryBranch(
+		s.NamespaceID,
+		uuid.New(),
+		uuid.New(),
+		uuid.New(),
+		nil,
+		[]*persistencespb.HistoryBranchRange{},
+		time.Duration(0),
+		time.Duration(0),
+		time.Duration(0),
+	)
+	s.NoError(err)
+	return branchToken
+}
+
+func (s *HistoryCompressionSuite) newHistoryEvents(
+	firstEventID int64,
+	lastEventID int64,
+) []*historypb.HistoryEvent {
+	events := make([]*historypb.HistoryEvent, 0, lastEventID-firstEventID+1)
+	for eventID := firstEventID; eventID <= lastEventID; eventID++ {
+		events = append(events, &historypb.HistoryEvent{
+			EventId:   eventID,
+			EventTime: timestamppb.New(time.Unix(0, rand.Int63()).UTC()),
+			EventType: enumspb.EVENT_TYPE_ACTIVITY_TASK_SCHEDULED,
+		})
+	}
+	return events
+}
+
+func (s *HistoryCompressionSuite) readHistoryEvents(
+	executionManager p.ExecutionManager,
+	branchToken []byte,
+) []*historypb.HistoryEvent {
+	resp, err := executionManager.ReadHistoryBranch(s.Ctx, &p.ReadHistoryBranchRequest{
+		ShardID:     s.ShardID,
+		BranchToken: branchToken,
+		MinEventID:  common.FirstEventID,
+		MaxEventID:  common.LastEventID,
+		PageSize:    1000,
+	})
+	s.NoError(err)
+	return resp.HistoryEvents
+}
+
+func (s *HistoryCompressionSuite) readStoreHistoryNodes(
+	executionManager p.ExecutionManager,
+	branchToken []byte,
+) []p.InternalHistoryNode {
+	branchInfo, err := executionManager.GetHistoryBranchUtil().ParseHistoryBranchInfo(branchToken)
+	s.NoError(err)
+	resp, err := s.ExecutionStore.ReadHistoryBranch(s.Ctx, &p.InternalReadHistoryBranchRequest{
+		BranchToken: branchToken,
+		ShardID:     s.ShardID,
+		BranchID:    branchInfo.GetBranchId(),
+		MinNodeID:   common.FirstEventID,
+		MaxNodeID:   common.LastEventID,
+		PageSize:    1000,
+	})
+	s.NoError(err)
+	return resp.Nodes
+}
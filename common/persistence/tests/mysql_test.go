@@ -95,6 +95,23 @@ func TestMySQLHistoryStoreSuite(t *testing.T) {
 	suite.Run(t, s)
 }
 
+func TestMySQLHistoryCompressionSuite(t *testing.T) {
+	testData, tearDown := setUpMySQLTest(t)
+	defer tearDown()
+
+	shardStore, err := testData.Factory.NewShardStore()
+	if err != nil {
+		t.Fatalf("unable to create MySQL DB: %v", err)
+	}
+	executionStore, err := testData.Factory.NewExecutionStore()
+	if err != nil {
+		t.Fatalf("unable to create MySQL DB: %v", err)
+	}
+
+	s := NewHistoryCompressionSuite(t, shardStore, executionStore, testData.Logger)
+	suite.Run(t, s)
+}
+
 func TestMySQLTaskQueueSuite(t *testing.T) {
 	testData, tearDown := setUpMySQLTest(t)
 	defer tearDown()
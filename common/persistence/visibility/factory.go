@@ -7,6 +7,7 @@ import (
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store"
 	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch"
@@ -50,6 +51,15 @@ func NewManager(
 	metricsHandler metrics.Handler,
 	logger log.Logger,
 ) (manager.VisibilityManager, error) {
+	var encryptor serialization.Encryptor
+	if persistenceCfg.Encryption != nil {
+		var err error
+		encryptor, err = serialization.NewLocalKeyEncryptor(persistenceCfg.Encryption.ActiveKeyID, persistenceCfg.Encryption.KeyFiles)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	visibilityManager, err := newVisibilityManagerFromDataStoreConfig(
 		persistenceCfg.GetVisibilityStoreConfig(),
 		persistenceResolver,
@@ -64,6 +74,7 @@ func NewManager(
 		slowQueryThreshold,
 		visibilityDisableOrderByClause,
 		visibilityEnableManualPagination,
+		encryptor,
 		metricsHandler,
 		logger,
 	)
@@ -89,6 +100,7 @@ func NewManager(
 		slowQueryThreshold,
 		visibilityDisableOrderByClause,
 		visibilityEnableManualPagination,
+		encryptor,
 		metricsHandler,
 		logger,
 	)
@@ -120,6 +132,7 @@ func newVisibilityManager(
 	maxWriteQPS dynamicconfig.IntPropertyFn,
 	operatorRPSRatio dynamicconfig.FloatPropertyFn,
 	slowQueryThreshold dynamicconfig.DurationPropertyFn,
+	encryptor serialization.Encryptor,
 	metricsHandler metrics.Handler,
 	visibilityPluginNameTag metrics.Tag,
 	visibilityIndexNameTag metrics.Tag,
@@ -133,7 +146,7 @@ func newVisibilityManager(
 		tag.NewStringTag(visibilityPluginNameTag.Key(), visibilityPluginNameTag.Value()),
 		tag.NewStringTag(visibilityIndexNameTag.Key(), visibilityIndexNameTag.Value()),
 	)
-	var visManager manager.VisibilityManager = newVisibilityManagerImpl(visStore, logger)
+	var visManager manager.VisibilityManager = newVisibilityManagerImpl(visStore, encryptor, logger)
 
 	// wrap with rate limiter
 	visManager = NewVisibilityManagerRateLimited(
@@ -171,6 +184,7 @@ func newVisibilityManagerFromDataStoreConfig(
 	slowQueryThreshold dynamicconfig.DurationPropertyFn,
 	visibilityDisableOrderByClause dynamicconfig.BoolPropertyFnWithNamespaceFilter,
 	visibilityEnableManualPagination dynamicconfig.BoolPropertyFnWithNamespaceFilter,
+	encryptor serialization.Encryptor,
 
 	metricsHandler metrics.Handler,
 	logger log.Logger,
@@ -200,6 +214,7 @@ func newVisibilityManagerFromDataStoreConfig(
 		maxWriteQPS,
 		operatorRPSRatio,
 		slowQueryThreshold,
+		encryptor,
 		metricsHandler,
 		metrics.VisibilityPluginNameTag(visStore.GetName()),
 		metrics.VisibilityIndexNameTag(visStore.GetIndexName()),
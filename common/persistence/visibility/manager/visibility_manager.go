@@ -141,6 +141,9 @@ type (
 	// GetWorkflowExecutionResponse is response to GetWorkflowExecution
 	GetWorkflowExecutionResponse struct {
 		Execution *workflowpb.WorkflowExecutionInfo
+		// MemoEncryptionKeyID is the ID of the key the memo is encrypted with at rest, it is
+		// empty if the memo is not encrypted.
+		MemoEncryptionKeyID string
 	}
 )
 
@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sync"
+
+	"github.com/blang/semver/v4"
+	"github.com/olivere/elastic/v7"
+	"github.com/olivere/elastic/v7/uritemplates"
+	"go.temporal.io/server/common/log"
+)
+
+type (
+	// clientOpenSearch implements Client for OpenSearch 2.x. OpenSearch is wire compatible with
+	// Elasticsearch 7.10 for search, scroll, count, bulk, mappings, and index templates, so those
+	// are handled by clientImpl. Point in time uses different endpoints and is overridden here.
+	clientOpenSearch struct {
+		*clientImpl
+
+		initIsOpenSearchPointInTimeSupported sync.Once
+		isOpenSearchPointInTimeSupported     bool
+	}
+
+	openSearchInfoResponse struct {
+		Version struct {
+			Distribution string `json:"distribution"`
+			Number       string `json:"number"`
+		} `json:"version"`
+	}
+
+	openSearchOpenPointInTimeResponse struct {
+		PitID string `json:"pit_id"`
+	}
+
+	openSearchClosePointInTimeResponse struct {
+		Pits []struct {
+			PitID      string `json:"pit_id"`
+			Successful bool   `json:"successful"`
+		} `json:"pits"`
+	}
+)
+
+const (
+	openSearchDistribution = "opensearch"
+)
+
+var (
+	openSearchPointInTimeSupportedIn = semver.MustParseRange(">=2.4.0")
+)
+
+var _ Client = (*clientOpenSearch)(nil)
+var _ CLIClient = (*clientOpenSearch)(nil)
+var _ IntegrationTestsClient = (*clientOpenSearch)(nil)
+
+// newOpenSearchClient create an OpenSearch client
+func newOpenSearchClient(cfg *Config, httpClient *http.Client, logger log.Logger) (*clientOpenSearch, error) {
+	client, err := newClient(cfg, httpClient, logger)
+	if err != nil {
+		return nil, err
+	}
+	return &clientOpenSearch{clientImpl: client}, nil
+}
+
+func (c *clientOpenSearch) IsPointInTimeSupported(ctx context.Context) bool {
+	c.initIsOpenSearchPointInTimeSupported.Do(func() {
+		c.isOpenSearchPointInTimeSupported = c.queryOpenSearchPointInTimeSupported(ctx)
+	})
+	return c.isOpenSearchPointInTimeSupported
+}
+
+func (c *clientOpenSearch) queryOpenSearchPointInTimeSupported(ctx context.Context) bool {
+	// PingResult from olivere/elastic/v7 doesn't have the distribution field.
+	res, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: "GET",
+		Path:   "/",
+	})
+	if err != nil {
+		return false
+	}
+	var info openSearchInfoResponse
+	if err := json.Unmarshal(res.Body, &info); err != nil {
+		return false
+	}
+	if info.Version.Distribution != openSearchDistribution {
+		return false
+	}
+	osVersion, err := semver.ParseTolerant(info.Version.Number)
+	if err != nil {
+		return false
+	}
+	return openSearchPointInTimeSupportedIn(osVersion)
+}
+
+func (c *clientOpenSearch) OpenPointInTime(ctx context.Context, index string, keepAliveInterval string) (string, error) {
+	path, err := uritemplates.Expand("/{index}/_search/point_in_time", map[string]string{
+		"index": index,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	res, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: "POST",
+		Path:   path,
+		Params: url.Values{"keep_alive": []string{keepAliveInterval}},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	var resp openSearchOpenPointInTimeResponse
+	if err := json.Unmarshal(res.Body, &resp); err != nil {
+		return "", err
+	}
+	return resp.PitID, nil
+}
+
+func (c *clientOpenSearch) ClosePointInTime(ctx context.Context, id string) (bool, error) {
+	res, err := c.esClient.PerformRequest(ctx, elastic.PerformRequestOptions{
+		Method: "DELETE",
+		Path:   "/_search/point_in_time",
+		Body:   map[string]any{"pit_id": []string{id}},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	var resp openSearchClosePointInTimeResponse
+	if err := json.Unmarshal(res.Body, &resp); err != nil {
+		return false, err
+	}
+	for _, pit := range resp.Pits {
+		if pit.PitID == id {
+			return pit.Successful, nil
+		}
+	}
+	return false, nil
+}
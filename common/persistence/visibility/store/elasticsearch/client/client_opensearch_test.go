@@ -0,0 +1,140 @@
+package client
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/olivere/elastic/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/log"
+)
+
+// newOpenSearchStandIn starts a test server which answers like OpenSearch for the given
+// version, and records the requests it gets.
+func newOpenSearchStandIn(t *testing.T, distribution string, version string) (*httptest.Server, *[]*http.Request, *[]string) {
+	var requests []*http.Request
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		reader := r.Body
+		if r.Header.Get("Content-Encoding") == "gzip" {
+			if gz, err := gzip.NewReader(r.Body); err == nil {
+				reader = gz
+			}
+		}
+		body, _ := io.ReadAll(reader)
+		requests = append(requests, r)
+		bodies = append(bodies, string(body))
+
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/":
+			resp := map[string]any{"version": map[string]any{"number": version}}
+			if distribution != "" {
+				resp["version"].(map[string]any)["distribution"] = distribution
+			}
+			_ = json.NewEncoder(w).Encode(resp)
+		case r.Method == http.MethodPost && r.URL.Path == "/test-index/_search/point_in_time":
+			_, _ = io.WriteString(w, `{"pit_id":"pit-1","_shards":{"total":1,"successful":1,"failed":0},"creation_time":1}`)
+		case r.Method == http.MethodDelete && r.URL.Path == "/_search/point_in_time":
+			_, _ = io.WriteString(w, `{"pits":[{"successful":true,"pit_id":"pit-1"}]}`)
+		case r.URL.Path == "/_search" || r.URL.Path == "/test-index/_search":
+			_, _ = io.WriteString(w, `{"took":1,"timed_out":false,"_shards":{"total":1,"successful":1,"skipped":0,"failed":0},"hits":{"max_score":null,"hits":[{"_index":"test-index","_id":"doc-1","_score":null,"sort":[1]}]},"aggregations":{"group":{"doc_count_error_upper_bound":0,"sum_other_doc_count":0,"buckets":[{"key":"Running","doc_count":2}]}}}`)
+		case r.URL.Path == "/test-index/_count":
+			_, _ = io.WriteString(w, `{"count":3,"_shards":{"total":1,"successful":1,"skipped":0,"failed":0}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `{"error":"not found"}`)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests, &bodies
+}
+
+func newOpenSearchTestClient(t *testing.T, server *httptest.Server) Client {
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	c, err := NewClient(&Config{Version: "opensearch2", URL: *serverURL}, server.Client(), log.NewNoopLogger())
+	require.NoError(t, err)
+	require.IsType(t, &clientOpenSearch{}, c)
+	return c
+}
+
+func TestOpenSearch_IsPointInTimeSupported(t *testing.T) {
+	tests := []struct {
+		name         string
+		distribution string
+		version      string
+		expected     bool
+	}{
+		{name: "opensearch 2.11", distribution: "opensearch", version: "2.11.1", expected: true},
+		{name: "opensearch 2.4", distribution: "opensearch", version: "2.4.0", expected: true},
+		{name: "opensearch 2.3", distribution: "opensearch", version: "2.3.0", expected: false},
+		{name: "elasticsearch", distribution: "", version: "7.10.2", expected: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server, _, _ := newOpenSearchStandIn(t, tc.distribution, tc.version)
+			c := newOpenSearchTestClient(t, server)
+			assert.Equal(t, tc.expected, c.IsPointInTimeSupported(context.Background()))
+		})
+	}
+}
+
+func TestOpenSearch_PointInTime(t *testing.T) {
+	server, requests, bodies := newOpenSearchStandIn(t, "opensearch", "2.11.1")
+	c := newOpenSearchTestClient(t, server)
+	ctx := context.Background()
+
+	id, err := c.OpenPointInTime(ctx, "test-index", "1m")
+	require.NoError(t, err)
+	assert.Equal(t, "pit-1", id)
+	assert.Equal(t, "1m", (*requests)[0].URL.Query().Get("keep_alive"))
+
+	result, err := c.Search(ctx, &SearchParameters{
+		Index:       "test-index",
+		Query:       elastic.NewMatchAllQuery(),
+		PageSize:    10,
+		PointInTime: elastic.NewPointInTimeWithKeepAlive(id, "1m"),
+	})
+	require.NoError(t, err)
+	require.Len(t, result.Hits.Hits, 1)
+	// with point in time, the index must not be part of the path
+	assert.Equal(t, "/_search", (*requests)[1].URL.Path)
+	assert.Contains(t, (*bodies)[1], `"pit":{"id":"pit-1","keep_alive":"1m"}`)
+
+	succeeded, err := c.ClosePointInTime(ctx, id)
+	require.NoError(t, err)
+	assert.True(t, succeeded)
+	assert.JSONEq(t, `{"pit_id":["pit-1"]}`, (*bodies)[2])
+}
+
+func TestOpenSearch_CountGroupBy(t *testing.T) {
+	server, requests, _ := newOpenSearchStandIn(t, "opensearch", "2.11.1")
+	c := newOpenSearchTestClient(t, server)
+	ctx := context.Background()
+
+	count, err := c.Count(ctx, "test-index", elastic.NewMatchAllQuery())
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+
+	result, err := c.CountGroupBy(ctx, "test-index", elastic.NewMatchAllQuery(), "group", elastic.NewTermsAggregation().Field("ExecutionStatus"))
+	require.NoError(t, err)
+	assert.Equal(t, "/test-index/_search", (*requests)[1].URL.Path)
+	agg, ok := result.Aggregations.Terms("group")
+	require.True(t, ok)
+	require.Len(t, agg.Buckets, 1)
+	assert.Equal(t, int64(2), agg.Buckets[0].DocCount)
+}
+
+func TestNewClient_UnsupportedVersion(t *testing.T) {
+	_, err := NewClient(&Config{Version: "opensearch1"}, nil, log.NewNoopLogger())
+	assert.Error(t, err)
+}
@@ -19,6 +19,8 @@ const (
 // Config for connecting to Elasticsearch
 type (
 	Config struct {
+		// Version is one of "v7", "v8" (both use the Elasticsearch 7 compatible client), or
+		// "opensearch2". Empty means "v7".
 		Version                      string                    `yaml:"version"`
 		URL                          url.URL                   `yaml:"url"`
 		URLs                         []url.URL                 `yaml:"urls"`
@@ -0,0 +1,551 @@
+package elasticsearch
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/persistence/visibility/store"
+	"go.temporal.io/server/common/persistence/visibility/store/elasticsearch/client"
+	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/tests/testutils"
+)
+
+type (
+	// OpenSearchVisibilitySuite runs the visibility store with the OpenSearch 2 client against a
+	// stand-in which answers in OpenSearch 2's response formats.
+	OpenSearchVisibilitySuite struct {
+		suite.Suite
+		*require.Assertions
+		standIn         *openSearchStandIn
+		visibilityStore *VisibilityStore
+	}
+
+	// openSearchStandIn answers like a single node OpenSearch 2.11 cluster with one index. It keeps
+	// the documents of bulk requests, and searches return them in the order they were indexed
+	// without evaluating the query.
+	openSearchStandIn struct {
+		sync.Mutex
+		index     string
+		docIDs    []string
+		docs      map[string]map[string]any
+		templates map[string]map[string]any
+		pits      map[string]bool
+		requests  []string
+	}
+)
+
+func TestOpenSearchVisibilitySuite(t *testing.T) {
+	suite.Run(t, new(OpenSearchVisibilitySuite))
+}
+
+func (s *OpenSearchVisibilitySuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.standIn = &openSearchStandIn{
+		index:     testIndex,
+		docs:      make(map[string]map[string]any),
+		templates: make(map[string]map[string]any),
+		pits:      make(map[string]bool),
+	}
+	server := httptest.NewServer(s.standIn)
+	s.T().Cleanup(server.Close)
+
+	serverURL, err := url.Parse(server.URL)
+	s.NoError(err)
+	cfg := &client.Config{
+		Version: "opensearch2",
+		URL:     *serverURL,
+		Indices: map[string]string{client.VisibilityAppName: testIndex},
+	}
+	cfg.SetHttpClient(server.Client())
+	s.visibilityStore, err = NewVisibilityStore(
+		cfg,
+		&ProcessorConfig{
+			IndexerConcurrency:       dynamicconfig.GetIntPropertyFn(32),
+			ESProcessorNumOfWorkers:  dynamicconfig.GetIntPropertyFn(1),
+			ESProcessorBulkActions:   dynamicconfig.GetIntPropertyFn(1),
+			ESProcessorBulkSize:      dynamicconfig.GetIntPropertyFn(2 << 20),
+			ESProcessorFlushInterval: dynamicconfig.GetDurationPropertyFn(time.Minute),
+			ESProcessorAckTimeout:    dynamicconfig.GetDurationPropertyFn(10 * time.Second),
+		},
+		searchattribute.NewTestProvider(),
+		searchattribute.NewTestMapperProvider(nil),
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		// the stand-in doesn't evaluate queries, so pages continue with search_after instead of a range query
+		dynamicconfig.GetBoolPropertyFnFilteredByNamespace(false),
+		metrics.NoopMetricsHandler,
+		log.NewNoopLogger(),
+	)
+	s.NoError(err)
+}
+
+func (s *OpenSearchVisibilitySuite) TearDownTest() {
+	s.visibilityStore.Close()
+}
+
+func (s *OpenSearchVisibilitySuite) recordStarted(workflowID string, status enumspb.WorkflowExecutionStatus, taskID int64) {
+	err := s.visibilityStore.RecordWorkflowExecutionStarted(context.Background(), &store.InternalRecordWorkflowExecutionStartedRequest{
+		InternalVisibilityRequestBase: &store.InternalVisibilityRequestBase{
+			NamespaceID:      testNamespaceID.String(),
+			WorkflowID:       workflowID,
+			RunID:            workflowID + "-run",
+			WorkflowTypeName: testWorkflowType,
+			StartTime:        time.Unix(0, taskID).UTC(),
+			ExecutionTime:    time.Unix(0, taskID).UTC(),
+			TaskID:           taskID,
+			ShardID:          1,
+			Status:           status,
+			TaskQueue:        "task-queue",
+		},
+	})
+	s.NoError(err)
+}
+
+func (s *OpenSearchVisibilitySuite) TestIndexPutTemplate() {
+	templateFile := path.Join(testutils.GetRepoRootDirectory(), "schema/elasticsearch/visibility/index_template_v7.json")
+	template, err := os.ReadFile(templateFile)
+	s.NoError(err)
+
+	esClient, ok := s.visibilityStore.GetEsClient().(client.IntegrationTestsClient)
+	s.True(ok)
+	acknowledged, err := esClient.IndexPutTemplate(context.Background(), "temporal_visibility_v1_template", string(template))
+	s.NoError(err)
+	s.True(acknowledged)
+	s.standIn.Lock()
+	defer s.standIn.Unlock()
+	s.Contains(s.standIn.templates, "temporal_visibility_v1_template")
+}
+
+func (s *OpenSearchVisibilitySuite) TestBulkAndGet() {
+	s.recordStarted(testWorkflowID, enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, 7)
+
+	resp, err := s.visibilityStore.GetWorkflowExecution(context.Background(), &manager.GetWorkflowExecutionRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		WorkflowID:  testWorkflowID,
+		RunID:       testWorkflowID + "-run",
+	})
+	s.NoError(err)
+	s.Equal(testWorkflowID, resp.Execution.WorkflowID)
+	s.Equal(testWorkflowType, resp.Execution.TypeName)
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, resp.Execution.Status)
+
+	err = s.visibilityStore.DeleteWorkflowExecution(context.Background(), &manager.VisibilityDeleteWorkflowExecutionRequest{
+		NamespaceID: testNamespaceID,
+		WorkflowID:  testWorkflowID,
+		RunID:       testWorkflowID + "-run",
+		TaskID:      8,
+	})
+	s.NoError(err)
+	s.standIn.Lock()
+	defer s.standIn.Unlock()
+	s.Empty(s.standIn.docs)
+}
+
+func (s *OpenSearchVisibilitySuite) TestListWorkflowExecutions() {
+	for i := 0; i < 3; i++ {
+		s.recordStarted(fmt.Sprintf("wf-%d", i), enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, int64(i+1))
+	}
+
+	request := &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		PageSize:    2,
+	}
+	var workflowIDs []string
+	for {
+		resp, err := s.visibilityStore.ListWorkflowExecutions(context.Background(), request)
+		s.NoError(err)
+		for _, execution := range resp.Executions {
+			workflowIDs = append(workflowIDs, execution.WorkflowID)
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+	s.Equal([]string{"wf-0", "wf-1", "wf-2"}, workflowIDs)
+}
+
+func (s *OpenSearchVisibilitySuite) TestScanWorkflowExecutions_PointInTime() {
+	for i := 0; i < 3; i++ {
+		s.recordStarted(fmt.Sprintf("wf-%d", i), enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, int64(i+1))
+	}
+
+	request := &manager.ListWorkflowExecutionsRequestV2{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		PageSize:    2,
+	}
+	var workflowIDs []string
+	for {
+		resp, err := s.visibilityStore.ScanWorkflowExecutions(context.Background(), request)
+		s.NoError(err)
+		for _, execution := range resp.Executions {
+			workflowIDs = append(workflowIDs, execution.WorkflowID)
+		}
+		if len(resp.NextPageToken) == 0 {
+			break
+		}
+		request.NextPageToken = resp.NextPageToken
+	}
+	s.Equal([]string{"wf-0", "wf-1", "wf-2"}, workflowIDs)
+	s.standIn.Lock()
+	defer s.standIn.Unlock()
+	s.Contains(s.standIn.requests, "POST /test-index/_search/point_in_time")
+	s.Contains(s.standIn.requests, "DELETE /_search/point_in_time")
+	s.Empty(s.standIn.pits)
+}
+
+func (s *OpenSearchVisibilitySuite) TestCountWorkflowExecutions() {
+	s.recordStarted("wf-0", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, 1)
+	s.recordStarted("wf-1", enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, 2)
+	s.recordStarted("wf-2", enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED, 3)
+
+	resp, err := s.visibilityStore.CountWorkflowExecutions(context.Background(), &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+	})
+	s.NoError(err)
+	s.Equal(int64(3), resp.Count)
+
+	resp, err = s.visibilityStore.CountWorkflowExecutions(context.Background(), &manager.CountWorkflowExecutionsRequest{
+		NamespaceID: testNamespaceID,
+		Namespace:   testNamespace,
+		Query:       "GROUP BY ExecutionStatus",
+	})
+	s.NoError(err)
+	s.Equal(int64(3), resp.Count)
+	s.Len(resp.Groups, 2)
+	counts := make(map[string]int64)
+	for _, group := range resp.Groups {
+		s.Len(group.GroupValues, 1)
+		var status string
+		s.NoError(json.Unmarshal(group.GroupValues[0].GetData(), &status))
+		counts[status] = group.Count
+	}
+	s.Equal(map[string]int64{"Running": 2, "Completed": 1}, counts)
+}
+
+func (o *openSearchStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	o.Lock()
+	defer o.Unlock()
+
+	reader := r.Body
+	if r.Header.Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(r.Body)
+		if err != nil {
+			o.writeError(w, http.StatusBadRequest, "parse_exception", err.Error())
+			return
+		}
+		reader = gz
+	}
+	body, err := io.ReadAll(reader)
+	if err != nil {
+		o.writeError(w, http.StatusBadRequest, "parse_exception", err.Error())
+		return
+	}
+	o.requests = append(o.requests, r.Method+" "+r.URL.Path)
+
+	indexPath := "/" + o.index
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/":
+		o.write(w, http.StatusOK, map[string]any{
+			"name":         "opensearch-node1",
+			"cluster_name": "opensearch-cluster",
+			"cluster_uuid": "cRuSq3hvSB-bGeMyRx6yIw",
+			"version": map[string]any{
+				"distribution":                        "opensearch",
+				"number":                              "2.11.1",
+				"build_type":                          "tar",
+				"build_snapshot":                      false,
+				"lucene_version":                      "9.7.0",
+				"minimum_wire_compatibility_version":  "7.10.0",
+				"minimum_index_compatibility_version": "7.0.0",
+			},
+			"tagline": "The OpenSearch Project: https://opensearch.org/",
+		})
+	case r.Method == http.MethodPut && strings.HasPrefix(r.URL.Path, "/_template/"):
+		o.putTemplate(w, strings.TrimPrefix(r.URL.Path, "/_template/"), body)
+	case r.Method == http.MethodPost && r.URL.Path == "/_bulk":
+		o.bulk(w, body)
+	case r.Method == http.MethodGet && strings.HasPrefix(r.URL.Path, indexPath+"/_doc/"):
+		o.get(w, strings.TrimPrefix(r.URL.Path, indexPath+"/_doc/"))
+	case r.Method == http.MethodPost && r.URL.Path == indexPath+"/_count":
+		o.write(w, http.StatusOK, map[string]any{"count": len(o.docIDs), "_shards": o.shards()})
+	case r.Method == http.MethodPost && r.URL.Path == indexPath+"/_search/point_in_time":
+		pitID := fmt.Sprintf("pit-%d", len(o.requests))
+		o.pits[pitID] = true
+		o.write(w, http.StatusOK, map[string]any{"pit_id": pitID, "_shards": o.shards(), "creation_time": time.Now().UnixMilli()})
+	case r.Method == http.MethodDelete && r.URL.Path == "/_search/point_in_time":
+		o.deletePointInTime(w, body)
+	case r.Method == http.MethodPost && (r.URL.Path == "/_search" || r.URL.Path == indexPath+"/_search"):
+		o.search(w, r.URL.Path == "/_search", body)
+	default:
+		o.writeError(w, http.StatusBadRequest, "illegal_argument_exception", "unexpected request "+r.Method+" "+r.URL.Path)
+	}
+}
+
+func (o *openSearchStandIn) putTemplate(w http.ResponseWriter, name string, body []byte) {
+	var template map[string]any
+	if err := json.Unmarshal(body, &template); err != nil {
+		o.writeError(w, http.StatusBadRequest, "parse_exception", err.Error())
+		return
+	}
+	if _, ok := template["index_patterns"]; !ok {
+		o.writeError(w, http.StatusBadRequest, "action_request_validation_exception", "Validation Failed: 1: index patterns are missing;")
+		return
+	}
+	o.templates[name] = template
+	o.write(w, http.StatusOK, map[string]any{"acknowledged": true})
+}
+
+func (o *openSearchStandIn) bulk(w http.ResponseWriter, body []byte) {
+	var items []map[string]any
+	scanner := bufio.NewScanner(strings.NewReader(string(body)))
+	scanner.Buffer(nil, len(body)+1)
+	for scanner.Scan() {
+		var action map[string]struct {
+			Index       string `json:"_index"`
+			ID          string `json:"_id"`
+			Version     int64  `json:"version"`
+			VersionType string `json:"version_type"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &action); err != nil {
+			o.writeError(w, http.StatusBadRequest, "parse_exception", err.Error())
+			return
+		}
+		for op, meta := range action {
+			item := map[string]any{
+				"_index":        meta.Index,
+				"_id":           meta.ID,
+				"_version":      meta.Version,
+				"_shards":       map[string]any{"total": 2, "successful": 1, "failed": 0},
+				"_seq_no":       len(items),
+				"_primary_term": 1,
+			}
+			switch op {
+			case "index":
+				var doc map[string]any
+				if !scanner.Scan() || json.Unmarshal(scanner.Bytes(), &doc) != nil {
+					o.writeError(w, http.StatusBadRequest, "parse_exception", "missing document of index request")
+					return
+				}
+				if _, ok := o.docs[meta.ID]; !ok {
+					o.docIDs = append(o.docIDs, meta.ID)
+				}
+				o.docs[meta.ID] = doc
+				item["result"] = "created"
+				item["status"] = http.StatusCreated
+			case "delete":
+				if _, ok := o.docs[meta.ID]; ok {
+					delete(o.docs, meta.ID)
+					o.docIDs = slices.DeleteFunc(o.docIDs, func(id string) bool { return id == meta.ID })
+					item["result"] = "deleted"
+					item["status"] = http.StatusOK
+				} else {
+					item["result"] = "not_found"
+					item["status"] = http.StatusNotFound
+				}
+			default:
+				o.writeError(w, http.StatusBadRequest, "illegal_argument_exception", "unexpected bulk operation "+op)
+				return
+			}
+			items = append(items, map[string]any{op: item})
+		}
+	}
+	o.write(w, http.StatusOK, map[string]any{"took": 3, "errors": false, "items": items})
+}
+
+func (o *openSearchStandIn) get(w http.ResponseWriter, docID string) {
+	doc, ok := o.docs[docID]
+	if !ok {
+		o.write(w, http.StatusNotFound, map[string]any{"_index": o.index, "_id": docID, "found": false})
+		return
+	}
+	o.write(w, http.StatusOK, map[string]any{
+		"_index":        o.index,
+		"_id":           docID,
+		"_version":      1,
+		"_seq_no":       0,
+		"_primary_term": 1,
+		"found":         true,
+		"_source":       doc,
+	})
+}
+
+func (o *openSearchStandIn) deletePointInTime(w http.ResponseWriter, body []byte) {
+	var request struct {
+		PitID []string `json:"pit_id"`
+	}
+	if err := json.Unmarshal(body, &request); err != nil {
+		o.writeError(w, http.StatusBadRequest, "parse_exception", err.Error())
+		return
+	}
+	var pits []map[string]any
+	for _, pitID := range request.PitID {
+		pits = append(pits, map[string]any{"pit_id": pitID, "successful": o.pits[pitID]})
+		delete(o.pits, pitID)
+	}
+	o.write(w, http.StatusOK, map[string]any{"pits": pits})
+}
+
+func (o *openSearchStandIn) search(w http.ResponseWriter, withPointInTime bool, body []byte) {
+	var request struct {
+		Size         *int              `json:"size"`
+		Sort         []json.RawMessage `json:"sort"`
+		SearchAfter  []any             `json:"search_after"`
+		Aggregations map[string]struct {
+			Terms struct {
+				Field string `json:"field"`
+			} `json:"terms"`
+		} `json:"aggregations"`
+		Pit *struct {
+			ID string `json:"id"`
+		} `json:"pit"`
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	if err := decoder.Decode(&request); err != nil {
+		o.writeError(w, http.StatusBadRequest, "parse_exception", err.Error())
+		return
+	}
+	if withPointInTime != (request.Pit != nil) {
+		o.writeError(w, http.StatusBadRequest, "illegal_argument_exception", "[indices] cannot be used with point in time")
+		return
+	}
+	if request.Pit != nil && !o.pits[request.Pit.ID] {
+		o.writeError(w, http.StatusNotFound, "search_context_missing_exception", "No search context found for id ["+request.Pit.ID+"]")
+		return
+	}
+
+	response := map[string]any{"took": 2, "timed_out": false, "_shards": o.shards()}
+	if request.Pit != nil {
+		response["pit_id"] = request.Pit.ID
+	}
+
+	aggregations := make(map[string]any)
+	for name, agg := range request.Aggregations {
+		counts := make(map[string]int)
+		var keys []string
+		for _, docID := range o.docIDs {
+			key := fmt.Sprint(o.docs[docID][agg.Terms.Field])
+			if counts[key] == 0 {
+				keys = append(keys, key)
+			}
+			counts[key]++
+		}
+		buckets := make([]map[string]any, 0, len(keys))
+		for _, key := range keys {
+			buckets = append(buckets, map[string]any{"key": key, "doc_count": counts[key]})
+		}
+		aggregations[name] = map[string]any{
+			"doc_count_error_upper_bound": 0,
+			"sum_other_doc_count":         0,
+			"buckets":                     buckets,
+		}
+	}
+	if len(aggregations) > 0 {
+		response["aggregations"] = aggregations
+	}
+
+	sortValues := make([][]any, len(o.docIDs))
+	start := 0
+	for i := range o.docIDs {
+		sortValues[i] = o.sortValues(i, request.Sort)
+		if len(request.SearchAfter) > 0 && fmt.Sprint(sortValues[i]) == fmt.Sprint(request.SearchAfter) {
+			start = i + 1
+		}
+	}
+	end := len(o.docIDs)
+	if request.Size != nil {
+		end = min(end, start+*request.Size)
+	}
+	hits := make([]map[string]any, 0)
+	for i := start; i < end; i++ {
+		hits = append(hits, map[string]any{
+			"_index":  o.index,
+			"_id":     o.docIDs[i],
+			"_score":  nil,
+			"_source": o.docs[o.docIDs[i]],
+			"sort":    sortValues[i],
+		})
+	}
+	response["hits"] = map[string]any{"max_score": nil, "hits": hits}
+	o.write(w, http.StatusOK, response)
+}
+
+// sortValues returns the sort values of the i-th document for the sort clauses of a search, like
+// OpenSearch does: dates as epoch nanoseconds, the minimum long for missing values, and the
+// document position for _doc.
+func (o *openSearchStandIn) sortValues(i int, sort []json.RawMessage) []any {
+	values := make([]any, 0, len(sort))
+	for _, clause := range sort {
+		var field string
+		if json.Unmarshal(clause, &field) != nil {
+			var fieldSort map[string]json.RawMessage
+			_ = json.Unmarshal(clause, &fieldSort)
+			for f := range fieldSort {
+				field = f
+			}
+		}
+		if field == "_doc" {
+			values = append(values, i)
+			continue
+		}
+		switch v := o.docs[o.docIDs[i]][field].(type) {
+		case nil:
+			values = append(values, int64(math.MinInt64))
+		case string:
+			if t, err := time.Parse(time.RFC3339Nano, v); err == nil {
+				values = append(values, t.UnixNano())
+			} else {
+				values = append(values, v)
+			}
+		default:
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+func (o *openSearchStandIn) shards() map[string]any {
+	return map[string]any{"total": 1, "successful": 1, "skipped": 0, "failed": 0}
+}
+
+func (o *openSearchStandIn) write(w http.ResponseWriter, status int, response any) {
+	w.Header().Set("Content-Type", "application/json; charset=UTF-8")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(response)
+}
+
+func (o *openSearchStandIn) writeError(w http.ResponseWriter, status int, errorType string, reason string) {
+	cause := map[string]any{"type": errorType, "reason": reason}
+	o.write(w, status, map[string]any{
+		"error":  map[string]any{"root_cause": []any{cause}, "type": errorType, "reason": reason},
+		"status": status,
+	})
+}
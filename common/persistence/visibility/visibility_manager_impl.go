@@ -26,8 +26,10 @@ type (
 	//  - call underlying store (standard or advanced),
 	//  - convert response.
 	visibilityManagerImpl struct {
-		store  store.VisibilityStore
-		logger log.Logger
+		store store.VisibilityStore
+		// encryptor encrypts memos at rest, it is nil if encryption is not configured
+		encryptor serialization.Encryptor
+		logger    log.Logger
 	}
 )
 
@@ -40,11 +42,13 @@ var _ manager.VisibilityManager = (*visibilityManagerImpl)(nil)
 
 func newVisibilityManagerImpl(
 	store store.VisibilityStore,
+	encryptor serialization.Encryptor,
 	logger log.Logger,
 ) *visibilityManagerImpl {
 	return &visibilityManagerImpl{
-		store:  store,
-		logger: logger,
+		store:     store,
+		encryptor: encryptor,
+		logger:    logger,
 	}
 }
 
@@ -176,7 +180,14 @@ func (p *visibilityManagerImpl) GetWorkflowExecution(
 	if err != nil {
 		return nil, err
 	}
-	return &manager.GetWorkflowExecutionResponse{Execution: execution}, err
+	var memoKeyID string
+	if response.Execution != nil {
+		memoKeyID, _ = serialization.EncryptionKeyID(response.Execution.Memo)
+	}
+	return &manager.GetWorkflowExecutionResponse{
+		Execution:           execution,
+		MemoEncryptionKeyID: memoKeyID,
+	}, err
 }
 
 func (p *visibilityManagerImpl) newInternalVisibilityRequestBase(
@@ -189,6 +200,11 @@ func (p *visibilityManagerImpl) newInternalVisibilityRequestBase(
 	if err != nil {
 		return nil, err
 	}
+	if p.encryptor != nil {
+		if memoBlob, err = p.encryptor.Encrypt(memoBlob); err != nil {
+			return nil, err
+		}
+	}
 
 	var searchAttrs *commonpb.SearchAttributes
 	if len(request.SearchAttributes.GetIndexedFields()) > 0 {
@@ -261,7 +277,14 @@ func (p *visibilityManagerImpl) convertInternalWorkflowExecutionInfo(
 	if internalExecution == nil {
 		return nil, nil
 	}
-	memo, err := deserializeMemo(internalExecution.Memo)
+	memoBlob := internalExecution.Memo
+	if p.encryptor != nil {
+		var err error
+		if memoBlob, err = p.encryptor.Decrypt(memoBlob); err != nil {
+			return nil, err
+		}
+	}
+	memo, err := deserializeMemo(memoBlob)
 	if err != nil {
 		return nil, err
 	}
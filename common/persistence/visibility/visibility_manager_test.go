@@ -1,6 +1,7 @@
 package visibility
 
 import (
+	"bytes"
 	"context"
 	"testing"
 	"time"
@@ -14,6 +15,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/sql/sqlplugin/mysql"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/persistence/visibility/store"
@@ -58,6 +60,7 @@ func (s *VisibilityManagerSuite) SetupTest() {
 		dynamicconfig.GetIntPropertyFn(1),
 		dynamicconfig.GetFloatPropertyFn(0.2),
 		dynamicconfig.GetDurationPropertyFn(time.Second),
+		nil,
 		s.metricsHandler,
 		metrics.VisibilityPluginNameTag(s.visibilityStore.GetName()),
 		metrics.VisibilityIndexNameTag(s.visibilityStore.GetIndexName()),
@@ -193,3 +196,36 @@ func (s *VisibilityManagerSuite) TestGetWorkflowExecution() {
 	_, err = s.visibilityManager.GetWorkflowExecution(context.Background(), request)
 	s.Equal(persistence.ErrPersistenceSystemLimitExceeded, err)
 }
+
+func (s *VisibilityManagerSuite) TestGetWorkflowExecution_MemoEncryptionKeyID() {
+	encryptor, err := serialization.NewEncryptor("key-1", map[string][]byte{"key-1": bytes.Repeat([]byte{1}, 32)})
+	s.NoError(err)
+	visibilityManager := newVisibilityManagerImpl(s.visibilityStore, encryptor, log.NewNoopLogger())
+	memo := &commonpb.Memo{Fields: map[string]*commonpb.Payload{"key": {Data: []byte("value")}}}
+	memoBlob, err := serializeMemo(memo)
+	s.NoError(err)
+	encryptedMemoBlob, err := encryptor.Encrypt(memoBlob)
+	s.NoError(err)
+
+	for _, tc := range []struct {
+		memo  *commonpb.DataBlob
+		keyID string
+	}{
+		{memo: encryptedMemoBlob, keyID: "key-1"},
+		{memo: memoBlob, keyID: ""},
+	} {
+		s.visibilityStore.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+			&store.InternalGetWorkflowExecutionResponse{Execution: &store.InternalWorkflowExecutionInfo{Memo: tc.memo}},
+			nil,
+		)
+		response, err := visibilityManager.GetWorkflowExecution(context.Background(), &manager.GetWorkflowExecutionRequest{
+			NamespaceID: testNamespaceUUID,
+			Namespace:   testNamespace,
+			RunID:       testWorkflowExecution.RunId,
+			WorkflowID:  testWorkflowExecution.WorkflowId,
+		})
+		s.NoError(err)
+		s.Equal(tc.keyID, response.MemoEncryptionKeyID)
+		s.Equal("value", string(response.Execution.Memo.Fields["key"].Data))
+	}
+}
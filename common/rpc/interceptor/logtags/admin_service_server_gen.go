@@ -29,10 +29,18 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.CloseShardResponse:
 		return nil
+	case *adminservice.CreateScheduleExclusionCalendarRequest:
+		return nil
+	case *adminservice.CreateScheduleExclusionCalendarResponse:
+		return nil
 	case *adminservice.DeepHealthCheckRequest:
 		return nil
 	case *adminservice.DeepHealthCheckResponse:
 		return nil
+	case *adminservice.DeleteScheduleExclusionCalendarRequest:
+		return nil
+	case *adminservice.DeleteScheduleExclusionCalendarResponse:
+		return nil
 	case *adminservice.DeleteWorkflowExecutionRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -62,10 +70,18 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.DescribeMutableStateResponse:
 		return nil
+	case *adminservice.DescribeScheduleExclusionCalendarRequest:
+		return nil
+	case *adminservice.DescribeScheduleExclusionCalendarResponse:
+		return nil
 	case *adminservice.DescribeTaskQueuePartitionRequest:
 		return nil
 	case *adminservice.DescribeTaskQueuePartitionResponse:
 		return nil
+	case *adminservice.ExplainDynamicConfigRequest:
+		return nil
+	case *adminservice.ExplainDynamicConfigResponse:
+		return nil
 	case *adminservice.ForceUnloadTaskQueuePartitionRequest:
 		return nil
 	case *adminservice.ForceUnloadTaskQueuePartitionResponse:
@@ -89,6 +105,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.GetDLQTasksResponse:
 		return nil
+	case *adminservice.GetDynamicConfigRequest:
+		return nil
+	case *adminservice.GetDynamicConfigResponse:
+		return nil
 	case *adminservice.GetNamespaceRequest:
 		return nil
 	case *adminservice.GetNamespaceResponse:
@@ -134,6 +154,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.ImportWorkflowExecutionResponse:
 		return nil
+	case *adminservice.ListBatchOperationResultsRequest:
+		return nil
+	case *adminservice.ListBatchOperationResultsResponse:
+		return nil
 	case *adminservice.ListClusterMembersRequest:
 		return nil
 	case *adminservice.ListClusterMembersResponse:
@@ -142,6 +166,14 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.ListClustersResponse:
 		return nil
+	case *adminservice.ListDynamicConfigRequest:
+		return nil
+	case *adminservice.ListDynamicConfigResponse:
+		return nil
+	case *adminservice.ListDynamicConfigDeviationsRequest:
+		return nil
+	case *adminservice.ListDynamicConfigDeviationsResponse:
+		return nil
 	case *adminservice.ListHistoryTasksRequest:
 		return nil
 	case *adminservice.ListHistoryTasksResponse:
@@ -150,6 +182,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.ListQueuesResponse:
 		return nil
+	case *adminservice.ListScheduleExclusionCalendarsRequest:
+		return nil
+	case *adminservice.ListScheduleExclusionCalendarsResponse:
+		return nil
 	case *adminservice.MergeDLQMessagesRequest:
 		return nil
 	case *adminservice.MergeDLQMessagesResponse:
@@ -158,6 +194,20 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		return nil
 	case *adminservice.MergeDLQTasksResponse:
 		return nil
+	case *adminservice.ModifyActivityPropertiesRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *adminservice.ModifyActivityPropertiesResponse:
+		return nil
+	case *adminservice.ModifyWorkflowPropertiesRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *adminservice.ModifyWorkflowPropertiesResponse:
+		return nil
 	case *adminservice.PurgeDLQMessagesRequest:
 		return nil
 	case *adminservice.PurgeDLQMessagesResponse:
@@ -206,6 +256,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.ResendReplicationTasksResponse:
 		return nil
+	case *adminservice.SetDynamicConfigRequest:
+		return nil
+	case *adminservice.SetDynamicConfigResponse:
+		return nil
 	case *adminservice.SyncWorkflowStateRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
@@ -213,6 +267,10 @@ func (wt *WorkflowTags) extractFromAdminServiceServerMessage(message any) []tag.
 		}
 	case *adminservice.SyncWorkflowStateResponse:
 		return nil
+	case *adminservice.UpdateScheduleExclusionCalendarRequest:
+		return nil
+	case *adminservice.UpdateScheduleExclusionCalendarResponse:
+		return nil
 	default:
 		return nil
 	}
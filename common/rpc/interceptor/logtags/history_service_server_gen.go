@@ -189,6 +189,27 @@ func (wt *WorkflowTags) extractFromHistoryServiceServerMessage(message any) []ta
 		return nil
 	case *historyservice.MergeDLQMessagesResponse:
 		return nil
+	case *historyservice.MigrateScheduleRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *historyservice.MigrateScheduleResponse:
+		return nil
+	case *historyservice.ModifyActivityPropertiesRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *historyservice.ModifyActivityPropertiesResponse:
+		return nil
+	case *historyservice.ModifyWorkflowPropertiesRequest:
+		return []tag.Tag{
+			tag.WorkflowID(r.GetExecution().GetWorkflowId()),
+			tag.WorkflowRunID(r.GetExecution().GetRunId()),
+		}
+	case *historyservice.ModifyWorkflowPropertiesResponse:
+		return nil
 	case *historyservice.PauseActivityRequest:
 		return []tag.Tag{
 			tag.WorkflowID(r.GetFrontendRequest().GetExecution().GetWorkflowId()),
@@ -0,0 +1,128 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	schedulepb "go.temporal.io/api/schedule/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/service/history/hsm"
+	"google.golang.org/protobuf/proto"
+)
+
+type (
+	// The Backfiller sub state machine is responsible for buffering manually
+	// requested actions (from an immediate request or backfill). Each request is
+	// handled by its own Backfiller node, which is deleted once all of the request's
+	// actions have been buffered.
+	Backfiller struct {
+		*schedulespb.BackfillerInternal
+	}
+
+	// The machine definition provides serialization/deserialization and type information.
+	backfillerMachineDefinition struct{}
+
+	BackfillerMachineState int
+)
+
+const (
+	// Unique identifier for the Backfiller sub state machine.
+	BackfillerMachineType = "scheduler.Backfiller"
+
+	// The Backfiller has only a single running state.
+	BackfillerMachineStateRunning BackfillerMachineState = 0
+)
+
+var (
+	_ hsm.StateMachine[BackfillerMachineState] = Backfiller{}
+	_ hsm.StateMachineDefinition               = &backfillerMachineDefinition{}
+)
+
+// AddBackfills adds a Backfiller sub state machine under the Scheduler node for
+// each of the patch's trigger immediately and backfill requests. Requests that
+// would exceed the maximum number of ongoing backfills (MaxBufferSize) are
+// dropped, and counted towards the Scheduler's BufferDropped.
+func AddBackfills(schedulerNode *hsm.Node, tweakables Tweakables, patch *schedulepb.SchedulePatch) error {
+	var backfillers []*schedulespb.BackfillerInternal
+	if trigger := patch.GetTriggerImmediately(); trigger != nil {
+		backfillers = append(backfillers, &schedulespb.BackfillerInternal{
+			Request: &schedulespb.BackfillerInternal_TriggerRequest{
+				TriggerRequest: common.CloneProto(trigger),
+			},
+		})
+	}
+	for _, request := range patch.GetBackfillRequest() {
+		backfillers = append(backfillers, &schedulespb.BackfillerInternal{
+			Request: &schedulespb.BackfillerInternal_BackfillRequest{
+				BackfillRequest: common.CloneProto(request),
+			},
+		})
+	}
+
+	collection := hsm.NewCollection[Backfiller](schedulerNode, BackfillerMachineType)
+	var dropped int64
+	for _, backfiller := range backfillers {
+		if collection.Size() >= tweakables.MaxBufferSize {
+			dropped++
+			continue
+		}
+
+		backfiller.BackfillId = uuid.NewString()
+		node, err := collection.Add(backfiller.BackfillId, Backfiller{backfiller})
+		if err != nil {
+			return err
+		}
+
+		// Generate the Backfiller's first task.
+		err = hsm.MachineTransition(node, func(b Backfiller) (hsm.TransitionOutput, error) {
+			return b.output()
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if dropped == 0 {
+		return nil
+	}
+	return hsm.MachineTransition(schedulerNode, func(s Scheduler) (hsm.TransitionOutput, error) {
+		return TransitionRecordAction.Apply(s, EventRecordAction{
+			Node:          schedulerNode,
+			BufferDropped: dropped,
+		})
+	})
+}
+
+func (b Backfiller) State() BackfillerMachineState {
+	return BackfillerMachineStateRunning
+}
+
+func (b Backfiller) SetState(_ BackfillerMachineState) {}
+
+func (b Backfiller) RegenerateTasks(node *hsm.Node) ([]hsm.Task, error) {
+	return b.tasks()
+}
+
+func (backfillerMachineDefinition) Type() string {
+	return BackfillerMachineType
+}
+
+func (backfillerMachineDefinition) Serialize(state any) ([]byte, error) {
+	if state, ok := state.(Backfiller); ok {
+		return proto.Marshal(state.BackfillerInternal)
+	}
+	return nil, fmt.Errorf("invalid backfiller state provided: %v", state)
+}
+
+func (backfillerMachineDefinition) Deserialize(body []byte) (any, error) {
+	state := &schedulespb.BackfillerInternal{}
+	return Backfiller{
+		BackfillerInternal: state,
+	}, proto.Unmarshal(body, state)
+}
+
+func (backfillerMachineDefinition) CompareState(a any, b any) (int, error) {
+	return 0, errors.New("unimplemented")
+}
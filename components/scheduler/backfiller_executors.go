@@ -0,0 +1,250 @@
+package scheduler
+
+import (
+	"fmt"
+	"time"
+
+	schedulepb "go.temporal.io/api/schedule/v1"
+	"go.temporal.io/api/serviceerror"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/service/history/hsm"
+	"go.uber.org/fx"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	BackfillerTaskExecutorOptions struct {
+		fx.In
+
+		Config         *Config
+		MetricsHandler metrics.Handler
+		BaseLogger     log.Logger
+		SpecProcessor  SpecProcessor
+	}
+
+	backfillerTaskExecutor struct {
+		BackfillerTaskExecutorOptions
+	}
+
+	// backfillProgressResult is the outcome of a single Backfiller iteration.
+	backfillProgressResult struct {
+		BufferedStarts []*schedulespb.BufferedStart
+
+		// High water mark to resume the backfill from.
+		LastProcessedTime time.Time
+
+		// Complete is true when all of the request's actions have been buffered (or
+		// dropped), and the Backfiller can be deleted.
+		Complete bool
+
+		// Number of actions dropped due to the max buffer size having been exceeded.
+		BufferDropped int64
+	}
+)
+
+const (
+	// Backfillers buffer at most BackfillsPerIteration actions, and then wait for
+	// backfillInterval before buffering more. This effectively rate limits large
+	// backfills.
+	backfillInterval = 1 * time.Second
+)
+
+func RegisterBackfillerExecutors(registry *hsm.Registry, options BackfillerTaskExecutorOptions) error {
+	e := backfillerTaskExecutor{
+		BackfillerTaskExecutorOptions: options,
+	}
+	return hsm.RegisterTimerExecutor(registry, e.executeBackfillTask)
+}
+
+func (e backfillerTaskExecutor) executeBackfillTask(env hsm.Environment, node *hsm.Node, task BackfillTask) error {
+	schedulerNode := node.Parent
+	scheduler, err := loadScheduler(schedulerNode, false)
+	if err != nil {
+		return err
+	}
+	logger := newTaggedLogger(e.BaseLogger, scheduler)
+
+	backfiller, err := e.loadBackfiller(node)
+	if err != nil {
+		return err
+	}
+
+	invokerNode, err := schedulerNode.Child([]hsm.Key{InvokerMachineKey})
+	if err != nil {
+		return fmt.Errorf(
+			"%w: %w",
+			serviceerror.NewInternal("Scheduler is missing its Invoker node"),
+			err,
+		)
+	}
+	invoker, err := hsm.MachineData[Invoker](invokerNode)
+	if err != nil {
+		return err
+	}
+
+	tweakables := e.Config.Tweakables(scheduler.Namespace)
+	var result backfillProgressResult
+	switch request := backfiller.Request.(type) {
+	case *schedulespb.BackfillerInternal_TriggerRequest:
+		result = e.processTrigger(env, scheduler, backfiller, invoker, tweakables)
+	case *schedulespb.BackfillerInternal_BackfillRequest:
+		// Backfills only use half of the buffer, leaving room for the Generator's
+		// actions. If the buffer is already full, back off before trying again.
+		limit := min(tweakables.BackfillsPerIteration, tweakables.MaxBufferSize/2-len(invoker.GetBufferedStarts()))
+		if limit <= 0 {
+			return hsm.MachineTransition(node, func(b Backfiller) (hsm.TransitionOutput, error) {
+				b.Attempt++
+				delay := e.Config.RetryPolicy().ComputeNextDelay(0, int(b.Attempt), nil)
+				b.NextInvocationTime = timestamppb.New(env.Now().Add(delay))
+
+				logger.Debug("Backfiller backing off, buffer is full",
+					tag.NewTimeTag("wakeupTime", b.NextInvocationTime.AsTime()))
+
+				return b.output()
+			})
+		}
+
+		result, err = e.processBackfill(scheduler, backfiller, request.BackfillRequest, limit)
+		if err != nil {
+			// An error here should be impossible, send to the DLQ.
+			logger.Error("Error processing backfill time range", tag.Error(err))
+
+			return fmt.Errorf(
+				"%w: %w",
+				serviceerror.NewInternal("Scheduler's Backfiller failed to process a time range"),
+				err,
+			)
+		}
+	default:
+		return serviceerror.NewInternalf("Scheduler's Backfiller has an unknown request type: %T", request)
+	}
+
+	// Transition the Invoker sub state machine to execute the new buffered actions.
+	if len(result.BufferedStarts) > 0 {
+		err = hsm.MachineTransition(invokerNode, func(i Invoker) (hsm.TransitionOutput, error) {
+			return TransitionEnqueue.Apply(i, EventEnqueue{
+				BufferedStarts: result.BufferedStarts,
+			})
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if result.BufferDropped > 0 {
+		err = hsm.MachineTransition(schedulerNode, func(s Scheduler) (hsm.TransitionOutput, error) {
+			return TransitionRecordAction.Apply(s, EventRecordAction{
+				Node:          schedulerNode,
+				BufferDropped: result.BufferDropped,
+			})
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	if result.Complete {
+		logger.Debug("Backfill complete", tag.NewStringTag("backfill-id", backfiller.BackfillId))
+		return schedulerNode.DeleteChild(node.Key)
+	}
+
+	// Write Backfiller internal state, flushing the high water mark to persistence.
+	// Another backfill task is also added.
+	err = hsm.MachineTransition(node, func(b Backfiller) (hsm.TransitionOutput, error) {
+		b.LastProcessedTime = timestamppb.New(result.LastProcessedTime)
+		b.NextInvocationTime = timestamppb.New(env.Now().Add(backfillInterval))
+		b.Attempt = 0
+		return b.output()
+	})
+	if err != nil {
+		return fmt.Errorf(
+			"%w: unable to transition Backfiller",
+			err,
+		)
+	}
+
+	return nil
+}
+
+// processTrigger buffers a single manual action at the current time, unless the
+// Invoker's buffer is full.
+func (e backfillerTaskExecutor) processTrigger(
+	env hsm.Environment,
+	scheduler Scheduler,
+	backfiller Backfiller,
+	invoker Invoker,
+	tweakables Tweakables,
+) backfillProgressResult {
+	if tweakables.MaxBufferSize > 0 && len(invoker.GetBufferedStarts()) >= tweakables.MaxBufferSize {
+		e.MetricsHandler.Counter(metrics.ScheduleBufferOverruns.Name()).Record(1)
+		return backfillProgressResult{
+			Complete:      true,
+			BufferDropped: 1,
+		}
+	}
+
+	now := env.Now()
+	return backfillProgressResult{
+		BufferedStarts: []*schedulespb.BufferedStart{
+			{
+				NominalTime:   timestamppb.New(now),
+				ActualTime:    timestamppb.New(now),
+				OverlapPolicy: scheduler.resolveOverlapPolicy(backfiller.GetTriggerRequest().GetOverlapPolicy()),
+				Manual:        true,
+				RequestId:     generateRequestID(scheduler, backfiller.BackfillId, now, now),
+			},
+		},
+		LastProcessedTime: now,
+		Complete:          true,
+	}
+}
+
+// processBackfill buffers up to limit actions from the backfill's time range,
+// resuming from the Backfiller's high water mark.
+func (e backfillerTaskExecutor) processBackfill(
+	scheduler Scheduler,
+	backfiller Backfiller,
+	request *schedulepb.BackfillRequest,
+	limit int,
+) (backfillProgressResult, error) {
+	// The backfill's start time is inclusive, while ProcessTimeRange's is exclusive.
+	start := request.GetStartTime().AsTime().Add(-1 * time.Millisecond)
+	if backfiller.LastProcessedTime != nil {
+		start = backfiller.LastProcessedTime.AsTime()
+	}
+	end := request.GetEndTime().AsTime()
+
+	res, err := e.SpecProcessor.ProcessTimeRange(
+		scheduler,
+		start,
+		end,
+		request.GetOverlapPolicy(),
+		backfiller.BackfillId,
+		true,
+		&limit,
+	)
+	if err != nil {
+		return backfillProgressResult{}, err
+	}
+
+	return backfillProgressResult{
+		BufferedStarts:    res.BufferedStarts,
+		LastProcessedTime: res.LastActionTime,
+		Complete:          res.NextWakeupTime.IsZero() || res.NextWakeupTime.After(end),
+	}, nil
+}
+
+// loadBackfiller loads the Backfiller's persisted state.
+func (e backfillerTaskExecutor) loadBackfiller(node *hsm.Node) (Backfiller, error) {
+	prevBackfiller, err := hsm.MachineData[Backfiller](node)
+	if err != nil {
+		return Backfiller{}, err
+	}
+
+	return Backfiller{
+		BackfillerInternal: prevBackfiller.BackfillerInternal,
+	}, nil
+}
@@ -0,0 +1,504 @@
+package scheduler_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	enumspb "go.temporal.io/api/enums/v1"
+	schedulepb "go.temporal.io/api/schedule/v1"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/api/historyservice/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/components/scheduler"
+	"go.temporal.io/server/service/history/hsm"
+	"go.temporal.io/server/service/history/hsm/hsmtest"
+	scheduler1 "go.temporal.io/server/service/worker/scheduler"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type backfillerTestEnv struct {
+	t             *testing.T
+	env           *fakeEnv
+	registry      *hsm.Registry
+	root          *hsm.Node
+	schedulerNode *hsm.Node
+}
+
+// newBackfillerTestEnv creates a Scheduler tree with an executor for Backfiller
+// tasks registered.
+func newBackfillerTestEnv(t *testing.T, sched *schedulepb.Schedule, tweakables scheduler.Tweakables) *backfillerTestEnv {
+	env := newFakeEnv()
+	registry := newRegistry(t)
+	ctrl := gomock.NewController(t)
+	root := newRoot(t, registry, &hsmtest.NodeBackend{})
+	schedulerNode := newSchedulerTree(t, registry, root, sched, nil)
+
+	config := defaultConfig()
+	config.Tweakables = func(_ string) scheduler.Tweakables {
+		return tweakables
+	}
+	require.NoError(t, scheduler.RegisterBackfillerExecutors(registry, scheduler.BackfillerTaskExecutorOptions{
+		Config:         config,
+		MetricsHandler: metrics.NoopMetricsHandler,
+		BaseLogger:     log.NewTestLogger(),
+		SpecProcessor:  newTestSpecProcessor(ctrl),
+	}))
+
+	return &backfillerTestEnv{
+		t:             t,
+		env:           env,
+		registry:      registry,
+		root:          root,
+		schedulerNode: schedulerNode,
+	}
+}
+
+func (e *backfillerTestEnv) addBackfills(patch *schedulepb.SchedulePatch, tweakables scheduler.Tweakables) {
+	require.NoError(e.t, scheduler.AddBackfills(e.schedulerNode, tweakables, patch))
+}
+
+func (e *backfillerTestEnv) backfillerNodes() []*hsm.Node {
+	return hsm.NewCollection[scheduler.Backfiller](e.schedulerNode, scheduler.BackfillerMachineType).List()
+}
+
+func (e *backfillerTestEnv) invoker() scheduler.Invoker {
+	invokerNode, err := e.schedulerNode.Child([]hsm.Key{scheduler.InvokerMachineKey})
+	require.NoError(e.t, err)
+	invoker, err := hsm.MachineData[scheduler.Invoker](invokerNode)
+	require.NoError(e.t, err)
+	return invoker
+}
+
+// drainBuffer removes and returns all of the Invoker's buffered starts, as if
+// they had been executed.
+func (e *backfillerTestEnv) drainBuffer() []*schedulespb.BufferedStart {
+	invoker := e.invoker()
+	starts := invoker.BufferedStarts
+	invoker.BufferedStarts = nil
+	return starts
+}
+
+// runBackfills executes Backfiller tasks until all Backfillers have completed,
+// draining the Invoker's buffer and advancing time between iterations. Returns
+// all buffered starts, and the number of iterations it took.
+func (e *backfillerTestEnv) runBackfills() ([]*schedulespb.BufferedStart, int) {
+	var starts []*schedulespb.BufferedStart
+	iterations := 0
+	for nodes := e.backfillerNodes(); len(nodes) > 0; nodes = e.backfillerNodes() {
+		iterations++
+		require.Less(e.t, iterations, 1000, "backfills did not complete")
+		for _, node := range nodes {
+			require.NoError(e.t, e.registry.ExecuteTimerTask(e.env, node, scheduler.BackfillTask{}))
+		}
+		starts = append(starts, e.drainBuffer()...)
+		e.env.now = e.env.now.Add(time.Second)
+	}
+	return starts, iterations
+}
+
+// backfillTasks returns all Backfill tasks generated in the tree.
+func (e *backfillerTestEnv) backfillTasks() []hsm.Task {
+	tasks, err := opLogTasks(e.root)
+	require.NoError(e.t, err)
+	var backfillTasks []hsm.Task
+	for _, task := range tasks {
+		if task.Type() == scheduler.TaskTypeBackfill {
+			backfillTasks = append(backfillTasks, task)
+		}
+	}
+	return backfillTasks
+}
+
+func nominalTimes(starts []*schedulespb.BufferedStart) []time.Time {
+	times := make([]time.Time, len(starts))
+	for i, start := range starts {
+		times[i] = start.NominalTime.AsTime()
+	}
+	return times
+}
+
+// calendarSchedule matches the schedule used by the scheduler workflow's backfill
+// tests: every 17 minutes from 19:00 on the 31st of the month.
+func calendarSchedule() *schedulepb.Schedule {
+	sched := defaultSchedule()
+	sched.Spec = &schedulepb.ScheduleSpec{
+		Calendar: []*schedulepb.CalendarSpec{{
+			Minute:     "*/17",
+			Hour:       "19",
+			DayOfMonth: "31",
+		}},
+	}
+	sched.Policies.OverlapPolicy = enumspb.SCHEDULE_OVERLAP_POLICY_SKIP
+	return sched
+}
+
+func TestAddBackfills(t *testing.T) {
+	e := newBackfillerTestEnv(t, defaultSchedule(), scheduler.DefaultTweakables)
+
+	e.addBackfills(&schedulepb.SchedulePatch{
+		TriggerImmediately: &schedulepb.TriggerImmediatelyRequest{},
+		BackfillRequest: []*schedulepb.BackfillRequest{
+			{StartTime: timestamppb.Now(), EndTime: timestamppb.Now()},
+			{StartTime: timestamppb.Now(), EndTime: timestamppb.Now()},
+		},
+	}, scheduler.DefaultTweakables)
+
+	// Each request has its own Backfiller, with an immediate Backfill task.
+	nodes := e.backfillerNodes()
+	require.Len(t, nodes, 3)
+	backfillIDs := make(map[string]bool)
+	triggers := 0
+	for _, node := range nodes {
+		backfiller, err := hsm.MachineData[scheduler.Backfiller](node)
+		require.NoError(t, err)
+		require.Equal(t, node.Key.ID, backfiller.BackfillId)
+		backfillIDs[backfiller.BackfillId] = true
+		if backfiller.GetTriggerRequest() != nil {
+			triggers++
+		}
+	}
+	require.Len(t, backfillIDs, 3)
+	require.Equal(t, 1, triggers)
+
+	tasks := e.backfillTasks()
+	require.Len(t, tasks, 3)
+	for _, task := range tasks {
+		// Timer tasks that fire right away are given a deadline that has already passed.
+		require.NotEqual(t, hsm.Immediate, task.Deadline())
+		require.False(t, task.Deadline().After(time.Now()))
+	}
+}
+
+func TestAddBackfills_BufferOverrun(t *testing.T) {
+	tweakables := scheduler.DefaultTweakables
+	tweakables.MaxBufferSize = 2
+	e := newBackfillerTestEnv(t, defaultSchedule(), tweakables)
+
+	// Requests beyond the max number of ongoing backfills are dropped.
+	e.addBackfills(&schedulepb.SchedulePatch{
+		TriggerImmediately: &schedulepb.TriggerImmediatelyRequest{},
+		BackfillRequest: []*schedulepb.BackfillRequest{
+			{StartTime: timestamppb.Now(), EndTime: timestamppb.Now()},
+			{StartTime: timestamppb.Now(), EndTime: timestamppb.Now()},
+		},
+	}, tweakables)
+	require.Len(t, e.backfillerNodes(), 2)
+
+	s, err := hsm.MachineData[scheduler.Scheduler](e.schedulerNode)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), s.Info.BufferDropped)
+}
+
+// Parity with the scheduler workflow's TestTriggerImmediate.
+func TestExecuteBackfillTask_TriggerImmediately(t *testing.T) {
+	e := newBackfillerTestEnv(t, calendarSchedule(), scheduler.DefaultTweakables)
+
+	// The schedule's overlap policy is used unless overridden.
+	e.addBackfills(&schedulepb.SchedulePatch{
+		TriggerImmediately: &schedulepb.TriggerImmediatelyRequest{},
+	}, scheduler.DefaultTweakables)
+	starts, iterations := e.runBackfills()
+	require.Equal(t, 1, iterations)
+	require.Len(t, starts, 1)
+	require.True(t, starts[0].Manual)
+	require.Equal(t, enumspb.SCHEDULE_OVERLAP_POLICY_SKIP, starts[0].OverlapPolicy)
+	require.Equal(t, e.env.now.Add(-time.Second).UTC(), starts[0].ActualTime.AsTime())
+	require.Equal(t, starts[0].ActualTime.AsTime(), starts[0].NominalTime.AsTime())
+
+	e.addBackfills(&schedulepb.SchedulePatch{
+		TriggerImmediately: &schedulepb.TriggerImmediatelyRequest{
+			OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+		},
+	}, scheduler.DefaultTweakables)
+	starts, _ = e.runBackfills()
+	require.Len(t, starts, 1)
+	require.True(t, starts[0].Manual)
+	require.Equal(t, enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL, starts[0].OverlapPolicy)
+}
+
+func TestExecuteBackfillTask_TriggerImmediately_BufferFull(t *testing.T) {
+	tweakables := scheduler.DefaultTweakables
+	tweakables.MaxBufferSize = 1
+	e := newBackfillerTestEnv(t, calendarSchedule(), tweakables)
+	invoker := e.invoker()
+	invoker.BufferedStarts = []*schedulespb.BufferedStart{{RequestId: "pending"}}
+
+	e.addBackfills(&schedulepb.SchedulePatch{
+		TriggerImmediately: &schedulepb.TriggerImmediatelyRequest{},
+	}, tweakables)
+	starts, iterations := e.runBackfills()
+	require.Equal(t, 1, iterations)
+	require.Len(t, starts, 1)
+	require.Equal(t, "pending", starts[0].RequestId)
+
+	s, err := hsm.MachineData[scheduler.Scheduler](e.schedulerNode)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), s.Info.BufferDropped)
+}
+
+// Parity with the scheduler workflow's TestBackfill.
+func TestExecuteBackfillTask_Backfill(t *testing.T) {
+	e := newBackfillerTestEnv(t, calendarSchedule(), scheduler.DefaultTweakables)
+
+	// Manual actions are buffered regardless of the catchup window and update time.
+	s, err := hsm.MachineData[scheduler.Scheduler](e.schedulerNode)
+	require.NoError(t, err)
+	s.Info.UpdateTime = timestamppb.New(e.env.now)
+
+	e.addBackfills(&schedulepb.SchedulePatch{
+		BackfillRequest: []*schedulepb.BackfillRequest{{
+			StartTime:     timestamppb.New(time.Date(2022, 5, 31, 0, 0, 0, 0, time.UTC)),
+			EndTime:       timestamppb.New(time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)),
+			OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ALL,
+		}},
+	}, scheduler.DefaultTweakables)
+	starts, iterations := e.runBackfills()
+	require.Equal(t, 1, iterations)
+	require.Equal(t, []time.Time{
+		time.Date(2022, 5, 31, 19, 0, 0, 0, time.UTC),
+		time.Date(2022, 5, 31, 19, 17, 0, 0, time.UTC),
+		time.Date(2022, 5, 31, 19, 34, 0, 0, time.UTC),
+		time.Date(2022, 5, 31, 19, 51, 0, 0, time.UTC),
+	}, nominalTimes(starts))
+	for _, start := range starts {
+		require.True(t, start.Manual)
+		require.Equal(t, enumspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ALL, start.OverlapPolicy)
+	}
+}
+
+// Parity with the scheduler workflow's TestBackfillInclusiveStartEnd.
+func TestExecuteBackfillTask_InclusiveStartEnd(t *testing.T) {
+	e := newBackfillerTestEnv(t, calendarSchedule(), scheduler.DefaultTweakables)
+
+	triggerBackfillTime := time.Date(2022, 5, 31, 19, 17, 0, 0, time.UTC)
+	ignoreBackfillTime := triggerBackfillTime.Add(500 * time.Millisecond)
+	e.addBackfills(&schedulepb.SchedulePatch{
+		BackfillRequest: []*schedulepb.BackfillRequest{
+			{
+				StartTime:     timestamppb.New(triggerBackfillTime),
+				EndTime:       timestamppb.New(triggerBackfillTime),
+				OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ALL,
+			},
+			{
+				StartTime:     timestamppb.New(ignoreBackfillTime),
+				EndTime:       timestamppb.New(ignoreBackfillTime),
+				OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ALL,
+			},
+		},
+	}, scheduler.DefaultTweakables)
+	starts, _ := e.runBackfills()
+	require.Equal(t, []time.Time{triggerBackfillTime}, nominalTimes(starts))
+}
+
+// Parity with the scheduler workflow's TestHugeBackfillBuffer.
+func TestExecuteBackfillTask_Incremental(t *testing.T) {
+	tweakables := scheduler.DefaultTweakables
+	tweakables.MaxBufferSize = 30
+	sched := defaultSchedule()
+	sched.Spec = &schedulepb.ScheduleSpec{
+		Interval: []*schedulepb.IntervalSpec{{Interval: durationpb.New(time.Hour)}},
+	}
+	e := newBackfillerTestEnv(t, sched, tweakables)
+
+	const backfillRuns = 100
+	const backfills = 4
+	base := time.Date(2001, 8, 6, 0, 0, 0, 0, time.UTC)
+	patch := &schedulepb.SchedulePatch{}
+	for i := 0; i < backfills; i++ {
+		patch.BackfillRequest = append(patch.BackfillRequest, &schedulepb.BackfillRequest{
+			StartTime:     timestamppb.New(base.Add(time.Duration(i*backfillRuns/backfills) * time.Hour)),
+			EndTime:       timestamppb.New(base.Add(time.Duration((i+1)*backfillRuns/backfills-1) * time.Hour)),
+			OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ALL,
+		})
+	}
+	e.addBackfills(patch, tweakables)
+
+	// After the first iteration, the Backfillers have filled no more than half of
+	// the buffer, and will continue after an interval.
+	for _, node := range e.backfillerNodes() {
+		require.NoError(t, e.registry.ExecuteTimerTask(e.env, node, scheduler.BackfillTask{}))
+	}
+	require.Len(t, e.invoker().BufferedStarts, tweakables.MaxBufferSize/2)
+	require.Len(t, e.backfillerNodes(), backfills)
+	for _, node := range e.backfillerNodes() {
+		backfiller, err := hsm.MachineData[scheduler.Backfiller](node)
+		require.NoError(t, err)
+		require.True(t, backfiller.NextInvocationTime.AsTime().After(e.env.now))
+	}
+	// The first two Backfillers made progress, while the others backed off.
+	tasks := e.backfillTasks()
+	require.Len(t, tasks, 2*backfills)
+	for i, task := range tasks[backfills:] {
+		if i < 2 {
+			require.True(t, e.env.now.Add(time.Second).Equal(task.Deadline()))
+		} else {
+			require.True(t, task.Deadline().After(e.env.now))
+		}
+	}
+
+	starts, _ := e.runBackfills()
+	starts = append(e.drainBuffer(), starts...)
+	require.Len(t, starts, backfillRuns)
+
+	// Every action is buffered exactly once.
+	requestIDs := make(map[string]bool)
+	times := make(map[time.Time]bool)
+	for _, start := range starts {
+		require.True(t, start.Manual)
+		require.False(t, requestIDs[start.RequestId])
+		requestIDs[start.RequestId] = true
+		times[start.NominalTime.AsTime()] = true
+	}
+	for i := 0; i < backfillRuns; i++ {
+		require.True(t, times[base.Add(time.Duration(i)*time.Hour)])
+	}
+}
+
+func TestExecuteBackfillTask_BufferFull(t *testing.T) {
+	tweakables := scheduler.DefaultTweakables
+	tweakables.MaxBufferSize = 4
+	e := newBackfillerTestEnv(t, calendarSchedule(), tweakables)
+	invoker := e.invoker()
+	invoker.BufferedStarts = []*schedulespb.BufferedStart{{RequestId: "a"}, {RequestId: "b"}}
+
+	e.addBackfills(&schedulepb.SchedulePatch{
+		BackfillRequest: []*schedulepb.BackfillRequest{{
+			StartTime: timestamppb.New(time.Date(2022, 5, 31, 0, 0, 0, 0, time.UTC)),
+			EndTime:   timestamppb.New(time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)),
+		}},
+	}, tweakables)
+	nodes := e.backfillerNodes()
+	require.Len(t, nodes, 1)
+
+	// Backfills only use half of the buffer, so nothing is buffered and the
+	// Backfiller backs off.
+	require.NoError(t, e.registry.ExecuteTimerTask(e.env, nodes[0], scheduler.BackfillTask{}))
+	require.Len(t, e.invoker().BufferedStarts, 2)
+	backfiller, err := hsm.MachineData[scheduler.Backfiller](nodes[0])
+	require.NoError(t, err)
+	require.Equal(t, int64(1), backfiller.Attempt)
+	require.Nil(t, backfiller.LastProcessedTime)
+	require.True(t, backfiller.NextInvocationTime.AsTime().After(e.env.now))
+
+	// Once there's room, the backfill makes progress again.
+	e.drainBuffer()
+	starts, _ := e.runBackfills()
+	require.Len(t, starts, 4)
+}
+
+// TestBackfillParityWithWorkflow runs the same backfill through the scheduler
+// workflow and the Backfiller, and compares the actions they buffer.
+func TestBackfillParityWithWorkflow(t *testing.T) {
+	sched := defaultSchedule()
+	sched.Spec = &schedulepb.ScheduleSpec{
+		Interval: []*schedulepb.IntervalSpec{{Interval: durationpb.New(17 * time.Minute)}},
+		Calendar: []*schedulepb.CalendarSpec{{
+			Minute:     "*/25",
+			Hour:       "19",
+			DayOfMonth: "31",
+		}},
+	}
+	// Spans several iterations of both the workflow and the Backfiller.
+	backfill := &schedulepb.BackfillRequest{
+		StartTime:     timestamppb.New(time.Date(2022, 5, 31, 0, 0, 0, 0, time.UTC)),
+		EndTime:       timestamppb.New(time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC)),
+		OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+	}
+	inRange := func(nominal time.Time) bool {
+		return !nominal.Before(backfill.StartTime.AsTime()) && !nominal.After(backfill.EndTime.AsTime())
+	}
+
+	workflowTimes := runWorkflowBackfill(t, common.CloneProto(sched), common.CloneProto(backfill), inRange)
+
+	e := newBackfillerTestEnv(t, common.CloneProto(sched), scheduler.DefaultTweakables)
+	e.addBackfills(&schedulepb.SchedulePatch{
+		BackfillRequest: []*schedulepb.BackfillRequest{common.CloneProto(backfill)},
+	}, scheduler.DefaultTweakables)
+	starts, iterations := e.runBackfills()
+	require.Greater(t, iterations, 1)
+	var backfillerTimes []time.Time
+	for _, nominal := range nominalTimes(starts) {
+		require.True(t, inRange(nominal))
+		backfillerTimes = append(backfillerTimes, nominal.Truncate(time.Second))
+	}
+
+	require.Greater(t, len(workflowTimes), scheduler1.CurrentTweakablePolicies.BackfillsPerIteration)
+	require.Equal(t, workflowTimes, backfillerTimes)
+}
+
+// runWorkflowBackfill runs the scheduler workflow with a backfill, and returns
+// the nominal times of the workflows it started for the backfill.
+func runWorkflowBackfill(
+	t *testing.T,
+	sched *schedulepb.Schedule,
+	backfill *schedulepb.BackfillRequest,
+	inRange func(time.Time) bool,
+) []time.Time {
+	prevTweakables := scheduler1.CurrentTweakablePolicies
+	scheduler1.CurrentTweakablePolicies.IterationsBeforeContinueAsNew = 20
+	defer func() { scheduler1.CurrentTweakablePolicies = prevTweakables }()
+
+	var testSuite testsuite.WorkflowTestSuite
+	env := testSuite.NewTestWorkflowEnvironment()
+	env.SetStartTime(time.Date(2022, 6, 1, 0, 0, 0, 0, time.UTC))
+	// The workflow's activities are unexported, so they're mocked by name.
+	env.RegisterActivityWithOptions(
+		func(context.Context, *historyservice.MigrateScheduleRequest) (*historyservice.MigrateScheduleResponse, error) {
+			return nil, nil
+		},
+		activity.RegisterOptions{Name: "MigrateSchedule"},
+	)
+	env.RegisterActivityWithOptions(
+		func(context.Context, *schedulespb.StartWorkflowRequest) (*schedulespb.StartWorkflowResponse, error) {
+			return nil, nil
+		},
+		activity.RegisterOptions{Name: "StartWorkflow"},
+	)
+	env.OnActivity("MigrateSchedule", mock.Anything, mock.Anything).Maybe().
+		Return(&historyservice.MigrateScheduleResponse{}, nil)
+
+	// ALLOW_ALL starts have the nominal time appended to their workflow ID.
+	workflowIDPrefix := sched.Action.GetStartWorkflow().GetWorkflowId() + "-"
+	var times []time.Time
+	env.OnActivity("StartWorkflow", mock.Anything, mock.Anything).Return(
+		func(_ context.Context, req *schedulespb.StartWorkflowRequest) (*schedulespb.StartWorkflowResponse, error) {
+			nominal, err := time.Parse(time.RFC3339, strings.TrimPrefix(req.Request.WorkflowId, workflowIDPrefix))
+			require.NoError(t, err)
+			if inRange(nominal) {
+				times = append(times, nominal)
+			}
+			return &schedulespb.StartWorkflowResponse{
+				RunId:         uuid.NewString(),
+				RealStartTime: timestamppb.New(env.Now()),
+			}, nil
+		})
+
+	env.ExecuteWorkflow(scheduler1.SchedulerWorkflow, &schedulespb.StartScheduleArgs{
+		Schedule: sched,
+		State: &schedulespb.InternalState{
+			Namespace:     namespace,
+			NamespaceId:   namespaceID,
+			ScheduleId:    scheduleID,
+			ConflictToken: scheduler1.InitialConflictToken,
+		},
+		InitialPatch: &schedulepb.SchedulePatch{
+			BackfillRequest: []*schedulepb.BackfillRequest{backfill},
+		},
+	})
+	require.True(t, env.IsWorkflowCompleted())
+	var canErr *workflow.ContinueAsNewError
+	require.ErrorAs(t, env.GetWorkflowError(), &canErr)
+	return times
+}
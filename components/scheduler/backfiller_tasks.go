@@ -0,0 +1,64 @@
+package scheduler
+
+import (
+	"time"
+
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/service/history/hsm"
+)
+
+type BackfillTask struct {
+	deadline time.Time
+}
+
+const (
+	TaskTypeBackfill = "scheduler.backfiller.Backfill"
+)
+
+var (
+	_ hsm.Task = BackfillTask{}
+)
+
+func (BackfillTask) Type() string {
+	return TaskTypeBackfill
+}
+
+func (b BackfillTask) Deadline() time.Time {
+	return b.deadline
+}
+
+func (BackfillTask) Destination() string {
+	return ""
+}
+
+func (BackfillTask) Validate(_ *persistencespb.StateMachineRef, _ *hsm.Node) error {
+	// Backfiller only has a single task/state, so no validation is done here.
+	return nil
+}
+
+type BackfillTaskSerializer struct{}
+
+func (BackfillTaskSerializer) Deserialize(_ []byte, attrs hsm.TaskAttributes) (hsm.Task, error) {
+	return BackfillTask{deadline: attrs.Deadline}, nil
+}
+
+func (BackfillTaskSerializer) Serialize(hsm.Task) ([]byte, error) {
+	return nil, nil
+}
+
+func (b Backfiller) tasks() ([]hsm.Task, error) {
+	// A new Backfiller buffers its first actions immediately.
+	deadline := immediateDeadline
+	if b.NextInvocationTime != nil {
+		deadline = b.NextInvocationTime.AsTime()
+	}
+	return []hsm.Task{BackfillTask{deadline: deadline}}, nil
+}
+
+func (b Backfiller) output() (hsm.TransitionOutput, error) {
+	tasks, err := b.tasks()
+	if err != nil {
+		return hsm.TransitionOutput{}, err
+	}
+	return hsm.TransitionOutput{Tasks: tasks}, nil
+}
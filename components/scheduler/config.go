@@ -15,6 +15,8 @@ type (
 		CanceledTerminatedCountAsFailures bool          // Whether cancelled+terminated count for pause-on-failure
 		RecentActionCount                 int           // Number of recent actions taken (workflow execution results) recorded in the ScheduleInfo metadata.
 		MaxActionsPerExecution            int           // Limits the number of actions (startWorkflow, terminate/cancel) taken by ExecuteTask in a single iteration
+		BackfillsPerIteration             int           // How many backfilled actions to buffer per Backfiller iteration
+		FutureActionCount                 int           // The number of future action times to include in Describe.
 
 		// TODO - incomplete tweakables list
 	}
@@ -24,6 +26,7 @@ type (
 		Tweakables         dynamicconfig.TypedPropertyFnWithNamespaceFilter[Tweakables]
 		ServiceCallTimeout dynamicconfig.DurationPropertyFn
 		RetryPolicy        func() backoff.RetryPolicy
+		MigrationMode      dynamicconfig.StringPropertyFnWithNamespaceFilter
 	}
 )
 
@@ -58,6 +61,8 @@ var (
 		CanceledTerminatedCountAsFailures: false,
 		RecentActionCount:                 10,
 		MaxActionsPerExecution:            10,
+		BackfillsPerIteration:             10,
+		FutureActionCount:                 10,
 	}
 )
 
@@ -65,6 +70,7 @@ func ConfigProvider(dc *dynamicconfig.Collection) *Config {
 	return &Config{
 		Tweakables:         CurrentTweakables.Get(dc),
 		ServiceCallTimeout: ServiceCallTimeout.Get(dc),
+		MigrationMode:      dynamicconfig.SchedulerMigrationMode.Get(dc),
 		RetryPolicy: func() backoff.RetryPolicy {
 			return backoff.NewExponentialRetryPolicy(
 				RetryPolicyInitialInterval.Get(dc)(),
@@ -0,0 +1,40 @@
+package scheduler
+
+import (
+	"go.temporal.io/server/service/history/hsm"
+	scheduler1 "go.temporal.io/server/service/worker/scheduler"
+	"go.uber.org/fx"
+)
+
+var Module = fx.Module(
+	"component.scheduler",
+	fx.Provide(ConfigProvider),
+	fx.Provide(scheduler1.NewSpecBuilder),
+	fx.Provide(SpecProcessorProvider),
+	fx.Provide(NewMigrator),
+	fx.Invoke(RegisterTaskSerializers),
+	fx.Invoke(RegisterStateMachines),
+	fx.Invoke(RegisterExecutors),
+)
+
+func SpecProcessorProvider(impl SpecProcessorImpl) SpecProcessor {
+	return impl
+}
+
+type executorOptions struct {
+	fx.In
+
+	Generator  GeneratorTaskExecutorOptions
+	Invoker    InvokerTaskExecutorOptions
+	Backfiller BackfillerTaskExecutorOptions
+}
+
+func RegisterExecutors(registry *hsm.Registry, options executorOptions) error {
+	if err := RegisterGeneratorExecutors(registry, options.Generator); err != nil {
+		return err
+	}
+	if err := RegisterInvokerExecutors(registry, options.Invoker); err != nil {
+		return err
+	}
+	return RegisterBackfillerExecutors(registry, options.Backfiller)
+}
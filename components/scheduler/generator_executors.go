@@ -3,6 +3,7 @@ package scheduler
 import (
 	"fmt"
 
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -67,7 +68,7 @@ func (e generatorTaskExecutor) executeBufferTask(env hsm.Environment, node *hsm.
 		t2 = t1
 	}
 
-	res, err := e.SpecProcessor.ProcessTimeRange(scheduler, t1, t2, false, nil)
+	res, err := e.SpecProcessor.ProcessTimeRange(scheduler, t1, t2, enumspb.SCHEDULE_OVERLAP_POLICY_UNSPECIFIED, "", false, nil)
 	if err != nil {
 		// An error here should be impossible, send to the DLQ.
 		logger.Error("Error processing time range", tag.Error(err))
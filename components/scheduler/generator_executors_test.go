@@ -3,6 +3,7 @@ package scheduler_test
 import (
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"go.temporal.io/server/common"
@@ -36,7 +37,7 @@ func TestExecuteBufferTask_ProcessTimeRangeFails(t *testing.T) {
 	// If ProcessTimeRange fails, we should fail the task as an internal error.
 	specProcessor := scheduler.NewMockSpecProcessor(ctrl)
 	specProcessor.EXPECT().ProcessTimeRange(
-		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 	).Return(nil, errors.New("processTimeRange bug"))
 
 	registerGeneratorExecutor(t, ctrl, registry, specProcessor)
@@ -102,7 +103,9 @@ func TestExecuteBufferTask_Basic(t *testing.T) {
 	require.Equal(t, 1, len(output.Output.Tasks))
 	task := output.Output.Tasks[0]
 	require.Equal(t, scheduler.TaskTypeProcessBuffer, task.Type())
-	require.Equal(t, hsm.Immediate, task.Deadline())
+	// Timer tasks that fire right away are given a deadline that has already passed.
+	require.NotEqual(t, hsm.Immediate, task.Deadline())
+	require.False(t, task.Deadline().After(time.Now()))
 
 	// The Buffer task should have a deadline on our next invocation time.
 	output, ok = opLog[1].(hsm.TransitionOperation)
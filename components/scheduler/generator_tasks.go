@@ -36,6 +36,16 @@ func (BufferTask) Validate(_ *persistencespb.StateMachineRef, _ *hsm.Node) error
 	return nil
 }
 
+type BufferTaskSerializer struct{}
+
+func (BufferTaskSerializer) Deserialize(_ []byte, attrs hsm.TaskAttributes) (hsm.Task, error) {
+	return BufferTask{deadline: attrs.Deadline}, nil
+}
+
+func (BufferTaskSerializer) Serialize(hsm.Task) ([]byte, error) {
+	return nil, nil
+}
+
 func (g Generator) tasks() ([]hsm.Task, error) {
 	return []hsm.Task{BufferTask{deadline: g.NextInvocationTime.AsTime()}}, nil
 }
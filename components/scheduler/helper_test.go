@@ -108,7 +108,7 @@ func newSchedulerTree(
 	_, err = schedulerNode.AddChild(scheduler.InvokerMachineKey, *invoker)
 	require.NoError(t, err)
 
-	// TODO - add others
+	// Backfiller nodes are added for each backfill request, see AddBackfills.
 
 	return schedulerNode
 }
@@ -154,6 +154,9 @@ func defaultConfig() *scheduler.Config {
 		RetryPolicy: func() backoff.RetryPolicy {
 			return backoff.NewExponentialRetryPolicy(1 * time.Second)
 		},
+		MigrationMode: func(_ string) string {
+			return string(scheduler.MigrationModeDisabled)
+		},
 	}
 }
 
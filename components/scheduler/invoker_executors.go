@@ -17,8 +17,11 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
 	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	commonnexus "go.temporal.io/server/common/nexus"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/util"
+	"go.temporal.io/server/components/nexusoperations"
 	"go.temporal.io/server/service/history/hsm"
 	scheduler1 "go.temporal.io/server/service/worker/scheduler"
 	"go.uber.org/fx"
@@ -34,6 +37,9 @@ type (
 		BaseLogger     log.Logger
 		HistoryClient  resource.HistoryClient
 		FrontendClient workflowservice.WorkflowServiceClient
+
+		EndpointRegistry    commonnexus.EndpointRegistry
+		NexusClientProvider nexusoperations.ClientProvider
 	}
 
 	invokerTaskExecutor struct {
@@ -127,7 +133,7 @@ func (e invokerTaskExecutor) executeExecuteTask(
 	ictx := e.newInvokerTaskExecutorContext(ctx, *scheduler)
 	result = result.Append(e.terminateWorkflows(ictx, logger, *scheduler, invoker.GetTerminateWorkflows()))
 	result = result.Append(e.cancelWorkflows(ictx, logger, *scheduler, invoker.GetCancelWorkflows()))
-	sres, startResults := e.startWorkflows(ictx, logger, env, *scheduler, eligibleStarts)
+	sres, startResults, nexusResults := e.startWorkflows(ictx, logger, env, *scheduler, eligibleStarts)
 	result = result.Append(sres)
 
 	// Write results.
@@ -145,8 +151,9 @@ func (e invokerTaskExecutor) executeExecuteTask(
 		// Record action results on the Scheduler.
 		return hsm.MachineTransition(node.Parent, func(s Scheduler) (hsm.TransitionOutput, error) {
 			return TransitionRecordAction.Apply(s, EventRecordAction{
-				ActionCount: int64(len(startResults)),
-				Results:     startResults,
+				ActionCount:           int64(len(startResults)),
+				Results:               startResults,
+				NexusOperationResults: nexusResults,
 			})
 		})
 	})
@@ -209,15 +216,30 @@ func (e invokerTaskExecutor) terminateWorkflows(
 }
 
 // startWorkflows executes the provided list of starts, returning a result with their outcomes.
+// If the schedule's action carries an action extension, starts signal a workflow or start a
+// Nexus operation instead.
 func (e invokerTaskExecutor) startWorkflows(
 	ctx invokerTaskExecutorContext,
 	logger log.Logger,
 	env hsm.Environment,
 	scheduler Scheduler,
 	starts []*schedulespb.BufferedStart,
-) (result executeResult, startResults []*schedulepb.ScheduleActionResult) {
+) (
+	result executeResult,
+	startResults []*schedulepb.ScheduleActionResult,
+	nexusResults []*schedulespb.NexusOperationActionResult,
+) {
+	ext, err := scheduler1.GetActionExtension(scheduler.GetSchedule().GetAction().GetStartWorkflow())
+	if err != nil {
+		// The frontend validates the extension, so this shouldn't happen. The action can't
+		// be taken as intended, so the starts are dropped instead of starting a workflow.
+		logger.Error("Invalid schedule action extension", tag.Error(err))
+		e.MetricsHandler.Counter(metrics.ScheduleActionErrors.Name()).Record(int64(len(starts)))
+		result.FailedStarts = starts
+		return
+	}
 	metricsWithTag := e.MetricsHandler.WithTags(
-		metrics.StringTag(metrics.ScheduleActionTypeTag, metrics.ScheduleActionStartWorkflow))
+		metrics.StringTag(metrics.ScheduleActionTypeTag, scheduler1.ActionTypeTag(ext)))
 
 	for _, start := range starts {
 		// Starts that haven't been executed yet will remain in `BufferedStarts`,
@@ -227,9 +249,22 @@ func (e invokerTaskExecutor) startWorkflows(
 			break
 		}
 
-		startResult, err := e.startWorkflow(ctx, env, scheduler, start)
+		var startResult *schedulepb.ScheduleActionResult
+		var err error
+		switch {
+		case ext.GetSignalWorkflow() != nil:
+			startResult, err = e.signalWorkflow(ctx, env, scheduler, start, ext.GetSignalWorkflow())
+		case ext.GetNexusOperation() != nil:
+			var nexusResult *schedulespb.NexusOperationActionResult
+			startResult, nexusResult, err = e.startNexusOperation(ctx, env, scheduler, start, ext.GetNexusOperation())
+			if nexusResult != nil {
+				nexusResults = append(nexusResults, nexusResult)
+			}
+		default:
+			startResult, err = e.startWorkflow(ctx, env, scheduler, start)
+		}
 		if err != nil {
-			logger.Error("Failed to start workflow", tag.Error(err))
+			logger.Error("Failed to take action", tag.NewStringTag("action", scheduler1.ActionTypeTag(ext)), tag.Error(err))
 
 			// Don't count "already started" for the error metric or retry, as it is most likely
 			// due to misconfiguration.
@@ -447,19 +482,8 @@ func (e invokerTaskExecutor) startWorkflow(
 	nominalTimeSec := start.NominalTime.AsTime().Truncate(time.Second)
 	workflowID := fmt.Sprintf("%s-%s", requestSpec.WorkflowId, nominalTimeSec.Format(time.RFC3339))
 
-	if start.Attempt >= InvokerMaxStartAttempts {
-		return nil, errRetryLimitExceeded
-	}
-
-	// Get rate limiter permission once per buffered start, on the first attempt only.
-	if start.Attempt == 1 {
-		delay, err := e.getRateLimiterPermission()
-		if err != nil {
-			return nil, err
-		}
-		if delay > 0 {
-			return nil, newRateLimitedError(delay)
-		}
+	if err := e.checkStartAttempt(start); err != nil {
+		return nil, err
 	}
 
 	// TODO - set last completion result/continued failure
@@ -500,6 +524,102 @@ func (e invokerTaskExecutor) startWorkflow(
 	}, nil
 }
 
+func (e invokerTaskExecutor) signalWorkflow(
+	ctx context.Context,
+	env hsm.Environment,
+	scheduler Scheduler,
+	start *schedulespb.BufferedStart,
+	signal *schedulespb.SignalWorkflowAction,
+) (*schedulepb.ScheduleActionResult, error) {
+	if err := e.checkStartAttempt(start); err != nil {
+		return nil, err
+	}
+
+	requestSpec := scheduler.GetSchedule().GetAction().GetStartWorkflow()
+	nominalTimeSec := start.NominalTime.AsTime().UTC().Truncate(time.Second)
+	request := scheduler1.NewSignalWorkflowRequest(
+		scheduler.Namespace,
+		scheduler.identity(),
+		start.RequestId,
+		requestSpec,
+		scheduler1.AddScheduleSearchAttributes(requestSpec.SearchAttributes, scheduler.ScheduleId, nominalTimeSec),
+		signal,
+	)
+	runID, err := scheduler1.SignalWorkflow(ctx, e.FrontendClient, request)
+	if err != nil {
+		return nil, err
+	}
+
+	return &schedulepb.ScheduleActionResult{
+		ScheduleTime: start.ActualTime,
+		ActualTime:   timestamppb.New(env.Now()),
+		StartWorkflowResult: &commonpb.WorkflowExecution{
+			WorkflowId: requestSpec.WorkflowId,
+			RunId:      runID,
+		},
+	}, nil
+}
+
+func (e invokerTaskExecutor) startNexusOperation(
+	ctx context.Context,
+	env hsm.Environment,
+	scheduler Scheduler,
+	start *schedulespb.BufferedStart,
+	op *schedulespb.NexusOperationAction,
+) (*schedulepb.ScheduleActionResult, *schedulespb.NexusOperationActionResult, error) {
+	if err := e.checkStartAttempt(start); err != nil {
+		return nil, nil, err
+	}
+
+	token, err := scheduler1.StartNexusOperation(
+		ctx,
+		e.EndpointRegistry,
+		e.NexusClientProvider,
+		namespace.ID(scheduler.NamespaceId),
+		&schedulespb.StartNexusOperationRequest{
+			Operation: op,
+			RequestId: start.RequestId,
+		},
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	actualTime := timestamppb.New(env.Now())
+	nexusResult := &schedulespb.NexusOperationActionResult{
+		ScheduleTime:   start.ActualTime,
+		ActualTime:     actualTime,
+		Endpoint:       op.GetEndpoint(),
+		Service:        op.GetService(),
+		Operation:      op.GetOperation(),
+		OperationToken: token,
+	}
+	return &schedulepb.ScheduleActionResult{
+		ScheduleTime: start.ActualTime,
+		ActualTime:   actualTime,
+	}, nexusResult, nil
+}
+
+// checkStartAttempt returns an error if a buffered start has run out of attempts, or should
+// wait for the rate limiter.
+func (e invokerTaskExecutor) checkStartAttempt(start *schedulespb.BufferedStart) error {
+	if start.Attempt >= InvokerMaxStartAttempts {
+		return errRetryLimitExceeded
+	}
+
+	// Get rate limiter permission once per buffered start, on the first attempt only.
+	if start.Attempt == 1 {
+		delay, err := e.getRateLimiterPermission()
+		if err != nil {
+			return err
+		}
+		if delay > 0 {
+			return newRateLimitedError(delay)
+		}
+	}
+	return nil
+}
+
 func (e invokerTaskExecutor) terminateWorkflow(
 	ctx context.Context,
 	scheduler Scheduler,
@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/nexus-rpc/sdk-go/nexus"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
@@ -19,11 +20,17 @@ import (
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
+	commonnamespace "go.temporal.io/server/common/namespace"
+	commonnexus "go.temporal.io/server/common/nexus"
+	"go.temporal.io/server/common/nexus/nexustest"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/testing/mockapi/workflowservicemock/v1"
 	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/components/scheduler"
 	"go.temporal.io/server/service/history/hsm"
 	"go.temporal.io/server/service/history/hsm/hsmtest"
+	scheduler1 "go.temporal.io/server/service/worker/scheduler"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -41,6 +48,9 @@ type invokerExecutorsSuite struct {
 	rootNode      *hsm.Node
 	schedulerNode *hsm.Node
 	invokerNode   *hsm.Node
+
+	// Address of the Nexus server that Nexus operation actions are started on.
+	nexusListenAddr string
 }
 
 func TestInvokerExecutorsSuite(t *testing.T) {
@@ -73,6 +83,21 @@ func (e *invokerExecutorsSuite) SetupTest() {
 		BaseLogger:     log.NewTestLogger(),
 		HistoryClient:  e.mockHistoryClient,
 		FrontendClient: e.mockFrontendClient,
+		EndpointRegistry: nexustest.FakeEndpointRegistry{
+			OnGetByName: func(ctx context.Context, namespaceID commonnamespace.ID, endpointName string) (*persistencespb.NexusEndpointEntry, error) {
+				return &persistencespb.NexusEndpointEntry{
+					Id:       "endpoint-id",
+					Endpoint: &persistencespb.NexusEndpoint{Spec: &persistencespb.NexusEndpointSpec{Name: endpointName}},
+				}, nil
+			},
+		},
+		NexusClientProvider: func(ctx context.Context, namespaceID string, entry *persistencespb.NexusEndpointEntry, service string) (*nexus.HTTPClient, error) {
+			return nexus.NewHTTPClient(nexus.HTTPClientOptions{
+				BaseURL:    "http://" + e.nexusListenAddr,
+				Service:    service,
+				Serializer: commonnexus.PayloadSerializer,
+			})
+		},
 	}))
 }
 
@@ -143,6 +168,215 @@ func (e *invokerExecutorsSuite) TestExecuteTask_Basic() {
 	})
 }
 
+// setActionExtension sets the action extension header of the schedule's start workflow action.
+func (e *invokerExecutorsSuite) setActionExtension(ext *commonpb.Payload) scheduler.Scheduler {
+	schedulerSm, err := hsm.MachineData[scheduler.Scheduler](e.schedulerNode)
+	require.NoError(e.T(), err)
+	schedulerSm.Schedule.Action.GetStartWorkflow().Header = &commonpb.Header{
+		Fields: map[string]*commonpb.Payload{scheduler1.ActionExtensionHeaderKey: ext},
+	}
+	return schedulerSm
+}
+
+// Execute signals the workflow of a signal action, without tracking it as running.
+func (e *invokerExecutorsSuite) TestExecuteTask_SignalAction() {
+	ext, err := payload.Encode(&schedulespb.ScheduleActionExtension{
+		Action: &schedulespb.ScheduleActionExtension_SignalWorkflow{
+			SignalWorkflow: &schedulespb.SignalWorkflowAction{SignalName: "tick"},
+		},
+	})
+	require.NoError(e.T(), err)
+	schedulerSm := e.setActionExtension(ext)
+
+	startTime := timestamppb.New(e.env.Now())
+	bufferedStarts := []*schedulespb.BufferedStart{
+		{
+			NominalTime:   startTime,
+			ActualTime:    startTime,
+			DesiredTime:   startTime,
+			Manual:        false,
+			RequestId:     "req1",
+			OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_SKIP,
+			Attempt:       1,
+		},
+	}
+
+	// Expect the long-lived workflow to be signaled, without a timestamp in its ID.
+	e.mockFrontendClient.EXPECT().
+		SignalWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *workflowservice.SignalWorkflowExecutionRequest, _ ...any) (*workflowservice.SignalWorkflowExecutionResponse, error) {
+			require.Equal(e.T(), "scheduled-wf", req.WorkflowExecution.WorkflowId)
+			require.Equal(e.T(), "tick", req.SignalName)
+			require.Equal(e.T(), "req1", req.RequestId)
+			return &workflowservice.SignalWorkflowExecutionResponse{}, nil
+		})
+
+	e.runTestCase(&testCase{
+		TaskType:                 scheduler.TaskTypeExecute,
+		InitialBufferedStarts:    bufferedStarts,
+		InitialState:             enumsspb.SCHEDULER_INVOKER_STATE_WAITING,
+		ExpectedBufferedStarts:   0,
+		ExpectedRunningWorkflows: 0,
+		ExpectedActionCount:      1,
+		ExpectedState:            enumsspb.SCHEDULER_INVOKER_STATE_PROCESSING,
+		ExpectedTasks: map[string]int{
+			scheduler.TaskTypeProcessBuffer: 1,
+		},
+	})
+	require.Len(e.T(), schedulerSm.Info.RecentActions, 1)
+	require.Equal(e.T(), "scheduled-wf", schedulerSm.Info.RecentActions[0].StartWorkflowResult.WorkflowId)
+}
+
+// Execute starts the workflow of a signal action with the signal and the schedule's search
+// attributes if it isn't running.
+func (e *invokerExecutorsSuite) TestExecuteTask_SignalWithStartAction() {
+	ext, err := payload.Encode(&schedulespb.ScheduleActionExtension{
+		Action: &schedulespb.ScheduleActionExtension_SignalWorkflow{
+			SignalWorkflow: &schedulespb.SignalWorkflowAction{SignalName: "tick", StartIfNotRunning: true},
+		},
+	})
+	require.NoError(e.T(), err)
+	schedulerSm := e.setActionExtension(ext)
+
+	startTime := timestamppb.New(e.env.Now())
+	bufferedStarts := []*schedulespb.BufferedStart{
+		{
+			NominalTime:   startTime,
+			ActualTime:    startTime,
+			DesiredTime:   startTime,
+			Manual:        false,
+			RequestId:     "req1",
+			OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_SKIP,
+			Attempt:       1,
+		},
+	}
+
+	e.mockFrontendClient.EXPECT().
+		SignalWithStartWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req *workflowservice.SignalWithStartWorkflowExecutionRequest, _ ...any) (*workflowservice.SignalWithStartWorkflowExecutionResponse, error) {
+			require.Equal(e.T(), "scheduled-wf", req.WorkflowId)
+			require.Equal(e.T(), "tick", req.SignalName)
+
+			var scheduledByID string
+			require.NoError(e.T(), payload.Decode(req.SearchAttributes.GetIndexedFields()[searchattribute.TemporalScheduledById], &scheduledByID))
+			require.Equal(e.T(), schedulerSm.ScheduleId, scheduledByID)
+			var scheduledStartTime time.Time
+			require.NoError(e.T(), payload.Decode(req.SearchAttributes.GetIndexedFields()[searchattribute.TemporalScheduledStartTime], &scheduledStartTime))
+			require.True(e.T(), startTime.AsTime().Truncate(time.Second).Equal(scheduledStartTime))
+			return &workflowservice.SignalWithStartWorkflowExecutionResponse{RunId: "run-id"}, nil
+		})
+
+	e.runTestCase(&testCase{
+		TaskType:                 scheduler.TaskTypeExecute,
+		InitialBufferedStarts:    bufferedStarts,
+		InitialState:             enumsspb.SCHEDULER_INVOKER_STATE_WAITING,
+		ExpectedBufferedStarts:   0,
+		ExpectedRunningWorkflows: 0,
+		ExpectedActionCount:      1,
+		ExpectedState:            enumsspb.SCHEDULER_INVOKER_STATE_PROCESSING,
+		ExpectedTasks: map[string]int{
+			scheduler.TaskTypeProcessBuffer: 1,
+		},
+	})
+	require.Len(e.T(), schedulerSm.Info.RecentActions, 1)
+	require.Equal(e.T(), "run-id", schedulerSm.Info.RecentActions[0].StartWorkflowResult.RunId)
+}
+
+// Execute starts the operation of a Nexus operation action and records it on the Scheduler.
+func (e *invokerExecutorsSuite) TestExecuteTask_NexusOperationAction() {
+	e.nexusListenAddr = nexustest.AllocListenAddress()
+	nexustest.NewNexusServer(e.T(), e.nexusListenAddr, nexustest.Handler{
+		OnStartOperation: func(ctx context.Context, service, operation string, input *nexus.LazyValue, options nexus.StartOperationOptions) (nexus.HandlerStartOperationResult[any], error) {
+			require.Equal(e.T(), "service", service)
+			require.Equal(e.T(), "operation", operation)
+			require.Equal(e.T(), "req1", options.RequestID)
+			return &nexus.HandlerStartOperationResultAsync{OperationToken: "op-token"}, nil
+		},
+	})
+
+	ext, err := payload.Encode(&schedulespb.ScheduleActionExtension{
+		Action: &schedulespb.ScheduleActionExtension_NexusOperation{
+			NexusOperation: &schedulespb.NexusOperationAction{
+				Endpoint:  "endpoint",
+				Service:   "service",
+				Operation: "operation",
+			},
+		},
+	})
+	require.NoError(e.T(), err)
+	schedulerSm := e.setActionExtension(ext)
+
+	startTime := timestamppb.New(e.env.Now())
+	bufferedStarts := []*schedulespb.BufferedStart{
+		{
+			NominalTime:   startTime,
+			ActualTime:    startTime,
+			DesiredTime:   startTime,
+			Manual:        false,
+			RequestId:     "req1",
+			OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_SKIP,
+			Attempt:       1,
+		},
+	}
+
+	e.runTestCase(&testCase{
+		TaskType:                 scheduler.TaskTypeExecute,
+		InitialBufferedStarts:    bufferedStarts,
+		InitialState:             enumsspb.SCHEDULER_INVOKER_STATE_WAITING,
+		ExpectedBufferedStarts:   0,
+		ExpectedRunningWorkflows: 0,
+		ExpectedActionCount:      1,
+		ExpectedState:            enumsspb.SCHEDULER_INVOKER_STATE_PROCESSING,
+		ExpectedTasks: map[string]int{
+			scheduler.TaskTypeProcessBuffer: 1,
+		},
+	})
+	require.Len(e.T(), schedulerSm.Info.RecentActions, 1)
+	require.Len(e.T(), schedulerSm.RecentNexusOperations, 1)
+	nexusResult := schedulerSm.RecentNexusOperations[0]
+	require.Equal(e.T(), "endpoint", nexusResult.Endpoint)
+	require.Equal(e.T(), "service", nexusResult.Service)
+	require.Equal(e.T(), "operation", nexusResult.Operation)
+	require.Equal(e.T(), "op-token", nexusResult.OperationToken)
+	require.Equal(e.T(), startTime.AsTime(), nexusResult.ScheduleTime.AsTime())
+}
+
+// Execute drops buffered starts if the action extension is invalid, instead of starting a
+// workflow.
+func (e *invokerExecutorsSuite) TestExecuteTask_InvalidActionExtension() {
+	e.setActionExtension(&commonpb.Payload{
+		Metadata: map[string][]byte{"encoding": []byte("binary/protobuf")},
+		Data:     []byte("invalid"),
+	})
+
+	startTime := timestamppb.New(e.env.Now())
+	bufferedStarts := []*schedulespb.BufferedStart{
+		{
+			NominalTime:   startTime,
+			ActualTime:    startTime,
+			DesiredTime:   startTime,
+			Manual:        false,
+			RequestId:     "req1",
+			OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+			Attempt:       1,
+		},
+	}
+
+	// No StartWorkflowExecution call is expected.
+	e.runTestCase(&testCase{
+		TaskType:                 scheduler.TaskTypeExecute,
+		InitialBufferedStarts:    bufferedStarts,
+		InitialState:             enumsspb.SCHEDULER_INVOKER_STATE_WAITING,
+		ExpectedBufferedStarts:   0,
+		ExpectedRunningWorkflows: 0,
+		ExpectedActionCount:      0,
+		ExpectedState:            enumsspb.SCHEDULER_INVOKER_STATE_PROCESSING,
+		ExpectedTasks: map[string]int{
+			scheduler.TaskTypeProcessBuffer: 1,
+		},
+	})
+}
+
 // ProcessBuffer attempts all buffered starts.
 func (e *invokerExecutorsSuite) TestProcessBufferTask_AllowAll() {
 	startTime := timestamppb.New(e.env.Now())
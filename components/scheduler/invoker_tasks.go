@@ -36,7 +36,7 @@ type processBufferResult struct {
 	MissedCatchupWindow int64
 
 	// Number of buffered starts dropped due to the max buffer size having been exceeded.
-	// TODO - set this from Generator/Backfiller
+	// TODO - set this from Generator
 	BufferDropped int64
 }
 
@@ -102,6 +102,10 @@ type EventRecordExecution struct {
 const TaskTypeProcessBuffer = "scheduler.invoker.ProcessBuffer"
 const TaskTypeExecute = "scheduler.invoker.Execute"
 
+// ExecuteTasks run on the outbound queue, which requires a destination. All of a
+// namespace's schedules share one, as they all call the local frontend.
+const executeTaskDestination = "temporal-scheduler"
+
 var _ hsm.Task = ProcessBufferTask{}
 var _ hsm.Task = ExecuteTask{}
 
@@ -114,7 +118,7 @@ func (e ExecuteTask) Deadline() time.Time {
 }
 
 func (ExecuteTask) Destination() string {
-	return ""
+	return executeTaskDestination
 }
 
 func (ExecuteTask) Validate(_ *persistencespb.StateMachineRef, node *hsm.Node) error {
@@ -137,12 +141,36 @@ func (ProcessBufferTask) Validate(_ *persistencespb.StateMachineRef, node *hsm.N
 	return validateTaskTransition(node, TransitionEnqueue)
 }
 
+type ProcessBufferTaskSerializer struct{}
+
+func (ProcessBufferTaskSerializer) Deserialize(_ []byte, attrs hsm.TaskAttributes) (hsm.Task, error) {
+	return ProcessBufferTask{deadline: attrs.Deadline}, nil
+}
+
+func (ProcessBufferTaskSerializer) Serialize(hsm.Task) ([]byte, error) {
+	return nil, nil
+}
+
+type ExecuteTaskSerializer struct{}
+
+func (ExecuteTaskSerializer) Deserialize([]byte, hsm.TaskAttributes) (hsm.Task, error) {
+	return ExecuteTask{}, nil
+}
+
+func (ExecuteTaskSerializer) Serialize(hsm.Task) ([]byte, error) {
+	return nil, nil
+}
+
 func (i Invoker) tasks() (tasks []hsm.Task, err error) {
 	// Add a ProcessBufferTask at the earliest possible time. If the buffer is empty,
 	// or starts are pending processing, the task will be scheduled immediately.
 	if i.State() == enumsspb.SCHEDULER_INVOKER_STATE_PROCESSING {
+		deadline := i.processingDeadline()
+		if deadline.IsZero() {
+			deadline = immediateDeadline
+		}
 		tasks = append(tasks, ProcessBufferTask{
-			deadline: i.processingDeadline(),
+			deadline: deadline,
 		})
 	}
 
@@ -0,0 +1,485 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/service/history/hsm"
+	scheduler1 "go.temporal.io/server/service/worker/scheduler"
+	"go.uber.org/fx"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	// MigrationMode controls migration of a namespace's schedules between the
+	// scheduler workflow and the state machine scheduler.
+	MigrationMode string
+
+	// WorkflowSchedule is the state of a schedule running as a scheduler workflow.
+	WorkflowSchedule struct {
+		Args             *schedulespb.StartScheduleArgs
+		Memo             *commonpb.Memo
+		SearchAttributes *commonpb.SearchAttributes
+
+		// The schedule's next action times, as returned by the scheduler workflow's
+		// describe query. Used to verify migrated schedules.
+		FutureActionTimes []*timestamppb.Timestamp
+	}
+
+	MigratorOptions struct {
+		fx.In
+
+		Config         *Config
+		MetricsHandler metrics.Handler
+		BaseLogger     log.Logger
+		SpecBuilder    *scheduler1.SpecBuilder
+	}
+
+	// Migrator converts schedules between the scheduler workflow and the state
+	// machine scheduler, according to each namespace's MigrationMode.
+	Migrator struct {
+		options MigratorOptions
+	}
+)
+
+const (
+	// Schedules aren't migrated.
+	MigrationModeDisabled MigrationMode = "disabled"
+	// Scheduler workflows are converted and verified, but not migrated.
+	MigrationModeVerify MigrationMode = "verify"
+	// Scheduler workflows are migrated to the state machine scheduler.
+	MigrationModeEnabled MigrationMode = "enabled"
+	// State machine schedulers are migrated back to scheduler workflows.
+	MigrationModeRollback MigrationMode = "rollback"
+
+	migrationDirectionToStateMachine = "to_state_machine"
+	migrationDirectionToWorkflow     = "to_workflow"
+)
+
+var (
+	ErrMigrationDisabled = serviceerror.NewFailedPrecondition("schedule migration is disabled for this namespace")
+
+	ErrMigrationVerificationFailed = errors.New("migrated schedule's next action times don't match")
+)
+
+func NewMigrator(options MigratorOptions) *Migrator {
+	return &Migrator{
+		options: options,
+	}
+}
+
+// Mode returns the namespace's MigrationMode. Unknown modes are treated as
+// disabled.
+func (m *Migrator) Mode(namespace string) MigrationMode {
+	switch mode := MigrationMode(m.options.Config.MigrationMode(namespace)); mode {
+	case MigrationModeVerify, MigrationModeEnabled, MigrationModeRollback:
+		return mode
+	default:
+		return MigrationModeDisabled
+	}
+}
+
+// MigrateFromWorkflow migrates a schedule from the scheduler workflow to the
+// state machine scheduler, adding a Scheduler tree under parent. Before the tree is
+// added, the migrated Scheduler's next action times are verified against the
+// workflow's. In verify mode, the tree isn't added, and a nil node is returned.
+//
+// The caller is responsible for carrying over the schedule's memo and search
+// attributes, and for stopping the scheduler workflow.
+func (m *Migrator) MigrateFromWorkflow(parent *hsm.Node, schedule WorkflowSchedule) (*hsm.Node, error) {
+	namespace := schedule.Args.GetState().GetNamespace()
+	mode := m.Mode(namespace)
+	if mode != MigrationModeVerify && mode != MigrationModeEnabled {
+		return nil, ErrMigrationDisabled
+	}
+
+	state := LegacyToMigrationState(schedule)
+	if err := m.verify(state, schedule.FutureActionTimes, migrationDirectionToStateMachine); err != nil {
+		return nil, err
+	}
+	if mode == MigrationModeVerify {
+		return nil, nil
+	}
+
+	node, err := CreateSchedulerFromMigrationState(parent, state)
+	if err != nil {
+		return nil, err
+	}
+	m.options.MetricsHandler.Counter(metrics.ScheduleMigrations.Name()).Record(
+		1,
+		metrics.NamespaceTag(namespace),
+		metrics.StringTag("direction", migrationDirectionToStateMachine),
+	)
+	return node, nil
+}
+
+// MigrateToWorkflow migrates a schedule from the state machine scheduler back to
+// the scheduler workflow, returning the state to start the workflow with. The
+// schedule's memo and search attributes are those of the Scheduler's execution.
+// Converting the returned state back must result in the same next action times.
+//
+// Unless force is set, the namespace must be in rollback mode. Force is used to
+// hand the schedule back to the scheduler workflow for changes that only the
+// workflow handles, such as schedule updates.
+//
+// The Scheduler tree isn't modified; the caller is responsible for deleting it
+// once the scheduler workflow has taken over.
+func (m *Migrator) MigrateToWorkflow(
+	schedulerNode *hsm.Node,
+	memo *commonpb.Memo,
+	searchAttributes *commonpb.SearchAttributes,
+	now time.Time,
+	force bool,
+) (WorkflowSchedule, error) {
+	schedule, err := m.Describe(schedulerNode, memo, searchAttributes, now)
+	if err != nil {
+		return WorkflowSchedule{}, err
+	}
+	namespace := schedule.Args.GetState().GetNamespace()
+	if !force && m.Mode(namespace) != MigrationModeRollback {
+		return WorkflowSchedule{}, ErrMigrationDisabled
+	}
+
+	if err := m.verify(LegacyToMigrationState(schedule), schedule.FutureActionTimes, migrationDirectionToWorkflow); err != nil {
+		return WorkflowSchedule{}, err
+	}
+
+	m.options.MetricsHandler.Counter(metrics.ScheduleMigrations.Name()).Record(
+		1,
+		metrics.NamespaceTag(namespace),
+		metrics.StringTag("direction", migrationDirectionToWorkflow),
+	)
+	return schedule, nil
+}
+
+// Describe returns the current state of a schedule run by the state machine
+// scheduler, as the scheduler workflow's state, along with its next action times.
+// The schedule isn't migrated and the Scheduler tree isn't modified.
+func (m *Migrator) Describe(
+	schedulerNode *hsm.Node,
+	memo *commonpb.Memo,
+	searchAttributes *commonpb.SearchAttributes,
+	now time.Time,
+) (WorkflowSchedule, error) {
+	state, err := MigrationStateFromScheduler(schedulerNode)
+	if err != nil {
+		return WorkflowSchedule{}, err
+	}
+	namespace := state.Scheduler.GetNamespace()
+	return WorkflowSchedule{
+		Args:              MigrationStateToLegacy(state, now),
+		Memo:              common.CloneProto(memo),
+		SearchAttributes:  common.CloneProto(searchAttributes),
+		FutureActionTimes: m.futureActionTimes(state, m.options.Config.Tweakables(namespace).FutureActionCount),
+	}, nil
+}
+
+// verify compares the migrated schedule's next action times to the expected times.
+func (m *Migrator) verify(
+	state *schedulespb.SchedulerMigrationState,
+	expected []*timestamppb.Timestamp,
+	direction string,
+) error {
+	err := compareActionTimes(expected, m.futureActionTimes(state, len(expected)))
+	if err != nil {
+		namespace := state.Scheduler.GetNamespace()
+		logger := log.With(
+			m.options.BaseLogger,
+			tag.NewStringTag("wf-namespace", namespace),
+			tag.NewStringTag("schedule-id", state.Scheduler.GetScheduleId()),
+		)
+		logger.Warn("Schedule migration verification failed",
+			tag.NewStringTag("direction", direction),
+			tag.Error(err))
+		m.options.MetricsHandler.Counter(metrics.ScheduleMigrationVerificationFailures.Name()).Record(
+			1,
+			metrics.NamespaceTag(namespace),
+			metrics.StringTag("direction", direction),
+		)
+	}
+	return err
+}
+
+func (m *Migrator) futureActionTimes(state *schedulespb.SchedulerMigrationState, n int) []*timestamppb.Timestamp {
+	scheduler := Scheduler{
+		SchedulerInternal:  state.Scheduler,
+		cacheConflictToken: state.Scheduler.GetConflictToken(),
+	}
+	return scheduler.futureActionTimes(m.options.SpecBuilder, state.Generator.GetLastProcessedTime().AsTime(), n)
+}
+
+func compareActionTimes(expected, actual []*timestamppb.Timestamp) error {
+	for i := 0; i < max(len(expected), len(actual)); i++ {
+		if i >= len(expected) || i >= len(actual) || !expected[i].AsTime().Equal(actual[i].AsTime()) {
+			return fmt.Errorf(
+				"%w: expected %v, got %v",
+				ErrMigrationVerificationFailed,
+				formatActionTimes(expected),
+				formatActionTimes(actual),
+			)
+		}
+	}
+	return nil
+}
+
+func formatActionTimes(times []*timestamppb.Timestamp) []string {
+	out := make([]string, len(times))
+	for i, t := range times {
+		out[i] = t.AsTime().Format(time.RFC3339)
+	}
+	return out
+}
+
+// LegacyToMigrationState converts a scheduler workflow's state to a
+// SchedulerMigrationState. The Generator resumes from the workflow's high water
+// mark, and each of the workflow's ongoing backfills is given a Backfiller.
+func LegacyToMigrationState(schedule WorkflowSchedule) *schedulespb.SchedulerMigrationState {
+	args := common.CloneProto(schedule.Args)
+	internal := args.GetState()
+
+	scheduler := Scheduler{
+		SchedulerInternal: &schedulespb.SchedulerInternal{
+			Schedule:             args.GetSchedule(),
+			Info:                 args.GetInfo(),
+			InitialPatch:         args.GetInitialPatch(),
+			Namespace:            internal.GetNamespace(),
+			NamespaceId:          internal.GetNamespaceId(),
+			ScheduleId:           internal.GetScheduleId(),
+			ConflictToken:        internal.GetConflictToken(),
+			LastCompletionResult: internal.GetLastCompletionResult(),
+			ContinuedFailure:     internal.GetContinuedFailure(),
+		},
+	}
+
+	generator := &schedulespb.GeneratorInternal{
+		NextInvocationTime: common.CloneProto(internal.GetLastProcessedTime()),
+		LastProcessedTime:  internal.GetLastProcessedTime(),
+	}
+
+	invoker := &schedulespb.InvokerInternal{
+		State:             enumsspb.SCHEDULER_INVOKER_STATE_WAITING,
+		BufferedStarts:    internal.GetBufferedStarts(),
+		LastProcessedTime: common.CloneProto(internal.GetLastProcessedTime()),
+	}
+	for i, start := range invoker.BufferedStarts {
+		// The scheduler workflow doesn't assign request IDs. Manual starts may
+		// overlap with each other, so they're made unique by their position.
+		backfillID := ""
+		if start.Manual {
+			backfillID = fmt.Sprintf("migrated-%d", i)
+		}
+		start.RequestId = generateRequestID(scheduler, backfillID, start.NominalTime.AsTime(), start.ActualTime.AsTime())
+	}
+	if len(invoker.BufferedStarts) > 0 {
+		invoker.State = enumsspb.SCHEDULER_INVOKER_STATE_PROCESSING
+	}
+
+	var backfillers []*schedulespb.BackfillerInternal
+	for _, request := range internal.GetOngoingBackfills() {
+		backfillers = append(backfillers, &schedulespb.BackfillerInternal{
+			Request: &schedulespb.BackfillerInternal_BackfillRequest{
+				BackfillRequest: request,
+			},
+			BackfillId: uuid.NewString(),
+			// The scheduler workflow advances an ongoing backfill's (exclusive) start
+			// time as it makes progress.
+			LastProcessedTime: common.CloneProto(request.GetStartTime()),
+		})
+	}
+
+	return &schedulespb.SchedulerMigrationState{
+		Scheduler:        scheduler.SchedulerInternal,
+		Generator:        generator,
+		Invoker:          invoker,
+		Backfillers:      backfillers,
+		Memo:             common.CloneProto(schedule.Memo),
+		SearchAttributes: common.CloneProto(schedule.SearchAttributes),
+	}
+}
+
+// MigrationStateToLegacy converts a SchedulerMigrationState to the scheduler
+// workflow's start arguments. Pending trigger immediately requests are buffered as
+// manual actions at now.
+func MigrationStateToLegacy(state *schedulespb.SchedulerMigrationState, now time.Time) *schedulespb.StartScheduleArgs {
+	state = common.CloneProto(state)
+	scheduler := state.GetScheduler()
+
+	internal := &schedulespb.InternalState{
+		Namespace:            scheduler.GetNamespace(),
+		NamespaceId:          scheduler.GetNamespaceId(),
+		ScheduleId:           scheduler.GetScheduleId(),
+		LastProcessedTime:    state.GetGenerator().GetLastProcessedTime(),
+		LastCompletionResult: scheduler.GetLastCompletionResult(),
+		ContinuedFailure:     scheduler.GetContinuedFailure(),
+		ConflictToken:        scheduler.GetConflictToken(),
+		// Have the workflow refresh the status of its running workflows before
+		// starting any buffered actions.
+		NeedRefresh: true,
+	}
+
+	// Pending cancels and terminates aren't carried over, the scheduler workflow
+	// resolves overlap policies for the buffer on its own.
+	for _, start := range state.GetInvoker().GetBufferedStarts() {
+		internal.BufferedStarts = append(internal.BufferedStarts, &schedulespb.BufferedStart{
+			NominalTime:   start.NominalTime,
+			ActualTime:    start.ActualTime,
+			DesiredTime:   start.DesiredTime,
+			OverlapPolicy: start.OverlapPolicy,
+			Manual:        start.Manual,
+		})
+	}
+
+	for _, backfiller := range state.GetBackfillers() {
+		switch request := backfiller.Request.(type) {
+		case *schedulespb.BackfillerInternal_BackfillRequest:
+			backfill := request.BackfillRequest
+			// The scheduler workflow's ongoing backfills have an exclusive start time.
+			if backfiller.LastProcessedTime != nil {
+				backfill.StartTime = backfiller.LastProcessedTime
+			} else {
+				backfill.StartTime = timestamppb.New(backfill.GetStartTime().AsTime().Add(-1 * time.Millisecond))
+			}
+			internal.OngoingBackfills = append(internal.OngoingBackfills, backfill)
+		case *schedulespb.BackfillerInternal_TriggerRequest:
+			internal.BufferedStarts = append(internal.BufferedStarts, &schedulespb.BufferedStart{
+				NominalTime:   timestamppb.New(now),
+				ActualTime:    timestamppb.New(now),
+				OverlapPolicy: request.TriggerRequest.GetOverlapPolicy(),
+				Manual:        true,
+			})
+		}
+	}
+
+	return &schedulespb.StartScheduleArgs{
+		Schedule:     scheduler.GetSchedule(),
+		Info:         scheduler.GetInfo(),
+		InitialPatch: scheduler.GetInitialPatch(),
+		State:        internal,
+	}
+}
+
+// CreateSchedulerFromMigrationState adds a Scheduler tree under parent from the
+// given state, along with its sub state machines' tasks.
+func CreateSchedulerFromMigrationState(parent *hsm.Node, state *schedulespb.SchedulerMigrationState) (*hsm.Node, error) {
+	state = common.CloneProto(state)
+
+	schedulerNode, err := parent.AddChild(hsm.Key{
+		Type: SchedulerMachineType,
+		ID:   state.Scheduler.GetScheduleId(),
+	}, Scheduler{
+		SchedulerInternal:  state.Scheduler,
+		cacheConflictToken: state.Scheduler.GetConflictToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	generatorNode, err := schedulerNode.AddChild(GeneratorMachineKey, Generator{state.Generator})
+	if err != nil {
+		return nil, err
+	}
+	err = hsm.MachineTransition(generatorNode, func(g Generator) (hsm.TransitionOutput, error) {
+		return g.output()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	invokerNode, err := schedulerNode.AddChild(InvokerMachineKey, Invoker{state.Invoker})
+	if err != nil {
+		return nil, err
+	}
+	err = hsm.MachineTransition(invokerNode, func(i Invoker) (hsm.TransitionOutput, error) {
+		return i.output()
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	collection := hsm.NewCollection[Backfiller](schedulerNode, BackfillerMachineType)
+	for _, backfiller := range state.Backfillers {
+		node, err := collection.Add(backfiller.BackfillId, Backfiller{backfiller})
+		if err != nil {
+			return nil, err
+		}
+		err = hsm.MachineTransition(node, func(b Backfiller) (hsm.TransitionOutput, error) {
+			return b.output()
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return schedulerNode, nil
+}
+
+// MigrationStateFromScheduler returns a snapshot of a Scheduler tree's state. The
+// memo and search attributes are left unset, as they belong to the Scheduler's
+// execution.
+func MigrationStateFromScheduler(schedulerNode *hsm.Node) (*schedulespb.SchedulerMigrationState, error) {
+	scheduler, err := loadScheduler(schedulerNode, true)
+	if err != nil {
+		return nil, err
+	}
+
+	generatorNode, err := schedulerNode.Child([]hsm.Key{GeneratorMachineKey})
+	if err != nil {
+		return nil, err
+	}
+	generator, err := hsm.MachineData[Generator](generatorNode)
+	if err != nil {
+		return nil, err
+	}
+
+	invokerNode, err := schedulerNode.Child([]hsm.Key{InvokerMachineKey})
+	if err != nil {
+		return nil, err
+	}
+	invoker, err := hsm.MachineData[Invoker](invokerNode)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &schedulespb.SchedulerMigrationState{
+		Scheduler: scheduler.SchedulerInternal,
+		Generator: common.CloneProto(generator.GeneratorInternal),
+		Invoker:   common.CloneProto(invoker.InvokerInternal),
+	}
+	for _, node := range hsm.NewCollection[Backfiller](schedulerNode, BackfillerMachineType).List() {
+		backfiller, err := hsm.MachineData[Backfiller](node)
+		if err != nil {
+			return nil, err
+		}
+		state.Backfillers = append(state.Backfillers, common.CloneProto(backfiller.BackfillerInternal))
+	}
+	// Backfillers run concurrently, but the scheduler workflow processes its ongoing
+	// backfills in order, so order them by progress.
+	slices.SortStableFunc(state.Backfillers, func(a, b *schedulespb.BackfillerInternal) int {
+		return backfillerProgress(a).Compare(backfillerProgress(b))
+	})
+
+	return state, nil
+}
+
+// backfillerProgress returns the time that a Backfiller will resume buffering
+// actions from. Trigger immediately requests have no progress.
+func backfillerProgress(backfiller *schedulespb.BackfillerInternal) time.Time {
+	if backfiller.GetTriggerRequest() != nil {
+		return time.Time{}
+	}
+	if backfiller.LastProcessedTime != nil {
+		return backfiller.LastProcessedTime.AsTime()
+	}
+	return backfiller.GetBackfillRequest().GetStartTime().AsTime()
+}
@@ -0,0 +1,292 @@
+package scheduler_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	schedulepb "go.temporal.io/api/schedule/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/testing/protorequire"
+	"go.temporal.io/server/components/scheduler"
+	"go.temporal.io/server/service/history/hsm"
+	"go.temporal.io/server/service/history/hsm/hsmtest"
+	scheduler1 "go.temporal.io/server/service/worker/scheduler"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var migrationBaseTime = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// newWorkflowSchedule returns the state of a scheduler workflow with buffered
+// starts, an ongoing backfill, and a running workflow.
+func newWorkflowSchedule() scheduler.WorkflowSchedule {
+	base := migrationBaseTime
+	running := &commonpb.WorkflowExecution{WorkflowId: "scheduled-wf-1", RunId: "run-1"}
+	var futureActionTimes []*timestamppb.Timestamp
+	for i := 1; i <= 10; i++ {
+		futureActionTimes = append(futureActionTimes, timestamppb.New(base.Add(time.Duration(i)*defaultInterval)))
+	}
+
+	return scheduler.WorkflowSchedule{
+		Args: &schedulespb.StartScheduleArgs{
+			Schedule: defaultSchedule(),
+			Info: &schedulepb.ScheduleInfo{
+				ActionCount:      3,
+				RunningWorkflows: []*commonpb.WorkflowExecution{running},
+				RecentActions: []*schedulepb.ScheduleActionResult{{
+					ScheduleTime:        timestamppb.New(base.Add(-2 * defaultInterval)),
+					ActualTime:          timestamppb.New(base.Add(-2 * defaultInterval)),
+					StartWorkflowResult: running,
+					StartWorkflowStatus: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+				}},
+				CreateTime: timestamppb.New(base.Add(-time.Hour)),
+				UpdateTime: timestamppb.New(base.Add(-time.Hour)),
+			},
+			State: &schedulespb.InternalState{
+				Namespace:         namespace,
+				NamespaceId:       namespaceID,
+				ScheduleId:        scheduleID,
+				LastProcessedTime: timestamppb.New(base),
+				BufferedStarts: []*schedulespb.BufferedStart{
+					{
+						NominalTime:   timestamppb.New(base.Add(-defaultInterval)),
+						ActualTime:    timestamppb.New(base.Add(-defaultInterval)),
+						OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ONE,
+					},
+					{
+						NominalTime:   timestamppb.New(base.Add(-defaultInterval)),
+						ActualTime:    timestamppb.New(base.Add(-defaultInterval)),
+						OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+						Manual:        true,
+					},
+				},
+				OngoingBackfills: []*schedulepb.BackfillRequest{{
+					StartTime:     timestamppb.New(base.Add(-24 * time.Hour)),
+					EndTime:       timestamppb.New(base.Add(-12 * time.Hour)),
+					OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_BUFFER_ALL,
+				}},
+				LastCompletionResult: payloads.EncodeString("result"),
+				ConflictToken:        7,
+				NeedRefresh:          true,
+			},
+		},
+		Memo: &commonpb.Memo{
+			Fields: map[string]*commonpb.Payload{"key": payload.EncodeString("memo")},
+		},
+		SearchAttributes: &commonpb.SearchAttributes{
+			IndexedFields: map[string]*commonpb.Payload{"CustomKeywordField": payload.EncodeString("value")},
+		},
+		FutureActionTimes: futureActionTimes,
+	}
+}
+
+func newTestMigrator(mode scheduler.MigrationMode) *scheduler.Migrator {
+	config := defaultConfig()
+	config.MigrationMode = func(_ string) string {
+		return string(mode)
+	}
+	return scheduler.NewMigrator(scheduler.MigratorOptions{
+		Config:         config,
+		MetricsHandler: metrics.NoopMetricsHandler,
+		BaseLogger:     log.NewTestLogger(),
+		SpecBuilder:    scheduler1.NewSpecBuilder(),
+	})
+}
+
+func TestLegacyToMigrationState(t *testing.T) {
+	schedule := newWorkflowSchedule()
+	state := scheduler.LegacyToMigrationState(schedule)
+
+	// Schedule-wide state is carried over to the Scheduler.
+	protorequire.ProtoEqual(t, schedule.Args.Schedule, state.Scheduler.Schedule)
+	protorequire.ProtoEqual(t, schedule.Args.Info, state.Scheduler.Info)
+	protorequire.ProtoEqual(t, schedule.Args.State.LastCompletionResult, state.Scheduler.LastCompletionResult)
+	require.Equal(t, int64(7), state.Scheduler.ConflictToken)
+	require.Equal(t, scheduleID, state.Scheduler.ScheduleId)
+	protorequire.ProtoEqual(t, schedule.Memo, state.Memo)
+	protorequire.ProtoEqual(t, schedule.SearchAttributes, state.SearchAttributes)
+
+	// The Generator resumes from the workflow's high water mark.
+	require.Equal(t, migrationBaseTime, state.Generator.LastProcessedTime.AsTime())
+	require.Equal(t, migrationBaseTime, state.Generator.NextInvocationTime.AsTime())
+
+	// Buffered starts are given unique request IDs.
+	require.Equal(t, enumsspb.SCHEDULER_INVOKER_STATE_PROCESSING, state.Invoker.State)
+	require.Len(t, state.Invoker.BufferedStarts, 2)
+	require.NotEmpty(t, state.Invoker.BufferedStarts[0].RequestId)
+	require.NotEmpty(t, state.Invoker.BufferedStarts[1].RequestId)
+	require.NotEqual(t, state.Invoker.BufferedStarts[0].RequestId, state.Invoker.BufferedStarts[1].RequestId)
+
+	// Ongoing backfills resume from their progress.
+	require.Len(t, state.Backfillers, 1)
+	require.NotEmpty(t, state.Backfillers[0].BackfillId)
+	protorequire.ProtoEqual(t, schedule.Args.State.OngoingBackfills[0], state.Backfillers[0].GetBackfillRequest())
+	require.Equal(t, migrationBaseTime.Add(-24*time.Hour), state.Backfillers[0].LastProcessedTime.AsTime())
+
+	// The workflow's state isn't modified.
+	protorequire.ProtoEqual(t, newWorkflowSchedule().Args, schedule.Args)
+}
+
+func TestMigrationState_RoundTrip(t *testing.T) {
+	registry := newRegistry(t)
+	root := newRoot(t, registry, &hsmtest.NodeBackend{})
+	schedule := newWorkflowSchedule()
+	state := scheduler.LegacyToMigrationState(schedule)
+
+	schedulerNode, err := scheduler.CreateSchedulerFromMigrationState(root, state)
+	require.NoError(t, err)
+
+	// Each sub state machine's tasks are generated.
+	tasks, err := opLogTasks(root)
+	require.NoError(t, err)
+	var taskTypes []string
+	for _, task := range tasks {
+		taskTypes = append(taskTypes, task.Type())
+	}
+	require.ElementsMatch(t, []string{
+		scheduler.TaskTypeBuffer,
+		scheduler.TaskTypeProcessBuffer,
+		scheduler.TaskTypeBackfill,
+	}, taskTypes)
+
+	// Exporting the Scheduler tree results in the same state, less the execution's
+	// memo and search attributes.
+	exported, err := scheduler.MigrationStateFromScheduler(schedulerNode)
+	require.NoError(t, err)
+	state.Memo = nil
+	state.SearchAttributes = nil
+	protorequire.ProtoEqual(t, state, exported)
+
+	// Converting back results in the workflow's original state.
+	args := scheduler.MigrationStateToLegacy(exported, time.Now())
+	protorequire.ProtoEqual(t, schedule.Args, args)
+}
+
+func TestMigrationStateToLegacy_Backfillers(t *testing.T) {
+	now := migrationBaseTime.Add(time.Hour)
+	backfillStart := migrationBaseTime.Add(-time.Hour)
+	state := scheduler.LegacyToMigrationState(newWorkflowSchedule())
+	state.Invoker.BufferedStarts = nil
+	state.Backfillers = []*schedulespb.BackfillerInternal{
+		{
+			Request: &schedulespb.BackfillerInternal_TriggerRequest{
+				TriggerRequest: &schedulepb.TriggerImmediatelyRequest{
+					OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+				},
+			},
+			BackfillId: "trigger",
+		},
+		{
+			Request: &schedulespb.BackfillerInternal_BackfillRequest{
+				BackfillRequest: &schedulepb.BackfillRequest{
+					StartTime: timestamppb.New(backfillStart),
+					EndTime:   timestamppb.New(migrationBaseTime),
+				},
+			},
+			BackfillId: "backfill",
+		},
+	}
+
+	args := scheduler.MigrationStateToLegacy(state, now)
+
+	// A pending trigger is buffered at the current time.
+	protorequire.ProtoSliceEqual(t, []*schedulespb.BufferedStart{{
+		NominalTime:   timestamppb.New(now),
+		ActualTime:    timestamppb.New(now),
+		OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+		Manual:        true,
+	}}, args.State.BufferedStarts)
+
+	// A backfill that hasn't started keeps its inclusive start time.
+	require.Len(t, args.State.OngoingBackfills, 1)
+	require.Equal(t, backfillStart.Add(-time.Millisecond), args.State.OngoingBackfills[0].StartTime.AsTime())
+}
+
+func TestMigrator_MigrateFromWorkflow(t *testing.T) {
+	registry := newRegistry(t)
+	root := newRoot(t, registry, &hsmtest.NodeBackend{})
+	schedulerKey := []hsm.Key{{Type: scheduler.SchedulerMachineType, ID: scheduleID}}
+
+	// Migration is disabled by default.
+	_, err := newTestMigrator(scheduler.MigrationModeDisabled).MigrateFromWorkflow(root, newWorkflowSchedule())
+	require.ErrorIs(t, err, scheduler.ErrMigrationDisabled)
+	_, err = newTestMigrator(scheduler.MigrationModeRollback).MigrateFromWorkflow(root, newWorkflowSchedule())
+	require.ErrorIs(t, err, scheduler.ErrMigrationDisabled)
+
+	// Schedules are verified, but not migrated, in verify mode.
+	node, err := newTestMigrator(scheduler.MigrationModeVerify).MigrateFromWorkflow(root, newWorkflowSchedule())
+	require.NoError(t, err)
+	require.Nil(t, node)
+	_, err = root.Child(schedulerKey)
+	require.ErrorIs(t, err, hsm.ErrStateMachineNotFound)
+
+	// A mismatch in next action times fails verification.
+	schedule := newWorkflowSchedule()
+	schedule.FutureActionTimes[3] = timestamppb.New(migrationBaseTime)
+	_, err = newTestMigrator(scheduler.MigrationModeEnabled).MigrateFromWorkflow(root, schedule)
+	require.ErrorIs(t, err, scheduler.ErrMigrationVerificationFailed)
+	_, err = root.Child(schedulerKey)
+	require.ErrorIs(t, err, hsm.ErrStateMachineNotFound)
+
+	node, err = newTestMigrator(scheduler.MigrationModeEnabled).MigrateFromWorkflow(root, newWorkflowSchedule())
+	require.NoError(t, err)
+	require.NotNil(t, node)
+	_, err = root.Child(schedulerKey)
+	require.NoError(t, err)
+}
+
+func TestMigrator_Verify(t *testing.T) {
+	migrator := newTestMigrator(scheduler.MigrationModeVerify)
+
+	// Actions before the schedule's last update are skipped.
+	schedule := newWorkflowSchedule()
+	schedule.Args.Info.UpdateTime = timestamppb.New(migrationBaseTime.Add(3*defaultInterval + time.Second))
+	_, err := migrator.MigrateFromWorkflow(nil, schedule)
+	require.ErrorIs(t, err, scheduler.ErrMigrationVerificationFailed)
+	schedule.FutureActionTimes = schedule.FutureActionTimes[3:]
+	for i := 1; i <= 3; i++ {
+		schedule.FutureActionTimes = append(schedule.FutureActionTimes, timestamppb.New(migrationBaseTime.Add(time.Duration(10+i)*defaultInterval)))
+	}
+	_, err = migrator.MigrateFromWorkflow(nil, schedule)
+	require.NoError(t, err)
+
+	// No more than the remaining actions are expected.
+	schedule = newWorkflowSchedule()
+	schedule.Args.Schedule.State.LimitedActions = true
+	schedule.Args.Schedule.State.RemainingActions = 2
+	_, err = migrator.MigrateFromWorkflow(nil, schedule)
+	require.ErrorIs(t, err, scheduler.ErrMigrationVerificationFailed)
+	schedule.FutureActionTimes = schedule.FutureActionTimes[:2]
+	_, err = migrator.MigrateFromWorkflow(nil, schedule)
+	require.NoError(t, err)
+}
+
+func TestMigrator_MigrateToWorkflow(t *testing.T) {
+	registry := newRegistry(t)
+	root := newRoot(t, registry, &hsmtest.NodeBackend{})
+	schedule := newWorkflowSchedule()
+	schedulerNode, err := scheduler.CreateSchedulerFromMigrationState(root, scheduler.LegacyToMigrationState(schedule))
+	require.NoError(t, err)
+
+	_, err = newTestMigrator(scheduler.MigrationModeEnabled).MigrateToWorkflow(schedulerNode, schedule.Memo, schedule.SearchAttributes, time.Now(), false)
+	require.ErrorIs(t, err, scheduler.ErrMigrationDisabled)
+
+	migrated, err := newTestMigrator(scheduler.MigrationModeRollback).MigrateToWorkflow(schedulerNode, schedule.Memo, schedule.SearchAttributes, time.Now(), false)
+	require.NoError(t, err)
+	protorequire.ProtoEqual(t, schedule.Args, migrated.Args)
+	protorequire.ProtoEqual(t, schedule.Memo, migrated.Memo)
+	protorequire.ProtoEqual(t, schedule.SearchAttributes, migrated.SearchAttributes)
+	protorequire.ProtoSliceEqual(t, schedule.FutureActionTimes, migrated.FutureActionTimes)
+
+	// Forced migrations don't depend on the namespace's mode.
+	forced, err := newTestMigrator(scheduler.MigrationModeEnabled).MigrateToWorkflow(schedulerNode, schedule.Memo, schedule.SearchAttributes, time.Now(), true)
+	require.NoError(t, err)
+	protorequire.ProtoEqual(t, migrated.Args, forced.Args)
+}
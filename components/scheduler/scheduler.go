@@ -21,8 +21,9 @@ type (
 	// - Invoker: executes buffered actions
 	// - Backfiller: buffers actions according to requested backfills
 	//
-	// A running Scheduler will always have exactly one of each of the above sub state
-	// machines mounted as nodes within the HSM tree. The top-level	machine itself
+	// A running Scheduler will always have exactly one Generator and one Invoker
+	// mounted as nodes within the HSM tree, as well as one Backfiller for each ongoing
+	// backfill or trigger immediately request. The top-level	machine itself
 	// remains in a singular running state for its lifetime (all work is done within the
 	// sub state machines). The Scheduler state machine is only responsible for creating
 	// the singleton sub state machines.
@@ -102,8 +103,22 @@ func RegisterStateMachines(r *hsm.Registry) error {
 	if err := r.RegisterMachine(invokerMachineDefinition{}); err != nil {
 		return err
 	}
-	// TODO: add other state machines here
-	return nil
+	return r.RegisterMachine(backfillerMachineDefinition{})
+}
+
+// RegisterTaskSerializers registers serializers for the sub state machines' tasks
+// with the HSM registry. Should be called during dependency injection.
+func RegisterTaskSerializers(r *hsm.Registry) error {
+	if err := r.RegisterTaskSerializer(TaskTypeBuffer, BufferTaskSerializer{}); err != nil {
+		return err
+	}
+	if err := r.RegisterTaskSerializer(TaskTypeProcessBuffer, ProcessBufferTaskSerializer{}); err != nil {
+		return err
+	}
+	if err := r.RegisterTaskSerializer(TaskTypeExecute, ExecuteTaskSerializer{}); err != nil {
+		return err
+	}
+	return r.RegisterTaskSerializer(TaskTypeBackfill, BackfillTaskSerializer{})
 }
 
 func (s Scheduler) State() SchedulerMachineState {
@@ -189,6 +204,34 @@ func (s *Scheduler) getCompiledSpec(specBuilder *scheduler.SpecBuilder) (*schedu
 	return s.compiledSpec, nil
 }
 
+// futureActionTimes returns up to n of the schedule's next action times after
+// the given time. As with the scheduler workflow's describe query, times before
+// the schedule's last update are skipped, and no more than the schedule's
+// remaining actions are returned. An invalid spec has no future action times.
+func (s *Scheduler) futureActionTimes(specBuilder *scheduler.SpecBuilder, after time.Time, n int) []*timestamppb.Timestamp {
+	cspec, err := s.getCompiledSpec(specBuilder)
+	if err != nil {
+		return nil
+	}
+
+	if s.Schedule.GetState().GetLimitedActions() {
+		n = min(int(s.Schedule.State.RemainingActions), n)
+	}
+
+	out := make([]*timestamppb.Timestamp, 0, n)
+	for t := after; len(out) < n; {
+		t = cspec.GetNextTime(s.jitterSeed(), t).Next
+		if t.IsZero() {
+			break
+		}
+		if s.Info.GetUpdateTime().AsTime().After(t) {
+			continue
+		}
+		out = append(out, timestamppb.New(t))
+	}
+	return out
+}
+
 func (s Scheduler) jitterSeed() string {
 	return fmt.Sprintf("%s-%s", s.NamespaceId, s.ScheduleId)
 }
@@ -240,6 +283,9 @@ type EventRecordAction struct {
 	BufferDropped       int64
 	MissedCatchupWindow int64
 	Results             []*schedulepb.ScheduleActionResult
+
+	// Nexus operations started by the actions of Results.
+	NexusOperationResults []*schedulespb.NexusOperationActionResult
 }
 
 // Fired when an action has been taken by the state machine scheduler and should
@@ -256,9 +302,14 @@ var TransitionRecordAction = hsm.NewTransition(
 		if len(event.Results) > 0 {
 			s.Info.RecentActions = util.SliceTail(append(s.Info.RecentActions, event.Results...), recentActionCount)
 		}
+		if len(event.NexusOperationResults) > 0 {
+			s.RecentNexusOperations = util.SliceTail(append(s.RecentNexusOperations, event.NexusOperationResults...), recentActionCount)
+		}
 
 		for _, result := range event.Results {
-			if result.StartWorkflowResult != nil {
+			// Signal actions record the signaled workflow without a status. They don't leave a
+			// workflow running on behalf of the schedule, so they aren't tracked.
+			if result.StartWorkflowResult != nil && result.StartWorkflowStatus == enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING {
 				s.Info.RunningWorkflows = append(s.Info.RunningWorkflows, result.StartWorkflowResult)
 			}
 		}
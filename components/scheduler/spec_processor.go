@@ -3,6 +3,7 @@ package scheduler
 import (
 	"time"
 
+	enumspb "go.temporal.io/api/enums/v1"
 	schedulespb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -21,12 +22,18 @@ type (
 		// ProcessTimeRange generates buffered actions according to the schedule spec for
 		// the given time range.
 		//
-		// The parameter manual is propagated to the returned BufferedStarts. When the limit
-		// is set to a non-nil pointer, it will be decremented for each buffered start, and
-		// the function will return early should limit reach 0.
+		// The parameter overlapPolicy overrides the schedule's overlap policy when it's
+		// specified. The parameter backfillID is used to generate request IDs, and must be
+		// set for backfills (see generateRequestID). The parameter manual is propagated to
+		// the returned BufferedStarts; manual actions are buffered regardless of the
+		// schedule's update time and catchup window. When the limit is set to a non-nil
+		// pointer, it will be decremented for each buffered start, and the function will
+		// return early should limit reach 0.
 		ProcessTimeRange(
 			scheduler Scheduler,
 			start, end time.Time,
+			overlapPolicy enumspb.ScheduleOverlapPolicy,
+			backfillID string,
 			manual bool,
 			limit *int,
 		) (*ProcessedTimeRange, error)
@@ -51,11 +58,13 @@ type (
 func (s SpecProcessorImpl) ProcessTimeRange(
 	scheduler Scheduler,
 	start, end time.Time,
+	overlapPolicy enumspb.ScheduleOverlapPolicy,
+	backfillID string,
 	manual bool,
 	limit *int,
 ) (*ProcessedTimeRange, error) {
 	tweakables := s.Config.Tweakables(scheduler.Namespace)
-	overlapPolicy := scheduler.overlapPolicy()
+	overlapPolicy = scheduler.resolveOverlapPolicy(overlapPolicy)
 
 	s.Logger.Debug("ProcessTimeRange",
 		tag.NewTimeTag("start", start),
@@ -88,13 +97,13 @@ func (s SpecProcessorImpl) ProcessTimeRange(
 	var err error
 	var bufferedStarts []*schedulespb.BufferedStart
 	for next, err = s.getNextTime(scheduler, start); err == nil && !(next.Next.IsZero() || next.Next.After(end)); next, err = s.getNextTime(scheduler, next.Next) {
-		if scheduler.Info.UpdateTime.AsTime().After(next.Next) {
+		if !manual && scheduler.Info.UpdateTime.AsTime().After(next.Next) {
 			// If we've received an update that took effect after the LastProcessedTime high
 			// water mark, discard actions that were scheduled to kick off before the update.
 			continue
 		}
 
-		if end.Sub(next.Next) > catchupWindow {
+		if !manual && end.Sub(next.Next) > catchupWindow {
 			s.Logger.Warn("Schedule missed catchup window",
 				tag.NewTimeTag("now", end),
 				tag.NewTimeTag("time", next.Next))
@@ -111,7 +120,7 @@ func (s SpecProcessorImpl) ProcessTimeRange(
 			ActualTime:    timestamppb.New(next.Next),
 			OverlapPolicy: overlapPolicy,
 			Manual:        manual,
-			RequestId:     generateRequestID(scheduler, "", next.Nominal, next.Next),
+			RequestId:     generateRequestID(scheduler, backfillID, next.Nominal, next.Next),
 		})
 		lastAction = next.Next
 
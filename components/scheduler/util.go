@@ -11,6 +11,11 @@ import (
 	"go.temporal.io/server/service/history/hsm"
 )
 
+// immediateDeadline is the deadline of timer tasks that should fire right away.
+// Immediate HSM tasks run on the outbound queue and need a destination, so these
+// are scheduled as timers that are already due instead.
+var immediateDeadline = time.Unix(0, 0).UTC()
+
 // validateTaskTransition ensures that the given transition is possible with the current
 // state machine state.
 func validateTaskTransition[
@@ -7,6 +7,8 @@ import (
 	"io"
 	"maps"
 	"net"
+	"slices"
+	"strconv"
 	"strings"
 	"sync/atomic"
 	"time"
@@ -22,6 +24,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	sdkclient "go.temporal.io/sdk/client"
 	"go.temporal.io/server/api/adminservice/v1"
+	batchspb "go.temporal.io/server/api/batch/v1"
 	clusterspb "go.temporal.io/server/api/cluster/v1"
 	commonspb "go.temporal.io/server/api/common/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
@@ -30,16 +33,20 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
 	serverClient "go.temporal.io/server/client"
 	"go.temporal.io/server/client/admin"
 	"go.temporal.io/server/client/frontend"
 	"go.temporal.io/server/client/history"
 	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/authorization"
 	"go.temporal.io/server/common/channel"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/convert"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/dynamicconfig/persistedconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -61,7 +68,9 @@ import (
 	"go.temporal.io/server/common/xdc"
 	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/worker/addsearchattributes"
+	"go.temporal.io/server/service/worker/batcher"
 	"go.temporal.io/server/service/worker/dlq"
+	"go.temporal.io/server/service/worker/scheduler"
 	"google.golang.org/grpc/health"
 	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -71,6 +80,7 @@ const (
 	getNamespaceReplicationMessageBatchSize = 100
 	defaultLastMessageID                    = -1
 	listClustersPageSize                    = 100
+	maxBatchOperationResultsPageSize        = 1000
 )
 
 type (
@@ -107,6 +117,8 @@ type (
 		clusterMetadata            cluster.Metadata
 		healthServer               *health.Server
 		historyHealthChecker       HealthChecker
+		dynamicConfigClient        dynamicconfig.Client
+		timeSource                 clock.TimeSource
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -141,6 +153,7 @@ type (
 		HealthServer                        *health.Server
 		EventSerializer                     serialization.Serializer
 		TimeSource                          clock.TimeSource
+		DynamicConfigClient                 dynamicconfig.Client
 
 		// DEPRECATED: only history service on server side is supposed to
 		// use the following components.
@@ -225,6 +238,8 @@ func NewAdminHandler(
 		clusterMetadata:      args.ClusterMetadata,
 		healthServer:         args.HealthServer,
 		historyHealthChecker: historyHealthChecker,
+		dynamicConfigClient:  args.DynamicConfigClient,
+		timeSource:           args.TimeSource,
 		taskCategoryRegistry: args.CategoryRegistry,
 		matchingClient:       args.matchingClient,
 	}
@@ -691,6 +706,65 @@ func (adh *AdminHandler) RebuildMutableState(
 	return &adminservice.RebuildMutableStateResponse{}, nil
 }
 
+func (adh *AdminHandler) ModifyWorkflowProperties(
+	ctx context.Context,
+	request *adminservice.ModifyWorkflowPropertiesRequest,
+) (_ *adminservice.ModifyWorkflowPropertiesResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := adh.historyClient.ModifyWorkflowProperties(ctx, &historyservice.ModifyWorkflowPropertiesRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+		Properties:  request.Properties,
+	}); err != nil {
+		return nil, err
+	}
+	return &adminservice.ModifyWorkflowPropertiesResponse{}, nil
+}
+
+func (adh *AdminHandler) ModifyActivityProperties(
+	ctx context.Context,
+	request *adminservice.ModifyActivityPropertiesRequest,
+) (_ *adminservice.ModifyActivityPropertiesResponse, retError error) {
+	defer log.CapturePanic(adh.logger, &retError)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	if err := validateExecution(request.Execution); err != nil {
+		return nil, err
+	}
+
+	namespaceID, err := adh.namespaceRegistry.GetNamespaceID(namespace.Name(request.GetNamespace()))
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := adh.historyClient.ModifyActivityProperties(ctx, &historyservice.ModifyActivityPropertiesRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   request.Execution,
+		ActivityId:  request.ActivityId,
+		RetryPolicy: request.RetryPolicy,
+	}); err != nil {
+		return nil, err
+	}
+	return &adminservice.ModifyActivityPropertiesResponse{}, nil
+}
+
 func (adh *AdminHandler) ImportWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.ImportWorkflowExecutionRequest,
@@ -1733,6 +1807,474 @@ func (adh *AdminHandler) ForceUnloadTaskQueuePartition(
 	}, nil
 }
 
+// GetDynamicConfig returns the values of a dynamic config key stored in persistence
+func (adh *AdminHandler) GetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.GetDynamicConfigRequest,
+) (_ *adminservice.GetDynamicConfigResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetKey() == "" {
+		return nil, errDynamicConfigKeyNotSet
+	}
+	client, ok := adh.dynamicConfigClient.(*persistedconfig.Client)
+	if !ok {
+		return nil, errPersistedDynamicConfigNotEnabled
+	}
+
+	dc, err := client.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &adminservice.GetDynamicConfigResponse{
+		Values: dc.GetValues()[strings.ToLower(request.GetKey())].GetValues(),
+	}
+	for _, change := range dc.GetChanges() {
+		if strings.EqualFold(change.GetKey(), request.GetKey()) {
+			resp.Changes = append(resp.Changes, change)
+		}
+	}
+	return resp, nil
+}
+
+// SetDynamicConfig replaces the values of a dynamic config key stored in persistence
+func (adh *AdminHandler) SetDynamicConfig(
+	ctx context.Context,
+	request *adminservice.SetDynamicConfigRequest,
+) (_ *adminservice.SetDynamicConfigResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetKey() == "" {
+		return nil, errDynamicConfigKeyNotSet
+	}
+	client, ok := adh.dynamicConfigClient.(*persistedconfig.Client)
+	if !ok {
+		return nil, errPersistedDynamicConfigNotEnabled
+	}
+	if len(request.GetIdentity()) > adh.config.MaxIDLengthLimit() {
+		return nil, errIdentityTooLong
+	}
+
+	change := &persistencespb.DynamicConfigChange{
+		Key:      request.GetKey(),
+		Values:   request.GetValues(),
+		Identity: request.GetIdentity(),
+		Reason:   request.GetReason(),
+	}
+	if claims, ok := ctx.Value(authorization.MappedClaims).(*authorization.Claims); ok && claims != nil {
+		change.Subject = claims.Subject
+	}
+	if err := client.Set(ctx, change); err != nil {
+		return nil, err
+	}
+	return &adminservice.SetDynamicConfigResponse{}, nil
+}
+
+// ListDynamicConfig returns all dynamic config values stored in persistence
+func (adh *AdminHandler) ListDynamicConfig(
+	ctx context.Context,
+	request *adminservice.ListDynamicConfigRequest,
+) (_ *adminservice.ListDynamicConfigResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	client, ok := adh.dynamicConfigClient.(*persistedconfig.Client)
+	if !ok {
+		return nil, errPersistedDynamicConfigNotEnabled
+	}
+
+	dc, err := client.Load(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.ListDynamicConfigResponse{
+		Values:  dc.GetValues(),
+		Changes: dc.GetChanges(),
+	}, nil
+}
+
+// ExplainDynamicConfig returns how the value of a dynamic config key is determined for a set
+// of constraints
+func (adh *AdminHandler) ExplainDynamicConfig(
+	_ context.Context,
+	request *adminservice.ExplainDynamicConfigRequest,
+) (_ *adminservice.ExplainDynamicConfigResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetKey() == "" {
+		return nil, errDynamicConfigKeyNotSet
+	}
+
+	e, err := dynamicconfig.Explain(
+		adh.dynamicConfigClient,
+		dynamicconfig.Key(request.GetKey()),
+		persistedconfig.ConstraintsFromProto(request.GetConstraints()),
+	)
+	if err != nil {
+		return nil, serviceerror.NewInvalidArgument(err.Error())
+	}
+
+	resp := &adminservice.ExplainDynamicConfigResponse{
+		Key:            e.Key.String(),
+		Description:    e.Description,
+		Precedence:     e.Precedence.String(),
+		MatchedDefault: e.MatchedDefault,
+	}
+	if e.ConstrainedDefaults == nil {
+		if resp.DefaultValue, err = persistedconfig.ValueToProto(e.Default); err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+	}
+	if resp.ConstrainedDefaults, err = persistedconfig.ConstrainedValuesToProto(e.ConstrainedDefaults); err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	for _, cs := range e.PrecedenceConstraints {
+		resp.PrecedenceConstraints = append(resp.PrecedenceConstraints, persistedconfig.ConstraintsToProto(cs))
+	}
+	if resp.Values, err = persistedconfig.ConstrainedValuesToProto(e.Values); err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	if resp.MatchingValues, err = persistedconfig.ConstrainedValuesToProto(e.MatchingValues); err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	if e.MatchedConstraints != nil {
+		resp.MatchedConstraints = persistedconfig.ConstraintsToProto(*e.MatchedConstraints)
+	}
+	if resp.EffectiveValue, err = persistedconfig.ValueToProto(e.Value); err != nil {
+		return nil, serviceerror.NewInternal(err.Error())
+	}
+	if e.ConvertError != nil {
+		resp.ConversionError = e.ConvertError.Error()
+	}
+	return resp, nil
+}
+
+// ListDynamicConfigDeviations returns the dynamic config keys with values that differ from
+// their defaults
+func (adh *AdminHandler) ListDynamicConfigDeviations(
+	_ context.Context,
+	request *adminservice.ListDynamicConfigDeviationsRequest,
+) (_ *adminservice.ListDynamicConfigDeviationsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+
+	resp := &adminservice.ListDynamicConfigDeviationsResponse{
+		Values: make(map[string]*persistencespb.DynamicConfigValues),
+	}
+	for _, deviation := range dynamicconfig.ListDeviations(adh.dynamicConfigClient) {
+		values, err := persistedconfig.ConstrainedValuesToProto(deviation.Values)
+		if err != nil {
+			return nil, serviceerror.NewInternal(err.Error())
+		}
+		resp.Values[deviation.Key.String()] = &persistencespb.DynamicConfigValues{Values: values}
+	}
+	return resp, nil
+}
+
+// CreateScheduleExclusionCalendar registers a named exclusion calendar on a namespace
+func (adh *AdminHandler) CreateScheduleExclusionCalendar(
+	ctx context.Context,
+	request *adminservice.CreateScheduleExclusionCalendarRequest,
+) (_ *adminservice.CreateScheduleExclusionCalendarResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := adh.validateScheduleExclusionCalendar(request.GetNamespace(), request.GetCalendar(), request.GetIdentity()); err != nil {
+		return nil, err
+	}
+
+	calendar := common.CloneProto(request.GetCalendar())
+	calendar.UpdateTime = timestamppb.New(adh.timeSource.Now())
+	calendar.UpdatedByIdentity = request.GetIdentity()
+	err = adh.updateScheduleExclusionCalendars(ctx, request.GetNamespace(), func(calendars map[string]*schedulespb.ExclusionCalendar) error {
+		if _, ok := calendars[calendar.GetName()]; ok {
+			return serviceerror.NewAlreadyExistsf("Exclusion calendar %s already exists.", calendar.GetName())
+		}
+		if maxCalendars := adh.config.MaxScheduleExclusionCalendarsPerNamespace(request.GetNamespace()); len(calendars) >= maxCalendars {
+			return serviceerror.NewInvalidArgumentf("Exclusion calendar limit exceeded. Max: %v", maxCalendars)
+		}
+		calendars[calendar.GetName()] = calendar
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.CreateScheduleExclusionCalendarResponse{Calendar: calendar}, nil
+}
+
+// UpdateScheduleExclusionCalendar replaces an existing exclusion calendar of a namespace
+func (adh *AdminHandler) UpdateScheduleExclusionCalendar(
+	ctx context.Context,
+	request *adminservice.UpdateScheduleExclusionCalendarRequest,
+) (_ *adminservice.UpdateScheduleExclusionCalendarResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if err := adh.validateScheduleExclusionCalendar(request.GetNamespace(), request.GetCalendar(), request.GetIdentity()); err != nil {
+		return nil, err
+	}
+
+	calendar := common.CloneProto(request.GetCalendar())
+	calendar.UpdateTime = timestamppb.New(adh.timeSource.Now())
+	calendar.UpdatedByIdentity = request.GetIdentity()
+	err = adh.updateScheduleExclusionCalendars(ctx, request.GetNamespace(), func(calendars map[string]*schedulespb.ExclusionCalendar) error {
+		if _, ok := calendars[calendar.GetName()]; !ok {
+			return serviceerror.NewNotFoundf("Exclusion calendar %s not found.", calendar.GetName())
+		}
+		calendars[calendar.GetName()] = calendar
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.UpdateScheduleExclusionCalendarResponse{Calendar: calendar}, nil
+}
+
+// DescribeScheduleExclusionCalendar returns an exclusion calendar of a namespace
+func (adh *AdminHandler) DescribeScheduleExclusionCalendar(
+	ctx context.Context,
+	request *adminservice.DescribeScheduleExclusionCalendarRequest,
+) (_ *adminservice.DescribeScheduleExclusionCalendarResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if request.GetName() == "" {
+		return nil, errExclusionCalendarNameNotSet
+	}
+
+	resp, err := adh.persistenceMetadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: request.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	calendar, ok := resp.Namespace.GetConfig().GetScheduleExclusionCalendars()[request.GetName()]
+	if !ok {
+		return nil, serviceerror.NewNotFoundf("Exclusion calendar %s not found.", request.GetName())
+	}
+	return &adminservice.DescribeScheduleExclusionCalendarResponse{Calendar: calendar}, nil
+}
+
+// DeleteScheduleExclusionCalendar removes an exclusion calendar from a namespace
+func (adh *AdminHandler) DeleteScheduleExclusionCalendar(
+	ctx context.Context,
+	request *adminservice.DeleteScheduleExclusionCalendarRequest,
+) (_ *adminservice.DeleteScheduleExclusionCalendarResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if request.GetName() == "" {
+		return nil, errExclusionCalendarNameNotSet
+	}
+
+	err = adh.updateScheduleExclusionCalendars(ctx, request.GetNamespace(), func(calendars map[string]*schedulespb.ExclusionCalendar) error {
+		if _, ok := calendars[request.GetName()]; !ok {
+			return serviceerror.NewNotFoundf("Exclusion calendar %s not found.", request.GetName())
+		}
+		delete(calendars, request.GetName())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &adminservice.DeleteScheduleExclusionCalendarResponse{}, nil
+}
+
+// ListScheduleExclusionCalendars returns the exclusion calendars of a namespace
+func (adh *AdminHandler) ListScheduleExclusionCalendars(
+	ctx context.Context,
+	request *adminservice.ListScheduleExclusionCalendarsRequest,
+) (_ *adminservice.ListScheduleExclusionCalendarsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+
+	resp, err := adh.persistenceMetadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: request.GetNamespace()})
+	if err != nil {
+		return nil, err
+	}
+	calendars := slices.Collect(maps.Values(resp.Namespace.GetConfig().GetScheduleExclusionCalendars()))
+	slices.SortFunc(calendars, func(a, b *schedulespb.ExclusionCalendar) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+	return &adminservice.ListScheduleExclusionCalendarsResponse{Calendars: calendars}, nil
+}
+
+func (adh *AdminHandler) validateScheduleExclusionCalendar(
+	nsName string,
+	calendar *schedulespb.ExclusionCalendar,
+	identity string,
+) error {
+	if nsName == "" {
+		return errNamespaceNotSet
+	}
+	if calendar == nil {
+		return errExclusionCalendarNotSet
+	}
+	if calendar.GetName() == "" {
+		return errExclusionCalendarNameNotSet
+	}
+	if len(calendar.GetName()) > adh.config.MaxIDLengthLimit() {
+		return serviceerror.NewInvalidArgument("Exclusion calendar name length exceeds limit.")
+	}
+	if len(identity) > adh.config.MaxIDLengthLimit() {
+		return errIdentityTooLong
+	}
+	if maxDates := adh.config.MaxScheduleExclusionCalendarDates(nsName); len(calendar.GetDates()) > maxDates {
+		return serviceerror.NewInvalidArgumentf("Exclusion calendar has too many dates. Max: %v", maxDates)
+	}
+	if err := scheduler.ValidateExclusionCalendar(calendar); err != nil {
+		return serviceerror.NewInvalidArgumentf("Invalid exclusion calendar: %v", err)
+	}
+	return nil
+}
+
+// updateScheduleExclusionCalendars applies update to the exclusion calendars of a namespace
+// and persists them. Schedules load the calendars through the namespace registry.
+func (adh *AdminHandler) updateScheduleExclusionCalendars(
+	ctx context.Context,
+	nsName string,
+	update func(calendars map[string]*schedulespb.ExclusionCalendar) error,
+) error {
+	metadata, err := adh.persistenceMetadataManager.GetMetadata(ctx)
+	if err != nil {
+		return err
+	}
+	resp, err := adh.persistenceMetadataManager.GetNamespace(ctx, &persistence.GetNamespaceRequest{Name: nsName})
+	if err != nil {
+		return err
+	}
+
+	existingNamespace := resp.Namespace
+	config := existingNamespace.Config
+	if config.ScheduleExclusionCalendars == nil {
+		config.ScheduleExclusionCalendars = make(map[string]*schedulespb.ExclusionCalendar)
+	}
+	if err := update(config.ScheduleExclusionCalendars); err != nil {
+		return err
+	}
+
+	return adh.persistenceMetadataManager.UpdateNamespace(ctx, &persistence.UpdateNamespaceRequest{
+		Namespace: &persistencespb.NamespaceDetail{
+			Info:                        existingNamespace.Info,
+			Config:                      config,
+			ReplicationConfig:           existingNamespace.ReplicationConfig,
+			ConfigVersion:               existingNamespace.ConfigVersion + 1,
+			FailoverVersion:             existingNamespace.FailoverVersion,
+			FailoverNotificationVersion: existingNamespace.FailoverNotificationVersion,
+		},
+		IsGlobalNamespace:   resp.IsGlobalNamespace,
+		NotificationVersion: metadata.NotificationVersion,
+	})
+}
+
+func (adh *AdminHandler) ListBatchOperationResults(
+	ctx context.Context,
+	request *adminservice.ListBatchOperationResultsRequest,
+) (_ *adminservice.ListBatchOperationResultsResponse, err error) {
+	defer log.CapturePanic(adh.logger, &err)
+
+	if request == nil {
+		return nil, errRequestNotSet
+	}
+	if request.GetNamespace() == "" {
+		return nil, errNamespaceNotSet
+	}
+	if request.GetJobId() == "" {
+		return nil, errBatchJobIDNotSet
+	}
+	offset := 0
+	if len(request.GetNextPageToken()) > 0 {
+		if offset, err = strconv.Atoi(string(request.GetNextPageToken())); err != nil || offset < 0 {
+			return nil, errInvalidNextPageToken
+		}
+	}
+	pageSize := int(request.GetPageSize())
+	if pageSize <= 0 || pageSize > maxBatchOperationResultsPageSize {
+		pageSize = maxBatchOperationResultsPageSize
+	}
+
+	// Results are in the heartbeat details of the batch activity while the batch is running,
+	// and in the result of the batch workflow once it completed.
+	client := adh.sdkClientFactory.NewClient(sdkclient.Options{
+		Namespace:     request.GetNamespace(),
+		DataConverter: sdk.PreferProtoDataConverter,
+	})
+	execution, err := client.DescribeWorkflowExecution(ctx, request.GetJobId(), "")
+	if err != nil {
+		return nil, err
+	}
+	var hbd batcher.HeartBeatDetails
+	switch status := execution.GetWorkflowExecutionInfo().GetStatus(); status { // nolint:exhaustive
+	case enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING:
+		if pending := execution.GetPendingActivities(); len(pending) > 0 && pending[0].GetHeartbeatDetails() != nil {
+			if err := sdk.PreferProtoDataConverter.FromPayloads(pending[0].GetHeartbeatDetails(), &hbd); err != nil {
+				return nil, err
+			}
+		}
+	case enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED:
+		if err := client.GetWorkflow(ctx, request.GetJobId(), "").Get(ctx, &hbd); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, serviceerror.NewFailedPreconditionf("Batch operation is %v. Results are only kept for running and completed batch operations.", status)
+	}
+	results, err := hbd.GetResults()
+	if err != nil {
+		return nil, serviceerror.NewInternalf("Unable to decode batch operation results: %v", err)
+	}
+
+	items := results.GetItems()
+	if request.GetFailuresOnly() {
+		items = slices.DeleteFunc(items, func(item *batchspb.BatchOperationItemResult) bool {
+			return item.GetError() == ""
+		})
+	}
+	response := &adminservice.ListBatchOperationResultsResponse{
+		DroppedSuccesses: results.GetDroppedSuccesses(),
+		DroppedFailures:  results.GetDroppedFailures(),
+	}
+	if offset < len(items) {
+		end := min(offset+pageSize, len(items))
+		response.Items = items[offset:end]
+		if end < len(items) {
+			response.NextPageToken = []byte(strconv.Itoa(end))
+		}
+	}
+	return response, nil
+}
+
 func (adh *AdminHandler) DeleteWorkflowExecution(
 	ctx context.Context,
 	request *adminservice.DeleteWorkflowExecutionRequest,
@@ -8,6 +8,7 @@ import (
 	"strings"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/pborman/uuid"
 	"github.com/stretchr/testify/assert"
@@ -23,6 +24,7 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/api/adminservicemock/v1"
+	batchspb "go.temporal.io/server/api/batch/v1"
 	commonspb "go.temporal.io/server/api/common/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
@@ -30,6 +32,7 @@ import (
 	"go.temporal.io/server/api/matchingservice/v1"
 	"go.temporal.io/server/api/matchingservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
 	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	clientmocks "go.temporal.io/server/client"
 	historyclient "go.temporal.io/server/client/history"
@@ -46,6 +49,7 @@ import (
 	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/resourcetest"
+	"go.temporal.io/server/common/sdk"
 	"go.temporal.io/server/common/searchattribute"
 	serviceerror2 "go.temporal.io/server/common/serviceerror"
 	test "go.temporal.io/server/common/testing"
@@ -54,12 +58,14 @@ import (
 	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/common/testing/testvars"
 	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/worker/batcher"
 	"go.temporal.io/server/service/worker/dlq"
 	"go.uber.org/mock/gomock"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/health"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 type (
@@ -172,6 +178,7 @@ func (s *adminHandlerSuite) SetupTest() {
 		health.NewServer(),
 		serialization.NewSerializer(),
 		clock.NewRealTimeSource(),
+		dynamicconfig.NewNoopClient(),
 		tasks.NewDefaultTaskCategoryRegistry(),
 		s.mockResource.GetMatchingClient(),
 	}
@@ -521,6 +528,154 @@ func (s *adminHandlerSuite) Test_RemoveRemoteCluster_Error() {
 	s.Error(err)
 }
 
+func (s *adminHandlerSuite) Test_GetDynamicConfig_KeyNotSet() {
+	_, err := s.handler.GetDynamicConfig(context.Background(), &adminservice.GetDynamicConfigRequest{})
+	s.Equal(errDynamicConfigKeyNotSet, err)
+}
+
+func (s *adminHandlerSuite) Test_DynamicConfig_NotEnabled() {
+	_, err := s.handler.GetDynamicConfig(context.Background(), &adminservice.GetDynamicConfigRequest{Key: "frontend.rps"})
+	s.Equal(errPersistedDynamicConfigNotEnabled, err)
+
+	_, err = s.handler.SetDynamicConfig(context.Background(), &adminservice.SetDynamicConfigRequest{Key: "frontend.rps"})
+	s.Equal(errPersistedDynamicConfigNotEnabled, err)
+
+	_, err = s.handler.ListDynamicConfig(context.Background(), &adminservice.ListDynamicConfigRequest{})
+	s.Equal(errPersistedDynamicConfigNotEnabled, err)
+}
+
+func (s *adminHandlerSuite) Test_ExplainDynamicConfig() {
+	resp, err := s.handler.ExplainDynamicConfig(context.Background(), &adminservice.ExplainDynamicConfigRequest{
+		Key:         dynamicconfig.FrontendMaxNamespaceRPSPerInstance.Key().String(),
+		Constraints: &persistencespb.DynamicConfigConstraints{Namespace: "ns"},
+	})
+	s.NoError(err)
+	s.Equal("Namespace", resp.Precedence)
+	s.Len(resp.PrecedenceConstraints, 2)
+	s.Equal("ns", resp.PrecedenceConstraints[0].GetNamespace())
+	s.Empty(resp.Values)
+	s.True(resp.MatchedDefault)
+	s.Equal(float64(2400), resp.DefaultValue.GetNumberValue())
+	s.Equal(float64(2400), resp.EffectiveValue.GetNumberValue())
+
+	_, err = s.handler.ExplainDynamicConfig(context.Background(), &adminservice.ExplainDynamicConfigRequest{Key: "unknownKey"})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) Test_CreateScheduleExclusionCalendar() {
+	s.handler.config.MaxIDLengthLimit = dynamicconfig.GetIntPropertyFn(1000)
+	s.handler.config.MaxScheduleExclusionCalendarsPerNamespace = dynamicconfig.GetIntPropertyFnFilteredByNamespace(2)
+	s.handler.config.MaxScheduleExclusionCalendarDates = dynamicconfig.GetIntPropertyFnFilteredByNamespace(10)
+
+	calendar := &schedulespb.ExclusionCalendar{
+		Name:  "holidays",
+		Dates: []*schedulespb.ExclusionCalendarDate{{Year: 2024, Month: 12, Day: 25}},
+	}
+
+	// invalid calendars are rejected before touching persistence
+	_, err := s.handler.CreateScheduleExclusionCalendar(context.Background(), &adminservice.CreateScheduleExclusionCalendarRequest{
+		Namespace: s.namespace.String(),
+		Calendar: &schedulespb.ExclusionCalendar{
+			Name:  "holidays",
+			Dates: []*schedulespb.ExclusionCalendarDate{{Year: 2024, Month: 2, Day: 30}},
+		},
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+
+	detail := &persistencespb.NamespaceDetail{
+		Info:          &persistencespb.NamespaceInfo{Id: s.namespaceID.String(), Name: s.namespace.String()},
+		Config:        &persistencespb.NamespaceConfig{},
+		ConfigVersion: 3,
+	}
+	s.mockResource.MetadataMgr.EXPECT().GetMetadata(gomock.Any()).Return(&persistence.GetMetadataResponse{NotificationVersion: 7}, nil).Times(2)
+	s.mockResource.MetadataMgr.EXPECT().GetNamespace(gomock.Any(), &persistence.GetNamespaceRequest{Name: s.namespace.String()}).Return(
+		&persistence.GetNamespaceResponse{Namespace: detail}, nil,
+	).Times(2)
+	s.mockResource.MetadataMgr.EXPECT().UpdateNamespace(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *persistence.UpdateNamespaceRequest) error {
+			s.Equal(int64(4), req.Namespace.ConfigVersion)
+			s.Equal(int64(7), req.NotificationVersion)
+			s.ProtoEqual(calendar.GetDates()[0], req.Namespace.Config.ScheduleExclusionCalendars["holidays"].GetDates()[0])
+			return nil
+		},
+	)
+
+	resp, err := s.handler.CreateScheduleExclusionCalendar(context.Background(), &adminservice.CreateScheduleExclusionCalendarRequest{
+		Namespace: s.namespace.String(),
+		Calendar:  calendar,
+		Identity:  "me",
+	})
+	s.NoError(err)
+	s.Equal("me", resp.Calendar.GetUpdatedByIdentity())
+	s.NotNil(resp.Calendar.GetUpdateTime())
+
+	// the stored namespace now has the calendar
+	_, err = s.handler.CreateScheduleExclusionCalendar(context.Background(), &adminservice.CreateScheduleExclusionCalendarRequest{
+		Namespace: s.namespace.String(),
+		Calendar:  calendar,
+	})
+	var alreadyExists *serviceerror.AlreadyExists
+	s.ErrorAs(err, &alreadyExists)
+}
+
+func (s *adminHandlerSuite) Test_ListBatchOperationResults() {
+	jobID := "job-id"
+	mockSdkClient := mocksdk.NewMockClient(s.controller)
+	s.mockResource.SDKClientFactory.EXPECT().NewClient(gomock.Any()).Return(mockSdkClient).AnyTimes()
+
+	results := &batchspb.BatchOperationResults{
+		Items: []*batchspb.BatchOperationItemResult{
+			{Execution: &commonpb.WorkflowExecution{WorkflowId: "wf-1"}},
+			{Execution: &commonpb.WorkflowExecution{WorkflowId: "wf-2"}, Error: "rejected"},
+			{Execution: &commonpb.WorkflowExecution{WorkflowId: "wf-3"}, Error: "not found"},
+		},
+		DroppedSuccesses: 5,
+	}
+	encodedResults, err := results.Marshal()
+	s.NoError(err)
+	details, err := sdk.PreferProtoDataConverter.ToPayloads(batcher.HeartBeatDetails{Results: encodedResults})
+	s.NoError(err)
+	mockSdkClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), jobID, "").Return(&workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING},
+		PendingActivities:     []*workflowpb.PendingActivityInfo{{HeartbeatDetails: details}},
+	}, nil).AnyTimes()
+
+	resp, err := s.handler.ListBatchOperationResults(context.Background(), &adminservice.ListBatchOperationResultsRequest{
+		Namespace:    s.namespace.String(),
+		JobId:        jobID,
+		FailuresOnly: true,
+		PageSize:     1,
+	})
+	s.NoError(err)
+	s.Len(resp.Items, 1)
+	s.Equal("wf-2", resp.Items[0].GetExecution().GetWorkflowId())
+	s.Equal(int64(5), resp.DroppedSuccesses)
+
+	resp, err = s.handler.ListBatchOperationResults(context.Background(), &adminservice.ListBatchOperationResultsRequest{
+		Namespace:     s.namespace.String(),
+		JobId:         jobID,
+		FailuresOnly:  true,
+		PageSize:      1,
+		NextPageToken: resp.NextPageToken,
+	})
+	s.NoError(err)
+	s.Len(resp.Items, 1)
+	s.Equal("wf-3", resp.Items[0].GetExecution().GetWorkflowId())
+	s.Empty(resp.NextPageToken)
+
+	mockSdkClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), "terminated-job-id", "").Return(&workflowservice.DescribeWorkflowExecutionResponse{
+		WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{Status: enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED},
+	}, nil)
+	_, err = s.handler.ListBatchOperationResults(context.Background(), &adminservice.ListBatchOperationResultsRequest{
+		Namespace: s.namespace.String(),
+		JobId:     "terminated-job-id",
+	})
+	var failedPrecondition *serviceerror.FailedPrecondition
+	s.ErrorAs(err, &failedPrecondition)
+}
+
 func (s *adminHandlerSuite) Test_AddOrUpdateRemoteCluster_RecordFound_Success() {
 	var rpcAddress = uuid.New()
 	var FrontendHttpAddress = uuid.New()
@@ -2083,6 +2238,96 @@ func (s *adminHandlerSuite) TestImportWorkflowExecution_WithNonAliasedSearchAttr
 	}
 }
 
+func (s *adminHandlerSuite) TestModifyWorkflowProperties() {
+	tv := testvars.New(s.T()).WithNamespaceName(s.namespace).WithNamespaceID(s.namespaceID)
+	properties := &historypb.WorkflowPropertiesModifiedExternallyEventAttributes{
+		NewWorkflowTaskTimeout: durationpb.New(time.Minute),
+	}
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(tv.NamespaceName()).Return(tv.NamespaceID(), nil)
+	s.mockHistoryClient.EXPECT().ModifyWorkflowProperties(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.ModifyWorkflowPropertiesRequest, _ ...grpc.CallOption) (*historyservice.ModifyWorkflowPropertiesResponse, error) {
+			s.Equal(tv.NamespaceID().String(), request.GetNamespaceId())
+			s.ProtoEqual(tv.WorkflowExecution(), request.GetExecution())
+			s.ProtoEqual(properties, request.GetProperties())
+			return &historyservice.ModifyWorkflowPropertiesResponse{}, nil
+		},
+	)
+	_, err := s.handler.ModifyWorkflowProperties(context.Background(), &adminservice.ModifyWorkflowPropertiesRequest{
+		Namespace:  tv.NamespaceName().String(),
+		Execution:  tv.WorkflowExecution(),
+		Properties: properties,
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestModifyWorkflowProperties_WorkflowClosed() {
+	tv := testvars.New(s.T()).WithNamespaceName(s.namespace).WithNamespaceID(s.namespaceID)
+
+	workflowCompletedErr := serviceerror.NewNotFound("workflow execution already completed")
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(tv.NamespaceName()).Return(tv.NamespaceID(), nil)
+	s.mockHistoryClient.EXPECT().ModifyWorkflowProperties(gomock.Any(), gomock.Any()).Return(nil, workflowCompletedErr)
+	_, err := s.handler.ModifyWorkflowProperties(context.Background(), &adminservice.ModifyWorkflowPropertiesRequest{
+		Namespace: tv.NamespaceName().String(),
+		Execution: tv.WorkflowExecution(),
+		Properties: &historypb.WorkflowPropertiesModifiedExternallyEventAttributes{
+			NewWorkflowTaskTimeout: durationpb.New(time.Minute),
+		},
+	})
+	s.ErrorIs(err, workflowCompletedErr)
+}
+
+func (s *adminHandlerSuite) TestModifyWorkflowProperties_InvalidRequest() {
+	_, err := s.handler.ModifyWorkflowProperties(context.Background(), nil)
+	s.ErrorIs(err, errRequestNotSet)
+
+	_, err = s.handler.ModifyWorkflowProperties(context.Background(), &adminservice.ModifyWorkflowPropertiesRequest{
+		Namespace: s.namespace.String(),
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *adminHandlerSuite) TestModifyActivityProperties() {
+	tv := testvars.New(s.T()).WithNamespaceName(s.namespace).WithNamespaceID(s.namespaceID)
+	retryPolicy := &commonpb.RetryPolicy{MaximumAttempts: 3}
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(tv.NamespaceName()).Return(tv.NamespaceID(), nil)
+	s.mockHistoryClient.EXPECT().ModifyActivityProperties(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *historyservice.ModifyActivityPropertiesRequest, _ ...grpc.CallOption) (*historyservice.ModifyActivityPropertiesResponse, error) {
+			s.Equal(tv.NamespaceID().String(), request.GetNamespaceId())
+			s.ProtoEqual(tv.WorkflowExecution(), request.GetExecution())
+			s.Equal(tv.ActivityID(), request.GetActivityId())
+			s.ProtoEqual(retryPolicy, request.GetRetryPolicy())
+			return &historyservice.ModifyActivityPropertiesResponse{}, nil
+		},
+	)
+	_, err := s.handler.ModifyActivityProperties(context.Background(), &adminservice.ModifyActivityPropertiesRequest{
+		Namespace:   tv.NamespaceName().String(),
+		Execution:   tv.WorkflowExecution(),
+		ActivityId:  tv.ActivityID(),
+		RetryPolicy: retryPolicy,
+	})
+	s.NoError(err)
+}
+
+func (s *adminHandlerSuite) TestModifyActivityProperties_WorkflowClosed() {
+	tv := testvars.New(s.T()).WithNamespaceName(s.namespace).WithNamespaceID(s.namespaceID)
+
+	workflowCompletedErr := serviceerror.NewNotFound("workflow execution already completed")
+
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(tv.NamespaceName()).Return(tv.NamespaceID(), nil)
+	s.mockHistoryClient.EXPECT().ModifyActivityProperties(gomock.Any(), gomock.Any()).Return(nil, workflowCompletedErr)
+	_, err := s.handler.ModifyActivityProperties(context.Background(), &adminservice.ModifyActivityPropertiesRequest{
+		Namespace:   tv.NamespaceName().String(),
+		Execution:   tv.WorkflowExecution(),
+		ActivityId:  tv.ActivityID(),
+		RetryPolicy: &commonpb.RetryPolicy{MaximumAttempts: 3},
+	})
+	s.ErrorIs(err, workflowCompletedErr)
+}
+
 func (s *adminHandlerSuite) validatePhysicalTaskQueueInfo(expectedPhysicalTaskQueueInfo *taskqueuespb.PhysicalTaskQueueInfo,
 	responsePhysicalTaskQueueInfo *taskqueuespb.PhysicalTaskQueueInfo) {
 
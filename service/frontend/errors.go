@@ -33,6 +33,8 @@ var (
 	errWorkflowTypeTooLong                                = serviceerror.NewInvalidArgument("WorkflowType length exceeds limit.")
 	errWorkflowIDTooLong                                  = serviceerror.NewInvalidArgument("WorkflowId length exceeds limit.")
 	errWorkflowRuleIDTooLong                              = serviceerror.NewInvalidArgument("Workflow Rule Id length exceeds limit.")
+	errWorkflowRuleTriggerNotSet                          = serviceerror.NewInvalidArgument("Workflow Rule trigger is not set.")
+	errWorkflowRuleActionsNotSet                          = serviceerror.NewInvalidArgument("Workflow Rule actions are not set.")
 	errSignalNameTooLong                                  = serviceerror.NewInvalidArgument("SignalName length exceeds limit.")
 	errTaskQueueTooLong                                   = serviceerror.NewInvalidArgument("TaskQueue length exceeds limit.")
 	errRequestIDTooLong                                   = serviceerror.NewInvalidArgument("RequestId length exceeds limit.")
@@ -87,6 +89,12 @@ var (
 	errTargetClusterNotSet    = serviceerror.NewInvalidArgument("TargetCluster is not set on request.")
 	errInvalidDLQJobToken     = serviceerror.NewInvalidArgument("Invalid DLQ job token.")
 
+	errDynamicConfigKeyNotSet           = serviceerror.NewInvalidArgument("Dynamic config key is not set on request.")
+	errPersistedDynamicConfigNotEnabled = serviceerror.NewFailedPrecondition("Persisted dynamic config is not enabled on this cluster.")
+
+	errExclusionCalendarNotSet     = serviceerror.NewInvalidArgument("Exclusion calendar is not set on request.")
+	errExclusionCalendarNameNotSet = serviceerror.NewInvalidArgument("Exclusion calendar name is not set on request.")
+
 	errPageSizeTooBigMessage = "PageSize is larger than allowed %d."
 
 	errSearchAttributeIsReservedMessage               = "Search attribute %s is reserved by system."
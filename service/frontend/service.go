@@ -214,6 +214,9 @@ type Config struct {
 	WorkflowRulesAPIsEnabled     dynamicconfig.BoolPropertyFnWithNamespaceFilter
 	MaxWorkflowRulesPerNamespace dynamicconfig.IntPropertyFnWithNamespaceFilter
 
+	MaxScheduleExclusionCalendarsPerNamespace dynamicconfig.IntPropertyFnWithNamespaceFilter
+	MaxScheduleExclusionCalendarDates         dynamicconfig.IntPropertyFnWithNamespaceFilter
+
 	HTTPAllowedHosts *dynamicconfig.GlobalCachedTypedValue[*regexp.Regexp]
 }
 
@@ -364,6 +367,9 @@ func NewConfig(
 		WorkflowRulesAPIsEnabled:       dynamicconfig.WorkflowRulesAPIsEnabled.Get(dc),
 		MaxWorkflowRulesPerNamespace:   dynamicconfig.MaxWorkflowRulesPerNamespace.Get(dc),
 
+		MaxScheduleExclusionCalendarsPerNamespace: dynamicconfig.MaxScheduleExclusionCalendarsPerNamespace.Get(dc),
+		MaxScheduleExclusionCalendarDates:         dynamicconfig.MaxScheduleExclusionCalendarDates.Get(dc),
+
 		HTTPAllowedHosts: dynamicconfig.NewGlobalCachedTypedValue(dc, dynamicconfig.FrontendHTTPAllowedHosts, func(patterns []string) (*regexp.Regexp, error) {
 			if len(patterns) == 0 {
 				return matchAny, nil
@@ -19,12 +19,14 @@ import (
 	filterpb "go.temporal.io/api/filter/v1"
 	historypb "go.temporal.io/api/history/v1"
 	querypb "go.temporal.io/api/query/v1"
+	rulespb "go.temporal.io/api/rules/v1"
 	schedulepb "go.temporal.io/api/schedule/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	updatepb "go.temporal.io/api/update/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	batchspb "go.temporal.io/server/api/batch/v1"
 	deploymentspb "go.temporal.io/server/api/deployment/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
@@ -66,6 +68,7 @@ import (
 	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/common/worker_versioning"
 	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/workflow/matcher"
 	"go.temporal.io/server/service/worker/batcher"
 	"go.temporal.io/server/service/worker/deployment"
 	"go.temporal.io/server/service/worker/scheduler"
@@ -3025,10 +3028,13 @@ func (wh *WorkflowHandler) CreateSchedule(ctx context.Context, request *workflow
 		return nil, err
 	}
 
-	if request.Schedule == nil {
-		request.Schedule = &schedulepb.Schedule{}
+	// The schedule is canonicalized on a copy, so that retries of the request still see the
+	// references to named exclusion calendars.
+	schedule := common.CloneProto(request.Schedule)
+	if schedule == nil {
+		schedule = &schedulepb.Schedule{}
 	}
-	err = wh.canonicalizeScheduleSpec(request.Schedule)
+	exclusionCalendarNames, exclusionCalendars, err := wh.canonicalizeScheduleSpec(namespaceName, schedule)
 	if err != nil {
 		return nil, err
 	}
@@ -3041,7 +3047,7 @@ func (wh *WorkflowHandler) CreateSchedule(ctx context.Context, request *workflow
 		return nil, err
 	}
 
-	if err = wh.validateStartWorkflowArgsForSchedule(namespaceName, request.GetSchedule().GetAction().GetStartWorkflow()); err != nil {
+	if err = wh.validateStartWorkflowArgsForSchedule(namespaceName, schedule.GetAction().GetStartWorkflow()); err != nil {
 		return nil, err
 	}
 
@@ -3051,13 +3057,16 @@ func (wh *WorkflowHandler) CreateSchedule(ctx context.Context, request *workflow
 
 	// Set up input to scheduler workflow
 	input := &schedulespb.StartScheduleArgs{
-		Schedule:     request.Schedule,
+		Schedule:     schedule,
 		InitialPatch: request.InitialPatch,
 		State: &schedulespb.InternalState{
 			Namespace:     namespaceName.String(),
 			NamespaceId:   namespaceID.String(),
 			ScheduleId:    request.ScheduleId,
 			ConflictToken: scheduler.InitialConflictToken,
+
+			ExclusionCalendarNames: exclusionCalendarNames,
+			ExclusionCalendars:     exclusionCalendars,
 		},
 	}
 	inputPayloads, err := sdk.PreferProtoDataConverter.ToPayloads(input)
@@ -3111,6 +3120,21 @@ func (wh *WorkflowHandler) validateStartWorkflowArgsForSchedule(
 		return nil
 	}
 
+	ext, err := scheduler.GetActionExtension(startWorkflow)
+	if err != nil {
+		return serviceerror.NewInvalidArgumentf("invalid schedule action extension: %v", err)
+	} else if ext != nil {
+		if err := scheduler.ValidateActionExtension(startWorkflow, ext); err != nil {
+			return serviceerror.NewInvalidArgumentf("invalid schedule action extension: %v", err)
+		}
+		// Only signal-with-start uses the rest of the start workflow action.
+		if ext.GetNexusOperation() != nil {
+			return nil
+		} else if !ext.GetSignalWorkflow().GetStartIfNotRunning() {
+			return wh.validateWorkflowID(startWorkflow.WorkflowId)
+		}
+	}
+
 	if err := wh.validateWorkflowID(startWorkflow.WorkflowId + scheduler.AppendedTimestampForValidation); err != nil {
 		return err
 	}
@@ -3715,6 +3739,12 @@ func (wh *WorkflowHandler) DescribeSchedule(ctx context.Context, request *workfl
 		return nil, err
 	}
 
+	if queryResponse.Migrated {
+		// The workflow only checks on the state machine scheduler periodically, so get the
+		// current state from the state machine scheduler itself.
+		wh.describeMigratedSchedule(ctx, namespaceID, executionInfo.GetExecution(), &queryResponse)
+	}
+
 	err = wh.annotateSearchAttributesOfScheduledWorkflow(&queryResponse, request.GetNamespace())
 	if err != nil {
 		return nil, serviceerror.NewInternalf("describe schedule: %v", err)
@@ -3778,6 +3808,39 @@ func (wh *WorkflowHandler) DescribeSchedule(ctx context.Context, request *workfl
 	}, nil
 }
 
+// describeMigratedSchedule replaces the schedule and info in queryResponse with the current
+// state of the state machine scheduler that runs the schedule. If that fails, the response
+// is left with the workflow's last known state.
+func (wh *WorkflowHandler) describeMigratedSchedule(
+	ctx context.Context,
+	namespaceID namespace.ID,
+	execution *commonpb.WorkflowExecution,
+	queryResponse *schedulespb.DescribeResponse,
+) {
+	res, err := wh.historyClient.MigrateSchedule(ctx, &historyservice.MigrateScheduleRequest{
+		NamespaceId: namespaceID.String(),
+		Execution:   execution,
+		Describe:    true,
+	})
+	if err != nil {
+		wh.logger.Warn("Failed to describe migrated schedule", tag.WorkflowID(execution.GetWorkflowId()), tag.Error(err))
+		return
+	}
+	if !res.Migrated || res.Args == nil {
+		// Migrated back since the query, the workflow's state is current.
+		return
+	}
+	info := res.Args.GetInfo()
+	if info == nil {
+		info = &schedulepb.ScheduleInfo{}
+	}
+	queryResponse.Schedule = res.Args.Schedule
+	queryResponse.Info = info
+	queryResponse.Info.FutureActionTimes = res.FutureActionTimes
+	queryResponse.Info.BufferSize = int64(len(res.Args.State.GetBufferedStarts()))
+	queryResponse.ConflictToken = res.Args.State.GetConflictToken()
+}
+
 func (wh *WorkflowHandler) annotateSearchAttributesOfScheduledWorkflow(
 	queryResponse *schedulespb.DescribeResponse,
 	nsName string,
@@ -3860,10 +3923,13 @@ func (wh *WorkflowHandler) UpdateSchedule(
 		return nil, err
 	}
 
-	if request.Schedule == nil {
-		request.Schedule = &schedulepb.Schedule{}
+	// The schedule is canonicalized on a copy, so that retries of the request still see the
+	// references to named exclusion calendars.
+	schedule := common.CloneProto(request.Schedule)
+	if schedule == nil {
+		schedule = &schedulepb.Schedule{}
 	}
-	err = wh.canonicalizeScheduleSpec(request.Schedule)
+	exclusionCalendarNames, _, err := wh.canonicalizeScheduleSpec(namespaceName, schedule)
 	if err != nil {
 		return nil, err
 	}
@@ -3878,14 +3944,15 @@ func (wh *WorkflowHandler) UpdateSchedule(
 
 	if err = wh.validateStartWorkflowArgsForSchedule(
 		namespaceName,
-		request.GetSchedule().GetAction().GetStartWorkflow(),
+		schedule.GetAction().GetStartWorkflow(),
 	); err != nil {
 		return nil, err
 	}
 
 	input := &schedulespb.FullUpdateRequest{
-		Schedule:         request.Schedule,
-		SearchAttributes: request.SearchAttributes,
+		Schedule:               schedule,
+		SearchAttributes:       request.SearchAttributes,
+		ExclusionCalendarNames: exclusionCalendarNames,
 	}
 	if len(request.ConflictToken) >= 8 {
 		input.ConflictToken = int64(binary.BigEndian.Uint64(request.ConflictToken))
@@ -4585,6 +4652,8 @@ func (wh *WorkflowHandler) StartBatchOperation(
 	var resetParams batcher.ResetParams
 	var updateOptionsParams batcher.UpdateOptionsParams
 	var unpauseActivitiesParams batcher.UnpauseActivitiesParams
+	var updateParams batcher.UpdateParams
+	var signalWithStartParams batcher.SignalWithStartParams
 	switch op := request.Operation.(type) {
 	case *workflowservice.StartBatchOperationRequest_TerminationOperation:
 		identity = op.TerminationOperation.GetIdentity()
@@ -4594,6 +4663,27 @@ func (wh *WorkflowHandler) StartBatchOperation(
 		operationType = batcher.BatchTypeSignal
 		signalParams.SignalName = op.SignalOperation.GetSignal()
 		signalParams.Input = op.SignalOperation.GetInput()
+		ext, err := batcher.GetOperationExtension(op.SignalOperation.GetHeader())
+		if err != nil {
+			return nil, serviceerror.NewInvalidArgumentf("Invalid batch operation extension: %v", err)
+		}
+		if ext != nil {
+			if err := batcher.ValidateOperationExtension(ext, signalParams.SignalName, len(request.Executions) > 0); err != nil {
+				return nil, serviceerror.NewInvalidArgumentf("Invalid batch operation extension: %v", err)
+			}
+			switch e := ext.GetOperation().(type) {
+			case *batchspb.BatchOperationExtension_UpdateWorkflow:
+				operationType = batcher.BatchTypeUpdate
+				if updateParams.Update, err = e.UpdateWorkflow.Marshal(); err != nil {
+					return nil, err
+				}
+			case *batchspb.BatchOperationExtension_SignalWithStart:
+				operationType = batcher.BatchTypeSignalWithStart
+				if signalWithStartParams.SignalWithStart, err = e.SignalWithStart.Marshal(); err != nil {
+					return nil, err
+				}
+			}
+		}
 	case *workflowservice.StartBatchOperationRequest_CancellationOperation:
 		identity = op.CancellationOperation.GetIdentity()
 		operationType = batcher.BatchTypeCancel
@@ -4673,6 +4763,8 @@ func (wh *WorkflowHandler) StartBatchOperation(
 		ResetParams:             resetParams,
 		UpdateOptionsParams:     updateOptionsParams,
 		UnpauseActivitiesParams: unpauseActivitiesParams,
+		UpdateParams:            updateParams,
+		SignalWithStartParams:   signalWithStartParams,
 	}
 	inputPayload, err := sdk.PreferProtoDataConverter.ToPayloads(input)
 	if err != nil {
@@ -4828,7 +4920,8 @@ func (wh *WorkflowHandler) DescribeBatchOperation(
 	switch operationTypeString {
 	case batcher.BatchTypeCancel:
 		operationType = enumspb.BATCH_OPERATION_TYPE_CANCEL
-	case batcher.BatchTypeSignal:
+	case batcher.BatchTypeSignal, batcher.BatchTypeSignalWithStart, batcher.BatchTypeUpdate:
+		// signal-with-start and update batches are started as signal batch operations
 		operationType = enumspb.BATCH_OPERATION_TYPE_SIGNAL
 	case batcher.BatchTypeTerminate:
 		operationType = enumspb.BATCH_OPERATION_TYPE_TERMINATE
@@ -5650,18 +5743,39 @@ func (wh *WorkflowHandler) validateWorkflowID(
 	return nil
 }
 
-func (wh *WorkflowHandler) canonicalizeScheduleSpec(schedule *schedulepb.Schedule) error {
+// canonicalizeScheduleSpec takes the references to named exclusion calendars out of the spec
+// and returns the names along with the namespace's calendars they refer to.
+func (wh *WorkflowHandler) canonicalizeScheduleSpec(
+	namespaceName namespace.Name,
+	schedule *schedulepb.Schedule,
+) ([]string, []*schedulespb.ExclusionCalendar, error) {
 	if schedule.Spec == nil {
 		schedule.Spec = &schedulepb.ScheduleSpec{}
 	}
-	compiledSpec, err := wh.scheduleSpecBuilder.NewCompiledSpec(schedule.Spec)
+	names := scheduler.ExclusionCalendarNames(schedule.Spec)
+	var calendars []*schedulespb.ExclusionCalendar
+	if len(names) > 0 {
+		ns, err := wh.namespaceRegistry.GetNamespace(namespaceName)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, name := range names {
+			cal, ok := ns.GetScheduleExclusionCalendar(name)
+			if !ok {
+				return nil, nil, serviceerror.NewInvalidArgumentf("Invalid schedule spec: exclusion calendar %q not found", name)
+			}
+			calendars = append(calendars, cal)
+		}
+	}
+	compiledSpec, err := wh.scheduleSpecBuilder.NewCompiledSpecWithCalendars(
+		scheduler.WithoutExclusionCalendarReferences(schedule.Spec),
+		calendars,
+	)
 	if err != nil {
-		return serviceerror.NewInvalidArgumentf("Invalid schedule spec: %v", err)
+		return nil, nil, serviceerror.NewInvalidArgumentf("Invalid schedule spec: %v", err)
 	}
-	// This mutates a part of the request message, but it's safe even in the presence of
-	// retries (reusing the same message) because canonicalization is idempotent.
 	schedule.Spec = compiledSpec.CanonicalForm()
-	return nil
+	return names, calendars, nil
 }
 
 func (wh *WorkflowHandler) decodeScheduleListInfo(memo *commonpb.Memo) *schedulepb.ScheduleListInfo {
@@ -5967,6 +6081,10 @@ func (wh *WorkflowHandler) CreateWorkflowRule(
 		return nil, errWorkflowRuleIDTooLong
 	}
 
+	if err := validateWorkflowRuleSpec(request.GetSpec()); err != nil {
+		return nil, err
+	}
+
 	rule, err := wh.namespaceHandler.CreateWorkflowRule(ctx,
 		request.GetSpec(),
 		request.GetIdentity(),
@@ -5983,6 +6101,34 @@ func (wh *WorkflowHandler) CreateWorkflowRule(
 	return response, nil
 }
 
+// validateWorkflowRuleSpec validates the rule queries upfront, otherwise an invalid rule would be
+// silently skipped every time history evaluates it.
+func validateWorkflowRuleSpec(spec *rulespb.WorkflowRuleSpec) error {
+	if visibilityQuery := spec.GetVisibilityQuery(); visibilityQuery != "" {
+		if err := matcher.ValidateMutableStateQuery(visibilityQuery); err != nil {
+			return serviceerror.NewInvalidArgumentf("Invalid Workflow Rule visibility query: %v", err)
+		}
+	}
+
+	activityTrigger := spec.GetActivityStart()
+	if activityTrigger == nil {
+		return errWorkflowRuleTriggerNotSet
+	}
+	if err := matcher.ValidateActivityQuery(activityTrigger.GetPredicate()); err != nil {
+		return serviceerror.NewInvalidArgumentf("Invalid Workflow Rule activity predicate: %v", err)
+	}
+
+	if len(spec.GetActions()) == 0 {
+		return errWorkflowRuleActionsNotSet
+	}
+	for _, action := range spec.GetActions() {
+		if action.GetActivityPause() == nil {
+			return serviceerror.NewInvalidArgumentf("Unsupported Workflow Rule action: %T", action.GetVariant())
+		}
+	}
+	return nil
+}
+
 func (wh *WorkflowHandler) DescribeWorkflowRule(
 	ctx context.Context,
 	request *workflowservice.DescribeWorkflowRuleRequest,
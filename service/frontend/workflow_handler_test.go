@@ -27,6 +27,7 @@ import (
 	updatepb "go.temporal.io/api/update/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	batchspb "go.temporal.io/server/api/batch/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
@@ -2361,6 +2362,71 @@ func (s *WorkflowHandlerSuite) TestStartBatchOperation_Signal() {
 	s.NoError(err)
 }
 
+func (s *WorkflowHandlerSuite) TestStartBatchOperation_Update() {
+	testNamespace := namespace.Name("test-namespace")
+	namespaceID := namespace.ID(uuid.NewString())
+	inputString := "unit test"
+	config := s.newConfig()
+	wh := s.getWorkflowHandler(config)
+	update := &batchspb.BatchOperationUpdateWorkflow{
+		Input:     &updatepb.Input{Name: "update name", Args: payloads.EncodeString(inputString)},
+		WaitStage: enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_ACCEPTED,
+	}
+	encodedUpdate, err := update.Marshal()
+	s.NoError(err)
+	params := &batcher.BatchParams{
+		Namespace:    testNamespace.String(),
+		Query:        inputString,
+		Reason:       inputString,
+		BatchType:    batcher.BatchTypeUpdate,
+		UpdateParams: batcher.UpdateParams{Update: encodedUpdate},
+	}
+	inputPayload, err := payloads.Encode(params)
+	s.NoError(err)
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(namespaceID, nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().StartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(
+			_ context.Context,
+			request *historyservice.StartWorkflowExecutionRequest,
+			_ ...grpc.CallOption,
+		) (*historyservice.StartWorkflowExecutionResponse, error) {
+			s.Equal(payload.EncodeString(batcher.BatchTypeUpdate), request.StartRequest.Memo.Fields[batcher.BatchOperationTypeMemo])
+			s.Equal(inputPayload, request.StartRequest.Input)
+			return &historyservice.StartWorkflowExecutionResponse{}, nil
+		},
+	)
+	s.mockVisibilityMgr.EXPECT().CountWorkflowExecutions(gomock.Any(), gomock.Any()).Return(&manager.CountWorkflowExecutionsResponse{Count: 0}, nil).Times(2)
+	newRequest := func(ext *batchspb.BatchOperationExtension) *workflowservice.StartBatchOperationRequest {
+		extPayload, err := payload.Encode(ext)
+		s.NoError(err)
+		return &workflowservice.StartBatchOperationRequest{
+			Namespace: testNamespace.String(),
+			JobId:     uuid.NewString(),
+			Operation: &workflowservice.StartBatchOperationRequest_SignalOperation{
+				SignalOperation: &batchpb.BatchOperationSignal{
+					Identity: inputString,
+					Header: &commonpb.Header{Fields: map[string]*commonpb.Payload{
+						batcher.OperationExtensionHeaderKey: extPayload,
+					}},
+				},
+			},
+			Reason:          inputString,
+			VisibilityQuery: inputString,
+		}
+	}
+
+	_, err = wh.StartBatchOperation(context.Background(), newRequest(&batchspb.BatchOperationExtension{
+		Operation: &batchspb.BatchOperationExtension_UpdateWorkflow{UpdateWorkflow: update},
+	}))
+	s.NoError(err)
+
+	_, err = wh.StartBatchOperation(context.Background(), newRequest(&batchspb.BatchOperationExtension{
+		Operation: &batchspb.BatchOperationExtension_UpdateWorkflow{UpdateWorkflow: &batchspb.BatchOperationUpdateWorkflow{}},
+	}))
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+}
+
 func (s *WorkflowHandlerSuite) TestStartBatchOperation_WorkflowExecutions_Signal() {
 	testNamespace := namespace.Name("test-namespace")
 	namespaceID := namespace.ID(uuid.NewString())
@@ -2824,6 +2890,41 @@ func (s *WorkflowHandlerSuite) TestDescribeBatchOperation_FailedStatus() {
 	s.Equal(enumspb.BATCH_OPERATION_STATE_FAILED, resp.GetState())
 }
 
+func (s *WorkflowHandlerSuite) TestDescribeBatchOperation_Update() {
+	jobID := uuid.NewString()
+	config := s.newConfig()
+	wh := s.getWorkflowHandler(config)
+	now := timestamppb.New(time.Now())
+	s.mockNamespaceCache.EXPECT().GetNamespaceID(gomock.Any()).Return(namespace.ID(uuid.NewString()), nil).AnyTimes()
+	s.mockHistoryClient.EXPECT().DescribeWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+		&historyservice.DescribeWorkflowExecutionResponse{
+			WorkflowExecutionInfo: &workflowpb.WorkflowExecutionInfo{
+				Execution: &commonpb.WorkflowExecution{
+					WorkflowId: jobID,
+				},
+				StartTime:     now,
+				CloseTime:     now,
+				Status:        enumspb.WORKFLOW_EXECUTION_STATUS_TIMED_OUT,
+				ExecutionTime: now,
+				Memo: &commonpb.Memo{
+					Fields: map[string]*commonpb.Payload{
+						batcher.BatchOperationTypeMemo: payload.EncodeString(batcher.BatchTypeUpdate),
+					},
+				},
+			},
+		}, nil,
+	)
+	request := &workflowservice.DescribeBatchOperationRequest{
+		Namespace: "test-namespace",
+		JobId:     jobID,
+	}
+
+	resp, err := wh.DescribeBatchOperation(context.Background(), request)
+	s.NoError(err)
+	// an update batch is started as a signal batch operation
+	s.Equal(enumspb.BATCH_OPERATION_TYPE_SIGNAL, resp.GetOperationType())
+}
+
 func (s *WorkflowHandlerSuite) TestDescribeBatchOperation_InvalidRequest() {
 	config := s.newConfig()
 	wh := s.getWorkflowHandler(config)
@@ -0,0 +1,184 @@
+package migrateschedule
+
+import (
+	"context"
+	"errors"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/components/scheduler"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/consts"
+	"go.temporal.io/server/service/history/hsm"
+	historyi "go.temporal.io/server/service/history/interfaces"
+)
+
+// Invoke migrates a schedule between its scheduler workflow and a state machine
+// scheduler hosted on the scheduler workflow's execution. The workflow stays
+// open while the state machine scheduler runs the schedule, so that its tasks
+// keep running, and takes the schedule back with the returned state.
+//
+// Requests are idempotent: a schedule that was already migrated isn't migrated
+// again, and a schedule that was already migrated back returns no state, in which
+// case the workflow resumes with its own. While the schedule stays migrated, its
+// current state is returned, so that the workflow can keep its description, memo and
+// search attributes up to date, and so that describe requests can answer from it.
+func Invoke(
+	ctx context.Context,
+	request *historyservice.MigrateScheduleRequest,
+	shardContext historyi.ShardContext,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+	migrator *scheduler.Migrator,
+) (*historyservice.MigrateScheduleResponse, error) {
+	response := &historyservice.MigrateScheduleResponse{}
+	err := api.GetAndUpdateWorkflowWithNew(
+		ctx,
+		nil,
+		definition.NewWorkflowKey(
+			request.GetNamespaceId(),
+			request.GetExecution().GetWorkflowId(),
+			request.GetExecution().GetRunId(),
+		),
+		func(workflowLease api.WorkflowLease) (*api.UpdateWorkflowAction, error) {
+			mutableState := workflowLease.GetMutableState()
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, consts.ErrWorkflowCompleted
+			}
+
+			root := mutableState.HSM()
+			var schedulerNode *hsm.Node
+			if nodes := hsm.NewCollection[scheduler.Scheduler](root, scheduler.SchedulerMachineType).List(); len(nodes) > 0 {
+				schedulerNode = nodes[0]
+			}
+
+			if request.GetArgs() != nil {
+				migrated, err := migrateFromWorkflow(request, mutableState, root, schedulerNode, migrator)
+				if err != nil {
+					return nil, err
+				}
+				response.Migrated = migrated
+				return &api.UpdateWorkflowAction{
+					Noop:               !migrated || schedulerNode != nil,
+					CreateWorkflowTask: false,
+				}, nil
+			}
+
+			if !request.GetDescribe() {
+				args, err := migrateToWorkflow(request, shardContext, mutableState, root, schedulerNode, migrator)
+				if err != nil {
+					return nil, err
+				}
+				if args != nil {
+					response.Args = args
+					return &api.UpdateWorkflowAction{
+						Noop:               false,
+						CreateWorkflowTask: false,
+					}, nil
+				}
+			}
+
+			if schedulerNode != nil {
+				// The schedule stays migrated, return its current state.
+				memo, searchAttributes := executionMemoAndSearchAttributes(mutableState)
+				schedule, err := migrator.Describe(schedulerNode, memo, searchAttributes, shardContext.GetTimeSource().Now())
+				if err != nil {
+					return nil, err
+				}
+				response.Migrated = true
+				response.Args = schedule.Args
+				response.FutureActionTimes = schedule.FutureActionTimes
+			}
+			return &api.UpdateWorkflowAction{
+				Noop:               true,
+				CreateWorkflowTask: false,
+			}, nil
+		},
+		nil,
+		shardContext,
+		workflowConsistencyChecker,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// migrateFromWorkflow adds a Scheduler tree for the scheduler workflow's state,
+// unless one already exists. Returns whether the schedule is migrated.
+func migrateFromWorkflow(
+	request *historyservice.MigrateScheduleRequest,
+	mutableState historyi.MutableState,
+	root *hsm.Node,
+	schedulerNode *hsm.Node,
+	migrator *scheduler.Migrator,
+) (bool, error) {
+	if schedulerNode != nil {
+		return true, nil
+	}
+
+	memo, searchAttributes := executionMemoAndSearchAttributes(mutableState)
+	node, err := migrator.MigrateFromWorkflow(root, scheduler.WorkflowSchedule{
+		Args:              request.GetArgs(),
+		Memo:              memo,
+		SearchAttributes:  searchAttributes,
+		FutureActionTimes: request.GetFutureActionTimes(),
+	})
+	switch {
+	case errors.Is(err, scheduler.ErrMigrationDisabled):
+		return false, nil
+	case errors.Is(err, scheduler.ErrMigrationVerificationFailed):
+		return false, serviceerror.NewFailedPrecondition(err.Error())
+	case err != nil:
+		return false, err
+	}
+	// In verify mode, the schedule is verified but not migrated.
+	return node != nil, nil
+}
+
+// migrateToWorkflow deletes the Scheduler tree and returns the state to resume
+// the scheduler workflow with. Returns nil if the schedule isn't migrated, or the
+// namespace's mode keeps it on the state machine scheduler.
+func migrateToWorkflow(
+	request *historyservice.MigrateScheduleRequest,
+	shardContext historyi.ShardContext,
+	mutableState historyi.MutableState,
+	root *hsm.Node,
+	schedulerNode *hsm.Node,
+	migrator *scheduler.Migrator,
+) (*schedulespb.StartScheduleArgs, error) {
+	if schedulerNode == nil {
+		return nil, nil
+	}
+
+	memo, searchAttributes := executionMemoAndSearchAttributes(mutableState)
+	schedule, err := migrator.MigrateToWorkflow(
+		schedulerNode,
+		memo,
+		searchAttributes,
+		shardContext.GetTimeSource().Now(),
+		request.GetForce(),
+	)
+	switch {
+	case errors.Is(err, scheduler.ErrMigrationDisabled):
+		return nil, nil
+	case errors.Is(err, scheduler.ErrMigrationVerificationFailed):
+		return nil, serviceerror.NewFailedPrecondition(err.Error())
+	case err != nil:
+		return nil, err
+	}
+
+	// Deleting the tree drops its pending tasks along with it.
+	if err := root.DeleteChild(schedulerNode.Key); err != nil {
+		return nil, err
+	}
+	return schedule.Args, nil
+}
+
+func executionMemoAndSearchAttributes(mutableState historyi.MutableState) (*commonpb.Memo, *commonpb.SearchAttributes) {
+	executionInfo := mutableState.GetExecutionInfo()
+	return &commonpb.Memo{Fields: executionInfo.GetMemo()},
+		&commonpb.SearchAttributes{IndexedFields: executionInfo.GetSearchAttributes()}
+}
@@ -0,0 +1,73 @@
+package modifyactivityproperties
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/retrypolicy"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/consts"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"google.golang.org/protobuf/proto"
+)
+
+func Invoke(
+	ctx context.Context,
+	request *historyservice.ModifyActivityPropertiesRequest,
+	shardContext historyi.ShardContext,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (*historyservice.ModifyActivityPropertiesResponse, error) {
+	if request.GetActivityId() == "" {
+		return nil, serviceerror.NewInvalidArgument("activity id is not set")
+	}
+	if request.GetRetryPolicy() == nil {
+		return nil, serviceerror.NewInvalidArgument("retry policy is not set")
+	}
+	namespaceEntry, err := shardContext.GetNamespaceRegistry().GetNamespaceByID(namespace.ID(request.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+	retryPolicy := proto.Clone(request.GetRetryPolicy()).(*commonpb.RetryPolicy)
+	retrypolicy.EnsureDefaults(retryPolicy, shardContext.GetConfig().DefaultActivityRetryPolicy(namespaceEntry.Name().String()))
+	if err := retrypolicy.Validate(retryPolicy); err != nil {
+		return nil, err
+	}
+
+	err = api.GetAndUpdateWorkflowWithNew(
+		ctx,
+		nil,
+		definition.NewWorkflowKey(
+			request.GetNamespaceId(),
+			request.GetExecution().GetWorkflowId(),
+			request.GetExecution().GetRunId(),
+		),
+		func(workflowLease api.WorkflowLease) (*api.UpdateWorkflowAction, error) {
+			mutableState := workflowLease.GetMutableState()
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, consts.ErrWorkflowCompleted
+			}
+			ai, ok := mutableState.GetActivityByActivityID(request.GetActivityId())
+			if !ok {
+				return nil, consts.ErrActivityNotFound
+			}
+			if _, err := mutableState.AddActivityPropertiesModifiedExternallyEvent(ai.GetScheduledEventId(), retryPolicy); err != nil {
+				return nil, err
+			}
+			return &api.UpdateWorkflowAction{
+				Noop:               false,
+				CreateWorkflowTask: false,
+			}, nil
+		},
+		nil,
+		shardContext,
+		workflowConsistencyChecker,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.ModifyActivityPropertiesResponse{}, nil
+}
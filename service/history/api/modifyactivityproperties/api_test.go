@@ -0,0 +1,145 @@
+package modifyactivityproperties
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/consts"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/tests"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type (
+	modifyActivityPropertiesSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller             *gomock.Controller
+		mockShard              *historyi.MockShardContext
+		mockMutableState       *historyi.MockMutableState
+		mockWorkflowContext    *historyi.MockWorkflowContext
+		mockConsistencyChecker *api.MockWorkflowConsistencyChecker
+	}
+)
+
+const activityID = "activity-id"
+
+func TestModifyActivityPropertiesSuite(t *testing.T) {
+	s := new(modifyActivityPropertiesSuite)
+	suite.Run(t, s)
+}
+
+func (s *modifyActivityPropertiesSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.mockMutableState = historyi.NewMockMutableState(s.controller)
+	s.mockWorkflowContext = historyi.NewMockWorkflowContext(s.controller)
+	s.mockConsistencyChecker = api.NewMockWorkflowConsistencyChecker(s.controller)
+
+	mockNamespaceRegistry := namespace.NewMockRegistry(s.controller)
+	mockNamespaceRegistry.EXPECT().GetNamespaceByID(tests.NamespaceID).Return(tests.LocalNamespaceEntry, nil).AnyTimes()
+	s.mockShard = historyi.NewMockShardContext(s.controller)
+	s.mockShard.EXPECT().GetConfig().Return(tests.NewDynamicConfig()).AnyTimes()
+	s.mockShard.EXPECT().GetNamespaceRegistry().Return(mockNamespaceRegistry).AnyTimes()
+}
+
+func (s *modifyActivityPropertiesSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *modifyActivityPropertiesSuite) expectWorkflowLease() {
+	workflowKey := definition.NewWorkflowKey(tests.NamespaceID.String(), tests.WorkflowID, tests.RunID)
+	lease := api.NewWorkflowLease(s.mockWorkflowContext, func(error) {}, s.mockMutableState)
+	s.mockConsistencyChecker.EXPECT().GetWorkflowLease(gomock.Any(), gomock.Any(), workflowKey, gomock.Any()).Return(lease, nil)
+}
+
+func (s *modifyActivityPropertiesSuite) invoke(
+	activityID string,
+	retryPolicy *commonpb.RetryPolicy,
+) (*historyservice.ModifyActivityPropertiesResponse, error) {
+	return Invoke(
+		context.Background(),
+		&historyservice.ModifyActivityPropertiesRequest{
+			NamespaceId: tests.NamespaceID.String(),
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: tests.WorkflowID,
+				RunId:      tests.RunID,
+			},
+			ActivityId:  activityID,
+			RetryPolicy: retryPolicy,
+		},
+		s.mockShard,
+		s.mockConsistencyChecker,
+	)
+}
+
+func (s *modifyActivityPropertiesSuite) TestSuccess() {
+	s.expectWorkflowLease()
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true)
+	s.mockMutableState.EXPECT().GetActivityByActivityID(activityID).Return(&persistencespb.ActivityInfo{ScheduledEventId: 5}, true)
+	s.mockMutableState.EXPECT().AddActivityPropertiesModifiedExternallyEvent(int64(5), gomock.Any()).DoAndReturn(
+		func(_ int64, retryPolicy *commonpb.RetryPolicy) (*historypb.HistoryEvent, error) {
+			s.Equal(int32(3), retryPolicy.GetMaximumAttempts())
+			// unset fields take their default
+			s.Equal(2.0, retryPolicy.GetBackoffCoefficient())
+			return &historypb.HistoryEvent{}, nil
+		},
+	)
+	s.mockWorkflowContext.EXPECT().UpdateWorkflowExecutionAsActive(gomock.Any(), s.mockShard).Return(nil)
+
+	resp, err := s.invoke(activityID, &commonpb.RetryPolicy{MaximumAttempts: 3})
+	s.NoError(err)
+	s.NotNil(resp)
+}
+
+func (s *modifyActivityPropertiesSuite) TestWorkflowClosed() {
+	s.expectWorkflowLease()
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(false)
+
+	_, err := s.invoke(activityID, &commonpb.RetryPolicy{MaximumAttempts: 3})
+	s.ErrorIs(err, consts.ErrWorkflowCompleted)
+}
+
+func (s *modifyActivityPropertiesSuite) TestActivityNotFound() {
+	s.expectWorkflowLease()
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true)
+	s.mockMutableState.EXPECT().GetActivityByActivityID(activityID).Return(nil, false)
+
+	_, err := s.invoke(activityID, &commonpb.RetryPolicy{MaximumAttempts: 3})
+	s.ErrorIs(err, consts.ErrActivityNotFound)
+}
+
+func (s *modifyActivityPropertiesSuite) TestNoPropertySet() {
+	var invalidArgument *serviceerror.InvalidArgument
+	_, err := s.invoke(activityID, nil)
+	s.ErrorAs(err, &invalidArgument)
+
+	_, err = s.invoke("", &commonpb.RetryPolicy{MaximumAttempts: 3})
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *modifyActivityPropertiesSuite) TestNegativeIntervals() {
+	for _, retryPolicy := range []*commonpb.RetryPolicy{
+		{InitialInterval: durationpb.New(-time.Second)},
+		{MaximumInterval: durationpb.New(-time.Second)},
+		{MaximumAttempts: -1},
+	} {
+		_, err := s.invoke(activityID, retryPolicy)
+		var invalidArgument *serviceerror.InvalidArgument
+		s.ErrorAs(err, &invalidArgument)
+	}
+}
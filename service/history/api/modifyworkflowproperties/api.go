@@ -0,0 +1,104 @@
+package modifyworkflowproperties
+
+import (
+	"context"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/tqid"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/consts"
+	historyi "go.temporal.io/server/service/history/interfaces"
+)
+
+const operation = "ModifyWorkflowProperties"
+
+func Invoke(
+	ctx context.Context,
+	request *historyservice.ModifyWorkflowPropertiesRequest,
+	shardContext historyi.ShardContext,
+	workflowConsistencyChecker api.WorkflowConsistencyChecker,
+) (*historyservice.ModifyWorkflowPropertiesResponse, error) {
+	properties := request.GetProperties()
+	if properties.GetNewTaskQueue() == "" &&
+		properties.GetNewWorkflowTaskTimeout() == nil &&
+		properties.GetNewWorkflowRunTimeout() == nil &&
+		properties.GetNewWorkflowExecutionTimeout() == nil &&
+		properties.GetUpsertedMemo() == nil {
+		return nil, serviceerror.NewInvalidArgument("no workflow property to modify")
+	}
+	if properties.GetNewWorkflowTaskTimeout().AsDuration() < 0 ||
+		properties.GetNewWorkflowRunTimeout().AsDuration() < 0 ||
+		properties.GetNewWorkflowExecutionTimeout().AsDuration() < 0 {
+		return nil, serviceerror.NewInvalidArgument("workflow timeouts cannot be negative")
+	}
+	config := shardContext.GetConfig()
+	if properties.GetNewTaskQueue() != "" {
+		if err := tqid.Validate(properties.GetNewTaskQueue(), config.MaxIDLengthLimit()); err != nil {
+			return nil, err
+		}
+	}
+
+	namespaceEntry, err := shardContext.GetNamespaceRegistry().GetNamespaceByID(namespace.ID(request.GetNamespaceId()))
+	if err != nil {
+		return nil, err
+	}
+	namespaceName := namespaceEntry.Name().String()
+
+	err = api.GetAndUpdateWorkflowWithNew(
+		ctx,
+		nil,
+		definition.NewWorkflowKey(
+			request.GetNamespaceId(),
+			request.GetExecution().GetWorkflowId(),
+			request.GetExecution().GetRunId(),
+		),
+		func(workflowLease api.WorkflowLease) (*api.UpdateWorkflowAction, error) {
+			mutableState := workflowLease.GetMutableState()
+			if !mutableState.IsWorkflowExecutionRunning() {
+				return nil, consts.ErrWorkflowCompleted
+			}
+			if properties.GetUpsertedMemo() != nil {
+				// The limit applies to the memo the workflow ends up with, not only to the upserted fields.
+				memo := &commonpb.Memo{
+					Fields: payload.MergeMapOfPayload(mutableState.GetExecutionInfo().GetMemo(), properties.GetUpsertedMemo().GetFields()),
+				}
+				if err := common.CheckEventBlobSizeLimit(
+					memo.Size(),
+					config.MemoSizeLimitWarn(namespaceName),
+					config.MemoSizeLimitError(namespaceName),
+					namespaceName,
+					request.GetExecution().GetWorkflowId(),
+					request.GetExecution().GetRunId(),
+					shardContext.GetMetricsHandler(),
+					shardContext.GetThrottledLogger(),
+					tag.BlobSizeViolationOperation(operation),
+				); err != nil {
+					return nil, common.ErrMemoSizeExceedsLimit
+				}
+			}
+			// A workflow task that's already scheduled stays on the task queue it was
+			// dispatched to, the next ones are scheduled on the new task queue.
+			if _, err := mutableState.AddWorkflowPropertiesModifiedExternallyEvent(ctx, properties); err != nil {
+				return nil, err
+			}
+			return &api.UpdateWorkflowAction{
+				Noop:               false,
+				CreateWorkflowTask: false,
+			}, nil
+		},
+		nil,
+		shardContext,
+		workflowConsistencyChecker,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &historyservice.ModifyWorkflowPropertiesResponse{}, nil
+}
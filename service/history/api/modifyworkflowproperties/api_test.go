@@ -0,0 +1,183 @@
+package modifyworkflowproperties
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+	commonpb "go.temporal.io/api/common/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/definition"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/common/testing/protomock"
+	"go.temporal.io/server/service/history/api"
+	"go.temporal.io/server/service/history/configs"
+	"go.temporal.io/server/service/history/consts"
+	historyi "go.temporal.io/server/service/history/interfaces"
+	"go.temporal.io/server/service/history/tests"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+type (
+	modifyWorkflowPropertiesSuite struct {
+		suite.Suite
+		*require.Assertions
+
+		controller             *gomock.Controller
+		config                 *configs.Config
+		mockShard              *historyi.MockShardContext
+		mockMutableState       *historyi.MockMutableState
+		mockWorkflowContext    *historyi.MockWorkflowContext
+		mockConsistencyChecker *api.MockWorkflowConsistencyChecker
+
+		execution *commonpb.WorkflowExecution
+	}
+)
+
+func TestModifyWorkflowPropertiesSuite(t *testing.T) {
+	s := new(modifyWorkflowPropertiesSuite)
+	suite.Run(t, s)
+}
+
+func (s *modifyWorkflowPropertiesSuite) SetupTest() {
+	s.Assertions = require.New(s.T())
+
+	s.controller = gomock.NewController(s.T())
+	s.config = tests.NewDynamicConfig()
+	s.mockMutableState = historyi.NewMockMutableState(s.controller)
+	s.mockWorkflowContext = historyi.NewMockWorkflowContext(s.controller)
+	s.mockConsistencyChecker = api.NewMockWorkflowConsistencyChecker(s.controller)
+
+	mockNamespaceRegistry := namespace.NewMockRegistry(s.controller)
+	mockNamespaceRegistry.EXPECT().GetNamespaceByID(tests.NamespaceID).Return(tests.LocalNamespaceEntry, nil).AnyTimes()
+	s.mockShard = historyi.NewMockShardContext(s.controller)
+	s.mockShard.EXPECT().GetConfig().Return(s.config).AnyTimes()
+	s.mockShard.EXPECT().GetNamespaceRegistry().Return(mockNamespaceRegistry).AnyTimes()
+	s.mockShard.EXPECT().GetMetricsHandler().Return(metrics.NoopMetricsHandler).AnyTimes()
+	s.mockShard.EXPECT().GetThrottledLogger().Return(log.NewNoopLogger()).AnyTimes()
+
+	s.execution = &commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+}
+
+func (s *modifyWorkflowPropertiesSuite) TearDownTest() {
+	s.controller.Finish()
+}
+
+func (s *modifyWorkflowPropertiesSuite) expectWorkflowLease() {
+	workflowKey := definition.NewWorkflowKey(tests.NamespaceID.String(), tests.WorkflowID, tests.RunID)
+	lease := api.NewWorkflowLease(s.mockWorkflowContext, func(error) {}, s.mockMutableState)
+	s.mockConsistencyChecker.EXPECT().GetWorkflowLease(gomock.Any(), gomock.Any(), workflowKey, gomock.Any()).Return(lease, nil)
+}
+
+func (s *modifyWorkflowPropertiesSuite) invoke(
+	properties *historypb.WorkflowPropertiesModifiedExternallyEventAttributes,
+) (*historyservice.ModifyWorkflowPropertiesResponse, error) {
+	return Invoke(
+		context.Background(),
+		&historyservice.ModifyWorkflowPropertiesRequest{
+			NamespaceId: tests.NamespaceID.String(),
+			Execution:   s.execution,
+			Properties:  properties,
+		},
+		s.mockShard,
+		s.mockConsistencyChecker,
+	)
+}
+
+func (s *modifyWorkflowPropertiesSuite) TestSuccess() {
+	properties := &historypb.WorkflowPropertiesModifiedExternallyEventAttributes{
+		NewTaskQueue:           "new-task-queue",
+		NewWorkflowTaskTimeout: durationpb.New(time.Minute),
+		UpsertedMemo: &commonpb.Memo{Fields: map[string]*commonpb.Payload{
+			"key": payload.EncodeString("value"),
+		}},
+	}
+	s.expectWorkflowLease()
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true)
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{})
+	s.mockMutableState.EXPECT().AddWorkflowPropertiesModifiedExternallyEvent(gomock.Any(), protomock.Eq(properties)).Return(&historypb.HistoryEvent{}, nil)
+	s.mockWorkflowContext.EXPECT().UpdateWorkflowExecutionAsActive(gomock.Any(), s.mockShard).Return(nil)
+
+	resp, err := s.invoke(properties)
+	s.NoError(err)
+	s.NotNil(resp)
+}
+
+func (s *modifyWorkflowPropertiesSuite) TestWorkflowClosed() {
+	s.expectWorkflowLease()
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(false)
+
+	_, err := s.invoke(&historypb.WorkflowPropertiesModifiedExternallyEventAttributes{
+		NewWorkflowTaskTimeout: durationpb.New(time.Minute),
+	})
+	s.ErrorIs(err, consts.ErrWorkflowCompleted)
+}
+
+func (s *modifyWorkflowPropertiesSuite) TestNoPropertySet() {
+	_, err := s.invoke(&historypb.WorkflowPropertiesModifiedExternallyEventAttributes{})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+
+	_, err = s.invoke(nil)
+	s.ErrorAs(err, &invalidArgument)
+}
+
+func (s *modifyWorkflowPropertiesSuite) TestNegativeTimeouts() {
+	for _, properties := range []*historypb.WorkflowPropertiesModifiedExternallyEventAttributes{
+		{NewWorkflowTaskTimeout: durationpb.New(-time.Second)},
+		{NewWorkflowRunTimeout: durationpb.New(-time.Second)},
+		{NewWorkflowExecutionTimeout: durationpb.New(-time.Second)},
+	} {
+		_, err := s.invoke(properties)
+		var invalidArgument *serviceerror.InvalidArgument
+		s.ErrorAs(err, &invalidArgument)
+	}
+}
+
+func (s *modifyWorkflowPropertiesSuite) TestInvalidTaskQueue() {
+	for _, taskQueue := range []string{
+		"/_sys/reserved",
+		strings.Repeat("a", s.config.MaxIDLengthLimit()+1),
+	} {
+		_, err := s.invoke(&historypb.WorkflowPropertiesModifiedExternallyEventAttributes{
+			NewTaskQueue: taskQueue,
+		})
+		var invalidArgument *serviceerror.InvalidArgument
+		s.ErrorAs(err, &invalidArgument)
+	}
+}
+
+func (s *modifyWorkflowPropertiesSuite) TestMemoSizeExceedsLimit() {
+	s.config.MemoSizeLimitWarn = dynamicconfig.GetIntPropertyFnFilteredByNamespace(16)
+	s.config.MemoSizeLimitError = dynamicconfig.GetIntPropertyFnFilteredByNamespace(32)
+	s.expectWorkflowLease()
+	s.mockMutableState.EXPECT().IsWorkflowExecutionRunning().Return(true)
+	// The limit is checked against the memo merged with the existing fields.
+	s.mockMutableState.EXPECT().GetExecutionInfo().Return(&persistencespb.WorkflowExecutionInfo{
+		Memo: map[string]*commonpb.Payload{
+			"existing": payload.EncodeString(strings.Repeat("a", 16)),
+		},
+	})
+
+	_, err := s.invoke(&historypb.WorkflowPropertiesModifiedExternallyEventAttributes{
+		UpsertedMemo: &commonpb.Memo{Fields: map[string]*commonpb.Payload{
+			"key": payload.EncodeString("value"),
+		}},
+	})
+	s.ErrorIs(err, common.ErrMemoSizeExceedsLimit)
+}
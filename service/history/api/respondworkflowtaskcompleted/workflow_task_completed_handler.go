@@ -508,6 +508,10 @@ func (handler *workflowTaskCompletedHandler) handlePostCommandEagerExecuteActivi
 		// activity cancelled in the same worflow task
 		return nil, nil
 	}
+	if ai.Paused {
+		// activity paused by a workflow rule when it was scheduled
+		return nil, nil
+	}
 
 	var stamp *commonpb.WorkerVersionStamp
 	// eager activity always uses workflow's build ID
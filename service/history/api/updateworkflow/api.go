@@ -50,6 +50,7 @@ type Updater struct {
 	// to avoid data races when used outside the workflow lease.
 	taskQueue              *taskqueuepb.TaskQueue
 	priority               *commonpb.Priority
+	fairnessKey            string
 	normalTaskQueueName    string
 	scheduledEventID       int64
 	scheduleToStartTimeout time.Duration
@@ -188,6 +189,7 @@ func (u *Updater) ApplyRequest(
 
 	u.taskQueue = common.CloneProto(newWorkflowTask.TaskQueue)
 	u.priority = common.CloneProto(ms.GetExecutionInfo().Priority)
+	u.fairnessKey = workflow.GetFairnessKey(u.shardCtx, ms)
 	u.normalTaskQueueName = ms.GetExecutionInfo().TaskQueue
 	u.directive = worker_versioning.MakeDirectiveForWorkflowTask(
 		ms.GetInheritedBuildId(),
@@ -276,6 +278,7 @@ func (u *Updater) addWorkflowTaskToMatching(ctx context.Context) error {
 		Clock:                  clock,
 		VersionDirective:       u.directive,
 		Priority:               u.priority,
+		FairnessKey:            u.fairnessKey,
 	})
 	if err != nil {
 		return err
@@ -5,25 +5,43 @@ import (
 	"errors"
 	"fmt"
 
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/chasm"
 	"go.temporal.io/server/common/definition"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/locks"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payloads"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/primitives"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/configs"
 	"go.temporal.io/server/service/history/consts"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/workflow"
 	"go.temporal.io/server/service/history/workflow/cache"
 )
 
-type ChasmEngine struct {
-	entityCache     cache.Cache
-	shardController shard.Controller
-	registry        *chasm.Registry
-	config          *configs.Config
-}
+type (
+	ChasmEngine struct {
+		entityCache     cache.Cache
+		shardController shard.Controller
+		registry        *chasm.Registry
+		config          *configs.Config
+	}
+
+	newEntityParams struct {
+		entityRef     chasm.ComponentRef
+		entityContext historyi.WorkflowContext
+		mutableState  historyi.MutableState
+		snapshot      *persistence.WorkflowSnapshot
+		events        []*persistence.WorkflowEvents
+	}
+)
 
 func NewChasmEngine(
 	entityCache cache.Cache,
@@ -39,12 +57,368 @@ func NewChasmEngine(
 	}
 }
 
+// NewEntity creates a new entity with the root component returned by newFn.
+// If there's already an entity with the same BusinessID, the BusinessIDReusePolicy (for closed entity)
+// or BusinessIDConflictPolicy (for running entity) specified in the TransitionOptions is applied.
+func (e *ChasmEngine) NewEntity(
+	ctx context.Context,
+	ref chasm.ComponentRef,
+	newFn func(chasm.MutableContext) (chasm.Component, error),
+	opts ...chasm.TransitionOption,
+) (chasm.ComponentRef, error) {
+	return e.newEntity(ctx, ref, newFn, nil, opts...)
+}
+
+// UpdateWithNewEntity applies updateFn to the running entity with the given BusinessID,
+// or creates a new entity with newFn and applies updateFn to it in the same transition
+// if there's no running entity. BusinessIDReusePolicy is applied if the current entity is closed.
+func (e *ChasmEngine) UpdateWithNewEntity(
+	ctx context.Context,
+	ref chasm.ComponentRef,
+	newFn func(chasm.MutableContext) (chasm.Component, error),
+	updateFn func(chasm.MutableContext, chasm.Component) error,
+	opts ...chasm.TransitionOption,
+) (chasm.ComponentRef, error) {
+	return e.newEntity(ctx, ref, newFn, updateFn, opts...)
+}
+
+func (e *ChasmEngine) newEntity(
+	ctx context.Context,
+	ref chasm.ComponentRef,
+	newFn func(chasm.MutableContext) (chasm.Component, error),
+	updateFn func(chasm.MutableContext, chasm.Component) error,
+	opts ...chasm.TransitionOption,
+) (newRef chasm.ComponentRef, retError error) {
+	options := chasm.NewTransitionOptions(opts...)
+	if options.Speculative {
+		return chasm.ComponentRef{}, serviceerror.NewInvalidArgument("Speculative transition is not supported when creating a new entity.")
+	}
+
+	shardContext, err := e.getShardContext(ref)
+	if err != nil {
+		return chasm.ComponentRef{}, err
+	}
+	namespaceEntry, err := shardContext.GetNamespaceRegistry().GetNamespaceByID(namespace.ID(ref.NamespaceID))
+	if err != nil {
+		return chasm.ComponentRef{}, err
+	}
+
+	currentEntityReleaseFn, err := e.entityCache.GetOrCreateCurrentWorkflowExecution(
+		ctx,
+		shardContext,
+		namespaceEntry.ID(),
+		ref.BusinessID,
+		locks.PriorityHigh,
+	)
+	if err != nil {
+		return chasm.ComponentRef{}, err
+	}
+	defer func() {
+		currentEntityReleaseFn(retError)
+	}()
+
+	newEntity, err := e.createNewEntity(ctx, shardContext, namespaceEntry, ref, newFn, updateFn)
+	if err != nil {
+		return chasm.ComponentRef{}, err
+	}
+	newEntity.snapshot, newEntity.events, err = newEntity.mutableState.CloseTransactionAsSnapshot(
+		historyi.TransactionPolicyActive,
+	)
+	if err != nil {
+		return chasm.ComponentRef{}, err
+	}
+
+	err = newEntity.entityContext.CreateWorkflowExecution(
+		ctx,
+		shardContext,
+		persistence.CreateWorkflowModeBrandNew,
+		"", // prevRunID
+		0,  // prevLastWriteVersion
+		newEntity.mutableState,
+		newEntity.snapshot,
+		newEntity.events,
+	)
+	if err == nil {
+		return newEntity.entityRef, nil
+	}
+
+	var currentEntityConditionFailed *persistence.CurrentWorkflowConditionFailedError
+	if !errors.As(err, &currentEntityConditionFailed) || len(currentEntityConditionFailed.RunID) == 0 {
+		return chasm.ComponentRef{}, err
+	}
+
+	// The mutable state created above will be deleted by a background process.
+	return e.handleEntityConflict(
+		ctx,
+		shardContext,
+		namespaceEntry,
+		newEntity,
+		newFn,
+		updateFn,
+		options,
+		currentEntityConditionFailed,
+	)
+}
+
+// handleEntityConflict handles CurrentWorkflowConditionFailedError where there's an entity with the same BusinessID.
+func (e *ChasmEngine) handleEntityConflict(
+	ctx context.Context,
+	shardContext historyi.ShardContext,
+	namespaceEntry *namespace.Namespace,
+	newEntity *newEntityParams,
+	newFn func(chasm.MutableContext) (chasm.Component, error),
+	updateFn func(chasm.MutableContext, chasm.Component) error,
+	options chasm.TransitionOptions,
+	currentEntityConditionFailed *persistence.CurrentWorkflowConditionFailedError,
+) (chasm.ComponentRef, error) {
+	currentEntityRef := newEntity.entityRef
+	currentEntityRef.EntityID = currentEntityConditionFailed.RunID
+
+	switch currentEntityConditionFailed.State {
+	// *running* entity: apply BusinessIDConflictPolicy
+	case enumsspb.WORKFLOW_EXECUTION_STATE_CREATED, enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING:
+		if updateFn != nil {
+			// UpdateWithNewEntity always updates the running entity.
+			return e.UpdateComponent(ctx, currentEntityRef, updateFn)
+		}
+
+		switch options.ConflictPolicy {
+		case chasm.BusinessIDConflictPolicyFail:
+			return chasm.ComponentRef{}, serviceerror.NewAlreadyExistsf(
+				"Entity is already running. BusinessID: %v, EntityID: %v.",
+				currentEntityRef.BusinessID,
+				currentEntityRef.EntityID,
+			)
+		case chasm.BusinessIDConflictPolicyUseExisting:
+			return currentEntityRef, nil
+		case chasm.BusinessIDConflictPolicyTermiateExisting:
+			return e.terminateAndCreateNewEntity(
+				ctx,
+				shardContext,
+				namespaceEntry,
+				currentEntityRef,
+				newFn,
+			)
+		default:
+			return chasm.ComponentRef{}, serviceerror.NewInternalf(
+				"Failed to process BusinessID conflict policy: %v.", options.ConflictPolicy,
+			)
+		}
+
+	// *closed* entity: apply BusinessIDReusePolicy
+	case enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED:
+		if err := resolveBusinessIDReusePolicy(
+			currentEntityRef,
+			currentEntityConditionFailed.Status,
+			options.ReusePolicy,
+		); err != nil {
+			return chasm.ComponentRef{}, err
+		}
+
+		if err := api.NewWorkflowVersionCheck(
+			shardContext,
+			currentEntityConditionFailed.LastWriteVersion,
+			newEntity.mutableState,
+		); err != nil {
+			return chasm.ComponentRef{}, err
+		}
+
+		if err := newEntity.entityContext.CreateWorkflowExecution(
+			ctx,
+			shardContext,
+			persistence.CreateWorkflowModeUpdateCurrent,
+			currentEntityConditionFailed.RunID,
+			currentEntityConditionFailed.LastWriteVersion,
+			newEntity.mutableState,
+			newEntity.snapshot,
+			newEntity.events,
+		); err != nil {
+			return chasm.ComponentRef{}, err
+		}
+		return newEntity.entityRef, nil
+
+	default:
+		// persistence.WorkflowStateZombie or unknown type
+		return chasm.ComponentRef{}, serviceerror.NewInternalf(
+			"Failed to process entity, entity has invalid state: %v.", currentEntityConditionFailed.State,
+		)
+	}
+}
+
+// terminateAndCreateNewEntity terminates the running entity and creates a new entity as the
+// current one for the BusinessID, in a single transaction.
+//
+// The new entity created for the first attempt has its transaction closed already, so the new
+// entity is created again, as a new workflow is for UpdateWithNew.
+func (e *ChasmEngine) terminateAndCreateNewEntity(
+	ctx context.Context,
+	shardContext historyi.ShardContext,
+	namespaceEntry *namespace.Namespace,
+	currentEntityRef chasm.ComponentRef,
+	newFn func(chasm.MutableContext) (chasm.Component, error),
+) (newRef chasm.ComponentRef, retError error) {
+	currentEntityContext, currentEntityReleaseFn, err := e.entityCache.GetOrCreateWorkflowExecution(
+		ctx,
+		shardContext,
+		namespaceEntry.ID(),
+		&commonpb.WorkflowExecution{
+			WorkflowId: currentEntityRef.BusinessID,
+			RunId:      currentEntityRef.EntityID,
+		},
+		locks.PriorityHigh,
+	)
+	if err != nil {
+		return chasm.ComponentRef{}, err
+	}
+	defer func() {
+		currentEntityReleaseFn(retError)
+	}()
+
+	currentMutableState, err := currentEntityContext.LoadMutableState(ctx, shardContext)
+	if err != nil {
+		return chasm.ComponentRef{}, err
+	}
+	if !currentMutableState.IsWorkflowExecutionRunning() {
+		// Current entity closed after the conflict was detected.
+		// By returning an Unavailable service error, the entire request will be retried.
+		return chasm.ComponentRef{}, serviceerror.NewUnavailablef("Termination failed: %v", consts.ErrWorkflowCompleted)
+	}
+
+	newEntity, err := e.createNewEntity(ctx, shardContext, namespaceEntry, currentEntityRef, newFn, nil)
+	if err != nil {
+		return chasm.ComponentRef{}, err
+	}
+
+	currentChasmTree, err := chasmTreeOf(currentMutableState)
+	if err != nil {
+		return chasm.ComponentRef{}, err
+	}
+	if err := currentChasmTree.Terminate(chasm.TerminateComponentRequest{
+		Identity: consts.IdentityHistoryService,
+		Reason:   "TerminateExisting BusinessIDConflictPolicy",
+		Details:  payloads.EncodeString(fmt.Sprintf("terminated by new EntityID: %s", newEntity.entityRef.EntityID)),
+	}); err != nil {
+		return chasm.ComponentRef{}, err
+	}
+
+	currentLastWriteVersion, err := currentMutableState.GetLastWriteVersion()
+	if err != nil {
+		return chasm.ComponentRef{}, err
+	}
+	if err := api.NewWorkflowVersionCheck(
+		shardContext,
+		currentLastWriteVersion,
+		newEntity.mutableState,
+	); err != nil {
+		return chasm.ComponentRef{}, err
+	}
+
+	if err := currentEntityContext.UpdateWorkflowExecutionWithNewAsActive(
+		ctx,
+		shardContext,
+		newEntity.entityContext,
+		newEntity.mutableState,
+	); err != nil {
+		return chasm.ComponentRef{}, err
+	}
+	return newEntity.entityRef, nil
+}
+
+// createNewEntity creates the mutable state for a new entity with a new EntityID.
+// The mutable state transaction is not closed.
+func (e *ChasmEngine) createNewEntity(
+	ctx context.Context,
+	shardContext historyi.ShardContext,
+	namespaceEntry *namespace.Namespace,
+	ref chasm.ComponentRef,
+	newFn func(chasm.MutableContext) (chasm.Component, error),
+	updateFn func(chasm.MutableContext, chasm.Component) error,
+) (*newEntityParams, error) {
+	entityRef := ref
+	entityRef.EntityID = primitives.NewUUID().String()
+
+	mutableState := workflow.NewMutableState(
+		shardContext,
+		shardContext.GetEventsCache(),
+		shardContext.GetLogger(),
+		namespaceEntry,
+		entityRef.BusinessID,
+		entityRef.EntityID,
+		shardContext.GetTimeSource().Now(),
+	)
+	chasmTree, err := chasmTreeOf(mutableState)
+	if err != nil {
+		return nil, err
+	}
+
+	mutableContext := chasm.NewMutableContext(ctx, chasmTree)
+	rootComponent, err := newFn(mutableContext)
+	if err != nil {
+		return nil, err
+	}
+	chasmTree.SetRootComponent(rootComponent)
+
+	if updateFn != nil {
+		if err := updateFn(mutableContext, rootComponent); err != nil {
+			return nil, err
+		}
+	}
+
+	entityContext := workflow.NewContext(
+		shardContext.GetConfig(),
+		definition.NewWorkflowKey(
+			entityRef.NamespaceID,
+			entityRef.BusinessID,
+			entityRef.EntityID,
+		),
+		shardContext.GetLogger(),
+		shardContext.GetThrottledLogger(),
+		shardContext.GetMetricsHandler(),
+	)
+
+	return &newEntityParams{
+		entityRef:     entityRef,
+		entityContext: entityContext,
+		mutableState:  mutableState,
+	}, nil
+}
+
+func resolveBusinessIDReusePolicy(
+	currentEntityRef chasm.ComponentRef,
+	currentStatus enumspb.WorkflowExecutionStatus,
+	reusePolicy chasm.BusinessIDReusePolicy,
+) error {
+	switch reusePolicy {
+	case chasm.BusinessIDReusePolicyAllowDuplicate:
+		// no error
+	case chasm.BusinessIDReusePolicyAllowDuplicateFailedOnly:
+		if _, ok := consts.FailedWorkflowStatuses[currentStatus]; !ok {
+			return serviceerror.NewAlreadyExistsf(
+				"Entity already finished successfully. BusinessID: %v, EntityID: %v. BusinessID reuse policy: allow duplicate BusinessID if last run failed.",
+				currentEntityRef.BusinessID,
+				currentEntityRef.EntityID,
+			)
+		}
+	case chasm.BusinessIDReusePolicyRejectDuplicate:
+		return serviceerror.NewAlreadyExistsf(
+			"Entity already finished. BusinessID: %v, EntityID: %v. BusinessID reuse policy: reject duplicate BusinessID.",
+			currentEntityRef.BusinessID,
+			currentEntityRef.EntityID,
+		)
+	default:
+		return serviceerror.NewInternalf("Failed to process BusinessID reuse policy: %v.", reusePolicy)
+	}
+	// ie "allow" creating a new entity
+	return nil
+}
+
 func (e *ChasmEngine) UpdateComponent(
 	ctx context.Context,
 	ref chasm.ComponentRef,
 	updateFn func(chasm.MutableContext, chasm.Component) error,
 	opts ...chasm.TransitionOption,
 ) (updatedRef chasm.ComponentRef, retError error) {
+	options := chasm.NewTransitionOptions(opts...)
 
 	shardContext, executionLease, err := e.getExecutionLease(ctx, ref)
 	if err != nil {
@@ -54,16 +428,9 @@ func (e *ChasmEngine) UpdateComponent(
 		executionLease.GetReleaseFn()(retError)
 	}()
 
-	mutableState := executionLease.GetMutableState()
-	chasmTree, ok := mutableState.ChasmTree().(*chasm.Node)
-	if !ok {
-		return chasm.ComponentRef{}, serviceerror.NewInternal(
-			fmt.Sprintf(
-				"CHASM tree implementation not properly wired up, encountered type: %T, expected type: %T",
-				mutableState.ChasmTree(),
-				&chasm.Node{},
-			),
-		)
+	chasmTree, err := chasmTreeOf(executionLease.GetMutableState())
+	if err != nil {
+		return chasm.ComponentRef{}, err
 	}
 
 	mutableContext := chasm.NewMutableContext(ctx, chasmTree)
@@ -76,9 +443,11 @@ func (e *ChasmEngine) UpdateComponent(
 		return chasm.ComponentRef{}, err
 	}
 
-	// TODO: Support WithSpeculative() TransitionOption.
-
-	if err := executionLease.GetContext().UpdateWorkflowExecutionAsActive(
+	if options.Speculative {
+		// Leave the transaction open so that changes are kept in the cached mutable state
+		// and persisted by the next non-speculative transition.
+		chasmTree.SetSpeculative()
+	} else if err := executionLease.GetContext().UpdateWorkflowExecutionAsActive(
 		ctx,
 		shardContext,
 	); err != nil {
@@ -111,15 +480,9 @@ func (e *ChasmEngine) ReadComponent(
 		executionLease.GetReleaseFn()(nil)
 	}()
 
-	chasmTree, ok := executionLease.GetMutableState().ChasmTree().(*chasm.Node)
-	if !ok {
-		return serviceerror.NewInternal(
-			fmt.Sprintf(
-				"CHASM tree implementation not properly wired up, encountered type: %T, expected type: %T",
-				executionLease.GetMutableState().ChasmTree(),
-				&chasm.Node{},
-			),
-		)
+	chasmTree, err := chasmTreeOf(executionLease.GetMutableState())
+	if err != nil {
+		return err
 	}
 
 	chasmContext := chasm.NewContext(ctx, chasmTree)
@@ -131,16 +494,22 @@ func (e *ChasmEngine) ReadComponent(
 	return readFn(chasmContext, component)
 }
 
-func (e *ChasmEngine) getExecutionLease(
-	ctx context.Context,
+func (e *ChasmEngine) getShardContext(
 	ref chasm.ComponentRef,
-) (historyi.ShardContext, api.WorkflowLease, error) {
+) (historyi.ShardContext, error) {
 	shardID, err := ref.ShardID(e.registry, e.config.NumberOfShards)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
-	shardContext, err := e.shardController.GetShardByID(shardID)
+	return e.shardController.GetShardByID(shardID)
+}
+
+func (e *ChasmEngine) getExecutionLease(
+	ctx context.Context,
+	ref chasm.ComponentRef,
+) (historyi.ShardContext, api.WorkflowLease, error) {
+	shardContext, err := e.getShardContext(ref)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -185,3 +554,19 @@ func (e *ChasmEngine) getExecutionLease(
 
 	return shardContext, entityLease, err
 }
+
+func chasmTreeOf(
+	mutableState historyi.MutableState,
+) (*chasm.Node, error) {
+	chasmTree, ok := mutableState.ChasmTree().(*chasm.Node)
+	if !ok {
+		return nil, serviceerror.NewInternal(
+			fmt.Sprintf(
+				"CHASM tree implementation not properly wired up, encountered type: %T, expected type: %T",
+				mutableState.ChasmTree(),
+				&chasm.Node{},
+			),
+		)
+	}
+	return chasmTree, nil
+}
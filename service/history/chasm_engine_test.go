@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	historyspb "go.temporal.io/server/api/history/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -26,6 +27,7 @@ import (
 	"go.temporal.io/server/service/history/hsm"
 	historyi "go.temporal.io/server/service/history/interfaces"
 	"go.temporal.io/server/service/history/shard"
+	"go.temporal.io/server/service/history/tasks"
 	"go.temporal.io/server/service/history/tests"
 	"go.temporal.io/server/service/history/workflow"
 	wcache "go.temporal.io/server/service/history/workflow/cache"
@@ -129,6 +131,322 @@ func (s *chasmEngineSuite) initAssertions() {
 	s.ProtoAssertions = protorequire.New(s.T())
 }
 
+func (s *chasmEngineSuite) TestNewEntity_BrandNew() {
+	tv := testvars.New(s.T())
+
+	ref := chasm.NewComponentRef[*testComponent](
+		chasm.EntityKey{
+			NamespaceID: string(tests.NamespaceID),
+			BusinessID:  tv.WorkflowID(),
+		},
+	)
+	newActivityID := tv.ActivityID()
+
+	s.mockExecutionManager.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(
+			_ context.Context,
+			request *persistence.CreateWorkflowExecutionRequest,
+		) (*persistence.CreateWorkflowExecutionResponse, error) {
+			s.Equal(persistence.CreateWorkflowModeBrandNew, request.Mode)
+			s.validateNewEntitySnapshot(&request.NewWorkflowSnapshot, newActivityID)
+			return tests.CreateWorkflowExecutionResponse, nil
+		},
+	).Times(1)
+
+	newRef, err := s.engine.NewEntity(
+		context.Background(),
+		ref,
+		s.newTestComponentFn(newActivityID),
+	)
+	s.NoError(err)
+	s.Equal(ref.BusinessID, newRef.BusinessID)
+	s.NotEmpty(newRef.EntityID)
+}
+
+func (s *chasmEngineSuite) TestNewEntity_ReusePolicy() {
+	testCases := []struct {
+		name          string
+		reusePolicy   chasm.BusinessIDReusePolicy
+		currentStatus enumspb.WorkflowExecutionStatus
+		expectCreated bool
+	}{
+		{
+			name:          "allow duplicate",
+			reusePolicy:   chasm.BusinessIDReusePolicyAllowDuplicate,
+			currentStatus: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			expectCreated: true,
+		},
+		{
+			name:          "allow duplicate failed only, current failed",
+			reusePolicy:   chasm.BusinessIDReusePolicyAllowDuplicateFailedOnly,
+			currentStatus: enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED,
+			expectCreated: true,
+		},
+		{
+			name:          "allow duplicate failed only, current completed",
+			reusePolicy:   chasm.BusinessIDReusePolicyAllowDuplicateFailedOnly,
+			currentStatus: enumspb.WORKFLOW_EXECUTION_STATUS_COMPLETED,
+			expectCreated: false,
+		},
+		{
+			name:          "reject duplicate",
+			reusePolicy:   chasm.BusinessIDReusePolicyRejectDuplicate,
+			currentStatus: enumspb.WORKFLOW_EXECUTION_STATUS_FAILED,
+			expectCreated: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			tv := testvars.New(s.T())
+			currentRunID := primitives.NewUUID().String()
+
+			ref := chasm.NewComponentRef[*testComponent](
+				chasm.EntityKey{
+					NamespaceID: string(tests.NamespaceID),
+					BusinessID:  tv.WorkflowID(),
+				},
+			)
+			newActivityID := tv.ActivityID()
+
+			s.mockExecutionManager.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+				nil,
+				&persistence.CurrentWorkflowConditionFailedError{
+					RunID:            currentRunID,
+					State:            enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED,
+					Status:           tc.currentStatus,
+					LastWriteVersion: s.namespaceEntry.FailoverVersion(),
+				},
+			).Times(1)
+			if tc.expectCreated {
+				s.mockExecutionManager.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(
+						_ context.Context,
+						request *persistence.CreateWorkflowExecutionRequest,
+					) (*persistence.CreateWorkflowExecutionResponse, error) {
+						s.Equal(persistence.CreateWorkflowModeUpdateCurrent, request.Mode)
+						s.Equal(currentRunID, request.PreviousRunID)
+						s.validateNewEntitySnapshot(&request.NewWorkflowSnapshot, newActivityID)
+						return tests.CreateWorkflowExecutionResponse, nil
+					},
+				).Times(1)
+			}
+
+			newRef, err := s.engine.NewEntity(
+				context.Background(),
+				ref,
+				s.newTestComponentFn(newActivityID),
+				chasm.WithBusinessIDPolicy(tc.reusePolicy, chasm.BusinessIDConflictPolicyFail),
+			)
+			if !tc.expectCreated {
+				var alreadyExistsErr *serviceerror.AlreadyExists
+				s.ErrorAs(err, &alreadyExistsErr)
+				return
+			}
+			s.NoError(err)
+			s.NotEqual(currentRunID, newRef.EntityID)
+		})
+	}
+}
+
+func (s *chasmEngineSuite) TestNewEntity_ConflictPolicy_Fail() {
+	tv := testvars.New(s.T())
+	currentRunID := primitives.NewUUID().String()
+
+	ref := chasm.NewComponentRef[*testComponent](
+		chasm.EntityKey{
+			NamespaceID: string(tests.NamespaceID),
+			BusinessID:  tv.WorkflowID(),
+		},
+	)
+
+	s.mockExecutionManager.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+		nil,
+		&persistence.CurrentWorkflowConditionFailedError{
+			RunID:  currentRunID,
+			State:  enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+			Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+	).Times(1)
+
+	_, err := s.engine.NewEntity(
+		context.Background(),
+		ref,
+		s.newTestComponentFn(tv.ActivityID()),
+		chasm.WithBusinessIDPolicy(chasm.BusinessIDReusePolicyAllowDuplicate, chasm.BusinessIDConflictPolicyFail),
+	)
+	var alreadyExistsErr *serviceerror.AlreadyExists
+	s.ErrorAs(err, &alreadyExistsErr)
+}
+
+func (s *chasmEngineSuite) TestNewEntity_ConflictPolicy_UseExisting() {
+	tv := testvars.New(s.T())
+	currentRunID := primitives.NewUUID().String()
+
+	ref := chasm.NewComponentRef[*testComponent](
+		chasm.EntityKey{
+			NamespaceID: string(tests.NamespaceID),
+			BusinessID:  tv.WorkflowID(),
+		},
+	)
+
+	s.mockExecutionManager.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+		nil,
+		&persistence.CurrentWorkflowConditionFailedError{
+			RunID:  currentRunID,
+			State:  enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+			Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+	).Times(1)
+
+	newRef, err := s.engine.NewEntity(
+		context.Background(),
+		ref,
+		s.newTestComponentFn(tv.ActivityID()),
+		chasm.WithBusinessIDPolicy(chasm.BusinessIDReusePolicyAllowDuplicate, chasm.BusinessIDConflictPolicyUseExisting),
+	)
+	s.NoError(err)
+	s.Equal(currentRunID, newRef.EntityID)
+}
+
+func (s *chasmEngineSuite) TestNewEntity_ConflictPolicy_TerminateExisting() {
+	tv := testvars.New(s.T())
+	currentKey := chasm.EntityKey{
+		NamespaceID: string(tests.NamespaceID),
+		BusinessID:  tv.WorkflowID(),
+		EntityID:    primitives.NewUUID().String(),
+	}
+
+	ref := chasm.NewComponentRef[*testComponent](
+		chasm.EntityKey{
+			NamespaceID: currentKey.NamespaceID,
+			BusinessID:  currentKey.BusinessID,
+		},
+	)
+	newActivityID := tv.ActivityID()
+
+	s.mockExecutionManager.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+		nil,
+		&persistence.CurrentWorkflowConditionFailedError{
+			RunID:  currentKey.EntityID,
+			State:  enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+			Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+	).Times(1)
+	s.mockExecutionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(&persistence.GetWorkflowExecutionResponse{
+			State: s.buildPersistenceMutableState(currentKey, &persistencespb.ActivityInfo{
+				ActivityId: tv.ActivityID(),
+			}),
+		}, nil).Times(1)
+	s.mockExecutionManager.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(
+			_ context.Context,
+			request *persistence.UpdateWorkflowExecutionRequest,
+		) (*persistence.UpdateWorkflowExecutionResponse, error) {
+			s.Equal(currentKey.EntityID, request.UpdateWorkflowMutation.ExecutionState.RunId)
+			s.Equal(enumsspb.WORKFLOW_EXECUTION_STATE_COMPLETED, request.UpdateWorkflowMutation.ExecutionState.State)
+			s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_TERMINATED, request.UpdateWorkflowMutation.ExecutionState.Status)
+			// The new entity is created in the same transaction.
+			s.Equal(persistence.UpdateWorkflowModeUpdateCurrent, request.Mode)
+			s.NotNil(request.NewWorkflowSnapshot)
+			s.validateNewEntitySnapshot(request.NewWorkflowSnapshot, newActivityID)
+
+			// Both entities are replicated with their own sync versioned transition task, since
+			// CHASM entities have no history events to carry the new entity with the terminated one.
+			currentReplicationTasks := request.UpdateWorkflowMutation.Tasks[tasks.CategoryReplication]
+			s.Len(currentReplicationTasks, 1)
+			currentSyncTask, ok := currentReplicationTasks[0].(*tasks.SyncVersionedTransitionTask)
+			s.True(ok)
+			s.Equal(currentKey.EntityID, currentSyncTask.RunID)
+			s.Empty(currentSyncTask.NewRunID)
+			s.False(currentSyncTask.IsFirstTask)
+
+			newReplicationTasks := request.NewWorkflowSnapshot.Tasks[tasks.CategoryReplication]
+			s.Len(newReplicationTasks, 1)
+			newSyncTask, ok := newReplicationTasks[0].(*tasks.SyncVersionedTransitionTask)
+			s.True(ok)
+			s.Equal(request.NewWorkflowSnapshot.ExecutionState.RunId, newSyncTask.RunID)
+			s.True(newSyncTask.IsFirstTask)
+			return tests.UpdateWorkflowExecutionResponse, nil
+		},
+	).Times(1)
+
+	newRef, err := s.engine.NewEntity(
+		context.Background(),
+		ref,
+		s.newTestComponentFn(newActivityID),
+		chasm.WithBusinessIDPolicy(chasm.BusinessIDReusePolicyAllowDuplicate, chasm.BusinessIDConflictPolicyTermiateExisting),
+	)
+	s.NoError(err)
+	s.NotEqual(currentKey.EntityID, newRef.EntityID)
+}
+
+func (s *chasmEngineSuite) TestUpdateWithNewEntity_UpdateRunning() {
+	tv := testvars.New(s.T())
+	currentKey := chasm.EntityKey{
+		NamespaceID: string(tests.NamespaceID),
+		BusinessID:  tv.WorkflowID(),
+		EntityID:    primitives.NewUUID().String(),
+	}
+
+	ref := chasm.NewComponentRef[*testComponent](
+		chasm.EntityKey{
+			NamespaceID: currentKey.NamespaceID,
+			BusinessID:  currentKey.BusinessID,
+		},
+	)
+	updatedActivityID := tv.ActivityID()
+
+	s.mockExecutionManager.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).Return(
+		nil,
+		&persistence.CurrentWorkflowConditionFailedError{
+			RunID:  currentKey.EntityID,
+			State:  enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+			Status: enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING,
+		},
+	).Times(1)
+	s.mockExecutionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(&persistence.GetWorkflowExecutionResponse{
+			State: s.buildPersistenceMutableState(currentKey, &persistencespb.ActivityInfo{
+				ActivityId: "",
+			}),
+		}, nil).Times(1)
+	s.mockExecutionManager.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(
+			_ context.Context,
+			request *persistence.UpdateWorkflowExecutionRequest,
+		) (*persistence.UpdateWorkflowExecutionResponse, error) {
+			s.Equal(currentKey.EntityID, request.UpdateWorkflowMutation.ExecutionState.RunId)
+			s.Nil(request.NewWorkflowSnapshot)
+
+			updatedNode, ok := request.UpdateWorkflowMutation.UpsertChasmNodes[""]
+			s.True(ok)
+			activityInfo := &persistencespb.ActivityInfo{}
+			err := serialization.Proto3Decode(updatedNode.Data.Data, updatedNode.Data.EncodingType, activityInfo)
+			s.NoError(err)
+			s.Equal(updatedActivityID, activityInfo.ActivityId)
+			return tests.UpdateWorkflowExecutionResponse, nil
+		},
+	).Times(1)
+
+	_, err := s.engine.UpdateWithNewEntity(
+		context.Background(),
+		ref,
+		s.newTestComponentFn(""),
+		func(
+			ctx chasm.MutableContext,
+			component chasm.Component,
+		) error {
+			tc, ok := component.(*testComponent)
+			s.True(ok)
+			tc.ActivityInfo.ActivityId = updatedActivityID
+			return nil
+		},
+	)
+	s.NoError(err)
+}
+
 func (s *chasmEngineSuite) TestUpdateComponent_Success() {
 	tv := testvars.New(s.T())
 	tv = tv.WithRunID(primitives.NewUUID().String())
@@ -182,6 +500,185 @@ func (s *chasmEngineSuite) TestUpdateComponent_Success() {
 	s.NoError(err)
 }
 
+func (s *chasmEngineSuite) TestUpdateComponent_Speculative() {
+	tv := testvars.New(s.T())
+	tv = tv.WithRunID(primitives.NewUUID().String())
+
+	ref := chasm.NewComponentRef[*testComponent](
+		chasm.EntityKey{
+			NamespaceID: string(tests.NamespaceID),
+			BusinessID:  tv.WorkflowID(),
+			EntityID:    tv.RunID(),
+		},
+	)
+	newActivityID := tv.ActivityID()
+	newAttempt := int32(2)
+
+	s.mockExecutionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).
+		Return(&persistence.GetWorkflowExecutionResponse{
+			State: s.buildPersistenceMutableState(ref.EntityKey, &persistencespb.ActivityInfo{
+				ActivityId: "",
+			}),
+		}, nil).Times(1)
+
+	// Speculative transition is not persisted.
+	_, err := s.engine.UpdateComponent(
+		context.Background(),
+		ref,
+		func(
+			ctx chasm.MutableContext,
+			component chasm.Component,
+		) error {
+			tc, ok := component.(*testComponent)
+			s.True(ok)
+			tc.ActivityInfo.ActivityId = newActivityID
+			return nil
+		},
+		chasm.WithSpeculative(),
+	)
+	s.NoError(err)
+
+	// Speculative changes are visible to subsequent operations.
+	err = s.engine.ReadComponent(
+		context.Background(),
+		ref,
+		func(
+			ctx chasm.Context,
+			component chasm.Component,
+		) error {
+			tc, ok := component.(*testComponent)
+			s.True(ok)
+			s.Equal(newActivityID, tc.ActivityInfo.ActivityId)
+			return nil
+		},
+	)
+	s.NoError(err)
+
+	// Next non-speculative transition persists the speculative changes as well.
+	s.mockExecutionManager.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(
+			_ context.Context,
+			request *persistence.UpdateWorkflowExecutionRequest,
+		) (*persistence.UpdateWorkflowExecutionResponse, error) {
+			updatedNode, ok := request.UpdateWorkflowMutation.UpsertChasmNodes[""]
+			s.True(ok)
+
+			activityInfo := &persistencespb.ActivityInfo{}
+			err := serialization.Proto3Decode(updatedNode.Data.Data, updatedNode.Data.EncodingType, activityInfo)
+			s.NoError(err)
+			s.Equal(newActivityID, activityInfo.ActivityId)
+			s.Equal(newAttempt, activityInfo.Attempt)
+			return tests.UpdateWorkflowExecutionResponse, nil
+		},
+	).Times(1)
+
+	_, err = s.engine.UpdateComponent(
+		context.Background(),
+		ref,
+		func(
+			ctx chasm.MutableContext,
+			component chasm.Component,
+		) error {
+			tc, ok := component.(*testComponent)
+			s.True(ok)
+			tc.ActivityInfo.Attempt = newAttempt
+			return nil
+		},
+	)
+	s.NoError(err)
+}
+
+func (s *chasmEngineSuite) TestUpdateComponent_Speculative_DiscardedOnReload() {
+	tv := testvars.New(s.T())
+	tv = tv.WithRunID(primitives.NewUUID().String())
+
+	ref := chasm.NewComponentRef[*testComponent](
+		chasm.EntityKey{
+			NamespaceID: string(tests.NamespaceID),
+			BusinessID:  tv.WorkflowID(),
+			EntityID:    tv.RunID(),
+		},
+	)
+
+	// The entity is loaded again after the failed transition clears it from the cache.
+	s.mockExecutionManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(
+			_ context.Context,
+			_ *persistence.GetWorkflowExecutionRequest,
+		) (*persistence.GetWorkflowExecutionResponse, error) {
+			return &persistence.GetWorkflowExecutionResponse{
+				State: s.buildPersistenceMutableState(ref.EntityKey, &persistencespb.ActivityInfo{
+					ActivityId: "",
+				}),
+			}, nil
+		}).Times(2)
+
+	_, err := s.engine.UpdateComponent(
+		context.Background(),
+		ref,
+		func(
+			ctx chasm.MutableContext,
+			component chasm.Component,
+		) error {
+			tc, ok := component.(*testComponent)
+			s.True(ok)
+			tc.ActivityInfo.ActivityId = tv.ActivityID()
+			return nil
+		},
+		chasm.WithSpeculative(),
+	)
+	s.NoError(err)
+
+	// A failed transition drops the cached entity along with the speculative changes.
+	updateErr := serviceerror.NewInternal("some random error")
+	_, err = s.engine.UpdateComponent(
+		context.Background(),
+		ref,
+		func(
+			ctx chasm.MutableContext,
+			component chasm.Component,
+		) error {
+			return updateErr
+		},
+	)
+	s.ErrorIs(err, updateErr)
+
+	err = s.engine.ReadComponent(
+		context.Background(),
+		ref,
+		func(
+			ctx chasm.Context,
+			component chasm.Component,
+		) error {
+			tc, ok := component.(*testComponent)
+			s.True(ok)
+			s.Empty(tc.ActivityInfo.ActivityId)
+			return nil
+		},
+	)
+	s.NoError(err)
+}
+
+func (s *chasmEngineSuite) TestNewEntity_Speculative() {
+	tv := testvars.New(s.T())
+
+	ref := chasm.NewComponentRef[*testComponent](
+		chasm.EntityKey{
+			NamespaceID: string(tests.NamespaceID),
+			BusinessID:  tv.WorkflowID(),
+		},
+	)
+
+	_, err := s.engine.NewEntity(
+		context.Background(),
+		ref,
+		s.newTestComponentFn(tv.ActivityID()),
+		chasm.WithSpeculative(),
+	)
+	var invalidArgumentErr *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgumentErr)
+}
+
 func (s *chasmEngineSuite) TestReadComponent_Success() {
 	tv := testvars.New(s.T())
 	tv = tv.WithRunID(primitives.NewUUID().String())
@@ -218,6 +715,34 @@ func (s *chasmEngineSuite) TestReadComponent_Success() {
 	s.NoError(err)
 }
 
+func (s *chasmEngineSuite) newTestComponentFn(
+	activityID string,
+) func(chasm.MutableContext) (chasm.Component, error) {
+	return func(_ chasm.MutableContext) (chasm.Component, error) {
+		return &testComponent{
+			ActivityInfo: &persistencespb.ActivityInfo{
+				ActivityId: activityID,
+			},
+		}, nil
+	}
+}
+
+func (s *chasmEngineSuite) validateNewEntitySnapshot(
+	snapshot *persistence.WorkflowSnapshot,
+	expectedActivityID string,
+) {
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, snapshot.ExecutionState.Status)
+
+	rootNode, ok := snapshot.ChasmNodes[""]
+	s.True(ok)
+	s.Equal("TestLibrary.test_component", rootNode.GetMetadata().GetComponentAttributes().GetType())
+
+	activityInfo := &persistencespb.ActivityInfo{}
+	err := serialization.Proto3Decode(rootNode.Data.Data, rootNode.Data.EncodingType, activityInfo)
+	s.NoError(err)
+	s.Equal(expectedActivityID, activityInfo.ActivityId)
+}
+
 func (s *chasmEngineSuite) buildPersistenceMutableState(
 	key chasm.EntityKey,
 	componentState proto.Message,
@@ -27,6 +27,7 @@ import (
 	"go.temporal.io/server/components/callbacks"
 	"go.temporal.io/server/components/nexusoperations"
 	nexusworkflow "go.temporal.io/server/components/nexusoperations/workflow"
+	"go.temporal.io/server/components/scheduler"
 	"go.temporal.io/server/service"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/archival"
@@ -75,6 +76,7 @@ var Module = fx.Options(
 
 	callbacks.Module,
 	nexusoperations.Module,
+	scheduler.Module,
 	fx.Invoke(nexusworkflow.RegisterCommandHandlers),
 )
 
@@ -8,6 +8,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	commonpb "go.temporal.io/api/common/v1"
 	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/serviceerror"
 	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
@@ -33,6 +34,7 @@ import (
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/tasktoken"
 	"go.temporal.io/server/common/testing/testhooks"
+	"go.temporal.io/server/components/scheduler"
 	"go.temporal.io/server/service/history/api"
 	"go.temporal.io/server/service/history/api/addtasks"
 	"go.temporal.io/server/service/history/api/deleteworkflow"
@@ -45,6 +47,9 @@ import (
 	"go.temporal.io/server/service/history/api/isactivitytaskvalid"
 	"go.temporal.io/server/service/history/api/isworkflowtaskvalid"
 	"go.temporal.io/server/service/history/api/listtasks"
+	"go.temporal.io/server/service/history/api/migrateschedule"
+	"go.temporal.io/server/service/history/api/modifyactivityproperties"
+	"go.temporal.io/server/service/history/api/modifyworkflowproperties"
 	"go.temporal.io/server/service/history/api/multioperation"
 	"go.temporal.io/server/service/history/api/pauseactivity"
 	"go.temporal.io/server/service/history/api/pollupdate"
@@ -135,6 +140,7 @@ type (
 		replicationProgressCache   replication.ProgressCache
 		syncStateRetriever         replication.SyncStateRetriever
 		outboundQueueCBPool        *circuitbreakerpool.OutboundQueueCircuitBreakerPool
+		scheduleMigrator           *scheduler.Migrator
 		testHooks                  testhooks.TestHooks
 	}
 )
@@ -162,6 +168,7 @@ func NewEngineWithShardContext(
 	dlqWriter replication.DLQWriter,
 	commandHandlerRegistry *workflow.CommandHandlerRegistry,
 	outboundQueueCBPool *circuitbreakerpool.OutboundQueueCircuitBreakerPool,
+	scheduleMigrator *scheduler.Migrator,
 	testHooks testhooks.TestHooks,
 ) historyi.Engine {
 	currentClusterName := shard.GetClusterMetadata().GetCurrentClusterName()
@@ -212,6 +219,7 @@ func NewEngineWithShardContext(
 		replicationProgressCache:   replicationProgressCache,
 		syncStateRetriever:         syncStateRetriever,
 		outboundQueueCBPool:        outboundQueueCBPool,
+		scheduleMigrator:           scheduleMigrator,
 		testHooks:                  testHooks,
 	}
 
@@ -223,6 +231,11 @@ func NewEngineWithShardContext(
 
 	historyEngImpl.eventsReapplier = ndc.NewEventsReapplier(shard.StateMachineRegistry(), shard.GetMetricsHandler(), logger)
 
+	historyEngImpl.nDCHistoryImporter = ndc.NewHistoryImporter(
+		shard,
+		workflowCache,
+		logger,
+	)
 	if shard.GetClusterMetadata().IsGlobalNamespaceEnabled() {
 		historyEngImpl.replicationAckMgr = replication.NewAckManager(
 			shard,
@@ -240,11 +253,6 @@ func NewEngineWithShardContext(
 			eventSerializer,
 			logger,
 		)
-		historyEngImpl.nDCHistoryImporter = ndc.NewHistoryImporter(
-			shard,
-			workflowCache,
-			logger,
-		)
 		historyEngImpl.nDCActivityStateReplicator = ndc.NewActivityStateReplicator(
 			shard,
 			workflowCache,
@@ -769,6 +777,24 @@ func (e *historyEngineImpl) ImportWorkflowExecution(
 	ctx context.Context,
 	request *historyservice.ImportWorkflowExecutionRequest,
 ) (*historyservice.ImportWorkflowExecutionResponse, error) {
+	namespaceEntry, err := e.shardContext.GetNamespaceRegistry().GetNamespaceByID(namespace.ID(request.NamespaceId))
+	if err != nil {
+		return nil, err
+	}
+	if !namespaceEntry.IsGlobalNamespace() {
+		// Events of local namespaces have empty version. Events written after the import would
+		// inherit the version of the imported events, which a local namespace can't resolve
+		// to a cluster.
+		for _, item := range request.VersionHistory.GetItems() {
+			if item.GetVersion() != common.EmptyVersion {
+				return nil, serviceerror.NewInvalidArgumentf(
+					"namespace %s is a local namespace and can only import history with empty event versions, got version %d",
+					namespaceEntry.Name(),
+					item.GetVersion(),
+				)
+			}
+		}
+	}
 	historyEvents, err := ndc.DeserializeBlobs(e.eventSerializer, request.HistoryBatches)
 	if err != nil {
 		return nil, err
@@ -1080,3 +1106,24 @@ func (e *historyEngineImpl) ResetActivity(
 ) (*historyservice.ResetActivityResponse, error) {
 	return resetactivity.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
 }
+
+func (e *historyEngineImpl) ModifyWorkflowProperties(
+	ctx context.Context,
+	request *historyservice.ModifyWorkflowPropertiesRequest,
+) (*historyservice.ModifyWorkflowPropertiesResponse, error) {
+	return modifyworkflowproperties.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
+}
+
+func (e *historyEngineImpl) ModifyActivityProperties(
+	ctx context.Context,
+	request *historyservice.ModifyActivityPropertiesRequest,
+) (*historyservice.ModifyActivityPropertiesResponse, error) {
+	return modifyactivityproperties.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker)
+}
+
+func (e *historyEngineImpl) MigrateSchedule(
+	ctx context.Context,
+	request *historyservice.MigrateScheduleRequest,
+) (*historyservice.MigrateScheduleResponse, error) {
+	return migrateschedule.Invoke(ctx, request, e.shardContext, e.workflowConsistencyChecker, e.scheduleMigrator)
+}
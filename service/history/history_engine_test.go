@@ -46,6 +46,7 @@ import (
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/serialization"
 	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/persistence/visibility/manager"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -5123,6 +5124,121 @@ func (s *engineSuite) TestRemoveSignalMutableState() {
 	s.Nil(err)
 }
 
+func (s *engineSuite) TestImportWorkflowExecution_LocalNamespace() {
+	s.historyEngine.eventSerializer = serialization.NewSerializer()
+	s.historyEngine.nDCHistoryImporter = ndc.NewHistoryImporter(s.mockShard, s.workflowCache, s.mockShard.GetLogger())
+
+	// history as exported by tdbg from a local namespace: events have empty version
+	startTime := timestamppb.New(time.Now().Add(-time.Minute))
+	historyEvents := []*historypb.HistoryEvent{
+		{
+			EventId:   1,
+			EventTime: startTime,
+			Version:   common.EmptyVersion,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+			TaskId:    1,
+			Attributes: &historypb.HistoryEvent_WorkflowExecutionStartedEventAttributes{
+				WorkflowExecutionStartedEventAttributes: &historypb.WorkflowExecutionStartedEventAttributes{
+					WorkflowType:             &commonpb.WorkflowType{Name: "wType"},
+					TaskQueue:                &taskqueuepb.TaskQueue{Name: "testTaskQueue", Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+					WorkflowExecutionTimeout: durationpb.New(time.Hour),
+					WorkflowRunTimeout:       durationpb.New(time.Hour),
+					WorkflowTaskTimeout:      durationpb.New(10 * time.Second),
+					OriginalExecutionRunId:   tests.RunID,
+					FirstExecutionRunId:      tests.RunID,
+					Attempt:                  1,
+				},
+			},
+		},
+		{
+			EventId:   2,
+			EventTime: startTime,
+			Version:   common.EmptyVersion,
+			EventType: enumspb.EVENT_TYPE_WORKFLOW_TASK_SCHEDULED,
+			TaskId:    2,
+			Attributes: &historypb.HistoryEvent_WorkflowTaskScheduledEventAttributes{
+				WorkflowTaskScheduledEventAttributes: &historypb.WorkflowTaskScheduledEventAttributes{
+					TaskQueue:           &taskqueuepb.TaskQueue{Name: "testTaskQueue", Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+					StartToCloseTimeout: durationpb.New(10 * time.Second),
+					Attempt:             1,
+				},
+			},
+		},
+	}
+	blob, err := serialization.NewSerializer().SerializeEvents(historyEvents, enumspb.ENCODING_TYPE_PROTO3)
+	s.NoError(err)
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: tests.WorkflowID,
+		RunId:      tests.RunID,
+	}
+	versionHistory := versionhistory.NewVersionHistory(nil, []*historyspb.VersionHistoryItem{
+		versionhistory.NewVersionHistoryItem(2, common.EmptyVersion),
+	})
+
+	// imported events are handled like replicated ones, which always resolve their source cluster as global
+	s.mockClusterMetadata.EXPECT().ClusterNameForFailoverVersion(true, common.EmptyVersion).Return("unknown-cluster-0").AnyTimes()
+	s.mockExecutionMgr.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound(""))
+	s.mockExecutionMgr.EXPECT().AppendHistoryNodes(gomock.Any(), gomock.Any()).Return(&persistence.AppendHistoryNodesResponse{Size: len(blob.Data)}, nil)
+	resp, err := s.historyEngine.ImportWorkflowExecution(context.Background(), &historyservice.ImportWorkflowExecutionRequest{
+		NamespaceId:    tests.NamespaceID.String(),
+		Execution:      execution,
+		HistoryBatches: []*commonpb.DataBlob{blob},
+		VersionHistory: versionHistory,
+	})
+	s.NoError(err)
+	s.True(resp.EventsApplied)
+	s.NotEmpty(resp.Token)
+
+	// an import is committed with an empty history
+	s.mockExecutionMgr.EXPECT().GetCurrentExecution(gomock.Any(), gomock.Any()).Return(nil, serviceerror.NewNotFound(""))
+	var createRequest *persistence.CreateWorkflowExecutionRequest
+	s.mockExecutionMgr.EXPECT().CreateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *persistence.CreateWorkflowExecutionRequest) (*persistence.CreateWorkflowExecutionResponse, error) {
+			createRequest = request
+			return tests.CreateWorkflowExecutionResponse, nil
+		})
+	_, err = s.historyEngine.ImportWorkflowExecution(context.Background(), &historyservice.ImportWorkflowExecutionRequest{
+		NamespaceId:    tests.NamespaceID.String(),
+		Execution:      execution,
+		VersionHistory: versionHistory,
+		Token:          resp.Token,
+	})
+	s.NoError(err)
+
+	s.Equal(persistence.CreateWorkflowModeBrandNew, createRequest.Mode)
+	snapshot := createRequest.NewWorkflowSnapshot
+	s.Equal(tests.WorkflowID, snapshot.ExecutionInfo.WorkflowId)
+	s.Equal(tests.RunID, snapshot.ExecutionState.RunId)
+	s.Equal(enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING, snapshot.ExecutionState.State)
+	s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING, snapshot.ExecutionState.Status)
+	s.Equal(int64(3), snapshot.NextEventID)
+	s.Equal(int64(2), snapshot.ExecutionInfo.WorkflowTaskScheduledEventId)
+	s.Equal("wType", snapshot.ExecutionInfo.WorkflowTypeName)
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(snapshot.ExecutionInfo.VersionHistories)
+	s.NoError(err)
+	s.ProtoEqual(versionHistory.Items[0], currentVersionHistory.Items[0])
+	s.Len(currentVersionHistory.Items, 1)
+	// the refreshed tasks include the transfer task of the scheduled workflow task
+	s.NotEmpty(snapshot.Tasks[tasks.CategoryTransfer])
+}
+
+func (s *engineSuite) TestImportWorkflowExecution_LocalNamespace_NonEmptyVersion() {
+	_, err := s.historyEngine.ImportWorkflowExecution(context.Background(), &historyservice.ImportWorkflowExecutionRequest{
+		NamespaceId: tests.NamespaceID.String(),
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: tests.WorkflowID,
+			RunId:      tests.RunID,
+		},
+		VersionHistory: versionhistory.NewVersionHistory(nil, []*historyspb.VersionHistoryItem{
+			versionhistory.NewVersionHistoryItem(3, common.EmptyVersion),
+			versionhistory.NewVersionHistoryItem(5, 1),
+		}),
+	})
+	var invalidArgument *serviceerror.InvalidArgument
+	s.ErrorAs(err, &invalidArgument)
+	s.ErrorContains(err, "local namespace")
+}
+
 func (s *engineSuite) TestReapplyEvents_ReturnSuccess() {
 	workflowExecution := commonpb.WorkflowExecution{
 		WorkflowId: "test-reapply",
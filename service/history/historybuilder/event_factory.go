@@ -712,6 +712,32 @@ func (b *EventFactory) CreateWorkflowPropertiesModifiedEvent(
 	return event
 }
 
+func (b *EventFactory) CreateWorkflowPropertiesModifiedExternallyEvent(
+	attributes *historypb.WorkflowPropertiesModifiedExternallyEventAttributes,
+) *historypb.HistoryEvent {
+	event := b.createHistoryEvent(enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY, b.timeSource.Now())
+	event.Attributes = &historypb.HistoryEvent_WorkflowPropertiesModifiedExternallyEventAttributes{
+		WorkflowPropertiesModifiedExternallyEventAttributes: attributes,
+	}
+	event.WorkerMayIgnore = true
+	return event
+}
+
+func (b *EventFactory) CreateActivityPropertiesModifiedExternallyEvent(
+	scheduledEventID int64,
+	newRetryPolicy *commonpb.RetryPolicy,
+) *historypb.HistoryEvent {
+	event := b.createHistoryEvent(enumspb.EVENT_TYPE_ACTIVITY_PROPERTIES_MODIFIED_EXTERNALLY, b.timeSource.Now())
+	event.Attributes = &historypb.HistoryEvent_ActivityPropertiesModifiedExternallyEventAttributes{
+		ActivityPropertiesModifiedExternallyEventAttributes: &historypb.ActivityPropertiesModifiedExternallyEventAttributes{
+			ScheduledEventId: scheduledEventID,
+			NewRetryPolicy:   newRetryPolicy,
+		},
+	}
+	event.WorkerMayIgnore = true
+	return event
+}
+
 func (b *EventFactory) CreateSignalExternalWorkflowExecutionFailedEvent(
 	workflowTaskCompletedEventID int64,
 	initiatedEventID int64,
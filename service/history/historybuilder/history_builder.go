@@ -617,6 +617,23 @@ func (b *HistoryBuilder) AddWorkflowPropertiesModifiedEvent(
 	return event
 }
 
+func (b *HistoryBuilder) AddWorkflowPropertiesModifiedExternallyEvent(
+	attributes *historypb.WorkflowPropertiesModifiedExternallyEventAttributes,
+) *historypb.HistoryEvent {
+	event := b.EventFactory.CreateWorkflowPropertiesModifiedExternallyEvent(attributes)
+	event, _ = b.EventStore.add(event)
+	return event
+}
+
+func (b *HistoryBuilder) AddActivityPropertiesModifiedExternallyEvent(
+	scheduledEventID int64,
+	newRetryPolicy *commonpb.RetryPolicy,
+) *historypb.HistoryEvent {
+	event := b.EventFactory.CreateActivityPropertiesModifiedExternallyEvent(scheduledEventID, newRetryPolicy)
+	event, _ = b.EventStore.add(event)
+	return event
+}
+
 func (b *HistoryBuilder) AddSignalExternalWorkflowExecutionFailedEvent(
 	workflowTaskCompletedEventID int64,
 	initiatedEventID int64,
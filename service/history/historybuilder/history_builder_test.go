@@ -432,6 +432,29 @@ func (s *historyBuilderSuite) TestWorkflowExecutionMemo() {
 	}, event)
 }
 
+func (s *historyBuilderSuite) TestWorkflowPropertiesModifiedExternally() {
+	attributes := &historypb.WorkflowPropertiesModifiedExternallyEventAttributes{
+		NewTaskQueue:                "random task queue",
+		NewWorkflowTaskTimeout:      durationpb.New(time.Duration(rand.Int63())),
+		NewWorkflowRunTimeout:       durationpb.New(time.Duration(rand.Int63())),
+		NewWorkflowExecutionTimeout: durationpb.New(time.Duration(rand.Int63())),
+		UpsertedMemo:                testMemo,
+	}
+	event := s.historyBuilder.AddWorkflowPropertiesModifiedExternallyEvent(attributes)
+	s.Equal(event, s.flush())
+	s.Equal(&historypb.HistoryEvent{
+		EventId:   common.BufferedEventID,
+		TaskId:    common.EmptyEventTaskID,
+		EventTime: timestamppb.New(s.now),
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY,
+		Version:   s.version,
+		Attributes: &historypb.HistoryEvent_WorkflowPropertiesModifiedExternallyEventAttributes{
+			WorkflowPropertiesModifiedExternallyEventAttributes: attributes,
+		},
+		WorkerMayIgnore: true,
+	}, event)
+}
+
 func (s *historyBuilderSuite) TestWorkflowExecutionCompleted() {
 	workflowTaskCompletionEventID := rand.Int63()
 	attributes := &commandpb.CompleteWorkflowExecutionCommandAttributes{
@@ -889,6 +912,29 @@ func (s *historyBuilderSuite) TestActivityTaskCancelRequested() {
 	}, event)
 }
 
+func (s *historyBuilderSuite) TestActivityPropertiesModifiedExternally() {
+	scheduledEventID := rand.Int63()
+	event := s.historyBuilder.AddActivityPropertiesModifiedExternallyEvent(
+		scheduledEventID,
+		testRetryPolicy,
+	)
+	s.Equal(event, s.flush())
+	s.Equal(&historypb.HistoryEvent{
+		EventId:   common.BufferedEventID,
+		TaskId:    common.EmptyEventTaskID,
+		EventTime: timestamppb.New(s.now),
+		EventType: enumspb.EVENT_TYPE_ACTIVITY_PROPERTIES_MODIFIED_EXTERNALLY,
+		Version:   s.version,
+		Attributes: &historypb.HistoryEvent_ActivityPropertiesModifiedExternallyEventAttributes{
+			ActivityPropertiesModifiedExternallyEventAttributes: &historypb.ActivityPropertiesModifiedExternallyEventAttributes{
+				ScheduledEventId: scheduledEventID,
+				NewRetryPolicy:   testRetryPolicy,
+			},
+		},
+		WorkerMayIgnore: true,
+	}, event)
+}
+
 func (s *historyBuilderSuite) TestActivityTaskCompleted() {
 	scheduledEventID := rand.Int63()
 	startedEventID := rand.Int63()
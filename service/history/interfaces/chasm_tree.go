@@ -19,6 +19,7 @@ type ChasmTree interface {
 	ApplyMutation(chasm.NodesMutation) error
 	ApplySnapshot(chasm.NodesSnapshot) error
 	IsDirty() bool
+	IsSpeculative() bool
 	Terminate(chasm.TerminateComponentRequest) error
 	Archetype() string
 	EachPureTask(
@@ -142,6 +142,20 @@ func (mr *MockChasmTreeMockRecorder) IsDirty() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsDirty", reflect.TypeOf((*MockChasmTree)(nil).IsDirty))
 }
 
+// IsSpeculative mocks base method.
+func (m *MockChasmTree) IsSpeculative() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsSpeculative")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// IsSpeculative indicates an expected call of IsSpeculative.
+func (mr *MockChasmTreeMockRecorder) IsSpeculative() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsSpeculative", reflect.TypeOf((*MockChasmTree)(nil).IsSpeculative))
+}
+
 // IsStale mocks base method.
 func (m *MockChasmTree) IsStale(arg0 chasm.ComponentRef) error {
 	m.ctrl.T.Helper()
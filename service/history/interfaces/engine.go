@@ -95,6 +95,9 @@ type (
 		PauseActivity(ctx context.Context, request *historyservice.PauseActivityRequest) (*historyservice.PauseActivityResponse, error)
 		UnpauseActivity(ctx context.Context, request *historyservice.UnpauseActivityRequest) (*historyservice.UnpauseActivityResponse, error)
 		ResetActivity(ctx context.Context, request *historyservice.ResetActivityRequest) (*historyservice.ResetActivityResponse, error)
+		ModifyWorkflowProperties(ctx context.Context, request *historyservice.ModifyWorkflowPropertiesRequest) (*historyservice.ModifyWorkflowPropertiesResponse, error)
+		ModifyActivityProperties(ctx context.Context, request *historyservice.ModifyActivityPropertiesRequest) (*historyservice.ModifyActivityPropertiesResponse, error)
+		MigrateSchedule(ctx context.Context, request *historyservice.MigrateScheduleRequest) (*historyservice.MigrateScheduleResponse, error)
 
 		NotifyNewHistoryEvent(event *events.Notification)
 		NotifyNewTasks(tasks map[tasks.Category][]tasks.Task)
@@ -413,6 +413,51 @@ func (mr *MockEngineMockRecorder) MergeDLQMessages(ctx, messagesRequest any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MergeDLQMessages", reflect.TypeOf((*MockEngine)(nil).MergeDLQMessages), ctx, messagesRequest)
 }
 
+// MigrateSchedule mocks base method.
+func (m *MockEngine) MigrateSchedule(ctx context.Context, request *historyservice.MigrateScheduleRequest) (*historyservice.MigrateScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateSchedule", ctx, request)
+	ret0, _ := ret[0].(*historyservice.MigrateScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateSchedule indicates an expected call of MigrateSchedule.
+func (mr *MockEngineMockRecorder) MigrateSchedule(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSchedule", reflect.TypeOf((*MockEngine)(nil).MigrateSchedule), ctx, request)
+}
+
+// ModifyActivityProperties mocks base method.
+func (m *MockEngine) ModifyActivityProperties(ctx context.Context, request *historyservice.ModifyActivityPropertiesRequest) (*historyservice.ModifyActivityPropertiesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyActivityProperties", ctx, request)
+	ret0, _ := ret[0].(*historyservice.ModifyActivityPropertiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyActivityProperties indicates an expected call of ModifyActivityProperties.
+func (mr *MockEngineMockRecorder) ModifyActivityProperties(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyActivityProperties", reflect.TypeOf((*MockEngine)(nil).ModifyActivityProperties), ctx, request)
+}
+
+// ModifyWorkflowProperties mocks base method.
+func (m *MockEngine) ModifyWorkflowProperties(ctx context.Context, request *historyservice.ModifyWorkflowPropertiesRequest) (*historyservice.ModifyWorkflowPropertiesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyWorkflowProperties", ctx, request)
+	ret0, _ := ret[0].(*historyservice.ModifyWorkflowPropertiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyWorkflowProperties indicates an expected call of ModifyWorkflowProperties.
+func (mr *MockEngineMockRecorder) ModifyWorkflowProperties(ctx, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyWorkflowProperties", reflect.TypeOf((*MockEngine)(nil).ModifyWorkflowProperties), ctx, request)
+}
+
 // NotifyNewHistoryEvent mocks base method.
 func (m *MockEngine) NotifyNewHistoryEvent(event *events.Notification) {
 	m.ctrl.T.Helper()
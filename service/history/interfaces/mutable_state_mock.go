@@ -67,6 +67,21 @@ func (m *MockMutableState) EXPECT() *MockMutableStateMockRecorder {
 	return m.recorder
 }
 
+// AddActivityPropertiesModifiedExternallyEvent mocks base method.
+func (m *MockMutableState) AddActivityPropertiesModifiedExternallyEvent(arg0 int64, arg1 *common.RetryPolicy) (*history.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddActivityPropertiesModifiedExternallyEvent", arg0, arg1)
+	ret0, _ := ret[0].(*history.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddActivityPropertiesModifiedExternallyEvent indicates an expected call of AddActivityPropertiesModifiedExternallyEvent.
+func (mr *MockMutableStateMockRecorder) AddActivityPropertiesModifiedExternallyEvent(arg0, arg1 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddActivityPropertiesModifiedExternallyEvent", reflect.TypeOf((*MockMutableState)(nil).AddActivityPropertiesModifiedExternallyEvent), arg0, arg1)
+}
+
 // AddActivityTaskCancelRequestedEvent mocks base method.
 func (m *MockMutableState) AddActivityTaskCancelRequestedEvent(arg0, arg1 int64, arg2 string) (*history.HistoryEvent, *persistence.ActivityInfo, error) {
 	m.ctrl.T.Helper()
@@ -785,6 +800,21 @@ func (mr *MockMutableStateMockRecorder) AddWorkflowPropertiesModifiedEvent(arg0,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowPropertiesModifiedEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowPropertiesModifiedEvent), arg0, arg1)
 }
 
+// AddWorkflowPropertiesModifiedExternallyEvent mocks base method.
+func (m *MockMutableState) AddWorkflowPropertiesModifiedExternallyEvent(ctx context.Context, attributes *history.WorkflowPropertiesModifiedExternallyEventAttributes) (*history.HistoryEvent, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddWorkflowPropertiesModifiedExternallyEvent", ctx, attributes)
+	ret0, _ := ret[0].(*history.HistoryEvent)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddWorkflowPropertiesModifiedExternallyEvent indicates an expected call of AddWorkflowPropertiesModifiedExternallyEvent.
+func (mr *MockMutableStateMockRecorder) AddWorkflowPropertiesModifiedExternallyEvent(ctx, attributes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowPropertiesModifiedExternallyEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowPropertiesModifiedExternallyEvent), ctx, attributes)
+}
+
 // AddWorkflowTaskCompletedEvent mocks base method.
 func (m *MockMutableState) AddWorkflowTaskCompletedEvent(arg0 *WorkflowTaskInfo, arg1 *workflowservice.RespondWorkflowTaskCompletedRequest, arg2 WorkflowTaskCompletionLimits) (*history.HistoryEvent, error) {
 	m.ctrl.T.Helper()
@@ -891,6 +921,20 @@ func (mr *MockMutableStateMockRecorder) AddWorkflowTaskTimedOutEvent(workflowTas
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddWorkflowTaskTimedOutEvent", reflect.TypeOf((*MockMutableState)(nil).AddWorkflowTaskTimedOutEvent), workflowTask)
 }
 
+// ApplyActivityPropertiesModifiedExternallyEvent mocks base method.
+func (m *MockMutableState) ApplyActivityPropertiesModifiedExternallyEvent(arg0 *history.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyActivityPropertiesModifiedExternallyEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyActivityPropertiesModifiedExternallyEvent indicates an expected call of ApplyActivityPropertiesModifiedExternallyEvent.
+func (mr *MockMutableStateMockRecorder) ApplyActivityPropertiesModifiedExternallyEvent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyActivityPropertiesModifiedExternallyEvent", reflect.TypeOf((*MockMutableState)(nil).ApplyActivityPropertiesModifiedExternallyEvent), arg0)
+}
+
 // ApplyActivityTaskCancelRequestedEvent mocks base method.
 func (m *MockMutableState) ApplyActivityTaskCancelRequestedEvent(arg0 *history.HistoryEvent) error {
 	m.ctrl.T.Helper()
@@ -1495,6 +1539,20 @@ func (mr *MockMutableStateMockRecorder) ApplyWorkflowPropertiesModifiedEvent(arg
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyWorkflowPropertiesModifiedEvent", reflect.TypeOf((*MockMutableState)(nil).ApplyWorkflowPropertiesModifiedEvent), arg0)
 }
 
+// ApplyWorkflowPropertiesModifiedExternallyEvent mocks base method.
+func (m *MockMutableState) ApplyWorkflowPropertiesModifiedExternallyEvent(arg0 *history.HistoryEvent) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyWorkflowPropertiesModifiedExternallyEvent", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyWorkflowPropertiesModifiedExternallyEvent indicates an expected call of ApplyWorkflowPropertiesModifiedExternallyEvent.
+func (mr *MockMutableStateMockRecorder) ApplyWorkflowPropertiesModifiedExternallyEvent(arg0 any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyWorkflowPropertiesModifiedExternallyEvent", reflect.TypeOf((*MockMutableState)(nil).ApplyWorkflowPropertiesModifiedExternallyEvent), arg0)
+}
+
 // ApplyWorkflowTaskCompletedEvent mocks base method.
 func (m *MockMutableState) ApplyWorkflowTaskCompletedEvent(arg0 *history.HistoryEvent) error {
 	m.ctrl.T.Helper()
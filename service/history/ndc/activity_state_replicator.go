@@ -136,6 +136,7 @@ func (r *ActivityStateReplicatorImpl) SyncActivityState(
 			RetryMaximumInterval:       request.RetryMaximumInterval,
 			RetryMaximumAttempts:       request.RetryMaximumAttempts,
 			RetryBackoffCoefficient:    request.RetryBackoffCoefficient,
+			PauseInfo:                  request.PauseInfo,
 		},
 	)
 	if err != nil {
@@ -133,6 +133,7 @@ type (
 		activityTaskScheduleToStartTimeout time.Duration
 		versionDirective                   *taskqueuespb.TaskVersionDirective
 		priority                           *commonpb.Priority
+		fairnessKey                        string
 	}
 
 	verifyCompletionRecordedPostActionInfo struct {
@@ -144,6 +145,7 @@ type (
 		taskqueue                          *taskqueuepb.TaskQueue
 		versionDirective                   *taskqueuespb.TaskVersionDirective
 		priority                           *commonpb.Priority
+		fairnessKey                        string
 	}
 )
 
@@ -158,6 +160,7 @@ func newExecutionTimerPostActionInfo(
 func newActivityTaskPostActionInfo(
 	mutableState historyi.MutableState,
 	activityInfo *persistencespb.ActivityInfo,
+	fairnessKey string,
 ) (*activityTaskPostActionInfo, error) {
 	directive := MakeDirectiveForActivityTask(mutableState, activityInfo)
 	priority := priorities.Merge(mutableState.GetExecutionInfo().Priority, activityInfo.Priority)
@@ -166,6 +169,7 @@ func newActivityTaskPostActionInfo(
 		activityTaskScheduleToStartTimeout: activityInfo.ScheduleToStartTimeout.AsDuration(),
 		versionDirective:                   directive,
 		priority:                           priority,
+		fairnessKey:                        fairnessKey,
 	}, nil
 }
 
@@ -174,6 +178,7 @@ func newActivityRetryTimePostActionInfo(
 	taskQueue string,
 	activityScheduleToStartTimeout time.Duration,
 	activityInfo *persistencespb.ActivityInfo,
+	fairnessKey string,
 ) (*activityTaskPostActionInfo, error) {
 	directive := MakeDirectiveForActivityTask(mutableState, activityInfo)
 	priority := priorities.Merge(mutableState.GetExecutionInfo().Priority, activityInfo.Priority)
@@ -183,6 +188,7 @@ func newActivityRetryTimePostActionInfo(
 		activityTaskScheduleToStartTimeout: activityScheduleToStartTimeout,
 		versionDirective:                   directive,
 		priority:                           priority,
+		fairnessKey:                        fairnessKey,
 	}, nil
 }
 
@@ -190,6 +196,7 @@ func newWorkflowTaskPostActionInfo(
 	mutableState historyi.MutableState,
 	workflowTaskScheduleToStartTimeout time.Duration,
 	taskqueue *taskqueuepb.TaskQueue,
+	fairnessKey string,
 ) (*workflowTaskPostActionInfo, error) {
 	directive := MakeDirectiveForWorkflowTask(mutableState)
 	priority := mutableState.GetExecutionInfo().Priority
@@ -199,6 +206,7 @@ func newWorkflowTaskPostActionInfo(
 		taskqueue:                          taskqueue,
 		versionDirective:                   directive,
 		priority:                           priority,
+		fairnessKey:                        fairnessKey,
 	}, nil
 }
 
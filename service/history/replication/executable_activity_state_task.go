@@ -89,6 +89,7 @@ func NewExecutableActivityStateTask(
 			RetryMaximumInterval:       task.RetryMaximumInterval,
 			RetryMaximumAttempts:       task.RetryMaximumAttempts,
 			RetryBackoffCoefficient:    task.RetryBackoffCoefficient,
+			PauseInfo:                  task.PauseInfo,
 		},
 
 		batchable: true,
@@ -114,6 +115,7 @@ func NewExecutableActivityStateTask(
 			RetryMaximumInterval:       task.RetryMaximumInterval,
 			RetryMaximumAttempts:       task.RetryMaximumAttempts,
 			RetryBackoffCoefficient:    task.RetryBackoffCoefficient,
+			PauseInfo:                  task.PauseInfo,
 		}),
 	}
 }
@@ -33,6 +33,31 @@ type (
 
 		taskAttr *replicationspb.VerifyVersionedTransitionTaskAttributes
 	}
+
+	VersionedTransitionStatus int
+
+	// VerifyVersionedTransitionResult is the result of VerifyVersionedTransition. The event range
+	// is set if the status is VersionedTransitionEventsMissing.
+	VerifyVersionedTransitionResult struct {
+		Status            VersionedTransitionStatus
+		StartEventID      int64
+		StartEventVersion int64
+		EndEventID        int64
+		EndEventVersion   int64
+	}
+)
+
+const (
+	// VersionedTransitionUnverifiable means there is no versioned transition or event to verify.
+	VersionedTransitionUnverifiable VersionedTransitionStatus = iota
+	// VersionedTransitionUpToDate means the mutable state has the versioned transition and its events.
+	VersionedTransitionUpToDate
+	// VersionedTransitionStateMissing means the mutable state is behind the versioned transition.
+	VersionedTransitionStateMissing
+	// VersionedTransitionEventsMissing means the mutable state is missing events on a non-current branch.
+	VersionedTransitionEventsMissing
+	// VersionedTransitionEventsLost means the mutable state has the versioned transition but not its events.
+	VersionedTransitionEventsLost
 )
 
 var _ ctasks.Task = (*ExecutableVerifyVersionedTransitionTask)(nil)
@@ -116,89 +141,130 @@ func (e *ExecutableVerifyVersionedTransitionTask) Execute() error {
 		}
 	}
 
-	transitionHistory := ms.GetExecutionInfo().TransitionHistory
-	if len(transitionHistory) == 0 {
-		return nil
+	result, err := VerifyVersionedTransition(
+		ms,
+		e.ReplicationTask().VersionedTransition,
+		e.taskAttr.NextEventId,
+		e.taskAttr.EventVersionHistory,
+	)
+	if err != nil {
+		return err
 	}
-	err = transitionhistory.StalenessCheck(transitionHistory, e.ReplicationTask().VersionedTransition)
 
-	// case 1: VersionedTransition is up-to-date on current mutable state
-	if err == nil {
-		if ms.GetNextEventId() < e.taskAttr.NextEventId {
-			return serviceerror.NewDataLossf("Workflow event missed. NamespaceId: %v, workflowId: %v, runId: %v, expected last eventId: %v, versionedTransition: %v",
-				e.NamespaceID, e.WorkflowID, e.RunID, e.taskAttr.NextEventId-1, e.ReplicationTask().VersionedTransition)
-		}
+	switch result.Status {
+	case VersionedTransitionUpToDate:
 		return e.verifyNewRunExist(ctx)
-	}
-
-	// case 2: verify task has newer VersionedTransition, need to sync state
-	if transitionhistory.Compare(e.ReplicationTask().VersionedTransition, transitionhistory.LastVersionedTransition(transitionHistory)) > 0 {
+	case VersionedTransitionEventsLost:
+		return serviceerror.NewDataLossf("Workflow event missed. NamespaceId: %v, workflowId: %v, runId: %v, expected last eventId: %v, versionedTransition: %v",
+			e.NamespaceID, e.WorkflowID, e.RunID, e.taskAttr.NextEventId-1, e.ReplicationTask().VersionedTransition)
+	case VersionedTransitionStateMissing:
 		return serviceerrors.NewSyncState(
 			"mutable state not up to date",
 			e.NamespaceID,
 			e.WorkflowID,
 			e.RunID,
-			transitionhistory.LastVersionedTransition(transitionHistory),
+			transitionhistory.LastVersionedTransition(ms.GetExecutionInfo().TransitionHistory),
 			ms.GetExecutionInfo().VersionHistories,
 		)
+	case VersionedTransitionEventsMissing:
+		return e.BackFillEvents(
+			ctx,
+			e.ExecutableTask.SourceClusterName(),
+			e.WorkflowKey,
+			result.StartEventID,
+			result.StartEventVersion,
+			result.EndEventID,
+			result.EndEventVersion,
+			e.taskAttr.NewRunId,
+		)
+	default:
+		return nil
 	}
-	// case 3: state transition is on non-current branch, but no event to verify
-	if e.taskAttr.NextEventId == common2.EmptyEventID {
-		return e.verifyNewRunExist(ctx)
+}
+
+func (e *ExecutableVerifyVersionedTransitionTask) verifyNewRunExist(ctx context.Context) error {
+	if len(e.taskAttr.NewRunId) == 0 {
+		return nil
+	}
+	_, err := e.getMutableState(ctx, e.taskAttr.NewRunId)
+	switch err.(type) {
+	case nil:
+		return nil
+	case *serviceerror.NotFound:
+		return serviceerror.NewDataLossf("workflow new run not found. NamespaceId: %v, workflowId: %v, runId: %v, newRunId: %v",
+			e.NamespaceID, e.WorkflowID, e.RunID, e.taskAttr.NewRunId)
+	default:
+		return err
 	}
+}
 
-	if len(e.taskAttr.EventVersionHistory) == 0 {
+// VerifyVersionedTransition checks whether the mutable state has the versioned transition and the
+// events of the mutable state it was replicated from. nextEventID and eventVersionHistory describe
+// the events of that mutable state up to the versioned transition. If versionedTransition is nil,
+// only the events are checked.
+func VerifyVersionedTransition(
+	ms *persistencespb.WorkflowMutableState,
+	versionedTransition *persistencespb.VersionedTransition,
+	nextEventID int64,
+	eventVersionHistory []*historyspb.VersionHistoryItem,
+) (VerifyVersionedTransitionResult, error) {
+	if versionedTransition != nil {
+		transitionHistory := ms.GetExecutionInfo().GetTransitionHistory()
+		if len(transitionHistory) == 0 {
+			return VerifyVersionedTransitionResult{Status: VersionedTransitionUnverifiable}, nil
+		}
+
+		// case 1: VersionedTransition is up-to-date on current mutable state
+		if transitionhistory.StalenessCheck(transitionHistory, versionedTransition) == nil {
+			if ms.GetNextEventId() < nextEventID {
+				return VerifyVersionedTransitionResult{Status: VersionedTransitionEventsLost}, nil
+			}
+			return VerifyVersionedTransitionResult{Status: VersionedTransitionUpToDate}, nil
+		}
+
+		// case 2: verified VersionedTransition is newer, need to sync state
+		if transitionhistory.Compare(versionedTransition, transitionhistory.LastVersionedTransition(transitionHistory)) > 0 {
+			return VerifyVersionedTransitionResult{Status: VersionedTransitionStateMissing}, nil
+		}
+		// case 3: state transition is on non-current branch, but no event to verify
+		if nextEventID == common2.EmptyEventID {
+			return VerifyVersionedTransitionResult{Status: VersionedTransitionUpToDate}, nil
+		}
+	}
+
+	if len(eventVersionHistory) == 0 {
 		// no events to verify
-		return nil
+		return VerifyVersionedTransitionResult{Status: VersionedTransitionUnverifiable}, nil
 	}
 
 	targetHistory := &historyspb.VersionHistory{
-		Items: e.taskAttr.EventVersionHistory,
+		Items: eventVersionHistory,
 	}
 
-	lcaItem, _, err := versionhistory.FindLCAVersionHistoryItemAndIndex(ms.GetExecutionInfo().VersionHistories, targetHistory)
+	lcaItem, _, err := versionhistory.FindLCAVersionHistoryItemAndIndex(ms.GetExecutionInfo().GetVersionHistories(), targetHistory)
 	if err != nil {
-		return err
+		return VerifyVersionedTransitionResult{}, err
 	}
 	lastItem, err := versionhistory.GetLastVersionHistoryItem(targetHistory)
 	if err != nil {
-		return err
+		return VerifyVersionedTransitionResult{}, err
 	}
 	// case 4: event on non-current branch are up-to-date
 	if versionhistory.IsEqualVersionHistoryItem(lcaItem, lastItem) {
-		return e.verifyNewRunExist(ctx)
+		return VerifyVersionedTransitionResult{Status: VersionedTransitionUpToDate}, nil
 	}
 	// case 5: event on non-current branch are not up-to-date, we need to backfill events
 	startEventVersion, err := versionhistory.GetVersionHistoryEventVersion(targetHistory, lcaItem.EventId+1)
 	if err != nil {
-		return err
-	}
-	return e.BackFillEvents(
-		ctx,
-		e.ExecutableTask.SourceClusterName(),
-		e.WorkflowKey,
-		lcaItem.EventId+1,
-		startEventVersion,
-		lastItem.EventId,
-		lastItem.Version,
-		e.taskAttr.NewRunId,
-	)
-}
-
-func (e *ExecutableVerifyVersionedTransitionTask) verifyNewRunExist(ctx context.Context) error {
-	if len(e.taskAttr.NewRunId) == 0 {
-		return nil
-	}
-	_, err := e.getMutableState(ctx, e.taskAttr.NewRunId)
-	switch err.(type) {
-	case nil:
-		return nil
-	case *serviceerror.NotFound:
-		return serviceerror.NewDataLossf("workflow new run not found. NamespaceId: %v, workflowId: %v, runId: %v, newRunId: %v",
-			e.NamespaceID, e.WorkflowID, e.RunID, e.taskAttr.NewRunId)
-	default:
-		return err
+		return VerifyVersionedTransitionResult{}, err
 	}
+	return VerifyVersionedTransitionResult{
+		Status:            VersionedTransitionEventsMissing,
+		StartEventID:      lcaItem.EventId + 1,
+		StartEventVersion: startEventVersion,
+		EndEventID:        lastItem.EventId,
+		EndEventVersion:   lastItem.Version,
+	}, nil
 }
 
 func (e *ExecutableVerifyVersionedTransitionTask) getMutableState(ctx context.Context, runId string) (_ *persistencespb.WorkflowMutableState, retError error) {
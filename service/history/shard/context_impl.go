@@ -909,6 +909,7 @@ func (s *ContextImpl) AppendHistoryEvents(
 	}
 
 	request.ShardID = s.shardID
+	request.NamespaceID = namespaceID.String()
 
 	size := 0
 	defer func() {
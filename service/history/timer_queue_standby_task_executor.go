@@ -342,7 +342,13 @@ func (t *timerQueueStandbyTaskExecutor) executeActivityRetryTimerTask(
 			return nil, nil
 		}
 
-		return newActivityRetryTimePostActionInfo(mutableState, activityInfo.TaskQueue, activityInfo.ScheduleToStartTimeout.AsDuration(), activityInfo)
+		return newActivityRetryTimePostActionInfo(
+			mutableState,
+			activityInfo.TaskQueue,
+			activityInfo.ScheduleToStartTimeout.AsDuration(),
+			activityInfo,
+			workflow.GetFairnessKey(t.shardContext, mutableState),
+		)
 	}
 
 	return t.processTimer(
@@ -670,6 +676,7 @@ func (t *timerQueueStandbyTaskExecutor) pushActivity(
 		Clock:                  vclock.NewVectorClock(t.shardContext.GetClusterMetadata().GetClusterID(), t.shardContext.GetShardID(), activityTask.TaskID),
 		VersionDirective:       pushActivityInfo.versionDirective,
 		Stamp:                  activityTask.Stamp,
+		FairnessKey:            pushActivityInfo.fairnessKey,
 	})
 
 	if err != nil {
@@ -195,13 +195,14 @@ func (t *transferQueueActiveTaskExecutor) processActivityTask(
 	timeout := timestamp.DurationValue(ai.ScheduleToStartTimeout)
 	directive := MakeDirectiveForActivityTask(mutableState, ai)
 	priority := priorities.Merge(mutableState.GetExecutionInfo().Priority, ai.Priority)
+	fairnessKey := workflow.GetFairnessKey(t.shardContext, mutableState)
 
 	// NOTE: do not access anything related mutable state after this lock release
 	// release the context lock since we no longer need mutable state and
 	// the rest of logic is making RPC call, which takes time.
 	release(nil)
 
-	return t.pushActivity(ctx, task, timeout, directive, priority, historyi.TransactionPolicyActive)
+	return t.pushActivity(ctx, task, timeout, directive, priority, fairnessKey, historyi.TransactionPolicyActive)
 }
 
 func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
@@ -244,6 +245,7 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 
 	directive := MakeDirectiveForWorkflowTask(mutableState)
 	priority := mutableState.GetExecutionInfo().Priority
+	fairnessKey := workflow.GetFairnessKey(t.shardContext, mutableState)
 
 	// NOTE: Do not access mutableState after this lock is released.
 	// It is important to release the workflow lock here, because pushWorkflowTask will call matching,
@@ -257,6 +259,7 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 		scheduleToStartTimeout.AsDuration(),
 		directive,
 		priority,
+		fairnessKey,
 		historyi.TransactionPolicyActive,
 	)
 
@@ -280,6 +283,7 @@ func (t *transferQueueActiveTaskExecutor) processWorkflowTask(
 			scheduleToStartTimeout.AsDuration(),
 			directive,
 			priority,
+			fairnessKey,
 			historyi.TransactionPolicyActive,
 		)
 	}
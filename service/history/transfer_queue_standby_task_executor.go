@@ -24,6 +24,7 @@ import (
 	"go.temporal.io/server/service/history/ndc"
 	"go.temporal.io/server/service/history/queues"
 	"go.temporal.io/server/service/history/tasks"
+	"go.temporal.io/server/service/history/workflow"
 	wcache "go.temporal.io/server/service/history/workflow/cache"
 )
 
@@ -132,7 +133,7 @@ func (t *transferQueueStandbyTaskExecutor) processActivityTask(
 		}
 
 		if activityInfo.StartedEventId == common.EmptyEventID {
-			return newActivityTaskPostActionInfo(mutableState, activityInfo)
+			return newActivityTaskPostActionInfo(mutableState, activityInfo, workflow.GetFairnessKey(t.shardContext, mutableState))
 		}
 
 		return nil, nil
@@ -183,6 +184,7 @@ func (t *transferQueueStandbyTaskExecutor) processWorkflowTask(
 				mutableState,
 				scheduleToStartTimeout.AsDuration(),
 				taskQueue,
+				workflow.GetFairnessKey(t.shardContext, mutableState),
 			)
 		}
 
@@ -512,6 +514,7 @@ func (t *transferQueueStandbyTaskExecutor) pushActivity(
 		pushActivityInfo.activityTaskScheduleToStartTimeout,
 		pushActivityInfo.versionDirective,
 		pushActivityInfo.priority,
+		pushActivityInfo.fairnessKey,
 		historyi.TransactionPolicyPassive,
 	)
 }
@@ -534,6 +537,7 @@ func (t *transferQueueStandbyTaskExecutor) pushWorkflowTask(
 		pushwtInfo.workflowTaskScheduleToStartTimeout,
 		pushwtInfo.versionDirective,
 		pushwtInfo.priority,
+		pushwtInfo.fairnessKey,
 		historyi.TransactionPolicyPassive,
 	)
 }
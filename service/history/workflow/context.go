@@ -511,11 +511,16 @@ func (c *ContextImpl) UpdateWorkflowExecutionWithNew(
 		}
 	}
 
-	if err := c.mergeUpdateWithNewReplicationTasks(
-		updateWorkflow,
-		newWorkflow,
-	); err != nil {
-		return err
+	// CHASM entities have no history events to carry the new run with. The closed entity and the
+	// new entity each keep their own sync versioned transition task, and the new entity's task is
+	// its first task, which creates it on the standby cluster.
+	if newWorkflow == nil || newMutableState.IsWorkflow() {
+		if err := c.mergeUpdateWithNewReplicationTasks(
+			updateWorkflow,
+			newWorkflow,
+		); err != nil {
+			return err
+		}
 	}
 
 	eventsToReapply := updateWorkflowEventsSeq
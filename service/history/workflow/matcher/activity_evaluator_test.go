@@ -7,6 +7,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/sqlquery"
 	"go.uber.org/mock/gomock"
@@ -583,3 +584,49 @@ func TestActivityInfoMatchEvaluator_BackoffInterval(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateActivityQuery(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		expectedError bool
+	}{
+		{
+			name:          "empty query",
+			query:         "",
+			expectedError: true,
+		},
+		{
+			name:          "valid query",
+			query:         "ActivityType = 'my_activity' AND Attempts > 10",
+			expectedError: false,
+		},
+		{
+			name:          "unknown field",
+			query:         "WorkflowId = 'workflow_id'",
+			expectedError: true,
+		},
+		{
+			name:          "invalid condition after short-circuit AND",
+			query:         "ActivityType = 'my_activity' AND Attempts > 'ten'",
+			expectedError: true,
+		},
+		{
+			name:          "unsupported operation",
+			query:         "Attempts starts_with '1'",
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateActivityQuery(tt.query)
+			if tt.expectedError {
+				var invalidArgumentErr *serviceerror.InvalidArgument
+				assert.ErrorAs(t, err, &invalidArgumentErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -36,3 +36,10 @@ func MatchActivity(ai *persistencespb.ActivityInfo, query string) (bool, error)
 	evaluator := newActivityMatchEvaluator(ai)
 	return evaluator.Evaluate(query)
 }
+
+// ValidateActivityQuery validates that the given query is supported by MatchActivity.
+// All conditions in the query are validated, regardless of the activity it will be matched with.
+func ValidateActivityQuery(query string) error {
+	evaluator := newActivityMatchEvaluator(&persistencespb.ActivityInfo{})
+	return validateQuery(query, evaluator.evaluateExpression)
+}
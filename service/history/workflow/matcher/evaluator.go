@@ -1,10 +1,12 @@
 package matcher
 
 import (
+	"errors"
 	"fmt"
 	"strings"
 
 	"github.com/temporalio/sqlparser"
+	"go.temporal.io/api/serviceerror"
 )
 
 type Evaluator interface {
@@ -33,6 +35,49 @@ func getWhereCause(query string) (sqlparser.Expr, error) {
 	return selectStmt.Where.Expr, nil
 }
 
+// validateQuery parses the given query and validates every condition in it with evaluateFn.
+// Unlike Evaluate, AND/OR expressions are not short-circuited, so invalid conditions can't be
+// hidden behind conditions that happen to decide the result for a particular input.
+func validateQuery(query string, evaluateFn func(sqlparser.Expr) (bool, error)) error {
+	query, err := prepareQuery(query)
+	if err != nil {
+		return NewMatcherError("%s: %v", malformedSqlQueryErrMessage, err)
+	}
+
+	whereCause, err := getWhereCause(query)
+	if err != nil {
+		return err
+	}
+
+	return validateExpression(whereCause, evaluateFn)
+}
+
+func validateExpression(expr sqlparser.Expr, evaluateFn func(sqlparser.Expr) (bool, error)) error {
+	switch e := (expr).(type) {
+	case *sqlparser.AndExpr:
+		if err := validateExpression(e.Left, evaluateFn); err != nil {
+			return err
+		}
+		return validateExpression(e.Right, evaluateFn)
+	case *sqlparser.OrExpr:
+		if err := validateExpression(e.Left, evaluateFn); err != nil {
+			return err
+		}
+		return validateExpression(e.Right, evaluateFn)
+	case *sqlparser.ParenExpr:
+		return validateExpression(e.Expr, evaluateFn)
+	default:
+		if _, err := evaluateFn(expr); err != nil {
+			var invalidArgumentErr *serviceerror.InvalidArgument
+			if errors.As(err, &invalidArgumentErr) {
+				return err
+			}
+			return NewMatcherError("%s: %v", invalidExpressionErrMessage, err)
+		}
+		return nil
+	}
+}
+
 func prepareQuery(query string) (string, error) {
 	query = strings.TrimSpace(query)
 	if query == "" {
@@ -38,3 +38,13 @@ func MatchMutableState(
 	evaluator := newMutableStateMatchEvaluator(executionInfo, executionState)
 	return evaluator.Evaluate(query)
 }
+
+// ValidateMutableStateQuery validates that the given query is supported by MatchMutableState.
+// All conditions in the query are validated, regardless of the mutable state it will be matched with.
+func ValidateMutableStateQuery(query string) error {
+	evaluator := newMutableStateMatchEvaluator(
+		&persistencespb.WorkflowExecutionInfo{},
+		&persistencespb.WorkflowExecutionState{},
+	)
+	return validateQuery(query, evaluator.evaluateExpression)
+}
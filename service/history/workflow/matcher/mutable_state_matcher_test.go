@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/sqlquery"
 	"go.uber.org/mock/gomock"
@@ -82,3 +83,54 @@ func TestMatchMutableState(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateMutableStateQuery(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		expectedError bool
+	}{
+		{
+			name:          "empty query",
+			query:         "",
+			expectedError: true,
+		},
+		{
+			name:          "full sql query",
+			query:         "select * from table where WorkflowId = 'my_workflow_id'",
+			expectedError: true,
+		},
+		{
+			name:          "valid query",
+			query:         "WorkflowId = 'workflow_id' AND StartTime > '2023-10-26T14:30:00Z'",
+			expectedError: false,
+		},
+		{
+			name:          "unknown field",
+			query:         "UnknownField = 'value'",
+			expectedError: true,
+		},
+		{
+			name:          "invalid condition after short-circuit AND",
+			query:         "WorkflowId = 'workflow_id' AND StartTime > 'not a time'",
+			expectedError: true,
+		},
+		{
+			name:          "invalid condition after short-circuit OR",
+			query:         "(WorkflowId != 'workflow_id' OR ExecutionStatus > 'Running')",
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateMutableStateQuery(tt.query)
+			if tt.expectedError {
+				var invalidArgumentErr *serviceerror.InvalidArgument
+				assert.ErrorAs(t, err, &invalidArgumentErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
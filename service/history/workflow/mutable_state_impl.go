@@ -1858,6 +1858,7 @@ func (ms *MutableStateImpl) UpdateActivityInfo(
 	ai.Stamp = incomingActivityInfo.GetStamp()
 
 	ai.Paused = incomingActivityInfo.GetPaused()
+	ai.PauseInfo = incomingActivityInfo.GetPauseInfo()
 	if incomingActivityInfo.RetryInitialInterval != nil {
 		ai.RetryInitialInterval = incomingActivityInfo.GetRetryInitialInterval()
 		ai.RetryMaximumInterval = incomingActivityInfo.GetRetryMaximumInterval()
@@ -3355,6 +3356,10 @@ func (ms *MutableStateImpl) AddActivityTaskScheduledEvent(
 
 	event := ms.hBuilder.AddActivityTaskScheduledEvent(workflowTaskCompletedEventID, command)
 	ai, err := ms.ApplyActivityTaskScheduledEvent(workflowTaskCompletedEventID, event)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	// TODO merge active & passive task generation
 	if !bypassTaskGeneration {
 		if err := ms.taskGenerator.GenerateActivityTasks(
@@ -3364,7 +3369,13 @@ func (ms *MutableStateImpl) AddActivityTaskScheduledEvent(
 		}
 	}
 
-	return event, ai, err
+	// Evaluate workflow rules when the activity is scheduled, so that a matching activity
+	// is paused before it is ever started. The activity task is still generated, as it is
+	// for replicated and rebuilt activities. Pausing bumps the activity's stamp, so the task
+	// is dropped once it's dispatched, and unpausing the activity generates a new one.
+	ActivityMatchWorkflowRules(ms, ms.timeSource, ms.logger, ai)
+
+	return event, ai, nil
 }
 
 func (ms *MutableStateImpl) ApplyActivityTaskScheduledEvent(
@@ -4341,6 +4352,157 @@ func (ms *MutableStateImpl) ApplyWorkflowPropertiesModifiedEvent(
 	}
 }
 
+func (ms *MutableStateImpl) AddWorkflowPropertiesModifiedExternallyEvent(
+	ctx context.Context,
+	attributes *historypb.WorkflowPropertiesModifiedExternallyEventAttributes,
+) (*historypb.HistoryEvent, error) {
+	opTag := tag.WorkflowActionWorkflowPropertiesModifiedExternally
+	if err := ms.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	event := ms.hBuilder.AddWorkflowPropertiesModifiedExternallyEvent(attributes)
+	if err := ms.ApplyWorkflowPropertiesModifiedExternallyEvent(event); err != nil {
+		return nil, err
+	}
+	// TODO merge active & passive task generation
+	if err := generateWorkflowPropertiesModifiedExternallyTasks(ctx, ms, ms.taskGenerator, attributes); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (ms *MutableStateImpl) ApplyWorkflowPropertiesModifiedExternallyEvent(
+	event *historypb.HistoryEvent,
+) error {
+	attributes := event.GetWorkflowPropertiesModifiedExternallyEventAttributes()
+	ms.approximateSize -= ms.executionInfo.Size()
+
+	if attributes.GetNewTaskQueue() != "" {
+		ms.executionInfo.TaskQueue = attributes.GetNewTaskQueue()
+		// sticky task queue belongs to a worker polling the previous task queue
+		ms.ClearStickyTaskQueue()
+	}
+	if attributes.NewWorkflowTaskTimeout != nil {
+		ms.executionInfo.DefaultWorkflowTaskTimeout = attributes.GetNewWorkflowTaskTimeout()
+	}
+	if attributes.NewWorkflowExecutionTimeout != nil {
+		ms.updateWorkflowExecutionExpirationTime(attributes.GetNewWorkflowExecutionTimeout())
+	}
+	if attributes.NewWorkflowRunTimeout != nil {
+		ms.executionInfo.WorkflowRunTimeout = attributes.GetNewWorkflowRunTimeout()
+	}
+	if attributes.NewWorkflowRunTimeout != nil || attributes.NewWorkflowExecutionTimeout != nil {
+		ms.updateWorkflowRunExpirationTime()
+	}
+	if attributes.UpsertedMemo != nil {
+		ms.updateMemo(attributes.GetUpsertedMemo().GetFields())
+	}
+
+	ms.approximateSize += ms.executionInfo.Size()
+	return nil
+}
+
+// updateWorkflowExecutionExpirationTime replaces the execution timeout of the workflow and recalculates
+// the expiration time relative to the start of the workflow execution chain.
+func (ms *MutableStateImpl) updateWorkflowExecutionExpirationTime(
+	executionTimeout *durationpb.Duration,
+) {
+	// The start time of the first run is not available in mutable state, derive it from the previous
+	// expiration time if there is one, otherwise fall back to the start time of the current run.
+	chainStartTime := ms.executionState.StartTime.AsTime()
+	previousExpirationTime := timestamp.TimeValue(ms.executionInfo.WorkflowExecutionExpirationTime)
+	previousTimeout := timestamp.DurationValue(ms.executionInfo.WorkflowExecutionTimeout)
+	if !previousExpirationTime.IsZero() && previousTimeout > 0 {
+		chainStartTime = previousExpirationTime.Add(-previousTimeout)
+	}
+
+	ms.executionInfo.WorkflowExecutionTimeout = executionTimeout
+	if executionTimeout.AsDuration() > 0 {
+		ms.executionInfo.WorkflowExecutionExpirationTime = timestamppb.New(chainStartTime.Add(executionTimeout.AsDuration()))
+	} else {
+		ms.executionInfo.WorkflowExecutionExpirationTime = nil
+	}
+}
+
+// updateWorkflowRunExpirationTime recalculates the run expiration time from the current run and execution timeouts.
+func (ms *MutableStateImpl) updateWorkflowRunExpirationTime() {
+	var workflowRunTimeoutTime time.Time
+	if runTimeout := timestamp.DurationValue(ms.executionInfo.WorkflowRunTimeout); runTimeout != 0 {
+		// execution time already includes the first workflow task backoff
+		workflowRunTimeoutTime = ms.executionInfo.ExecutionTime.AsTime().Add(runTimeout)
+	}
+
+	// run timeout, if not specified, defaults to execution timeout
+	workflowExecutionTimeoutTime := timestamp.TimeValue(ms.executionInfo.WorkflowExecutionExpirationTime)
+	if !workflowExecutionTimeoutTime.IsZero() &&
+		(workflowRunTimeoutTime.IsZero() || workflowRunTimeoutTime.After(workflowExecutionTimeoutTime)) {
+		workflowRunTimeoutTime = workflowExecutionTimeoutTime
+	}
+	ms.executionInfo.WorkflowRunExpirationTime = timestamppb.New(workflowRunTimeoutTime)
+}
+
+func (ms *MutableStateImpl) AddActivityPropertiesModifiedExternallyEvent(
+	scheduledEventID int64,
+	newRetryPolicy *commonpb.RetryPolicy,
+) (*historypb.HistoryEvent, error) {
+	opTag := tag.WorkflowActionActivityPropertiesModifiedExternally
+	if err := ms.checkMutability(opTag); err != nil {
+		return nil, err
+	}
+
+	if _, ok := ms.GetActivityInfo(scheduledEventID); !ok {
+		ms.logWarn(mutableStateInvalidHistoryActionMsg, opTag,
+			tag.WorkflowEventID(ms.GetNextEventID()),
+			tag.ErrorTypeInvalidHistoryAction,
+			tag.WorkflowScheduledEventID(scheduledEventID))
+		return nil, ms.createCallerError(opTag, fmt.Sprintf("ScheduledEventID: %d", scheduledEventID))
+	}
+
+	event := ms.hBuilder.AddActivityPropertiesModifiedExternallyEvent(scheduledEventID, newRetryPolicy)
+	if err := ms.ApplyActivityPropertiesModifiedExternallyEvent(event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func (ms *MutableStateImpl) ApplyActivityPropertiesModifiedExternallyEvent(
+	event *historypb.HistoryEvent,
+) error {
+	attributes := event.GetActivityPropertiesModifiedExternallyEventAttributes()
+	ai, ok := ms.GetActivityInfo(attributes.GetScheduledEventId())
+	if !ok {
+		// Same as activity cancel requested event, the activity could have been completed
+		// by events which were buffered together with this event.
+		return nil
+	}
+	retryPolicy := attributes.GetNewRetryPolicy()
+	if retryPolicy == nil {
+		return nil
+	}
+
+	ms.approximateSize -= ai.Size()
+
+	ai.Version = event.GetVersion()
+	if !ai.HasRetryPolicy {
+		ai.HasRetryPolicy = true
+		if scheduleToCloseTimeout := ai.ScheduleToCloseTimeout.AsDuration(); scheduleToCloseTimeout > 0 {
+			ai.RetryExpirationTime = timestamppb.New(ai.ScheduledTime.AsTime().Add(scheduleToCloseTimeout))
+		}
+	}
+	// number of attempts is preserved, only the policy for subsequent attempts is replaced
+	ai.RetryInitialInterval = retryPolicy.GetInitialInterval()
+	ai.RetryBackoffCoefficient = retryPolicy.GetBackoffCoefficient()
+	ai.RetryMaximumInterval = retryPolicy.GetMaximumInterval()
+	ai.RetryMaximumAttempts = retryPolicy.GetMaximumAttempts()
+	ai.RetryNonRetryableErrorTypes = retryPolicy.GetNonRetryableErrorTypes()
+
+	ms.updateActivityInfos[ai.ScheduledEventId] = ai
+	ms.activityInfosUserDataUpdated[ai.ScheduledEventId] = struct{}{}
+	ms.approximateSize += ai.Size()
+	return nil
+}
+
 func (ms *MutableStateImpl) AddExternalWorkflowExecutionSignaled(
 	initiatedID int64,
 	targetNamespace namespace.Name,
@@ -5934,13 +6096,13 @@ func (ms *MutableStateImpl) UpdateWorkflowStateStatus(
 }
 
 // IsDirty is used for sanity check that mutable state is "clean" after mutable state lock is released.
-// However, certain in-memory changes (e.g. speculative workflow task) won't be cleared before releasing
-// the lock and have to be excluded from the check.
+// However, certain in-memory changes (e.g. speculative workflow task, speculative CHASM transition)
+// won't be cleared before releasing the lock and have to be excluded from the check.
 func (ms *MutableStateImpl) IsDirty() bool {
 	return ms.hBuilder.IsDirty() ||
 		len(ms.InsertTasks) > 0 ||
 		(ms.stateMachineNode != nil && ms.stateMachineNode.Dirty()) ||
-		ms.chasmTree.IsDirty()
+		(ms.chasmTree.IsDirty() && !ms.chasmTree.IsSpeculative())
 }
 
 // isStateDirty is used upon closing transaction to determine if application data has been updated, and
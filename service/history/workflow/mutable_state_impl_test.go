@@ -18,6 +18,7 @@ import (
 	enumspb "go.temporal.io/api/enums/v1"
 	failurepb "go.temporal.io/api/failure/v1"
 	historypb "go.temporal.io/api/history/v1"
+	rulespb "go.temporal.io/api/rules/v1"
 	"go.temporal.io/api/serviceerror"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	updatepb "go.temporal.io/api/update/v1"
@@ -37,6 +38,7 @@ import (
 	"go.temporal.io/server/common/failure"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/persistence/transitionhistory"
 	"go.temporal.io/server/common/persistence/versionhistory"
@@ -1976,6 +1978,91 @@ func (s *mutableStateSuite) TestApplyActivityTaskStartedEvent() {
 	s.Assert().Nil(ai.LastHeartbeatDetails)
 }
 
+func (s *mutableStateSuite) TestApplyWorkflowPropertiesModifiedExternallyEvent() {
+	state := s.buildWorkflowMutableState()
+	state.ExecutionInfo.StickyTaskQueue = "sticky-task-queue"
+
+	var err error
+	s.mutableState, err = NewMutableStateFromDB(s.mockShard, s.mockEventsCache, s.logger, tests.LocalNamespaceEntry, state, 123)
+	s.NoError(err)
+
+	startTime := state.ExecutionState.StartTime.AsTime()
+	attributes := &historypb.WorkflowPropertiesModifiedExternallyEventAttributes{
+		NewTaskQueue:                "new-task-queue",
+		NewWorkflowTaskTimeout:      durationpb.New(10 * time.Second),
+		NewWorkflowRunTimeout:       durationpb.New(2 * time.Hour),
+		NewWorkflowExecutionTimeout: durationpb.New(time.Hour),
+		UpsertedMemo: &commonpb.Memo{
+			Fields: map[string]*commonpb.Payload{"key": payload.EncodeString("value")},
+		},
+	}
+	err = s.mutableState.ApplyWorkflowPropertiesModifiedExternallyEvent(&historypb.HistoryEvent{
+		EventId:   int64(104),
+		EventTime: timestamppb.New(time.Now().UTC()),
+		Version:   int64(101),
+		Attributes: &historypb.HistoryEvent_WorkflowPropertiesModifiedExternallyEventAttributes{
+			WorkflowPropertiesModifiedExternallyEventAttributes: attributes,
+		},
+	})
+	s.NoError(err)
+
+	executionInfo := s.mutableState.GetExecutionInfo()
+	s.Equal("new-task-queue", executionInfo.TaskQueue)
+	s.Empty(executionInfo.StickyTaskQueue)
+	s.Equal(10*time.Second, executionInfo.DefaultWorkflowTaskTimeout.AsDuration())
+	s.Equal(2*time.Hour, executionInfo.WorkflowRunTimeout.AsDuration())
+	s.Equal(time.Hour, executionInfo.WorkflowExecutionTimeout.AsDuration())
+	s.Equal(startTime.Add(time.Hour), executionInfo.WorkflowExecutionExpirationTime.AsTime())
+	// run expiration time is capped by the execution expiration time
+	s.Equal(startTime.Add(time.Hour), executionInfo.WorkflowRunExpirationTime.AsTime())
+	protorequire.ProtoEqual(s.T(), payload.EncodeString("value"), executionInfo.Memo["key"])
+}
+
+func (s *mutableStateSuite) TestApplyActivityPropertiesModifiedExternallyEvent() {
+	state := s.buildWorkflowMutableState()
+
+	var err error
+	s.mutableState, err = NewMutableStateFromDB(s.mockShard, s.mockEventsCache, s.logger, tests.LocalNamespaceEntry, state, 123)
+	s.NoError(err)
+
+	var scheduledEventID int64
+	var ai *persistencespb.ActivityInfo
+	for scheduledEventID, ai = range s.mutableState.GetPendingActivityInfos() {
+		break
+	}
+	s.False(ai.HasRetryPolicy)
+
+	version := int64(101)
+	retryPolicy := &commonpb.RetryPolicy{
+		InitialInterval:        durationpb.New(time.Second),
+		BackoffCoefficient:     2,
+		MaximumInterval:        durationpb.New(time.Minute),
+		MaximumAttempts:        10,
+		NonRetryableErrorTypes: []string{"bad-error"},
+	}
+	err = s.mutableState.ApplyActivityPropertiesModifiedExternallyEvent(&historypb.HistoryEvent{
+		EventId:   int64(104),
+		EventTime: timestamppb.New(time.Now().UTC()),
+		Version:   version,
+		Attributes: &historypb.HistoryEvent_ActivityPropertiesModifiedExternallyEventAttributes{
+			ActivityPropertiesModifiedExternallyEventAttributes: &historypb.ActivityPropertiesModifiedExternallyEventAttributes{
+				ScheduledEventId: scheduledEventID,
+				NewRetryPolicy:   retryPolicy,
+			},
+		},
+	})
+	s.NoError(err)
+	s.Equal(version, ai.Version)
+	s.True(ai.HasRetryPolicy)
+	s.Equal(ai.ScheduledTime.AsTime().Add(ai.ScheduleToCloseTimeout.AsDuration()), ai.RetryExpirationTime.AsTime())
+	s.Equal(time.Second, ai.RetryInitialInterval.AsDuration())
+	s.Equal(float64(2), ai.RetryBackoffCoefficient)
+	s.Equal(time.Minute, ai.RetryMaximumInterval.AsDuration())
+	s.Equal(int32(10), ai.RetryMaximumAttempts)
+	s.Equal([]string{"bad-error"}, ai.RetryNonRetryableErrorTypes)
+	s.Contains(s.mutableState.updateActivityInfos, scheduledEventID)
+}
+
 func (s *mutableStateSuite) TestAddContinueAsNewEvent_Default() {
 	dbState := s.buildWorkflowMutableState()
 	dbState.BufferedEvents = nil
@@ -2212,6 +2299,113 @@ func (s *mutableStateSuite) TestRetryWorkflowTask_WithNextRetryDelay() {
 	s.Equal(enumspb.RETRY_STATE_IN_PROGRESS, retryState)
 	s.Equal(duration, expectedDelayDuration)
 }
+
+func (s *mutableStateSuite) TestAddActivityTaskScheduledEvent_WorkflowRules() {
+	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
+
+	testCases := []struct {
+		name           string
+		activityType   string
+		expectedPaused bool
+	}{
+		{
+			name:           "matching activity is paused",
+			activityType:   "paused-activity-type",
+			expectedPaused: true,
+		},
+		{
+			name:           "non-matching activity is scheduled",
+			activityType:   "activity-type",
+			expectedPaused: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.mutableState = NewMutableState(s.mockShard, s.mockEventsCache, s.logger, s.namespaceEntry, tests.WorkflowID, tests.RunID, time.Now().UTC())
+			s.mutableState.namespaceEntry = namespace.NewGlobalNamespaceForTest(
+				&persistencespb.NamespaceInfo{Id: tests.NamespaceID.String(), Name: tests.Namespace.String()},
+				&persistencespb.NamespaceConfig{
+					WorkflowRules: map[string]*rulespb.WorkflowRule{
+						"pause-rule": {
+							Spec: &rulespb.WorkflowRuleSpec{
+								Id: "pause-rule",
+								Trigger: &rulespb.WorkflowRuleSpec_ActivityStart{
+									ActivityStart: &rulespb.WorkflowRuleSpec_ActivityStartingTrigger{
+										Predicate: "ActivityType = 'paused-activity-type'",
+									},
+								},
+								Actions: []*rulespb.WorkflowRuleAction{
+									{
+										Variant: &rulespb.WorkflowRuleAction_ActivityPause{
+											ActivityPause: &rulespb.WorkflowRuleAction_ActionActivityPause{},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				&persistencespb.NamespaceReplicationConfig{
+					ActiveClusterName: cluster.TestCurrentClusterName,
+					Clusters:          []string{cluster.TestCurrentClusterName},
+				},
+				s.namespaceEntry.FailoverVersion(),
+			)
+
+			workflowTaskCompletedEventID := int64(4)
+			_, activityInfo, err := s.mutableState.AddActivityTaskScheduledEvent(
+				workflowTaskCompletedEventID,
+				&commandpb.ScheduleActivityTaskCommandAttributes{
+					ActivityId:   "5",
+					ActivityType: &commonpb.ActivityType{Name: tc.activityType},
+					TaskQueue:    &taskqueuepb.TaskQueue{Name: "task-queue"},
+				},
+				false,
+			)
+			s.NoError(err)
+			s.Equal(tc.expectedPaused, activityInfo.Paused)
+
+			// The activity task is generated either way, but a paused activity's task is stale.
+			var activityTasks []*tasks.ActivityTask
+			for _, task := range s.mutableState.InsertTasks[tasks.CategoryTransfer] {
+				if activityTask, ok := task.(*tasks.ActivityTask); ok {
+					activityTasks = append(activityTasks, activityTask)
+				}
+			}
+			s.Len(activityTasks, 1)
+			if !tc.expectedPaused {
+				s.Equal(activityInfo.Stamp, activityTasks[0].Stamp)
+				return
+			}
+			s.Equal("pause-rule", activityInfo.GetPauseInfo().GetRuleId())
+			s.NotEqual(activityInfo.Stamp, activityTasks[0].Stamp)
+
+			// The pause survives reloading the mutable state.
+			dbState := s.mutableState.CloneToProto()
+			s.mutableState, err = NewMutableStateFromDB(s.mockShard, s.mockEventsCache, s.logger, s.mutableState.namespaceEntry, dbState, 123)
+			s.NoError(err)
+			activityInfo, ok := s.mutableState.GetActivityByActivityID("5")
+			s.True(ok)
+			s.True(activityInfo.Paused)
+			s.Equal("pause-rule", activityInfo.GetPauseInfo().GetRuleId())
+
+			// Unpausing the activity generates a task to dispatch it.
+			err = UnpauseActivity(s.mockShard, s.mutableState, activityInfo, false, false, 0)
+			s.NoError(err)
+			s.False(activityInfo.Paused)
+			var retryTasks []*tasks.ActivityRetryTimerTask
+			for _, task := range s.mutableState.InsertTasks[tasks.CategoryTimer] {
+				if retryTask, ok := task.(*tasks.ActivityRetryTimerTask); ok {
+					retryTasks = append(retryTasks, retryTask)
+				}
+			}
+			s.Len(retryTasks, 1)
+			s.Equal(activityInfo.Stamp, retryTasks[0].Stamp)
+		})
+	}
+}
+
 func (s *mutableStateSuite) TestRetryActivity_TruncateRetryableFailure() {
 	s.mockEventsCache.EXPECT().PutEvent(gomock.Any(), gomock.Any()).AnyTimes()
 
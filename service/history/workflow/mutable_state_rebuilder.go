@@ -646,9 +646,27 @@ func (b *MutableStateRebuilderImpl) applyEvents(
 				return nil, err
 			}
 
-		case enumspb.EVENT_TYPE_ACTIVITY_PROPERTIES_MODIFIED_EXTERNALLY,
-			enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY:
-			return nil, serviceerror.NewUnimplemented("Workflow/activity property modification not implemented")
+		case enumspb.EVENT_TYPE_ACTIVITY_PROPERTIES_MODIFIED_EXTERNALLY:
+			if err := b.mutableState.ApplyActivityPropertiesModifiedExternallyEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+
+		case enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY:
+			if err := b.mutableState.ApplyWorkflowPropertiesModifiedExternallyEvent(
+				event,
+			); err != nil {
+				return nil, err
+			}
+			if err := generateWorkflowPropertiesModifiedExternallyTasks(
+				ctx,
+				b.mutableState,
+				taskGenerator,
+				event.GetWorkflowPropertiesModifiedExternallyEventAttributes(),
+			); err != nil {
+				return nil, err
+			}
 
 		case enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_OPTIONS_UPDATED:
 			if err := b.mutableState.ApplyWorkflowExecutionOptionsUpdatedEvent(event); err != nil {
@@ -956,6 +956,124 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowPropertiesModified(
 	s.Equal(event.TaskId, s.executionInfo.LastRunningClock)
 }
 
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowPropertiesModifiedExternally() {
+	version := int64(1)
+	requestID := uuid.New()
+
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      tests.RunID,
+	}
+
+	now := time.Now().UTC()
+	evenType := enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY
+	event := &historypb.HistoryEvent{
+		TaskId:    rand.Int63(),
+		Version:   version,
+		EventId:   130,
+		EventTime: timestamppb.New(now),
+		EventType: evenType,
+		Attributes: &historypb.HistoryEvent_WorkflowPropertiesModifiedExternallyEventAttributes{
+			WorkflowPropertiesModifiedExternallyEventAttributes: &historypb.WorkflowPropertiesModifiedExternallyEventAttributes{
+				NewWorkflowTaskTimeout: durationpb.New(time.Minute),
+			},
+		},
+	}
+	s.mockMutableState.EXPECT().ApplyWorkflowPropertiesModifiedExternallyEvent(protomock.Eq(event)).Return(nil)
+	s.mockUpdateVersion(event)
+	s.mockMutableState.EXPECT().ClearStickyTaskQueue()
+
+	_, err := s.stateRebuilder.ApplyEvents(context.Background(), tests.NamespaceID, requestID, execution, s.toHistory(event), nil, "")
+	s.Nil(err)
+	s.Equal(event.TaskId, s.executionInfo.LastRunningClock)
+}
+
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowPropertiesModifiedExternally_VisibilityTask() {
+	version := int64(1)
+	requestID := uuid.New()
+
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      tests.RunID,
+	}
+
+	now := time.Now().UTC()
+	evenType := enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY
+	eventID := int64(130)
+	// task queue and memo are part of the visibility record, so changing either upserts it
+	for _, attributes := range []*historypb.WorkflowPropertiesModifiedExternallyEventAttributes{
+		{NewTaskQueue: "some random task queue"},
+		{UpsertedMemo: &commonpb.Memo{Fields: map[string]*commonpb.Payload{"some random key": payload.EncodeString("some random value")}}},
+	} {
+		eventID++
+		event := &historypb.HistoryEvent{
+			TaskId:    rand.Int63(),
+			Version:   version,
+			EventId:   eventID,
+			EventTime: timestamppb.New(now),
+			EventType: evenType,
+			Attributes: &historypb.HistoryEvent_WorkflowPropertiesModifiedExternallyEventAttributes{
+				WorkflowPropertiesModifiedExternallyEventAttributes: attributes,
+			},
+		}
+		s.mockMutableState.EXPECT().ApplyWorkflowPropertiesModifiedExternallyEvent(protomock.Eq(event)).Return(nil)
+		s.mockUpdateVersion(event)
+		s.mockTaskGenerator.EXPECT().GenerateUpsertVisibilityTask().Return(nil)
+		s.mockMutableState.EXPECT().ClearStickyTaskQueue()
+
+		_, err := s.stateRebuilder.ApplyEvents(context.Background(), tests.NamespaceID, requestID, execution, s.toHistory(event), nil, "")
+		s.Nil(err)
+		s.Equal(event.TaskId, s.executionInfo.LastRunningClock)
+	}
+}
+
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeWorkflowPropertiesModifiedExternally_NewTimeouts() {
+	version := int64(1)
+	requestID := uuid.New()
+
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      tests.RunID,
+	}
+
+	now := time.Now().UTC()
+	startEvent := &historypb.HistoryEvent{
+		EventId:   common.FirstEventID,
+		EventType: enumspb.EVENT_TYPE_WORKFLOW_EXECUTION_STARTED,
+	}
+	evenType := enumspb.EVENT_TYPE_WORKFLOW_PROPERTIES_MODIFIED_EXTERNALLY
+	event := &historypb.HistoryEvent{
+		TaskId:    rand.Int63(),
+		Version:   version,
+		EventId:   130,
+		EventTime: timestamppb.New(now),
+		EventType: evenType,
+		Attributes: &historypb.HistoryEvent_WorkflowPropertiesModifiedExternallyEventAttributes{
+			WorkflowPropertiesModifiedExternallyEventAttributes: &historypb.WorkflowPropertiesModifiedExternallyEventAttributes{
+				NewWorkflowRunTimeout:       durationpb.New(time.Hour),
+				NewWorkflowExecutionTimeout: durationpb.New(2 * time.Hour),
+			},
+		},
+	}
+	s.executionInfo.WorkflowExecutionTimerTaskStatus = TimerTaskStatusCreated
+	s.mockMutableState.EXPECT().ApplyWorkflowPropertiesModifiedExternallyEvent(protomock.Eq(event)).Return(nil)
+	s.mockUpdateVersion(event)
+	s.mockMutableState.EXPECT().GetStartEvent(gomock.Any()).Return(startEvent, nil)
+	s.mockTaskGenerator.EXPECT().GenerateWorkflowStartTasks(protomock.Eq(startEvent)).DoAndReturn(
+		func(_ *historypb.HistoryEvent) (int32, error) {
+			// the execution timer must be regenerated for the new execution timeout
+			s.Equal(int32(TimerTaskStatusNone), s.executionInfo.WorkflowExecutionTimerTaskStatus)
+			return TimerTaskStatusCreated, nil
+		},
+	)
+	s.mockMutableState.EXPECT().ClearStickyTaskQueue()
+
+	_, err := s.stateRebuilder.ApplyEvents(context.Background(), tests.NamespaceID, requestID, execution, s.toHistory(event), nil, "")
+	s.Nil(err)
+	s.Equal(int32(TimerTaskStatusCreated), s.executionInfo.WorkflowExecutionTimerTaskStatus)
+	s.Equal(event.TaskId, s.executionInfo.LastRunningClock)
+}
+
 func (s *stateBuilderSuite) TestApplyEvents_EventTypeMarkerRecorded() {
 	version := int64(1)
 	requestID := uuid.New()
@@ -1508,6 +1626,42 @@ func (s *stateBuilderSuite) TestApplyEvents_EventTypeActivityTaskCompleted() {
 	s.Equal(event.TaskId, s.executionInfo.LastRunningClock)
 }
 
+func (s *stateBuilderSuite) TestApplyEvents_EventTypeActivityPropertiesModifiedExternally() {
+	version := int64(1)
+	requestID := uuid.New()
+
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: "some random workflow ID",
+		RunId:      tests.RunID,
+	}
+
+	now := time.Now().UTC()
+	evenType := enumspb.EVENT_TYPE_ACTIVITY_PROPERTIES_MODIFIED_EXTERNALLY
+	event := &historypb.HistoryEvent{
+		TaskId:    rand.Int63(),
+		Version:   version,
+		EventId:   130,
+		EventTime: timestamppb.New(now),
+		EventType: evenType,
+		Attributes: &historypb.HistoryEvent_ActivityPropertiesModifiedExternallyEventAttributes{
+			ActivityPropertiesModifiedExternallyEventAttributes: &historypb.ActivityPropertiesModifiedExternallyEventAttributes{
+				ScheduledEventId: 5,
+				NewRetryPolicy:   &commonpb.RetryPolicy{MaximumAttempts: 10},
+			},
+		},
+	}
+
+	s.mockMutableState.EXPECT().ApplyActivityPropertiesModifiedExternallyEvent(protomock.Eq(event)).Return(nil)
+	s.mockUpdateVersion(event)
+	// assertion on timer generated is in `mockUpdateVersion` function, since activity / user timer
+	// need to be refreshed each time
+	s.mockMutableState.EXPECT().ClearStickyTaskQueue()
+
+	_, err := s.stateRebuilder.ApplyEvents(context.Background(), tests.NamespaceID, requestID, execution, s.toHistory(event), nil, "")
+	s.Nil(err)
+	s.Equal(event.TaskId, s.executionInfo.LastRunningClock)
+}
+
 func (s *stateBuilderSuite) TestApplyEvents_EventTypeActivityTaskCancelRequested() {
 	version := int64(1)
 	requestID := uuid.New()
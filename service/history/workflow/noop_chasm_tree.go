@@ -33,6 +33,10 @@ func (*noopChasmTree) IsDirty() bool {
 	return false
 }
 
+func (*noopChasmTree) IsSpeculative() bool {
+	return false
+}
+
 func (*noopChasmTree) Terminate(chasm.TerminateComponentRequest) error {
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 	"context"
 
 	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
 	"go.temporal.io/api/serviceerror"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -227,6 +228,40 @@ func RefreshTasksForWorkflowStart(
 	return nil
 }
 
+// generateWorkflowPropertiesModifiedExternallyTasks generates the tasks needed after workflow
+// properties have been modified externally: timeout timers are regenerated if any timeout changed,
+// stale timers are ignored upon firing since their expiration time no longer matches mutable state.
+func generateWorkflowPropertiesModifiedExternallyTasks(
+	ctx context.Context,
+	mutableState historyi.MutableState,
+	taskGenerator TaskGenerator,
+	attributes *historypb.WorkflowPropertiesModifiedExternallyEventAttributes,
+) error {
+	if attributes.NewWorkflowRunTimeout != nil || attributes.NewWorkflowExecutionTimeout != nil {
+		startEvent, err := mutableState.GetStartEvent(ctx)
+		if err != nil {
+			return err
+		}
+
+		executionInfo := mutableState.GetExecutionInfo()
+		if attributes.NewWorkflowExecutionTimeout != nil {
+			executionInfo.WorkflowExecutionTimerTaskStatus = TimerTaskStatusNone
+		}
+		executionInfo.WorkflowExecutionTimerTaskStatus, err = taskGenerator.GenerateWorkflowStartTasks(
+			startEvent,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Task queue and memo are the only properties that are part of visibility records.
+	if attributes.NewTaskQueue == "" && attributes.UpsertedMemo == nil {
+		return nil
+	}
+	return taskGenerator.GenerateUpsertVisibilityTask()
+}
+
 func (r *TaskRefresherImpl) refreshTasksForWorkflowClose(
 	ctx context.Context,
 	mutableState historyi.MutableState,
@@ -11,6 +11,7 @@ import (
 	persistencespb "go.temporal.io/server/api/persistence/v1"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/effect"
+	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/worker_versioning"
 	"go.temporal.io/server/components/callbacks"
@@ -315,3 +316,31 @@ func PersistenceCallbackToAPICallback(cb *persistencespb.Callback) (*commonpb.Ca
 	}
 	return res, nil
 }
+
+// GetFairnessKey returns the fairness key for the workflow and activity tasks of a workflow:
+// its value of the keyword search attribute named by history.fairnessKeySearchAttribute. It
+// returns "" if the setting or the search attribute isn't set.
+func GetFairnessKey(shardContext historyi.ShardContext, mutableState historyi.MutableState) string {
+	nsName := mutableState.GetNamespaceEntry().Name()
+	alias := shardContext.GetConfig().FairnessKeySearchAttribute(nsName.String())
+	if alias == "" {
+		return ""
+	}
+	// search attributes are stored under their field name, which differs from the alias for
+	// visibility stores with custom search attribute mapping.
+	fieldName := alias
+	if mapper, err := shardContext.GetSearchAttributesMapperProvider().GetMapper(nsName); err == nil && mapper != nil {
+		if name, err := mapper.GetFieldName(alias, nsName.String()); err == nil {
+			fieldName = name
+		}
+	}
+	saPayload, ok := mutableState.GetExecutionInfo().GetSearchAttributes()[fieldName]
+	if !ok {
+		return ""
+	}
+	var key string
+	if err := payload.Decode(saPayload, &key); err != nil {
+		return ""
+	}
+	return key
+}
@@ -2,6 +2,8 @@ package matching
 
 import (
 	"context"
+	"slices"
+	"sync"
 	"testing"
 	"time"
 
@@ -303,3 +305,43 @@ func (s *BacklogManagerTestSuite) TestApproximateBacklogCount_NotIncrementedBySp
 	s.Equal(int64(0), s.blm.TotalApproximateBacklogCount(),
 		"backlog count should not be incremented")
 }
+
+func (s *BacklogManagerTestSuite) TestFairnessOnlyOrdersLoadedTasks() {
+	if !s.newMatcher {
+		s.T().Skip("fairness keys are only used by the new backlog manager")
+	}
+	blm := s.blm.(*priBacklogManagerImpl)
+	blm.config.GetTasksBatchSize = func() int { return 2 }
+	blm.config.GetTasksReloadAt = func() int { return 0 }
+
+	var lock sync.Mutex
+	var loadedKeys []string
+	s.ptqMgr.EXPECT().AddSpooledTask(gomock.Any()).DoAndReturn(func(task *internalTask) error {
+		lock.Lock()
+		defer lock.Unlock()
+		loadedKeys = append(loadedKeys, task.getFairnessKey())
+		return nil
+	}).AnyTimes()
+	getLoadedKeys := func() []string {
+		lock.Lock()
+		defer lock.Unlock()
+		return slices.Clone(loadedKeys)
+	}
+
+	blm.Start()
+	defer blm.Stop()
+	s.NoError(blm.WaitUntilInitialized(context.Background()))
+
+	for _, key := range []string{"a", "a", "a", "b"} {
+		s.NoError(blm.SpoolTask(&persistencespb.TaskInfo{
+			CreateTime:  timestamp.TimeNowPtrUtc(),
+			FairnessKey: key,
+		}))
+	}
+
+	// The task of key "b" would be dispatched next, but it stays in persistence behind the
+	// tasks of key "a" until the loaded ones are dispatched: keys only order loaded tasks.
+	s.Eventually(func() bool { return len(getLoadedKeys()) == 2 }, time.Second, 10*time.Millisecond)
+	s.Never(func() bool { return slices.Contains(getLoadedKeys(), "b") }, 200*time.Millisecond, 10*time.Millisecond)
+	s.Equal([]string{"a", "a"}, getLoadedKeys())
+}
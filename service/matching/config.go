@@ -71,6 +71,8 @@ type (
 		MembershipUnloadDelay                    dynamicconfig.DurationPropertyFn
 		TaskQueueInfoByBuildIdTTL                dynamicconfig.DurationPropertyFnWithTaskQueueFilter
 		PriorityLevels                           dynamicconfig.IntPropertyFnWithTaskQueueFilter
+		FairnessKeyWeightsSub                    dynamicconfig.TypedSubscribableWithTaskQueueFilter[map[string]float64]
+		FairnessKeyRateLimitSub                  dynamicconfig.TypedSubscribableWithTaskQueueFilter[float64]
 
 		// Time to hold a poll request before returning an empty response if there are no tasks
 		LongPollExpirationInterval dynamicconfig.DurationPropertyFnWithTaskQueueFilter
@@ -134,6 +136,8 @@ type (
 		MaxTaskDeleteBatchSize     func() int
 		TaskDeleteInterval         func() time.Duration
 		PriorityLevels             func() int32
+		FairnessKeyWeightsSub      func(func(map[string]float64)) (map[string]float64, func())
+		FairnessKeyRateLimitSub    func(func(float64)) (float64, func())
 
 		GetUserDataLongPollTimeout dynamicconfig.DurationPropertyFn
 		GetUserDataMinWaitTime     time.Duration
@@ -267,6 +271,8 @@ func NewConfig(
 		MembershipUnloadDelay:                    dynamicconfig.MatchingMembershipUnloadDelay.Get(dc),
 		TaskQueueInfoByBuildIdTTL:                dynamicconfig.TaskQueueInfoByBuildIdTTL.Get(dc),
 		PriorityLevels:                           dynamicconfig.MatchingPriorityLevels.Get(dc),
+		FairnessKeyWeightsSub:                    dynamicconfig.MatchingFairnessKeyWeights.Subscribe(dc),
+		FairnessKeyRateLimitSub:                  dynamicconfig.MatchingFairnessKeyRateLimit.Subscribe(dc),
 		MatchingDropNonRetryableTasks:            dynamicconfig.MatchingDropNonRetryableTasks.Get(dc),
 		MaxIDLengthLimit:                         dynamicconfig.MaxIDLengthLimit.Get(dc),
 
@@ -343,6 +349,12 @@ func newTaskQueueConfig(tq *tqid.TaskQueue, config *Config, ns namespace.Name) *
 		PriorityLevels: func() int32 {
 			return int32(config.PriorityLevels(ns.String(), taskQueueName, taskType))
 		},
+		FairnessKeyWeightsSub: func(cb func(map[string]float64)) (map[string]float64, func()) {
+			return config.FairnessKeyWeightsSub(ns.String(), taskQueueName, taskType, cb)
+		},
+		FairnessKeyRateLimitSub: func(cb func(float64)) (float64, func()) {
+			return config.FairnessKeyRateLimitSub(ns.String(), taskQueueName, taskType, cb)
+		},
 		GetUserDataLongPollTimeout: config.GetUserDataLongPollTimeout,
 		GetUserDataMinWaitTime:     1 * time.Second,
 		GetUserDataReturnBudget:    returnEmptyTaskTimeBudget,
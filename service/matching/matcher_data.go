@@ -4,14 +4,17 @@ import (
 	"container/heap"
 	"context"
 	"slices"
+	"strings"
 	"sync"
 	"time"
 
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common/clock"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/softassert"
 	"go.temporal.io/server/common/util"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 const invalidHeapIndex = -13 // use unusual value to stand out in panics
@@ -77,14 +80,34 @@ type taskPQ struct {
 
 	// rate limiter for overall queue
 	wholeQueueLimiter simpleLimiter
+
+	// per-fairness-key dispatch order and rate limits
+	fairness fairnessState
+
+	// max of the current time and the whole-queue ready time. when per-key rate limits are
+	// enabled, tasks are ordered by the ready time of their key, and all keys that are ready
+	// before this time compare as equally ready. updated by refreshOrder.
+	readyFloor int64
 }
 
 func (t *taskPQ) Add(task *internalTask) {
+	if task.source == enumsspb.TASK_SOURCE_DB_BACKLOG && task.forwardInfo == nil {
+		t.ages.record(task.event.Data.CreateTime, 1)
+	}
+	if !task.isPollForwarder {
+		t.fairness.record(task, 1)
+	}
 	heap.Push(t, task)
 }
 
 func (t *taskPQ) Remove(task *internalTask) {
 	heap.Remove(t, task.matchHeapIndex)
+	if task.source == enumsspb.TASK_SOURCE_DB_BACKLOG && task.forwardInfo == nil {
+		t.ages.record(task.event.Data.CreateTime, -1)
+	}
+	if !task.isPollForwarder {
+		t.fairness.record(task, -1)
+	}
 }
 
 func (t *taskPQ) readyTimeForTask(task *internalTask) int64 {
@@ -95,7 +118,8 @@ func (t *taskPQ) readyTimeForTask(task *internalTask) int64 {
 	// }
 	return max(
 		t.wholeQueueLimiter.ready,
-		// TODO(pri): add more times here, e.g. fairness key limit, per-task backoff
+		t.fairness.readyTime(task),
+		// TODO(pri): add more times here, e.g. per-task backoff
 	)
 }
 
@@ -106,6 +130,69 @@ func (t *taskPQ) consumeTokens(now int64, task *internalTask, tokens int64) {
 	}
 
 	t.wholeQueueLimiter.consume(now, tokens)
+	if ks := task.fairKey; ks != nil && t.fairness.keyRateLimited() {
+		t.updateKeys([]*fairnessKeyState{ks}, func() { t.fairness.consume(now, task, tokens) })
+	}
+	t.refreshOrder(now)
+}
+
+// dispatched advances the pass of the key of a task that was just matched.
+func (t *taskPQ) dispatched(task *internalTask) {
+	ks := task.fairKey
+	if ks == nil {
+		return
+	}
+	if len(t.fairness.keys) == 1 {
+		// all tasks share this key so their order doesn't change
+		t.fairness.dispatched(task)
+		return
+	}
+	t.updateKeys([]*fairnessKeyState{ks}, func() { t.fairness.dispatched(task) })
+}
+
+// refreshOrder moves readyFloor to now (or the whole-queue ready time, if later). Only keys
+// with a ready time between the old and new floor compare differently afterwards, so only
+// their tasks are re-sorted.
+func (t *taskPQ) refreshOrder(now int64) {
+	floor := max(now, t.wholeQueueLimiter.ready)
+	if floor == t.readyFloor {
+		return
+	} else if !t.fairness.keyRateLimited() {
+		t.readyFloor = floor
+		return
+	}
+	lo, hi := min(floor, t.readyFloor), max(floor, t.readyFloor)
+	var changed []*fairnessKeyState
+	for _, ks := range t.fairness.keys {
+		if ks.limiter.ready > lo && ks.limiter.ready <= hi {
+			changed = append(changed, ks)
+		}
+	}
+	t.updateKeys(changed, func() { t.readyFloor = floor })
+}
+
+// updateKeys calls update, which may change the pass or ready time of the given keys, and
+// re-sorts only the tasks of those keys. The tasks are taken out of the heap before update so
+// that the rest of the heap stays valid.
+func (t *taskPQ) updateKeys(keys []*fairnessKeyState, update func()) {
+	var tasks []*internalTask
+	for _, ks := range keys {
+		for task := range ks.tasks {
+			heap.Remove(t, task.matchHeapIndex)
+			tasks = append(tasks, task)
+		}
+	}
+	update()
+	for _, task := range tasks {
+		heap.Push(t, task)
+	}
+}
+
+// resetOrder re-establishes heap order from scratch, e.g. after per-key rate limits were
+// turned on or off.
+func (t *taskPQ) resetOrder(now int64) {
+	t.readyFloor = max(now, t.wholeQueueLimiter.ready)
+	heap.Init(t)
 }
 
 // implements heap.Interface
@@ -125,15 +212,16 @@ func (t *taskPQ) Less(i int, j int) bool {
 
 	a, b := t.heap[i], t.heap[j]
 
-	// TODO(pri): ready time is not task-specific yet, we only have whole-queue, so we don't
-	// need to consider this here yet.
-	// // ready time
-	// aready, bready := max(t.now, t.readyTimeForTask(a)), max(t.now, t.readyTimeForTask(b))
-	// if aready < bready {
-	// 	return true
-	// } else if aready > bready {
-	// 	return false
-	// }
+	// ready time: this only differs between tasks with per-key rate limits, otherwise the
+	// whole-queue limit applies equally to all tasks.
+	if t.fairness.keyRateLimited() {
+		aready, bready := max(t.readyFloor, t.fairness.readyTime(a)), max(t.readyFloor, t.fairness.readyTime(b))
+		if aready < bready {
+			return true
+		} else if aready > bready {
+			return false
+		}
+	}
 
 	// poll forwarder is always last
 	if !a.isPollForwarder && b.isPollForwarder {
@@ -151,6 +239,14 @@ func (t *taskPQ) Less(i int, j int) bool {
 		return false
 	}
 
+	// fairness key pass
+	apass, bpass := t.fairness.pass(a), t.fairness.pass(b)
+	if apass < bpass {
+		return true
+	} else if apass > bpass {
+		return false
+	}
+
 	// Note: sync match tasks have a fixed negative id.
 	// Query tasks will get 0 here.
 	var aid, bid int64
@@ -175,10 +271,6 @@ func (t *taskPQ) Push(x any) {
 	task := x.(*internalTask) // nolint:revive
 	task.matchHeapIndex = len(t.heap)
 	t.heap = append(t.heap, task)
-
-	if task.source == enumsspb.TASK_SOURCE_DB_BACKLOG && task.forwardInfo == nil {
-		t.ages.record(task.event.Data.CreateTime, 1)
-	}
 }
 
 // implements heap.Interface, do not call directly
@@ -187,11 +279,6 @@ func (t *taskPQ) Pop() any {
 	task := t.heap[last]
 	t.heap = t.heap[:last]
 	task.matchHeapIndex = invalidHeapIndex
-
-	if task.source == enumsspb.TASK_SOURCE_DB_BACKLOG && task.forwardInfo == nil {
-		t.ages.record(task.event.Data.CreateTime, -1)
-	}
-
 	return task
 }
 
@@ -207,6 +294,7 @@ func (t *taskPQ) ForEachTask(pred func(*internalTask) bool, post func(*internalT
 		if task.source == enumsspb.TASK_SOURCE_DB_BACKLOG && task.forwardInfo == nil {
 			t.ages.record(task.event.Data.CreateTime, -1)
 		}
+		t.fairness.record(task, -1)
 		post(task)
 		return true
 	})
@@ -243,7 +331,8 @@ func newMatcherData(config *taskQueueConfig, logger log.Logger, timeSource clock
 		timeSource: timeSource,
 		canForward: canForward,
 		tasks: taskPQ{
-			ages: newBacklogAgeTracker(),
+			ages:     newBacklogAgeTracker(),
+			fairness: newFairnessState(),
 		},
 	}
 }
@@ -255,6 +344,54 @@ func (d *matcherData) UpdateRateLimit(rate float64, burstDuration time.Duration)
 	d.tasks.wholeQueueLimiter.set(rate, burstDuration)
 }
 
+func (d *matcherData) UpdateFairnessKeyWeights(weights map[string]float64) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	d.tasks.fairness.weights = weights
+}
+
+// FairnessKeyStatus returns the dispatch state of the fairness keys that have tasks in the queue.
+func (d *matcherData) FairnessKeyStatus() []*taskqueuespb.FairnessKeyStatus {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	now := d.timeSource.Now().UnixNano()
+	f := &d.tasks.fairness
+	var status []*taskqueuespb.FairnessKeyStatus
+	for key, ks := range f.keys {
+		if len(ks.tasks) == 0 {
+			continue
+		}
+		keyStatus := &taskqueuespb.FairnessKeyStatus{
+			Key:         key,
+			Weight:      f.weight(key),
+			LoadedTasks: int64(len(ks.tasks)),
+			Pass:        ks.pass,
+		}
+		if f.keyRateLimited() && ks.limiter.ready > now {
+			keyStatus.RateLimitedUntil = timestamppb.New(time.Unix(0, ks.limiter.ready))
+		}
+		status = append(status, keyStatus)
+	}
+	slices.SortFunc(status, func(a, b *taskqueuespb.FairnessKeyStatus) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	return status
+}
+
+func (d *matcherData) UpdateFairnessKeyRateLimit(rate float64, burstDuration time.Duration) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	if rate == d.tasks.fairness.keyRate && burstDuration == d.tasks.fairness.keyBurst {
+		return
+	}
+	d.tasks.fairness.setKeyRate(rate, burstDuration)
+	d.tasks.resetOrder(d.timeSource.Now().UnixNano())
+	d.findAndWakeMatches() // tasks may be ready earlier now
+}
+
 func (d *matcherData) EnqueueTaskNoWait(task *internalTask) {
 	d.lock.Lock()
 	defer d.lock.Unlock()
@@ -452,7 +589,8 @@ func (d *matcherData) findAndWakeMatches() {
 	allowForwarding := d.canForward && d.allowForwarding()
 
 	now := d.timeSource.Now().UnixNano()
-	// TODO(pri): for task-specific ready time, we need to do a full/partial re-heapify here
+	d.tasks.fairness.gc(now)
+	d.tasks.refreshOrder(now)
 
 	for {
 		// search for highest priority match
@@ -476,6 +614,7 @@ func (d *matcherData) findAndWakeMatches() {
 
 		// TODO(pri): maybe we can allow tasks to have costs other than 1
 		d.tasks.consumeTokens(now, task, 1)
+		d.tasks.dispatched(task)
 		task.recycleToken = d.recycleToken
 
 		res := &matchResult{task: task, poller: poller}
@@ -622,3 +761,135 @@ func (s *simpleLimiter) consume(now int64, tokens int64) {
 	// burst from now and adding one interval.
 	s.ready = max(now, s.ready+s.burst.Nanoseconds()) - s.burst.Nanoseconds() + tokens*s.interval.Nanoseconds()
 }
+
+// fairness
+
+const defaultFairnessKeyWeight = 1.0
+
+// fairnessState implements start-time fair queueing across fairness keys. Each key has a
+// pass, and within a priority level, tasks are ordered by the pass of their key (and then by
+// task id as before). Dispatching a task advances its key's pass by 1/weight, so a key with
+// twice the weight advances half as fast and gets twice the dispatches. A key that becomes
+// active again starts at no less than the current virtual time, so it can't build up credit
+// while idle.
+type fairnessState struct {
+	weights     map[string]float64
+	keyRate     float64       // per-key dispatch rate, zero means no per-key limit
+	keyBurst    time.Duration // per-key burst duration
+	virtualTime float64       // pass of the key of the latest dispatched task
+
+	keys map[string]*fairnessKeyState
+	// keys with no tasks in the queue. they're removed by gc once they have no effect.
+	idle map[string]struct{}
+}
+
+type fairnessKeyState struct {
+	pass    float64                    // pass of the next task dispatched with this key
+	tasks   map[*internalTask]struct{} // tasks with this key in the queue
+	limiter simpleLimiter
+}
+
+func newFairnessState() fairnessState {
+	return fairnessState{
+		keys: make(map[string]*fairnessKeyState),
+		idle: make(map[string]struct{}),
+	}
+}
+
+func (f *fairnessState) weight(key string) float64 {
+	if w, ok := f.weights[key]; ok && w > 0 {
+		return w
+	}
+	return defaultFairnessKeyWeight
+}
+
+// record tracks the tasks per key and sets task.fairKey.
+func (f *fairnessState) record(task *internalTask, delta int) {
+	key := task.getFairnessKey()
+	ks, ok := f.keys[key]
+	if !ok {
+		ks = &fairnessKeyState{pass: f.virtualTime, tasks: make(map[*internalTask]struct{})}
+		if f.keyRateLimited() {
+			ks.limiter.set(f.keyRate, f.keyBurst)
+		}
+		f.keys[key] = ks
+	}
+	if len(ks.tasks) == 0 && delta > 0 {
+		// key becomes active
+		ks.pass = max(ks.pass, f.virtualTime)
+	}
+	if delta > 0 {
+		ks.tasks[task] = struct{}{}
+	} else {
+		delete(ks.tasks, task)
+	}
+	task.fairKey = ks
+	if len(ks.tasks) > 0 {
+		delete(f.idle, key)
+	} else {
+		f.idle[key] = struct{}{}
+	}
+}
+
+func (f *fairnessState) dispatched(task *internalTask) {
+	ks := task.fairKey
+	f.virtualTime = max(f.virtualTime, ks.pass)
+	ks.pass += 1 / f.weight(task.getFairnessKey())
+}
+
+// pass returns the pass used to order the task. Poll forwarders don't belong to a key.
+func (f *fairnessState) pass(task *internalTask) float64 {
+	if task.fairKey == nil {
+		return 0
+	}
+	return task.fairKey.pass
+}
+
+func (f *fairnessState) keyRateLimited() bool {
+	return f.keyRate > 0
+}
+
+func (f *fairnessState) setKeyRate(rate float64, burstDuration time.Duration) {
+	f.keyRate = max(rate, 0)
+	f.keyBurst = burstDuration
+	for _, ks := range f.keys {
+		if f.keyRateLimited() {
+			ks.limiter.set(f.keyRate, f.keyBurst)
+		} else {
+			ks.limiter = simpleLimiter{}
+		}
+	}
+}
+
+func (f *fairnessState) readyTime(task *internalTask) int64 {
+	if !f.keyRateLimited() || task.fairKey == nil {
+		return 0
+	}
+	return task.fairKey.limiter.ready
+}
+
+func (f *fairnessState) consume(now int64, task *internalTask, tokens int64) {
+	if !f.keyRateLimited() || task.fairKey == nil {
+		return
+	}
+	// note: if a token is recycled after the key was removed by gc, this has no effect
+	task.fairKey.limiter.consume(now, tokens)
+}
+
+// gc removes state for idle keys once neither their rate limit nor their pass would affect
+// tasks added with that key later.
+func (f *fairnessState) gc(now int64) {
+	if len(f.idle) == len(f.keys) {
+		// no tasks at all: like in start-time fair queueing when the server is idle, virtual
+		// time jumps to the largest pass so all keys start over evenly.
+		for _, ks := range f.keys {
+			f.virtualTime = max(f.virtualTime, ks.pass)
+		}
+	}
+	for key := range f.idle {
+		if ks := f.keys[key]; ks.limiter.ready <= now && ks.pass <= f.virtualTime {
+			delete(f.keys, key)
+			delete(f.idle, key)
+		}
+	}
+}
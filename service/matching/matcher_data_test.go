@@ -365,6 +365,186 @@ func (s *MatcherDataSuite) TestOrder() {
 	// poll forwarder is last to match, but it does a half-match so we won't see it here
 }
 
+func (s *MatcherDataSuite) newBacklogTaskWithFairnessKey(id int64, key string) *internalTask {
+	t := s.newBacklogTask(id, 0, nil)
+	t.event.Data.FairnessKey = key
+	return t
+}
+
+func (s *MatcherDataSuite) pollFairnessKeys(n int) []string {
+	keys := make([]string, n)
+	for i := range keys {
+		keys[i] = s.pollFakeTime(time.Second).task.getFairnessKey()
+	}
+	return keys
+}
+
+func (s *MatcherDataSuite) TestFairnessOrder() {
+	for i := range 6 {
+		s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(1+int64(i), "a"))
+	}
+	for i := range 3 {
+		s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(11+int64(i), "b"))
+	}
+
+	s.Equal([]string{"a", "b", "a", "b", "a", "b", "a", "a", "a"}, s.pollFairnessKeys(9))
+}
+
+func (s *MatcherDataSuite) TestFairnessPriorityFirst() {
+	t1 := s.newBacklogTaskWithPriority(1, 0, nil, &commonpb.Priority{PriorityKey: 2})
+	t1.event.Data.FairnessKey = "a"
+	t2 := s.newBacklogTaskWithPriority(2, 0, nil, &commonpb.Priority{PriorityKey: 1})
+	t2.event.Data.FairnessKey = "b"
+	t3 := s.newBacklogTaskWithPriority(3, 0, nil, &commonpb.Priority{PriorityKey: 1})
+	t3.event.Data.FairnessKey = "b"
+
+	s.md.EnqueueTaskNoWait(t1)
+	s.md.EnqueueTaskNoWait(t2)
+	s.md.EnqueueTaskNoWait(t3)
+
+	// b is ahead by pass after t2, but priority comes first
+	s.Equal(t2, s.pollFakeTime(time.Second).task)
+	s.Equal(t3, s.pollFakeTime(time.Second).task)
+	s.Equal(t1, s.pollFakeTime(time.Second).task)
+}
+
+func (s *MatcherDataSuite) TestFairnessWeights() {
+	s.md.UpdateFairnessKeyWeights(map[string]float64{"a": 2})
+
+	for i := range 6 {
+		s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(1+int64(i), "a"))
+		s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(11+int64(i), "b"))
+	}
+
+	s.Equal([]string{"a", "b", "a", "a", "b", "a"}, s.pollFairnessKeys(6))
+}
+
+func (s *MatcherDataSuite) TestFairnessNewKeyGetsNoCredit() {
+	for i := range 4 {
+		s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(1+int64(i), "a"))
+	}
+	s.Equal([]string{"a", "a"}, s.pollFairnessKeys(2))
+
+	// b shows up late and shares from now on, instead of catching up with a
+	for i := range 3 {
+		s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(11+int64(i), "b"))
+	}
+	s.Equal([]string{"b", "a", "b", "a", "b"}, s.pollFairnessKeys(5))
+}
+
+func (s *MatcherDataSuite) TestFairnessKeyRateLimit() {
+	// one task per second per key, with no burst
+	s.md.UpdateFairnessKeyRateLimit(1, 0)
+	s.md.UpdateFairnessKeyWeights(map[string]float64{"a": 100})
+
+	a1 := s.newBacklogTaskWithFairnessKey(1, "a")
+	a2 := s.newBacklogTaskWithFairnessKey(2, "a")
+	b1 := s.newBacklogTaskWithFairnessKey(3, "b")
+	b2 := s.newBacklogTaskWithFairnessKey(4, "b")
+	for _, t := range []*internalTask{a1, a2, b1, b2} {
+		s.md.EnqueueTaskNoWait(t)
+	}
+
+	s.Equal(a1, s.pollFakeTime(time.Second).task)
+	s.Equal(b1, s.pollFakeTime(time.Second).task)
+
+	// a2 is ahead of b2 by pass, but both keys are rate limited now
+	s.ts.Advance(time.Second)
+	s.Equal(a2, s.pollFakeTime(time.Second).task)
+
+	// b was rate limited for the same time as a, so it's ready too. a isn't.
+	s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(5, "a"))
+	s.Equal(b2, s.pollFakeTime(time.Second).task)
+}
+
+func (s *MatcherDataSuite) TestFairnessIdleKeysAreRemoved() {
+	s.md.UpdateFairnessKeyRateLimit(1, 0)
+
+	s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(1, "a"))
+	s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(2, "b"))
+	s.Equal([]string{"a", "b"}, s.pollFairnessKeys(2))
+
+	s.md.lock.Lock()
+	s.Len(s.md.tasks.fairness.keys, 2, "rate limited keys are kept until their limit is caught up")
+	s.md.lock.Unlock()
+
+	s.ts.Advance(time.Second)
+	s.md.rematchAfterTimer()
+
+	s.md.lock.Lock()
+	s.Empty(s.md.tasks.fairness.keys)
+	s.md.lock.Unlock()
+
+	// a key that's only idle for a moment keeps its pass while others are active
+	s.md.UpdateFairnessKeyRateLimit(0, 0)
+	for i := range 3 {
+		s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(11+int64(i), "b"))
+	}
+	s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(21, "c"))
+	s.Equal([]string{"b", "c"}, s.pollFairnessKeys(2))
+	s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(22, "c"))
+	s.Equal([]string{"b", "c", "b"}, s.pollFairnessKeys(3))
+
+	s.md.lock.Lock()
+	defer s.md.lock.Unlock()
+	s.Len(s.md.tasks.fairness.keys, 2)
+}
+
+func (s *MatcherDataSuite) TestFairnessHeapStaysOrdered() {
+	// dispatches and rate limits only re-sort the tasks of the affected keys. check that the
+	// heap is still valid after each one.
+	s.md.UpdateFairnessKeyWeights(map[string]float64{"a": 3, "b": 2})
+
+	checkHeap := func() {
+		s.md.lock.Lock()
+		defer s.md.lock.Unlock()
+		for i := 1; i < s.md.tasks.Len(); i++ {
+			s.False(s.md.tasks.Less(i, (i-1)/2), "heap order violated at %d", i)
+		}
+	}
+
+	keys := []string{"a", "b", "c", "d"}
+	for i := range 200 {
+		t := s.newBacklogTaskWithPriority(int64(i), 0, nil, &commonpb.Priority{PriorityKey: int32(1 + rand.Intn(3))})
+		t.event.Data.FairnessKey = keys[rand.Intn(len(keys))]
+		s.md.EnqueueTaskNoWait(t)
+	}
+	checkHeap()
+	for range 100 {
+		s.NotNil(s.pollFakeTime(time.Second).task)
+		checkHeap()
+	}
+
+	s.md.UpdateFairnessKeyRateLimit(1000, 0)
+	for range 100 {
+		// some key is always ready again after one interval
+		s.ts.Advance(time.Millisecond)
+		s.NotNil(s.pollFakeTime(time.Second).task)
+		checkHeap()
+	}
+}
+
+func (s *MatcherDataSuite) TestFairnessKeyStatus() {
+	s.md.UpdateFairnessKeyWeights(map[string]float64{"a": 2})
+	for i := range 3 {
+		s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(1+int64(i), "a"))
+	}
+	s.md.EnqueueTaskNoWait(s.newBacklogTaskWithFairnessKey(11, "b"))
+	s.Equal([]string{"a"}, s.pollFairnessKeys(1))
+
+	status := s.md.FairnessKeyStatus()
+	s.Len(status, 2)
+	s.Equal("a", status[0].Key)
+	s.Equal(2.0, status[0].Weight)
+	s.EqualValues(2, status[0].LoadedTasks)
+	s.Equal(0.5, status[0].Pass)
+	s.Nil(status[0].RateLimitedUntil)
+	s.Equal("b", status[1].Key)
+	s.Equal(1.0, status[1].Weight)
+	s.EqualValues(1, status[1].LoadedTasks)
+	s.Equal(0.0, status[1].Pass)
+}
+
 func (s *MatcherDataSuite) TestPollForwardSuccess() {
 	t1 := s.newBacklogTask(1, 0, nil)
 	t2 := s.newBacklogTask(2, 0, nil)
@@ -529,6 +529,7 @@ func (e *matchingEngineImpl) AddWorkflowTask(
 		CreateTime:       timestamppb.New(now),
 		VersionDirective: addRequest.VersionDirective,
 		Priority:         addRequest.Priority,
+		FairnessKey:      addRequest.FairnessKey,
 	}
 
 	return pm.AddTask(ctx, addTaskParams{
@@ -568,6 +569,7 @@ func (e *matchingEngineImpl) AddActivityTask(
 		VersionDirective: addRequest.VersionDirective,
 		Stamp:            addRequest.Stamp,
 		Priority:         addRequest.Priority,
+		FairnessKey:      addRequest.FairnessKey,
 	}
 
 	return pm.AddTask(ctx, addTaskParams{
@@ -3720,7 +3720,7 @@ func (m *testTaskManager) GetTasks(
 		if taskID < request.InclusiveMinTaskID {
 			continue
 		}
-		if taskID >= request.ExclusiveMaxTaskID {
+		if taskID >= request.ExclusiveMaxTaskID || (request.PageSize > 0 && len(tasks) >= request.PageSize) {
 			break
 		}
 		tasks = append(tasks, it.Value().(*persistencespb.AllocatedTaskInfo))
@@ -491,6 +491,13 @@ func (c *physicalTaskQueueManagerImpl) GetInternalTaskQueueStatus() []*taskqueue
 	return c.backlogMgr.InternalStatus()
 }
 
+func (c *physicalTaskQueueManagerImpl) GetFairnessKeyStatus() []*taskqueuespb.FairnessKeyStatus {
+	if c.priMatcher == nil {
+		return nil
+	}
+	return c.priMatcher.FairnessKeyStatus()
+}
+
 func (c *physicalTaskQueueManagerImpl) TrySyncMatch(ctx context.Context, task *internalTask) (bool, error) {
 	if !task.isForwarded() {
 		// request sent by history service
@@ -49,6 +49,9 @@ type (
 		LegacyDescribeTaskQueue(includeTaskQueueStatus bool) *matchingservice.DescribeTaskQueueResponse
 		GetStats() *taskqueuepb.TaskQueueStats
 		GetInternalTaskQueueStatus() []*taskqueuespb.InternalTaskQueueStatus
+		// GetFairnessKeyStatus returns the dispatch state of each fairness key with loaded tasks.
+		// It's empty when the queue doesn't use the priority matcher.
+		GetFairnessKeyStatus() []*taskqueuespb.FairnessKeyStatus
 		UnloadFromPartitionManager(unloadCause)
 		QueueKey() *PhysicalTaskQueueKey
 		// MakePollerScalingDecision makes a decision on whether to scale pollers up or down based on the current state
@@ -129,6 +129,20 @@ func (mr *MockphysicalTaskQueueManagerMockRecorder) GetAllPollerInfo() *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllPollerInfo", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).GetAllPollerInfo))
 }
 
+// GetFairnessKeyStatus mocks base method.
+func (m *MockphysicalTaskQueueManager) GetFairnessKeyStatus() []*taskqueue0.FairnessKeyStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFairnessKeyStatus")
+	ret0, _ := ret[0].([]*taskqueue0.FairnessKeyStatus)
+	return ret0
+}
+
+// GetFairnessKeyStatus indicates an expected call of GetFairnessKeyStatus.
+func (mr *MockphysicalTaskQueueManagerMockRecorder) GetFairnessKeyStatus() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFairnessKeyStatus", reflect.TypeOf((*MockphysicalTaskQueueManager)(nil).GetFairnessKeyStatus))
+}
+
 // GetInternalTaskQueueStatus mocks base method.
 func (m *MockphysicalTaskQueueManager) GetInternalTaskQueueStatus() []*taskqueue0.InternalTaskQueueStatus {
 	m.ctrl.T.Helper()
@@ -162,6 +162,11 @@ func (c *priBacklogManagerImpl) loadSubqueuesLocked(subqueues []persistencespb.S
 	}
 }
 
+// getSubqueueForPriority returns the subqueue a task of the given priority is written to.
+// Subqueues are only split by priority, not by fairness key: a subqueue is read in task id
+// order, at most GetTasksBatchSize tasks at a time, and fairness keys only order the tasks
+// that were read into memory. So a key with a large backlog still delays the tasks of other
+// keys that are only in the database until its tasks ahead of them are loaded.
 func (c *priBacklogManagerImpl) getSubqueueForPriority(priority int32) int {
 	levels := c.config.PriorityLevels()
 	if priority == 0 {
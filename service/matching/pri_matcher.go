@@ -9,6 +9,7 @@ import (
 	"go.temporal.io/api/serviceerror"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/matchingservice/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/backoff"
 	"go.temporal.io/server/common/clock"
@@ -44,8 +45,9 @@ type priTaskMatcher struct {
 	adminNsRate float64
 	adminTqRate float64
 	dynamicRate float64
+	fairKeyRate float64
 
-	cancel1, cancel2 func()
+	cancel1, cancel2, cancel3, cancel4 func()
 }
 
 type waitingPoller struct {
@@ -113,8 +115,13 @@ func newPriTaskMatcher(
 
 	tm.adminNsRate, tm.cancel1 = config.AdminNamespaceToPartitionRateSub(tm.setAdminNsRate)
 	tm.adminTqRate, tm.cancel2 = config.AdminNamespaceTaskQueueToPartitionRateSub(tm.setAdminTqRate)
+	tm.fairKeyRate, tm.cancel3 = config.FairnessKeyRateLimitSub(tm.setFairnessKeyRate)
 	tm.setLimitLocked()
 
+	var weights map[string]float64
+	weights, tm.cancel4 = config.FairnessKeyWeightsSub(tm.data.UpdateFairnessKeyWeights)
+	tm.data.UpdateFairnessKeyWeights(weights)
+
 	return tm
 }
 
@@ -143,6 +150,8 @@ func (tm *priTaskMatcher) Start() {
 func (tm *priTaskMatcher) Stop() {
 	tm.cancel1()
 	tm.cancel2()
+	tm.cancel3()
+	tm.cancel4()
 }
 
 func (tm *priTaskMatcher) forwardTasks(lim quotas.RateLimiter, retrier backoff.Retrier) {
@@ -502,12 +511,21 @@ func (tm *priTaskMatcher) setAdminTqRate(rps float64) {
 	tm.setLimitLocked()
 }
 
+func (tm *priTaskMatcher) setFairnessKeyRate(rps float64) {
+	tm.limiterLock.Lock()
+	defer tm.limiterLock.Unlock()
+	tm.fairKeyRate = rps
+	tm.setLimitLocked()
+}
+
 func (tm *priTaskMatcher) setLimitLocked() {
 	perPartitionDynamicRate := tm.dynamicRate
+	perPartitionFairKeyRate := tm.fairKeyRate
 
 	if n := tm.numPartitions(); n > 0 {
 		// divide the rate equally across all partitions
 		perPartitionDynamicRate /= float64(n)
+		perPartitionFairKeyRate /= float64(n)
 	}
 
 	rate := min(
@@ -517,6 +535,12 @@ func (tm *priTaskMatcher) setLimitLocked() {
 	)
 
 	tm.data.UpdateRateLimit(rate, burstDuration)
+	tm.data.UpdateFairnessKeyRateLimit(perPartitionFairKeyRate, burstDuration)
+}
+
+// FairnessKeyStatus returns the backlog and dispatch state of each fairness key
+func (tm *priTaskMatcher) FairnessKeyStatus() []*taskqueuespb.FairnessKeyStatus {
+	return tm.data.FairnessKeyStatus()
 }
 
 // Rate returns the current dynamic rate setting
@@ -23,6 +23,20 @@ type (
 		name    string
 		handler metrics.Handler
 	}
+	priMetricsHistogram struct {
+		name    string
+		unit    metrics.MetricUnit
+		handler metrics.Handler
+	}
+	priMetricsBatchHandler struct {
+		priMetricHandler
+		batch metrics.BatchHandler
+	}
+)
+
+var (
+	_ metrics.Handler      = priMetricHandler{}
+	_ metrics.BatchHandler = priMetricsBatchHandler{}
 )
 
 // TODO(pri): cleanup; delete this
@@ -47,16 +61,26 @@ func (p priMetricHandler) Gauge(name string) metrics.GaugeIface {
 	return priMetricsGauge{name: name, handler: p.handler}
 }
 
-func (p priMetricHandler) WithTags(...metrics.Tag) metrics.Handler {
-	panic("not implemented")
+func (p priMetricHandler) WithTags(tags ...metrics.Tag) metrics.Handler {
+	return newPriMetricsHandler(p.handler.WithTags(tags...))
 }
 
-func (p priMetricHandler) Histogram(string, metrics.MetricUnit) metrics.HistogramIface {
-	panic("not implemented")
+func (p priMetricHandler) Histogram(name string, unit metrics.MetricUnit) metrics.HistogramIface {
+	return priMetricsHistogram{name: name, unit: unit, handler: p.handler}
 }
 
-func (p priMetricHandler) StartBatch(string) metrics.BatchHandler {
-	panic("not implemented")
+// StartBatch returns a BatchHandler which emits both the original and the prefixed
+// metrics as part of the same batch of the underlying handler.
+func (p priMetricHandler) StartBatch(name string) metrics.BatchHandler {
+	batch := p.handler.StartBatch(name)
+	return priMetricsBatchHandler{
+		priMetricHandler: newPriMetricsHandler(batch),
+		batch:            batch,
+	}
+}
+
+func (b priMetricsBatchHandler) Close() error {
+	return b.batch.Close()
 }
 
 func (c priMetricsCounter) Record(i int64, tag ...metrics.Tag) {
@@ -74,6 +98,11 @@ func (t priMetricsGauge) Record(v float64, tag ...metrics.Tag) {
 	t.handler.Gauge(withPriPrefix(t.name)).Record(v, tag...)
 }
 
+func (h priMetricsHistogram) Record(v int64, tag ...metrics.Tag) {
+	h.handler.Histogram(h.name, h.unit).Record(v, tag...)
+	h.handler.Histogram(withPriPrefix(h.name), h.unit).Record(v, tag...)
+}
+
 func withPriPrefix(name string) string {
 	return "pri_" + name
 }
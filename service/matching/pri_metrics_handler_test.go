@@ -0,0 +1,81 @@
+package matching
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/metrics/metricstest"
+)
+
+func TestPriMetricsHandler_RecordsBothNames(t *testing.T) {
+	t.Parallel()
+
+	captureHandler := metricstest.NewCaptureHandler()
+	capture := captureHandler.StartCapture()
+	defer captureHandler.StopCapture(capture)
+
+	handler := newPriMetricsHandler(captureHandler)
+	handler.Counter("counter").Record(1)
+	handler.Gauge("gauge").Record(2.5)
+	handler.Timer("timer").Record(time.Second)
+	handler.Histogram("histogram", metrics.MetricUnit(metrics.Bytes)).Record(10)
+
+	snapshot := capture.Snapshot()
+	for name, value := range map[string]any{
+		"counter":   int64(1),
+		"gauge":     2.5,
+		"timer":     time.Second,
+		"histogram": int64(10),
+	} {
+		for _, n := range []string{name, withPriPrefix(name)} {
+			require.Len(t, snapshot[n], 1, n)
+			require.Equal(t, value, snapshot[n][0].Value, n)
+		}
+	}
+	require.Equal(t, metrics.MetricUnit(metrics.Bytes), snapshot[withPriPrefix("histogram")][0].Unit)
+}
+
+func TestPriMetricsHandler_WithTags(t *testing.T) {
+	t.Parallel()
+
+	captureHandler := metricstest.NewCaptureHandler()
+	capture := captureHandler.StartCapture()
+	defer captureHandler.StopCapture(capture)
+
+	handler := newPriMetricsHandler(captureHandler).WithTags(metrics.TaskPriorityTag("3"))
+	metrics.TaskDispatchLatencyPerTaskQueue.With(handler).Record(time.Second, metrics.StringTag("source", "backlog"))
+
+	snapshot := capture.Snapshot()
+	for _, n := range []string{
+		metrics.TaskDispatchLatencyPerTaskQueue.Name(),
+		withPriPrefix(metrics.TaskDispatchLatencyPerTaskQueue.Name()),
+	} {
+		require.Len(t, snapshot[n], 1, n)
+		require.Equal(t, map[string]string{
+			metrics.TaskPriorityTagName: "3",
+			"source":                    "backlog",
+		}, snapshot[n][0].Tags)
+	}
+}
+
+func TestPriMetricsHandler_StartBatch(t *testing.T) {
+	t.Parallel()
+
+	captureHandler := metricstest.NewCaptureHandler()
+	capture := captureHandler.StartCapture()
+	defer captureHandler.StopCapture(capture)
+
+	batch := newPriMetricsHandler(captureHandler).StartBatch("batch")
+	batch.Counter("counter").Record(1)
+	batch.WithTags(metrics.TaskPriorityTag("1")).Histogram("histogram", metrics.MetricUnit(metrics.Milliseconds)).Record(5)
+	require.NoError(t, batch.Close())
+
+	snapshot := capture.Snapshot()
+	require.Len(t, snapshot["counter"], 1)
+	require.Len(t, snapshot[withPriPrefix("counter")], 1)
+	require.Len(t, snapshot["histogram"], 1)
+	require.Len(t, snapshot[withPriPrefix("histogram")], 1)
+	require.Equal(t, "1", snapshot[withPriPrefix("histogram")][0].Tags[metrics.TaskPriorityTagName])
+}
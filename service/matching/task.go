@@ -66,6 +66,7 @@ type (
 		waitableMatchResult
 		forwardCtx      context.Context // non-nil for sync match task only
 		isPollForwarder bool
+		fairKey         *fairnessKeyState // set by taskPQ while the task is in the queue
 	}
 
 	// taskResponse is used to report the result of either a match with a local poller,
@@ -256,6 +257,15 @@ func (task *internalTask) getPriority() *commonpb.Priority {
 	return nil
 }
 
+// getFairnessKey returns the key used to share dispatch fairly between tenants of the same
+// task queue and priority level. Query and nexus tasks share the default key.
+func (task *internalTask) getFairnessKey() string {
+	if task.event != nil {
+		return task.event.AllocatedTaskInfo.GetData().GetFairnessKey()
+	}
+	return ""
+}
+
 // finish marks a task as finished. Should be called after a poller picks up a task
 // and marks it as started. If the task is unable to marked as started, then this
 // method should be called with a non-nil error argument.
@@ -636,6 +636,7 @@ func (pm *taskQueuePartitionManagerImpl) Describe(
 		}
 		if internalTaskQueueStatus {
 			vInfo.PhysicalTaskQueueInfo.InternalTaskQueueStatus = physicalQueue.GetInternalTaskQueueStatus()
+			vInfo.PhysicalTaskQueueInfo.FairnessKeyStatus = physicalQueue.GetFairnessKeyStatus()
 		}
 
 		// The following assigns buildID to either a v2 based buildID or a versionID representing a worker-deployment version.
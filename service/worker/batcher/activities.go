@@ -11,9 +11,12 @@ import (
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
 	"go.temporal.io/api/serviceerror"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
+	updatepb "go.temporal.io/api/update/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/activity"
 	sdkclient "go.temporal.io/sdk/client"
+	batchspb "go.temporal.io/server/api/batch/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
@@ -41,6 +44,13 @@ type activities struct {
 	namespaceID namespace.ID
 	rps         dynamicconfig.IntPropertyFnWithNamespaceFilter
 	concurrency dynamicconfig.IntPropertyFnWithNamespaceFilter
+	maxResults  dynamicconfig.IntPropertyFnWithNamespaceFilter
+}
+
+type taskResult struct {
+	err error
+	// result for the workflow, only set if the batch records results
+	item *batchspb.BatchOperationItemResult
 }
 
 func (a *activities) checkNamespace(namespace string) error {
@@ -72,6 +82,21 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 			return hbd, err
 		}
 	}
+	// Deserialize update and signal with start options if set
+	if b := batchParams.UpdateParams.Update; b != nil {
+		batchParams.UpdateParams.update = &batchspb.BatchOperationUpdateWorkflow{}
+		if err := batchParams.UpdateParams.update.Unmarshal(b); err != nil {
+			logger.Error("Failed to deserialize batch update", tag.Error(err))
+			return hbd, err
+		}
+	}
+	if b := batchParams.SignalWithStartParams.SignalWithStart; b != nil {
+		batchParams.SignalWithStartParams.signalWithStart = &batchspb.BatchOperationSignalWithStart{}
+		if err := batchParams.SignalWithStartParams.signalWithStart.Unmarshal(b); err != nil {
+			logger.Error("Failed to deserialize batch signal with start", tag.Error(err))
+			return hbd, err
+		}
+	}
 
 	sdkClient := a.ClientFactory.NewClient(sdkclient.Options{
 		Namespace:     batchParams.Namespace,
@@ -86,6 +111,18 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 		}
 	}
 
+	results, err := hbd.GetResults()
+	if err != nil {
+		logger.Error("Failed to recover results from last heartbeat, start over from beginning", tag.Error(err))
+		hbd = HeartBeatDetails{}
+		results = &batchspb.BatchOperationResults{}
+		startOver = true
+	}
+	maxResults := a.maxResults(a.namespace.String())
+	// only the job id is known when the batch runs: use it as update id so that an update that
+	// is retried isn't applied twice
+	jobID := activity.GetInfo(ctx).WorkflowExecution.ID
+
 	adjustedQuery := a.adjustQuery(batchParams)
 
 	if startOver {
@@ -108,13 +145,16 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 	burstLimit := int(math.Ceil(rps)) // should never be zero because everything would be rejected
 	rateLimiter := rate.NewLimiter(rateLimit, burstLimit)
 	taskCh := make(chan taskDetail, pageSize)
-	respCh := make(chan error, pageSize)
+	respCh := make(chan taskResult, pageSize)
 	for i := 0; i < a.getOperationConcurrency(batchParams.Concurrency); i++ {
-		go startTaskProcessor(ctx, batchParams, taskCh, respCh, rateLimiter, sdkClient, a.FrontendClient, metricsHandler, logger)
+		go startTaskProcessor(ctx, batchParams, jobID, taskCh, respCh, rateLimiter, sdkClient, a.FrontendClient, metricsHandler, logger)
 	}
 
 	for {
-		executions := batchParams.Executions
+		var tasks []taskDetail
+		for _, execution := range batchParams.Executions {
+			tasks = append(tasks, taskDetail{execution: execution})
+		}
 		pageToken := hbd.PageToken
 		if len(adjustedQuery) > 0 {
 			resp, err := sdkClient.ListWorkflow(ctx, &workflowservice.ListWorkflowExecutionsRequest{
@@ -129,21 +169,23 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 			}
 			pageToken = resp.NextPageToken
 			for _, wf := range resp.Executions {
-				executions = append(executions, wf.Execution)
+				tasks = append(tasks, taskDetail{
+					execution:    wf.Execution,
+					workflowType: wf.Type,
+					taskQueue:    wf.TaskQueue,
+				})
 			}
 		}
 
-		batchCount := len(executions)
+		batchCount := len(tasks)
 		if batchCount <= 0 {
 			break
 		}
 		// send all tasks
-		for _, wf := range executions {
-			taskCh <- taskDetail{
-				execution: wf,
-				attempts:  1,
-				hbd:       hbd,
-			}
+		for _, task := range tasks {
+			task.attempts = 1
+			task.hbd = hbd
+			taskCh <- task
 		}
 
 		succCount := 0
@@ -152,12 +194,15 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 	Loop:
 		for {
 			select {
-			case err := <-respCh:
-				if err == nil {
+			case res := <-respCh:
+				if res.err == nil && res.item.GetError() == "" {
 					succCount++
 				} else {
 					errCount++
 				}
+				if res.item != nil {
+					addItemResult(results, res.item, maxResults)
+				}
 				if succCount+errCount == batchCount {
 					break Loop
 				}
@@ -172,6 +217,12 @@ func (a *activities) BatchActivity(ctx context.Context, batchParams BatchParams)
 		hbd.PageToken = pageToken
 		hbd.SuccessCount += succCount
 		hbd.ErrorCount += errCount
+		if batchParams.recordsResults() {
+			if hbd.Results, err = results.Marshal(); err != nil {
+				logger.Error("Failed to serialize batch operation results", tag.Error(err))
+				return HeartBeatDetails{}, err
+			}
+		}
 		activity.RecordHeartbeat(ctx, hbd)
 
 		if len(hbd.PageToken) == 0 {
@@ -199,7 +250,7 @@ func (a *activities) adjustQuery(batchParams BatchParams) string {
 	}
 
 	switch batchParams.BatchType {
-	case BatchTypeTerminate, BatchTypeSignal, BatchTypeCancel, BatchTypeUpdateOptions, BatchTypeUnpauseActivities:
+	case BatchTypeTerminate, BatchTypeSignal, BatchTypeCancel, BatchTypeUpdateOptions, BatchTypeUnpauseActivities, BatchTypeUpdate:
 		return fmt.Sprintf("(%s) AND (%s)", batchParams.Query, statusRunningQueryFilter)
 	default:
 		return batchParams.Query
@@ -224,8 +275,9 @@ func (a *activities) getOperationConcurrency(concurrency int) int {
 func startTaskProcessor(
 	ctx context.Context,
 	batchParams BatchParams,
+	jobID string,
 	taskCh chan taskDetail,
-	respCh chan error,
+	respCh chan taskResult,
 	limiter *rate.Limiter,
 	sdkClient sdkclient.Client,
 	frontendClient workflowservice.WorkflowServiceClient,
@@ -241,6 +293,7 @@ func startTaskProcessor(
 				return
 			}
 			var err error
+			var item *batchspb.BatchOperationItemResult
 
 			switch batchParams.BatchType {
 			case BatchTypeTerminate:
@@ -267,6 +320,20 @@ func startTaskProcessor(
 						})
 						return err
 					})
+			case BatchTypeSignalWithStart:
+				err = processTask(ctx, limiter, task,
+					func(workflowID, runID string) error {
+						var err error
+						item, err = signalWithStartWorkflow(ctx, frontendClient, batchParams, task)
+						return err
+					})
+			case BatchTypeUpdate:
+				err = processTask(ctx, limiter, task,
+					func(workflowID, runID string) error {
+						var err error
+						item, err = updateWorkflow(ctx, frontendClient, batchParams, jobID, task)
+						return err
+					})
 			case BatchTypeDelete:
 				err = processTask(ctx, limiter, task,
 					func(workflowID, runID string) error {
@@ -362,7 +429,11 @@ func startTaskProcessor(
 
 				_, ok := batchParams._nonRetryableErrors[err.Error()]
 				if ok || task.attempts > batchParams.AttemptsOnRetryableError {
-					respCh <- err
+					res := taskResult{err: err}
+					if batchParams.recordsResults() {
+						res.item = &batchspb.BatchOperationItemResult{Execution: task.execution, Error: err.Error()}
+					}
+					respCh <- res
 				} else {
 					// put back to the channel if less than attemptsOnError
 					task.attempts++
@@ -370,7 +441,7 @@ func startTaskProcessor(
 				}
 			} else {
 				metrics.BatcherProcessorSuccess.With(metricsHandler).Record(1)
-				respCh <- nil
+				respCh <- taskResult{item: item}
 			}
 		}
 	}
@@ -400,6 +471,98 @@ func processTask(
 	return nil
 }
 
+// signalWithStartWorkflow signals a workflow, starting a new run if it isn't running. A
+// workflow matched by a query is started with its own type and task queue unless the batch
+// overrides them.
+func signalWithStartWorkflow(
+	ctx context.Context,
+	frontendClient workflowservice.WorkflowServiceClient,
+	batchParams BatchParams,
+	task taskDetail,
+) (*batchspb.BatchOperationItemResult, error) {
+	sws := batchParams.SignalWithStartParams.signalWithStart
+	workflowType := task.workflowType
+	if sws.GetWorkflowType().GetName() != "" {
+		workflowType = sws.GetWorkflowType()
+	}
+	taskQueue := &taskqueuepb.TaskQueue{Name: task.taskQueue, Kind: enumspb.TASK_QUEUE_KIND_NORMAL}
+	if sws.GetTaskQueue().GetName() != "" {
+		taskQueue = sws.GetTaskQueue()
+	}
+	resp, err := frontendClient.SignalWithStartWorkflowExecution(ctx, &workflowservice.SignalWithStartWorkflowExecutionRequest{
+		Namespace:    batchParams.Namespace,
+		WorkflowId:   task.execution.GetWorkflowId(),
+		WorkflowType: workflowType,
+		TaskQueue:    taskQueue,
+		Input:        sws.GetInput(),
+		RequestId:    uuid.New(),
+		SignalName:   batchParams.SignalParams.SignalName,
+		SignalInput:  batchParams.SignalParams.Input,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &batchspb.BatchOperationItemResult{
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: task.execution.GetWorkflowId(),
+			RunId:      resp.GetRunId(),
+		},
+	}, nil
+}
+
+// updateWorkflow sends the update of the batch to a workflow and waits for it to reach the
+// wait stage. An update that's rejected or fails is recorded as a failed result rather than
+// returned as an error: sending it again wouldn't change its outcome.
+func updateWorkflow(
+	ctx context.Context,
+	frontendClient workflowservice.WorkflowServiceClient,
+	batchParams BatchParams,
+	jobID string,
+	task taskDetail,
+) (*batchspb.BatchOperationItemResult, error) {
+	update := batchParams.UpdateParams.update
+	waitStage := update.GetWaitStage()
+	if waitStage == enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_UNSPECIFIED {
+		waitStage = enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED
+	}
+	req := &workflowservice.UpdateWorkflowExecutionRequest{
+		Namespace:         batchParams.Namespace,
+		WorkflowExecution: task.execution,
+		WaitPolicy:        &updatepb.WaitPolicy{LifecycleStage: waitStage},
+		Request: &updatepb.Request{
+			Meta:  &updatepb.Meta{UpdateId: jobID, Identity: "batch update"},
+			Input: update.GetInput(),
+		},
+	}
+	item := &batchspb.BatchOperationItemResult{Execution: task.execution}
+	for {
+		// Poll with a deadline shorter than the heartbeat timeout: the update returns the
+		// stage it's at when the deadline is near, and is asked again after a heartbeat.
+		pollCtx, cancel := context.WithTimeout(ctx, batchParams.ActivityHeartBeatTimeout/2)
+		resp, err := frontendClient.UpdateWorkflowExecution(pollCtx, req)
+		cancel()
+		activity.RecordHeartbeat(ctx, task.hbd)
+		if err != nil {
+			if common.IsNotFoundError(err) {
+				// the workflow closed after it was matched
+				item.Error = err.Error()
+				return item, nil
+			} else if common.IsContextDeadlineExceededErr(err) && ctx.Err() == nil {
+				continue
+			}
+			return nil, err
+		}
+		if resp.GetOutcome() == nil && resp.GetStage() < waitStage {
+			continue
+		}
+		item.UpdateStage = resp.GetStage()
+		if failure := resp.GetOutcome().GetFailure(); failure != nil {
+			item.Error = failure.GetMessage()
+		}
+		return item, nil
+	}
+}
+
 func isDone(ctx context.Context) bool {
 	select {
 	case <-ctx.Done():
@@ -11,14 +11,18 @@ import (
 	"github.com/stretchr/testify/suite"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	failurepb "go.temporal.io/api/failure/v1"
 	historypb "go.temporal.io/api/history/v1"
+	updatepb "go.temporal.io/api/update/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/testsuite"
+	batchspb "go.temporal.io/server/api/batch/v1"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/primitives/timestamp"
 	"go.temporal.io/server/common/testing/mockapi/workflowservicemock/v1"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
 )
 
 type activitiesSuite struct {
@@ -332,6 +336,18 @@ func (s *activitiesSuite) TestAdjustQuery() {
 			expectedResult: fmt.Sprintf("(A=B OR ExecutionStatus='Completed') AND (%s)", statusRunningQueryFilter),
 			batchType:      BatchTypeTerminate,
 		},
+		{
+			name:           "Update",
+			query:          "A=B",
+			expectedResult: fmt.Sprintf("(A=B) AND (%s)", statusRunningQueryFilter),
+			batchType:      BatchTypeUpdate,
+		},
+		{
+			name:           "Signal with start",
+			query:          "A=B",
+			expectedResult: "A=B",
+			batchType:      BatchTypeSignalWithStart,
+		},
 		{
 			name:           "Not supported batch type",
 			query:          "A=B",
@@ -348,3 +364,96 @@ func (s *activitiesSuite) TestAdjustQuery() {
 		})
 	}
 }
+
+func (s *activitiesSuite) TestUpdateWorkflow() {
+	execution := &commonpb.WorkflowExecution{WorkflowId: "wf", RunId: "run"}
+	batchParams := setDefaultParams(BatchParams{
+		Namespace: "ns",
+		UpdateParams: UpdateParams{update: &batchspb.BatchOperationUpdateWorkflow{
+			Input: &updatepb.Input{Name: "update"},
+		}},
+	})
+	failure := &failurepb.Failure{Message: "rejected"}
+	gomock.InOrder(
+		// the update hasn't completed when the poll returns: it's asked again
+		s.mockFrontendClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, req *workflowservice.UpdateWorkflowExecutionRequest, _ ...grpc.CallOption) (*workflowservice.UpdateWorkflowExecutionResponse, error) {
+				s.Equal("job", req.GetRequest().GetMeta().GetUpdateId())
+				s.Equal(enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED, req.GetWaitPolicy().GetLifecycleStage())
+				s.Equal(execution, req.GetWorkflowExecution())
+				return &workflowservice.UpdateWorkflowExecutionResponse{
+					Stage: enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_ACCEPTED,
+				}, nil
+			}),
+		s.mockFrontendClient.EXPECT().UpdateWorkflowExecution(gomock.Any(), gomock.Any()).Return(&workflowservice.UpdateWorkflowExecutionResponse{
+			Stage:   enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED,
+			Outcome: &updatepb.Outcome{Value: &updatepb.Outcome_Failure{Failure: failure}},
+		}, nil),
+	)
+
+	// updateWorkflow heartbeats, so it needs an activity context
+	updateActivity := func(ctx context.Context) (*batchspb.BatchOperationItemResult, error) {
+		return updateWorkflow(ctx, s.mockFrontendClient, batchParams, "job", taskDetail{execution: execution})
+	}
+	env := s.NewTestActivityEnvironment()
+	env.RegisterActivity(updateActivity)
+	val, err := env.ExecuteActivity(updateActivity)
+	s.NoError(err)
+	var item *batchspb.BatchOperationItemResult
+	s.NoError(val.Get(&item))
+	s.Equal("rejected", item.GetError())
+	s.Equal(enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED, item.GetUpdateStage())
+}
+
+func (s *activitiesSuite) TestSignalWithStartWorkflow() {
+	batchParams := BatchParams{
+		Namespace:             "ns",
+		SignalParams:          SignalParams{SignalName: "signal"},
+		SignalWithStartParams: SignalWithStartParams{signalWithStart: &batchspb.BatchOperationSignalWithStart{}},
+	}
+	task := taskDetail{
+		execution:    &commonpb.WorkflowExecution{WorkflowId: "wf", RunId: "old-run"},
+		workflowType: &commonpb.WorkflowType{Name: "type"},
+		taskQueue:    "queue",
+	}
+	s.mockFrontendClient.EXPECT().SignalWithStartWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, req *workflowservice.SignalWithStartWorkflowExecutionRequest, _ ...grpc.CallOption) (*workflowservice.SignalWithStartWorkflowExecutionResponse, error) {
+			// defaults to the type and task queue of the matched workflow
+			s.Equal("type", req.GetWorkflowType().GetName())
+			s.Equal("queue", req.GetTaskQueue().GetName())
+			s.Equal("signal", req.GetSignalName())
+			return &workflowservice.SignalWithStartWorkflowExecutionResponse{RunId: "new-run"}, nil
+		})
+
+	item, err := signalWithStartWorkflow(context.Background(), s.mockFrontendClient, batchParams, task)
+	s.NoError(err)
+	s.Equal("new-run", item.GetExecution().GetRunId())
+	s.Empty(item.GetError())
+}
+
+func (s *activitiesSuite) TestAddItemResult() {
+	success := func(id string) *batchspb.BatchOperationItemResult {
+		return &batchspb.BatchOperationItemResult{Execution: &commonpb.WorkflowExecution{WorkflowId: id}}
+	}
+	failure := func(id string) *batchspb.BatchOperationItemResult {
+		return &batchspb.BatchOperationItemResult{Execution: &commonpb.WorkflowExecution{WorkflowId: id}, Error: "error"}
+	}
+	ids := func(results *batchspb.BatchOperationResults) []string {
+		var ids []string
+		for _, item := range results.GetItems() {
+			ids = append(ids, item.GetExecution().GetWorkflowId())
+		}
+		return ids
+	}
+
+	results := &batchspb.BatchOperationResults{}
+	for _, item := range []*batchspb.BatchOperationItemResult{
+		success("s1"), failure("f1"), success("s2"), failure("f2"), failure("f3"), failure("f4"),
+	} {
+		addItemResult(results, item, 3)
+	}
+	// successes are dropped first, then the oldest failures
+	s.Equal([]string{"f2", "f3", "f4"}, ids(results))
+	s.Equal(int64(2), results.GetDroppedSuccesses())
+	s.Equal(int64(1), results.GetDroppedFailures())
+}
@@ -0,0 +1,93 @@
+package batcher
+
+import (
+	"errors"
+	"slices"
+
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	batchspb "go.temporal.io/server/api/batch/v1"
+	"go.temporal.io/server/common/payload"
+)
+
+// OperationExtensionHeaderKey is the key in the header of a signal batch operation under which
+// a BatchOperationExtension is encoded. A batch with an extension updates workflows or
+// signals them with start instead of only signaling them.
+const OperationExtensionHeaderKey = "temporal-batch-operation"
+
+// GetOperationExtension returns the operation extension carried by the header of a signal
+// batch operation, or nil if it doesn't have one.
+func GetOperationExtension(header *commonpb.Header) (*batchspb.BatchOperationExtension, error) {
+	p, ok := header.GetFields()[OperationExtensionHeaderKey]
+	if !ok {
+		return nil, nil
+	}
+	var ext batchspb.BatchOperationExtension
+	if err := payload.Decode(p, &ext); err != nil {
+		return nil, err
+	}
+	return &ext, nil
+}
+
+// ValidateOperationExtension returns an error if the operation extension is malformed.
+// byExecutions is true if the batch is given a list of executions rather than a query.
+func ValidateOperationExtension(ext *batchspb.BatchOperationExtension, signalName string, byExecutions bool) error {
+	switch op := ext.GetOperation().(type) {
+	case *batchspb.BatchOperationExtension_UpdateWorkflow:
+		if op.UpdateWorkflow.GetInput().GetName() == "" {
+			return errors.New("update name is not set")
+		}
+		switch op.UpdateWorkflow.GetWaitStage() { // nolint:exhaustive
+		case enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_UNSPECIFIED,
+			enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_ACCEPTED,
+			enumspb.UPDATE_WORKFLOW_EXECUTION_LIFECYCLE_STAGE_COMPLETED:
+		default:
+			return errors.New("wait stage must be accepted or completed")
+		}
+	case *batchspb.BatchOperationExtension_SignalWithStart:
+		if signalName == "" {
+			return errors.New("signal name is not set")
+		}
+		if byExecutions && op.SignalWithStart.GetWorkflowType().GetName() == "" {
+			return errors.New("workflow type must be set when executions are given")
+		}
+		if byExecutions && op.SignalWithStart.GetTaskQueue().GetName() == "" {
+			return errors.New("task queue must be set when executions are given")
+		}
+	default:
+		return errors.New("operation is not set")
+	}
+	return nil
+}
+
+// GetResults returns the per-workflow results recorded in the heartbeat details.
+func (hbd HeartBeatDetails) GetResults() (*batchspb.BatchOperationResults, error) {
+	results := &batchspb.BatchOperationResults{}
+	if len(hbd.Results) == 0 {
+		return results, nil
+	}
+	if err := results.Unmarshal(hbd.Results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// addItemResult adds a result, keeping at most maxResults of them. To make room it drops the
+// oldest success, or the oldest failure if there are only failures: failures are the results
+// callers need to act on.
+func addItemResult(results *batchspb.BatchOperationResults, item *batchspb.BatchOperationItemResult, maxResults int) {
+	results.Items = append(results.Items, item)
+	if len(results.Items) <= maxResults {
+		return
+	}
+	i := slices.IndexFunc(results.Items, func(r *batchspb.BatchOperationItemResult) bool {
+		return r.GetError() == ""
+	})
+	if i >= 0 {
+		results.DroppedSuccesses++
+	} else {
+		i = 0
+		results.DroppedFailures++
+	}
+	results.Items = slices.Delete(results.Items, i, i+1)
+}
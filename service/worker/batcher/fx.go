@@ -78,5 +78,6 @@ func (s *workerComponent) activities(name namespace.Name, id namespace.ID) *acti
 		namespaceID:  id,
 		rps:          dynamicconfig.BatcherRPS.Get(s.dc),
 		concurrency:  dynamicconfig.BatcherConcurrency.Get(s.dc),
+		maxResults:   dynamicconfig.BatcherMaxItemResults.Get(s.dc),
 	}
 }
@@ -9,6 +9,7 @@ import (
 	workflowpb "go.temporal.io/api/workflow/v1"
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
+	batchspb "go.temporal.io/server/api/batch/v1"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/worker_versioning"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
@@ -42,6 +43,10 @@ const (
 	BatchTypeUpdateOptions = "update_options"
 	// BatchTypePauseActivities is batch type for unpausing activities
 	BatchTypeUnpauseActivities = "unpause_activities"
+	// BatchTypeUpdate is batch type for sending an update to workflows
+	BatchTypeUpdate = "update"
+	// BatchTypeSignalWithStart is batch type for signaling workflows, starting them if they aren't running
+	BatchTypeSignalWithStart = "signal_with_start"
 )
 
 var (
@@ -98,6 +103,21 @@ type (
 		Jitter         time.Duration
 	}
 
+	// UpdateParams is the parameters for sending an update to workflows
+	UpdateParams struct {
+		// This is a serialized batchspb.BatchOperationUpdateWorkflow, see ResetParams.
+		Update []byte
+		update *batchspb.BatchOperationUpdateWorkflow // deserialized version
+	}
+
+	// SignalWithStartParams is the parameters for signaling workflows with start. The signal
+	// name and input are in SignalParams.
+	SignalWithStartParams struct {
+		// This is a serialized batchspb.BatchOperationSignalWithStart, see ResetParams.
+		SignalWithStart []byte
+		signalWithStart *batchspb.BatchOperationSignalWithStart // deserialized version
+	}
+
 	// BatchParams is the parameters for batch operation workflow
 	BatchParams struct {
 		// Target namespace to execute batch operation
@@ -108,7 +128,7 @@ type (
 		Executions []*commonpb.WorkflowExecution
 		// Reason for the operation
 		Reason string
-		// Supporting: signal,cancel,terminate,delete,reset
+		// Supporting: signal,cancel,terminate,delete,reset,update_options,unpause_activities,update,signal_with_start
 		BatchType string
 
 		// Below are all optional
@@ -116,7 +136,7 @@ type (
 		TerminateParams TerminateParams
 		// CancelParams is params only for BatchTypeCancel
 		CancelParams CancelParams
-		// SignalParams is params only for BatchTypeSignal
+		// SignalParams is params only for BatchTypeSignal and BatchTypeSignalWithStart
 		SignalParams SignalParams
 		// DeleteParams is params only for BatchTypeDelete
 		DeleteParams DeleteParams
@@ -126,6 +146,10 @@ type (
 		UpdateOptionsParams UpdateOptionsParams
 		// UnpauseActivitiesParams is params only for BatchTypeUnpauseActivities
 		UnpauseActivitiesParams UnpauseActivitiesParams
+		// UpdateParams is params only for BatchTypeUpdate
+		UpdateParams UpdateParams
+		// SignalWithStartParams is params only for BatchTypeSignalWithStart
+		SignalWithStartParams SignalWithStartParams
 
 		// RPS sets the requests-per-second limit for the batch.
 		// The default (and max) is defined by `worker.BatcherRPS` in the dynamic config.
@@ -154,11 +178,17 @@ type (
 		SuccessCount int
 		// Number of workflows that give up due to errors.
 		ErrorCount int
+		// Per-workflow results as a serialized batchspb.BatchOperationResults. Only recorded
+		// for batch types that report results, see recordsResults.
+		Results []byte
 	}
 
 	taskDetail struct {
 		execution *commonpb.WorkflowExecution
-		attempts  int
+		// type and task queue of the workflow, if it was matched by a query
+		workflowType *commonpb.WorkflowType
+		taskQueue    string
+		attempts     int
 		// passing along the current heartbeat details to make heartbeat within a task so that it won't timeout
 		hbd HeartBeatDetails
 	}
@@ -237,6 +267,19 @@ func validateParams(params BatchParams) error {
 			return fmt.Errorf("must provide signal name")
 		}
 		return nil
+	case BatchTypeUpdate:
+		if len(params.UpdateParams.Update) == 0 {
+			return fmt.Errorf("must provide update")
+		}
+		return nil
+	case BatchTypeSignalWithStart:
+		if params.SignalParams.SignalName == "" {
+			return fmt.Errorf("must provide signal name")
+		}
+		if len(params.SignalWithStartParams.SignalWithStart) == 0 {
+			return fmt.Errorf("must provide signal with start")
+		}
+		return nil
 	case BatchTypeUpdateOptions:
 		if params.UpdateOptionsParams.WorkflowExecutionOptions == nil {
 			return fmt.Errorf("must provide UpdateOptions")
@@ -257,6 +300,11 @@ func validateParams(params BatchParams) error {
 	}
 }
 
+// recordsResults returns true if the batch records per-workflow results.
+func (params BatchParams) recordsResults() bool {
+	return params.BatchType == BatchTypeUpdate || params.BatchType == BatchTypeSignalWithStart
+}
+
 func setDefaultParams(params BatchParams) BatchParams {
 	if params.AttemptsOnRetryableError <= 1 {
 		params.AttemptsOnRetryableError = defaultAttemptsOnRetryableError
@@ -21,6 +21,7 @@ import (
 	"go.temporal.io/server/common/resolver"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/components/nexusoperations"
 	"go.temporal.io/server/service"
 	"go.temporal.io/server/service/worker/addsearchattributes"
 	"go.temporal.io/server/service/worker/batcher"
@@ -67,6 +68,11 @@ var Module = fx.Options(
 			})
 		},
 	),
+	// Nexus endpoints and clients for schedules that start Nexus operations.
+	fx.Provide(nexusoperations.EndpointRegistryProvider),
+	fx.Invoke(nexusoperations.EndpointRegistryLifetimeHooks),
+	fx.Provide(nexusoperations.DefaultNexusTransportProvider),
+	fx.Provide(nexusoperations.ClientProviderFactory),
 	fx.Provide(HostInfoProvider),
 	fx.Provide(VisibilityManagerProvider),
 	fx.Provide(ThrottledLoggerRpsFnProvider),
@@ -15,8 +15,10 @@ import (
 	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/activity"
 	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/server/api/adminservice/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
 	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
 	replicationspb "go.temporal.io/server/api/replication/v1"
 	serverClient "go.temporal.io/server/client"
 	"go.temporal.io/server/common/definition"
@@ -26,8 +28,11 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/transitionhistory"
+	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/rpc/interceptor"
+	"go.temporal.io/server/service/history/replication"
 	"google.golang.org/grpc/metadata"
 )
 
@@ -84,13 +89,51 @@ type (
 		VerifiedWorkflowCount int64
 	}
 
+	verifyMigratedWorkflowsRequest struct {
+		Namespace         string
+		NamespaceID       string
+		TargetClusterName string
+		VerifyInterval    time.Duration `validate:"gte=0"`
+		Executions        []*commonpb.WorkflowExecution
+		// RPS limits the replication tasks generated again for executions that are not verified.
+		RPS float64
+	}
+
+	verifyMigratedWorkflowsHeartbeatDetails struct {
+		replicationTasksHeartbeatDetails
+		VerifiedWorkflowCount int64
+		SkippedWorkflowCount  int64
+	}
+
+	verifyMigratedWorkflowsResponse struct {
+		VerifiedWorkflowCount int64
+		SkippedWorkflowCount  int64
+	}
+
+	terminateMigratedWorkflowsRequest struct {
+		Namespace         string
+		NamespaceID       string
+		TargetClusterName string
+		Executions        []*commonpb.WorkflowExecution
+	}
+
+	terminateMigratedWorkflowsHeartbeatDetails struct {
+		NextIndex               int
+		TerminatedWorkflowCount int64
+	}
+
+	terminateMigratedWorkflowsResponse struct {
+		TerminatedWorkflowCount int64
+	}
+
 	metadataRequest struct {
 		Namespace string
 	}
 
 	metadataResponse struct {
-		ShardCount  int32
-		NamespaceID string
+		ShardCount        int32
+		NamespaceID       string
+		ActiveClusterName string
 	}
 
 	waitCatchupRequest struct {
@@ -144,8 +187,9 @@ func (a *activities) GetMetadata(_ context.Context, request metadataRequest) (*m
 	}
 
 	return &metadataResponse{
-		ShardCount:  a.historyShardCount,
-		NamespaceID: string(nsEntry.ID()),
+		ShardCount:        a.historyShardCount,
+		NamespaceID:       string(nsEntry.ID()),
+		ActiveClusterName: nsEntry.ActiveClusterName(),
 	}, nil
 }
 
@@ -702,32 +746,43 @@ func (a *activities) checkSkipWorkflowExecution(
 		}, err
 	}
 
+	return a.checkSkipMutableState(request.Namespace, resp.GetDatabaseMutableState(), ns, tags), nil
+}
+
+// checkSkipMutableState checks if the execution of the mutable state on the current cluster
+// doesn't need to be verified.
+func (a *activities) checkSkipMutableState(
+	namespaceName string,
+	ms *persistencespb.WorkflowMutableState,
+	ns *namespace.Namespace,
+	tags []tag.Tag,
+) verifyResult {
 	// Zombie workflow should be a transient state. However, if there is Zombie workflow on the source cluster,
 	// it is skipped to avoid such workflow being processed on the target cluster.
-	if resp.GetDatabaseMutableState().GetExecutionState().GetState() == enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE {
-		a.forceReplicationMetricsHandler.WithTags(metrics.NamespaceTag(request.Namespace)).Counter(metrics.EncounterZombieWorkflowCount.Name()).Record(1)
+	if ms.GetExecutionState().GetState() == enumsspb.WORKFLOW_EXECUTION_STATE_ZOMBIE {
+		a.forceReplicationMetricsHandler.WithTags(metrics.NamespaceTag(namespaceName)).Counter(metrics.EncounterZombieWorkflowCount.Name()).Record(1)
 		a.logger.Info("createReplicationTasks skip Zombie workflow", tags...)
 		return verifyResult{
 			status: skipped,
 			reason: reasonZombieWorkflow,
-		}, nil
+		}
 	}
 
 	// Skip verifying workflow which has already passed retention time.
-	if closeTime := resp.GetDatabaseMutableState().GetExecutionInfo().GetCloseTime(); closeTime != nil && ns != nil && ns.Retention() > 0 {
+	if closeTime := ms.GetExecutionInfo().GetCloseTime(); closeTime != nil && ns != nil && ns.Retention() > 0 {
 		deleteTime := closeTime.AsTime().Add(ns.Retention())
 		if deleteTime.Before(time.Now()) {
-			a.forceReplicationMetricsHandler.WithTags(metrics.NamespaceTag(request.Namespace)).Counter(metrics.EncounterPassRetentionWorkflowCount.Name()).Record(1)
+			a.forceReplicationMetricsHandler.WithTags(metrics.NamespaceTag(namespaceName)).Counter(metrics.EncounterPassRetentionWorkflowCount.Name()).Record(1)
 			return verifyResult{
 				status: skipped,
 				reason: reasonWorkflowCloseToRetention,
-			}, nil
+			}
 		}
 	}
 
 	return verifyResult{
 		status: notVerified,
-	}, nil
+	}
 }
 
 func (a *activities) verifySingleReplicationTask(
@@ -816,6 +871,7 @@ func (a *activities) verifyReplicationTasks(
 
 const (
 	defaultNoProgressNotRetryableTimeout = 30 * time.Minute
+	resendReplicationTasksInterval       = time.Minute
 )
 
 func (a *activities) VerifyReplicationTasks(ctx context.Context, request *verifyReplicationTasksRequest) (verifyReplicationTasksResponse, error) {
@@ -883,6 +939,244 @@ func (a *activities) VerifyReplicationTasks(ctx context.Context, request *verify
 	}
 }
 
+// VerifyMigratedWorkflows waits until the target cluster has the state of the executions of the
+// request on the current cluster. It runs the check of ExecutableVerifyVersionedTransitionTask:
+// an execution is verified when the target cluster has its last versioned transition and its last
+// event. If an execution is not verified for resendReplicationTasksInterval, its replication tasks
+// are generated again. State that was already sent to the target cluster is then replicated as a
+// verify versioned transition task, which makes the target cluster sync the missing state.
+func (a *activities) VerifyMigratedWorkflows(ctx context.Context, request *verifyMigratedWorkflowsRequest) (verifyMigratedWorkflowsResponse, error) {
+	var response verifyMigratedWorkflowsResponse
+	var details verifyMigratedWorkflowsHeartbeatDetails
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &details); err != nil {
+			return response, err
+		}
+	} else {
+		details.CheckPoint = time.Now()
+		activity.RecordHeartbeat(ctx, details)
+	}
+
+	remoteClient, err := a.clientBean.GetRemoteAdminClient(request.TargetClusterName)
+	if err != nil {
+		return response, err
+	}
+	nsEntry, err := a.namespaceRegistry.GetNamespace(namespace.Name(request.Namespace))
+	if err != nil {
+		return response, err
+	}
+
+	ctx = a.setCallerInfoForServerAPI(ctx, namespace.ID(request.NamespaceID))
+	remoteCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptor.DCRedirectionContextHeaderName, "false"))
+	rateLimiter := quotas.NewRateLimiter(request.RPS, int(math.Ceil(request.RPS)))
+	lastResendTime := time.Now()
+
+	for {
+		// Since replication has a lag, sleep first.
+		time.Sleep(request.VerifyInterval)
+
+		progress := false
+		for ; details.NextIndex < len(request.Executions); details.NextIndex++ {
+			we := request.Executions[details.NextIndex]
+			r, err := a.verifyMigratedWorkflow(ctx, remoteCtx, request, remoteClient, nsEntry, we)
+			if err != nil {
+				return response, err
+			}
+			if !r.isVerified() {
+				details.LastNotVerifiedWorkflowExecution = we
+				break
+			}
+			if r.status == verified {
+				details.VerifiedWorkflowCount++
+			} else {
+				details.SkippedWorkflowCount++
+			}
+			progress = true
+			activity.RecordHeartbeat(ctx, details)
+		}
+		if progress {
+			details.CheckPoint = time.Now()
+		}
+		activity.RecordHeartbeat(ctx, details)
+
+		if details.NextIndex == len(request.Executions) {
+			response.VerifiedWorkflowCount = details.VerifiedWorkflowCount
+			response.SkippedWorkflowCount = details.SkippedWorkflowCount
+			return response, nil
+		}
+
+		diff := time.Since(details.CheckPoint)
+		if diff > defaultNoProgressNotRetryableTimeout {
+			return response, temporal.NewNonRetryableApplicationError(
+				fmt.Sprintf("VerifyMigratedWorkflows was not able to make progress for more than %v minutes (not retryable): WorkflowExecution: '%v' is not up to date in TargetCluster: '%s'",
+					diff.Minutes(), details.LastNotVerifiedWorkflowExecution, request.TargetClusterName),
+				"",
+				nil)
+		}
+		if diff > resendReplicationTasksInterval && time.Since(lastResendTime) > resendReplicationTasksInterval {
+			we := details.LastNotVerifiedWorkflowExecution
+			wKey := definition.NewWorkflowKey(request.NamespaceID, we.GetWorkflowId(), we.GetRunId())
+			if err := a.generateWorkflowReplicationTask(ctx, rateLimiter, wKey, []string{request.TargetClusterName}); err != nil && !isNotFoundServiceError(err) {
+				return response, err
+			}
+			lastResendTime = time.Now()
+		}
+	}
+}
+
+func (a *activities) verifyMigratedWorkflow(
+	ctx context.Context,
+	remoteCtx context.Context,
+	request *verifyMigratedWorkflowsRequest,
+	remoteClient adminservice.AdminServiceClient,
+	ns *namespace.Namespace,
+	we *commonpb.WorkflowExecution,
+) (verifyResult, error) {
+	tags := []tag.Tag{tag.WorkflowNamespaceID(request.NamespaceID), tag.WorkflowID(we.GetWorkflowId()), tag.WorkflowRunID(we.GetRunId())}
+	sourceResp, err := a.historyClient.DescribeMutableState(ctx, &historyservice.DescribeMutableStateRequest{
+		NamespaceId: request.NamespaceID,
+		Execution:   we,
+	})
+	if err != nil {
+		if isNotFoundServiceError(err) {
+			// The execution was deleted on the current cluster after it was listed.
+			return verifyResult{
+				status: skipped,
+				reason: reasonWorkflowNotFound,
+			}, nil
+		}
+		return verifyResult{
+			status: notVerified,
+		}, err
+	}
+	if r := a.checkSkipMutableState(request.Namespace, sourceResp.GetDatabaseMutableState(), ns, tags); r.status == skipped {
+		return r, nil
+	}
+
+	targetResp, err := remoteClient.DescribeMutableState(remoteCtx, &adminservice.DescribeMutableStateRequest{
+		Namespace: request.Namespace,
+		Execution: we,
+	})
+	switch err.(type) {
+	case nil:
+	case *serviceerror.NotFound:
+		return verifyResult{
+			status: notVerified,
+		}, nil
+	case *serviceerror.NamespaceNotFound:
+		return verifyResult{
+			status: notVerified,
+		}, temporal.NewNonRetryableApplicationError("failed to describe mutable state from the remote cluster", "NamespaceNotFound", err)
+	default:
+		return verifyResult{
+			status: notVerified,
+		}, errors.WithMessage(err, "failed to describe mutable state from the remote cluster")
+	}
+
+	replicated, err := isMutableStateReplicated(sourceResp.GetDatabaseMutableState(), targetResp.GetDatabaseMutableState())
+	if err != nil {
+		return verifyResult{
+			status: notVerified,
+		}, err
+	}
+	if !replicated {
+		return verifyResult{
+			status: notVerified,
+		}, nil
+	}
+	return verifyResult{
+		status: verified,
+	}, nil
+}
+
+// isMutableStateReplicated returns true if the target mutable state has the last versioned
+// transition and the events of the source mutable state.
+func isMutableStateReplicated(source, target *persistencespb.WorkflowMutableState) (bool, error) {
+	sourceHistory, err := versionhistory.GetCurrentVersionHistory(source.GetExecutionInfo().GetVersionHistories())
+	if err != nil {
+		return false, err
+	}
+	result, err := replication.VerifyVersionedTransition(
+		target,
+		transitionhistory.LastVersionedTransition(source.GetExecutionInfo().GetTransitionHistory()),
+		source.GetNextEventId(),
+		sourceHistory.GetItems(),
+	)
+	if err != nil {
+		return false, err
+	}
+	return result.Status == replication.VersionedTransitionUpToDate, nil
+}
+
+// TerminateMigratedWorkflows takes the request's executions off the current cluster, once the
+// namespace is active on the target cluster. The current cluster's copies are standby copies by
+// then, so they're deleted rather than terminated, which isn't replicated. Copies that the target
+// cluster isn't up to date with are left alone.
+func (a *activities) TerminateMigratedWorkflows(ctx context.Context, request *terminateMigratedWorkflowsRequest) (terminateMigratedWorkflowsResponse, error) {
+	var response terminateMigratedWorkflowsResponse
+	var details terminateMigratedWorkflowsHeartbeatDetails
+	if activity.HasHeartbeatDetails(ctx) {
+		if err := activity.GetHeartbeatDetails(ctx, &details); err != nil {
+			return response, err
+		}
+	}
+
+	nsEntry, err := a.namespaceRegistry.GetNamespace(namespace.Name(request.Namespace))
+	if err != nil {
+		return response, err
+	}
+	if !nsEntry.ActiveInCluster(request.TargetClusterName) {
+		return response, temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("namespace %s is not active in cluster %s", request.Namespace, request.TargetClusterName),
+			"FailedPrecondition",
+			nil)
+	}
+	remoteClient, err := a.clientBean.GetRemoteAdminClient(request.TargetClusterName)
+	if err != nil {
+		return response, err
+	}
+
+	ctx = a.setCallerInfoForServerAPI(ctx, namespace.ID(request.NamespaceID))
+	remoteCtx := metadata.NewOutgoingContext(ctx, metadata.Pairs(interceptor.DCRedirectionContextHeaderName, "false"))
+	verifyRequest := &verifyMigratedWorkflowsRequest{
+		Namespace:         request.Namespace,
+		NamespaceID:       request.NamespaceID,
+		TargetClusterName: request.TargetClusterName,
+	}
+	for ; details.NextIndex < len(request.Executions); details.NextIndex++ {
+		we := request.Executions[details.NextIndex]
+		r, err := a.verifyMigratedWorkflow(ctx, remoteCtx, verifyRequest, remoteClient, nsEntry, we)
+		if err != nil {
+			return response, err
+		}
+		if r.status != verified {
+			a.logger.Info("TerminateMigratedWorkflows skip workflow not up to date in the target cluster",
+				tag.WorkflowNamespaceID(request.NamespaceID), tag.WorkflowID(we.GetWorkflowId()), tag.WorkflowRunID(we.GetRunId()))
+			continue
+		}
+
+		_, err = a.historyClient.ForceDeleteWorkflowExecution(ctx, &historyservice.ForceDeleteWorkflowExecutionRequest{
+			NamespaceId: request.NamespaceID,
+			Request: &adminservice.DeleteWorkflowExecutionRequest{
+				Namespace: request.Namespace,
+				Execution: we,
+			},
+		})
+		switch {
+		case err == nil:
+			details.TerminatedWorkflowCount++
+		case isNotFoundServiceError(err):
+			// already deleted
+		default:
+			return response, err
+		}
+		activity.RecordHeartbeat(ctx, details)
+	}
+
+	response.TerminatedWorkflowCount = details.TerminatedWorkflowCount
+	return response, nil
+}
+
 // WaitCatchup waits for the CatchupCluster to catch necessary data from the current cluster,
 // ensuring it has caught up to the TargetCluster's ack level for the specified namespace.
 func (a *activities) WaitCatchup(ctx context.Context, params CatchUpParams) error {
@@ -13,7 +13,10 @@ import (
 	"go.temporal.io/sdk/interceptor"
 	"go.temporal.io/sdk/testsuite"
 	"go.temporal.io/sdk/worker"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/adminservicemock/v1"
 	enumsspb "go.temporal.io/server/api/enums/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
 	"go.temporal.io/server/api/historyservice/v1"
 	"go.temporal.io/server/api/historyservicemock/v1"
 	persistencespb "go.temporal.io/server/api/persistence/v1"
@@ -22,6 +25,7 @@ import (
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
 	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/versionhistory"
 	"go.temporal.io/server/common/testing/mockapi/workflowservicemock/v1"
 	"go.temporal.io/server/common/testing/protoassert"
 	"go.temporal.io/server/common/testing/protomock"
@@ -46,6 +50,8 @@ type activitiesSuite struct {
 	mockHistoryClient  *historyservicemock.MockHistoryServiceClient
 	mockRemoteClient   *workflowservicemock.MockWorkflowServiceClient
 
+	mockRemoteAdminClient *adminservicemock.MockAdminServiceClient
+
 	logger             log.Logger
 	mockMetricsHandler *metrics.MockHandler
 
@@ -103,6 +109,7 @@ func (s *activitiesSuite) SetupTest() {
 	s.mockFrontendClient = workflowservicemock.NewMockWorkflowServiceClient(s.controller)
 	s.mockHistoryClient = historyservicemock.NewMockHistoryServiceClient(s.controller)
 	s.mockRemoteClient = workflowservicemock.NewMockWorkflowServiceClient(s.controller)
+	s.mockRemoteAdminClient = adminservicemock.NewMockAdminServiceClient(s.controller)
 
 	s.logger = log.NewNoopLogger()
 	s.mockMetricsHandler = metrics.NewMockHandler(s.controller)
@@ -110,6 +117,7 @@ func (s *activitiesSuite) SetupTest() {
 	s.mockMetricsHandler.EXPECT().Timer(gomock.Any()).Return(metrics.NoopTimerMetricFunc).AnyTimes()
 	s.mockMetricsHandler.EXPECT().Counter(gomock.Any()).Return(metrics.NoopCounterMetricFunc).AnyTimes()
 	s.mockClientBean.EXPECT().GetRemoteFrontendClient(remoteCluster).Return(nil, s.mockRemoteClient, nil).AnyTimes()
+	s.mockClientBean.EXPECT().GetRemoteAdminClient(remoteCluster).Return(s.mockRemoteAdminClient, nil).AnyTimes()
 	s.mockNamespaceRegistry.EXPECT().GetNamespaceName(gomock.Any()).
 		Return(namespace.Name(mockedNamespace), nil).AnyTimes()
 	s.mockNamespaceRegistry.EXPECT().GetNamespace(gomock.Any()).
@@ -594,6 +602,165 @@ func (s *activitiesSuite) Test_isNotFoundServiceError() {
 	s.False(isNotFoundServiceError(serviceerror.NewInternal("")))
 }
 
+func newMigratedMutableState(transitionCount int64, lastEventID int64) *persistencespb.WorkflowMutableState {
+	return &persistencespb.WorkflowMutableState{
+		ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+			TransitionHistory: []*persistencespb.VersionedTransition{
+				{NamespaceFailoverVersion: 1, TransitionCount: transitionCount},
+			},
+			VersionHistories: versionhistory.NewVersionHistories(versionhistory.NewVersionHistory(nil, []*historyspb.VersionHistoryItem{
+				versionhistory.NewVersionHistoryItem(lastEventID, 1),
+			})),
+		},
+		ExecutionState: &persistencespb.WorkflowExecutionState{
+			State: enumsspb.WORKFLOW_EXECUTION_STATE_RUNNING,
+		},
+		NextEventId: lastEventID + 1,
+	}
+}
+
+func (s *activitiesSuite) Test_isMutableStateReplicated() {
+	source := newMigratedMutableState(5, 10)
+	for _, tc := range []struct {
+		target     *persistencespb.WorkflowMutableState
+		replicated bool
+	}{
+		{target: newMigratedMutableState(5, 10), replicated: true},
+		{target: newMigratedMutableState(6, 11), replicated: true},
+		// missing state
+		{target: newMigratedMutableState(4, 10), replicated: false},
+		// missing events
+		{target: newMigratedMutableState(5, 9), replicated: false},
+	} {
+		replicated, err := isMutableStateReplicated(source, tc.target)
+		s.NoError(err)
+		s.Equal(tc.replicated, replicated)
+	}
+
+	// without state-based replication only events are compared
+	source.ExecutionInfo.TransitionHistory = nil
+	target := newMigratedMutableState(1, 10)
+	target.ExecutionInfo.TransitionHistory = nil
+	replicated, err := isMutableStateReplicated(source, target)
+	s.NoError(err)
+	s.True(replicated)
+}
+
+func (s *activitiesSuite) TestVerifyMigratedWorkflows() {
+	env, iceptor := s.initEnv()
+
+	request := verifyMigratedWorkflowsRequest{
+		Namespace:         mockedNamespace,
+		NamespaceID:       mockedNamespaceID,
+		TargetClusterName: remoteCluster,
+		Executions:        []*commonpb.WorkflowExecution{execution1, execution2},
+		RPS:               10,
+	}
+
+	// execution1 is replicated after the second check
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&historyservice.DescribeMutableStateRequest{
+		NamespaceId: mockedNamespaceID,
+		Execution:   execution1,
+	})).Return(&historyservice.DescribeMutableStateResponse{DatabaseMutableState: newMigratedMutableState(5, 10)}, nil).Times(3)
+	gomock.InOrder(
+		s.mockRemoteAdminClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&adminservice.DescribeMutableStateRequest{
+			Namespace: mockedNamespace,
+			Execution: execution1,
+		})).Return(nil, serviceerror.NewNotFound("")),
+		s.mockRemoteAdminClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).
+			Return(&adminservice.DescribeMutableStateResponse{DatabaseMutableState: newMigratedMutableState(4, 8)}, nil),
+		s.mockRemoteAdminClient.EXPECT().DescribeMutableState(gomock.Any(), gomock.Any()).
+			Return(&adminservice.DescribeMutableStateResponse{DatabaseMutableState: newMigratedMutableState(5, 10)}, nil),
+	)
+
+	// execution2 was deleted on the current cluster
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&historyservice.DescribeMutableStateRequest{
+		NamespaceId: mockedNamespaceID,
+		Execution:   execution2,
+	})).Return(nil, serviceerror.NewNotFound("")).Times(1)
+
+	f, err := env.ExecuteActivity(s.a.VerifyMigratedWorkflows, &request)
+	s.NoError(err)
+	var output verifyMigratedWorkflowsResponse
+	s.NoError(f.Get(&output))
+	s.Equal(int64(1), output.VerifiedWorkflowCount)
+	s.Equal(int64(1), output.SkippedWorkflowCount)
+
+	s.NotEmpty(iceptor.verifyMigratedRecordedHeartbeats)
+	lastHeartBeat := iceptor.verifyMigratedRecordedHeartbeats[len(iceptor.verifyMigratedRecordedHeartbeats)-1]
+	s.Equal(len(request.Executions), lastHeartBeat.NextIndex)
+}
+
+func (s *activitiesSuite) TestTerminateMigratedWorkflows() {
+	env, iceptor := s.initEnv()
+	registry := namespace.NewMockRegistry(s.controller)
+	registry.EXPECT().GetNamespace(namespace.Name(mockedNamespace)).Return(namespace.NewGlobalNamespaceForTest(
+		nil, nil, &persistencespb.NamespaceReplicationConfig{ActiveClusterName: remoteCluster}, 1), nil)
+	registry.EXPECT().GetNamespaceName(gomock.Any()).Return(namespace.Name(mockedNamespace), nil).AnyTimes()
+	s.a.namespaceRegistry = registry
+
+	request := terminateMigratedWorkflowsRequest{
+		Namespace:         mockedNamespace,
+		NamespaceID:       mockedNamespaceID,
+		TargetClusterName: remoteCluster,
+		Executions:        []*commonpb.WorkflowExecution{execution1, execution2},
+	}
+
+	// execution1 is up to date in the target cluster and is deleted
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&historyservice.DescribeMutableStateRequest{
+		NamespaceId: mockedNamespaceID,
+		Execution:   execution1,
+	})).Return(&historyservice.DescribeMutableStateResponse{DatabaseMutableState: newMigratedMutableState(5, 10)}, nil)
+	s.mockRemoteAdminClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&adminservice.DescribeMutableStateRequest{
+		Namespace: mockedNamespace,
+		Execution: execution1,
+	})).Return(&adminservice.DescribeMutableStateResponse{DatabaseMutableState: newMigratedMutableState(6, 12)}, nil)
+	s.mockHistoryClient.EXPECT().ForceDeleteWorkflowExecution(gomock.Any(), protomock.Eq(&historyservice.ForceDeleteWorkflowExecutionRequest{
+		NamespaceId: mockedNamespaceID,
+		Request: &adminservice.DeleteWorkflowExecutionRequest{
+			Namespace: mockedNamespace,
+			Execution: execution1,
+		},
+	})).Return(&historyservice.ForceDeleteWorkflowExecutionResponse{}, nil)
+
+	// execution2 is behind in the target cluster and is left alone
+	s.mockHistoryClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&historyservice.DescribeMutableStateRequest{
+		NamespaceId: mockedNamespaceID,
+		Execution:   execution2,
+	})).Return(&historyservice.DescribeMutableStateResponse{DatabaseMutableState: newMigratedMutableState(5, 10)}, nil)
+	s.mockRemoteAdminClient.EXPECT().DescribeMutableState(gomock.Any(), protomock.Eq(&adminservice.DescribeMutableStateRequest{
+		Namespace: mockedNamespace,
+		Execution: execution2,
+	})).Return(&adminservice.DescribeMutableStateResponse{DatabaseMutableState: newMigratedMutableState(4, 8)}, nil)
+
+	f, err := env.ExecuteActivity(s.a.TerminateMigratedWorkflows, &request)
+	s.NoError(err)
+	var output terminateMigratedWorkflowsResponse
+	s.NoError(f.Get(&output))
+	s.Equal(int64(1), output.TerminatedWorkflowCount)
+
+	s.NotEmpty(iceptor.terminateMigratedRecordedHeartbeats)
+	lastHeartBeat := iceptor.terminateMigratedRecordedHeartbeats[len(iceptor.terminateMigratedRecordedHeartbeats)-1]
+	s.Equal(int64(1), lastHeartBeat.TerminatedWorkflowCount)
+}
+
+func (s *activitiesSuite) TestTerminateMigratedWorkflows_NotActiveInTargetCluster() {
+	env, _ := s.initEnv()
+	registry := namespace.NewMockRegistry(s.controller)
+	registry.EXPECT().GetNamespace(namespace.Name(mockedNamespace)).Return(namespace.NewGlobalNamespaceForTest(
+		nil, nil, &persistencespb.NamespaceReplicationConfig{ActiveClusterName: "current_cluster"}, 1), nil)
+	s.a.namespaceRegistry = registry
+
+	// nothing is deleted while the namespace is active in the current cluster
+	_, err := env.ExecuteActivity(s.a.TerminateMigratedWorkflows, &terminateMigratedWorkflowsRequest{
+		Namespace:         mockedNamespace,
+		NamespaceID:       mockedNamespaceID,
+		TargetClusterName: remoteCluster,
+		Executions:        []*commonpb.WorkflowExecution{execution1},
+	})
+	s.ErrorContains(err, "is not active in cluster")
+}
+
 func (s *activitiesSuite) TestGenerateReplicationTasks_Success() {
 	env, iceptor := s.initEnv()
 
@@ -588,6 +588,8 @@ type heartbeatRecordingInterceptor struct {
 	seedRecordedHeartbeats                []seedReplicationQueueWithUserDataEntriesHeartbeatDetails
 	replicationRecordedHeartbeats         []replicationTasksHeartbeatDetails
 	generateReplicationRecordedHeartbeats []int
+	verifyMigratedRecordedHeartbeats      []verifyMigratedWorkflowsHeartbeatDetails
+	terminateMigratedRecordedHeartbeats   []terminateMigratedWorkflowsHeartbeatDetails
 	T                                     *testing.T
 }
 
@@ -608,6 +610,10 @@ func (i *heartbeatRecordingInterceptor) RecordHeartbeat(ctx context.Context, det
 		i.replicationRecordedHeartbeats = append(i.replicationRecordedHeartbeats, d)
 	} else if d, ok := details[0].(int); ok {
 		i.generateReplicationRecordedHeartbeats = append(i.generateReplicationRecordedHeartbeats, d)
+	} else if d, ok := details[0].(verifyMigratedWorkflowsHeartbeatDetails); ok {
+		i.verifyMigratedRecordedHeartbeats = append(i.verifyMigratedRecordedHeartbeats, d)
+	} else if d, ok := details[0].(terminateMigratedWorkflowsHeartbeatDetails); ok {
+		i.terminateMigratedRecordedHeartbeats = append(i.terminateMigratedRecordedHeartbeats, d)
 	} else {
 		assert.Fail(i.T, "invalid heartbeat details")
 	}
@@ -0,0 +1,364 @@
+package migration
+
+import (
+	"fmt"
+	"time"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/temporal"
+	"go.temporal.io/sdk/workflow"
+)
+
+type (
+	// MigrateWorkflowsParams is the input of MigrateWorkflowsWorkflow.
+	MigrateWorkflowsParams struct {
+		Namespace         string `validate:"required"`
+		Query             string `validate:"required"` // query to list workflows to migrate
+		TargetClusterName string `validate:"required"`
+
+		ConcurrentActivityCount int
+		OverallRps              float64 // RPS for enqueuing of replication tasks
+		ListWorkflowsPageSize   int     // PageSize of ListWorkflow, will paginate through results.
+		PageCountPerExecution   int     // number of pages to be processed before continue as new, max is 1000.
+		VerifyIntervalInSeconds int     `validate:"gte=0"`
+
+		// TerminateSourceWorkflows takes the executions off the current cluster once all of them
+		// are replicated and verified. Terminating them here would replicate the termination to
+		// the target cluster, so the namespace is first handed over to the target cluster, unless
+		// it's already active there. The current cluster's copies are standby copies from then on,
+		// and the ones the target cluster is up to date with are deleted, which isn't replicated.
+		// The handover moves all of the namespace's executions to the target cluster, so this is
+		// rejected unless Query matches all of them.
+		TerminateSourceWorkflows bool
+
+		NextPageToken []byte // used by continue as new
+
+		// Set once the namespace is handed over, while the source copies are terminated.
+		TerminatingSourceWorkflows bool
+
+		// Carry over the progress after continue-as-new.
+		ContinuedAsNewCount     int
+		TotalWorkflowCount      int64
+		ReplicatedWorkflowCount int64
+		VerifiedWorkflowCount   int64
+		SkippedWorkflowCount    int64
+		TerminatedWorkflowCount int64
+		LastStartTime           time.Time
+		LastCloseTime           time.Time
+	}
+
+	// MigrateWorkflowsStatus is the result of the migrate-workflows-status query.
+	MigrateWorkflowsStatus struct {
+		TotalWorkflowCount int64
+		// ReplicatedWorkflowCount is the number of executions replication tasks were generated for.
+		ReplicatedWorkflowCount int64
+		// VerifiedWorkflowCount is the number of executions found up to date on the target cluster.
+		VerifiedWorkflowCount int64
+		// SkippedWorkflowCount is the number of executions that don't need to be verified, e.g.
+		// because they were deleted on the current cluster.
+		SkippedWorkflowCount int64
+		// TerminatedWorkflowCount is the number of the current cluster's copies deleted after
+		// the handover, see MigrateWorkflowsParams.TerminateSourceWorkflows.
+		TerminatedWorkflowCount int64
+		ContinuedAsNewCount     int
+		LastStartTime           time.Time
+		LastCloseTime           time.Time
+		// PageTokenForRestart is the list workflows page token of the first page processed by the
+		// current run.
+		PageTokenForRestart []byte
+	}
+)
+
+const (
+	migrateWorkflowsWorkflowName    = "migrate-workflows"
+	migrateWorkflowsStatusQueryType = "migrate-workflows-status"
+)
+
+// MigrateWorkflowsWorkflow replicates the executions of a namespace matching a visibility query
+// to a target cluster, verifies that the target cluster has the same state as the current
+// cluster, and optionally terminates the executions on the current cluster.
+func MigrateWorkflowsWorkflow(ctx workflow.Context, params MigrateWorkflowsParams) error {
+	startPageToken := params.NextPageToken
+
+	_ = workflow.SetQueryHandler(ctx, migrateWorkflowsStatusQueryType, func() (MigrateWorkflowsStatus, error) {
+		return MigrateWorkflowsStatus{
+			TotalWorkflowCount:      params.TotalWorkflowCount,
+			ReplicatedWorkflowCount: params.ReplicatedWorkflowCount,
+			VerifiedWorkflowCount:   params.VerifiedWorkflowCount,
+			SkippedWorkflowCount:    params.SkippedWorkflowCount,
+			TerminatedWorkflowCount: params.TerminatedWorkflowCount,
+			ContinuedAsNewCount:     params.ContinuedAsNewCount,
+			LastStartTime:           params.LastStartTime,
+			LastCloseTime:           params.LastCloseTime,
+			PageTokenForRestart:     startPageToken,
+		}, nil
+	})
+
+	if err := validateAndSetMigrateWorkflowsParams(&params); err != nil {
+		return err
+	}
+
+	if params.TotalWorkflowCount == 0 {
+		if params.TerminateSourceWorkflows {
+			// fail before replicating anything
+			if err := checkQueryCoversNamespace(ctx, params); err != nil {
+				return err
+			}
+		}
+		wfCount, err := countWorkflowForReplication(ctx, ForceReplicationParams{
+			Namespace: params.Namespace,
+			Query:     params.Query,
+		})
+		if err != nil {
+			return err
+		}
+		params.TotalWorkflowCount = wfCount
+	}
+
+	metadataResp, err := getClusterMetadata(ctx, ForceReplicationParams{Namespace: params.Namespace})
+	if err != nil {
+		return err
+	}
+
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Hour,
+		HeartbeatTimeout:    time.Second * 30,
+		RetryPolicy:         forceReplicationActivityRetryPolicy,
+	}
+	actx := workflow.WithActivityOptions(ctx, ao)
+	var a *activities
+
+	pendingPages := 0
+	var migrateErr error
+	for i := 0; i < params.PageCountPerExecution; i++ {
+		var listResp listWorkflowsResponse
+		if err := workflow.ExecuteActivity(
+			actx,
+			a.ListWorkflows,
+			&workflowservice.ListWorkflowExecutionsRequest{
+				Namespace:     params.Namespace,
+				PageSize:      int32(params.ListWorkflowsPageSize),
+				NextPageToken: params.NextPageToken,
+				Query:         params.Query,
+			}).Get(ctx, &listResp); err != nil {
+			return err
+		}
+		params.NextPageToken = listResp.NextPageToken
+		params.LastStartTime = listResp.LastStartTime
+		params.LastCloseTime = listResp.LastCloseTime
+
+		pendingPages++
+		workflow.Go(ctx, func(ctx workflow.Context) {
+			defer func() { pendingPages-- }()
+			processExecutions := migrateWorkflowExecutions
+			if params.TerminatingSourceWorkflows {
+				processExecutions = terminateSourceWorkflowExecutions
+			}
+			if err := processExecutions(ctx, metadataResp.NamespaceID, listResp.Executions, &params); err != nil && migrateErr == nil {
+				migrateErr = err
+			}
+		})
+
+		if err := workflow.Await(ctx, func() bool {
+			return pendingPages < params.ConcurrentActivityCount || migrateErr != nil
+		}); err != nil {
+			return err
+		}
+		if migrateErr != nil {
+			return migrateErr
+		}
+		if params.NextPageToken == nil {
+			break
+		}
+	}
+
+	if err := workflow.Await(ctx, func() bool { return pendingPages == 0 }); err != nil {
+		return err
+	}
+	if migrateErr != nil {
+		return migrateErr
+	}
+
+	if params.NextPageToken == nil {
+		if !params.TerminateSourceWorkflows || params.TerminatingSourceWorkflows {
+			return nil
+		}
+		if metadataResp.ActiveClusterName != params.TargetClusterName {
+			// executions started since must match the query too, or they'd be handed over
+			// without being replicated
+			if err := checkQueryCoversNamespace(ctx, params); err != nil {
+				return err
+			}
+			if err := handOverNamespace(ctx, params); err != nil {
+				return err
+			}
+		}
+		// Go over the executions again to terminate them, on a new run so that the namespace
+		// metadata is reloaded.
+		params.TerminatingSourceWorkflows = true
+	}
+
+	params.ContinuedAsNewCount++
+
+	// There are still more workflows to migrate. Continue-as-new to process on a new run.
+	// This prevents history size from exceeding the server-defined limit
+	return workflow.NewContinueAsNewError(ctx, MigrateWorkflowsWorkflow, params)
+}
+
+// migrateWorkflowExecutions replicates and verifies one page of executions.
+func migrateWorkflowExecutions(
+	ctx workflow.Context,
+	namespaceID string,
+	executions []*commonpb.WorkflowExecution,
+	params *MigrateWorkflowsParams,
+) error {
+	if len(executions) == 0 {
+		return nil
+	}
+
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Hour,
+		HeartbeatTimeout:    time.Second * 60,
+		RetryPolicy:         forceReplicationActivityRetryPolicy,
+	}
+	actx := workflow.WithActivityOptions(ctx, ao)
+	var a *activities
+
+	if err := workflow.ExecuteActivity(
+		actx,
+		a.GenerateReplicationTasks,
+		&generateReplicationTasksRequest{
+			NamespaceID:    namespaceID,
+			Executions:     executions,
+			RPS:            params.OverallRps / float64(params.ConcurrentActivityCount),
+			TargetClusters: []string{params.TargetClusterName},
+		}).Get(ctx, nil); err != nil {
+		return err
+	}
+	params.ReplicatedWorkflowCount += int64(len(executions))
+
+	var verifyResp verifyMigratedWorkflowsResponse
+	if err := workflow.ExecuteActivity(
+		actx,
+		a.VerifyMigratedWorkflows,
+		&verifyMigratedWorkflowsRequest{
+			Namespace:         params.Namespace,
+			NamespaceID:       namespaceID,
+			TargetClusterName: params.TargetClusterName,
+			Executions:        executions,
+			VerifyInterval:    time.Duration(params.VerifyIntervalInSeconds) * time.Second,
+			RPS:               params.OverallRps / float64(params.ConcurrentActivityCount),
+		}).Get(ctx, &verifyResp); err != nil {
+		return err
+	}
+	params.VerifiedWorkflowCount += verifyResp.VerifiedWorkflowCount
+	params.SkippedWorkflowCount += verifyResp.SkippedWorkflowCount
+	return nil
+}
+
+// checkQueryCoversNamespace fails unless the query of params matches all executions of the
+// namespace, as handing the namespace over would otherwise leave the executions not matching it
+// standby on the current cluster, without a copy on the target cluster.
+func checkQueryCoversNamespace(ctx workflow.Context, params MigrateWorkflowsParams) error {
+	namespaceCount, err := countWorkflowForReplication(ctx, ForceReplicationParams{Namespace: params.Namespace})
+	if err != nil {
+		return err
+	}
+	queryCount, err := countWorkflowForReplication(ctx, ForceReplicationParams{
+		Namespace: params.Namespace,
+		Query:     params.Query,
+	})
+	if err != nil {
+		return err
+	}
+	if queryCount != namespaceCount {
+		return temporal.NewNonRetryableApplicationError(
+			fmt.Sprintf("InvalidArgument: TerminateSourceWorkflows requires Query to match all executions of the namespace, it matches %d of %d", queryCount, namespaceCount),
+			"InvalidArgument",
+			nil)
+	}
+	return nil
+}
+
+// handOverNamespace makes the target cluster active for the namespace, so that the current
+// cluster's copies of the executions can be terminated without affecting the target's.
+func handOverNamespace(ctx workflow.Context, params MigrateWorkflowsParams) error {
+	options := workflow.ChildWorkflowOptions{
+		WorkflowID: fmt.Sprintf("%s-namespace-handover", workflow.GetInfo(ctx).WorkflowExecution.ID),
+	}
+	childCtx := workflow.WithChildOptions(ctx, options)
+	return workflow.ExecuteChildWorkflow(childCtx, namespaceHandoverWorkflowName, NamespaceHandoverParams{
+		Namespace:              params.Namespace,
+		RemoteCluster:          params.TargetClusterName,
+		HandoverTimeoutSeconds: maximumHandoverTimeoutSeconds,
+	}).Get(ctx, nil)
+}
+
+// terminateSourceWorkflowExecutions terminates the current cluster's copies of one page of
+// executions, after the namespace was handed over to the target cluster.
+func terminateSourceWorkflowExecutions(
+	ctx workflow.Context,
+	namespaceID string,
+	executions []*commonpb.WorkflowExecution,
+	params *MigrateWorkflowsParams,
+) error {
+	if len(executions) == 0 {
+		return nil
+	}
+
+	ao := workflow.ActivityOptions{
+		StartToCloseTimeout: time.Hour,
+		HeartbeatTimeout:    time.Second * 60,
+		RetryPolicy:         forceReplicationActivityRetryPolicy,
+	}
+	actx := workflow.WithActivityOptions(ctx, ao)
+	var a *activities
+
+	var terminateResp terminateMigratedWorkflowsResponse
+	if err := workflow.ExecuteActivity(
+		actx,
+		a.TerminateMigratedWorkflows,
+		&terminateMigratedWorkflowsRequest{
+			Namespace:         params.Namespace,
+			NamespaceID:       namespaceID,
+			TargetClusterName: params.TargetClusterName,
+			Executions:        executions,
+		}).Get(ctx, &terminateResp); err != nil {
+		return err
+	}
+	params.TerminatedWorkflowCount += terminateResp.TerminatedWorkflowCount
+	return nil
+}
+
+func validateAndSetMigrateWorkflowsParams(params *MigrateWorkflowsParams) error {
+	if len(params.Namespace) == 0 {
+		return temporal.NewNonRetryableApplicationError("InvalidArgument: Namespace is required", "InvalidArgument", nil)
+	}
+	if len(params.Query) == 0 {
+		return temporal.NewNonRetryableApplicationError("InvalidArgument: Query is required", "InvalidArgument", nil)
+	}
+	if len(params.TargetClusterName) == 0 {
+		return temporal.NewNonRetryableApplicationError("InvalidArgument: TargetClusterName is required", "InvalidArgument", nil)
+	}
+
+	if params.ConcurrentActivityCount <= 0 {
+		params.ConcurrentActivityCount = 1
+	}
+	if params.OverallRps <= 0 {
+		params.OverallRps = float64(params.ConcurrentActivityCount)
+	}
+	if params.ListWorkflowsPageSize <= 0 {
+		params.ListWorkflowsPageSize = defaultListWorkflowsPageSize
+	}
+	if params.PageCountPerExecution <= 0 {
+		params.PageCountPerExecution = defaultPageCountPerExecution
+	}
+	if params.PageCountPerExecution > maxPageCountPerExecution {
+		params.PageCountPerExecution = maxPageCountPerExecution
+	}
+	if params.VerifyIntervalInSeconds <= 0 {
+		params.VerifyIntervalInSeconds = defaultVerifyIntervalInSeconds
+	}
+	return nil
+}
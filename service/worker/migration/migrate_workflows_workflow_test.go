@@ -0,0 +1,207 @@
+package migration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pborman/uuid"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/converter"
+	"go.temporal.io/sdk/testsuite"
+	"go.temporal.io/sdk/workflow"
+)
+
+func TestMigrateWorkflowsWorkflow(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	namespaceID := uuid.New()
+	executions := []*commonpb.WorkflowExecution{
+		{WorkflowId: "wf1", RunId: "run1"},
+		{WorkflowId: "wf2", RunId: "run2"},
+	}
+
+	var a *activities
+	env.OnActivity(a.CountWorkflow, mock.Anything, mock.Anything).Return(&countWorkflowResponse{WorkflowCount: 4}, nil)
+	env.OnActivity(a.GetMetadata, mock.Anything, metadataRequest{Namespace: "test-ns"}).Return(&metadataResponse{ShardCount: 4, NamespaceID: namespaceID}, nil)
+
+	pageCount := 0
+	env.OnActivity(a.ListWorkflows, mock.Anything, mock.Anything).Return(func(ctx context.Context, request *workflowservice.ListWorkflowExecutionsRequest) (*listWorkflowsResponse, error) {
+		require.Equal(t, "test-ns", request.Namespace)
+		require.Equal(t, "WorkflowType = 'test'", request.Query)
+		pageCount++
+		if pageCount == 1 {
+			return &listWorkflowsResponse{Executions: executions, NextPageToken: []byte("token")}, nil
+		}
+		return &listWorkflowsResponse{Executions: executions}, nil
+	}).Times(2)
+	env.OnActivity(a.GenerateReplicationTasks, mock.Anything, mock.Anything).Return(func(ctx context.Context, request *generateReplicationTasksRequest) error {
+		require.Equal(t, []string{"target"}, request.TargetClusters)
+		return nil
+	}).Times(2)
+	env.OnActivity(a.VerifyMigratedWorkflows, mock.Anything, mock.Anything).Return(verifyMigratedWorkflowsResponse{
+		VerifiedWorkflowCount: 1,
+		SkippedWorkflowCount:  1,
+	}, nil).Times(2)
+
+	env.ExecuteWorkflow(MigrateWorkflowsWorkflow, MigrateWorkflowsParams{
+		Namespace:               "test-ns",
+		Query:                   "WorkflowType = 'test'",
+		TargetClusterName:       "target",
+		ConcurrentActivityCount: 2,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+
+	envValue, err := env.QueryWorkflow(migrateWorkflowsStatusQueryType)
+	require.NoError(t, err)
+	var status MigrateWorkflowsStatus
+	require.NoError(t, envValue.Get(&status))
+	require.Equal(t, MigrateWorkflowsStatus{
+		TotalWorkflowCount:      4,
+		ReplicatedWorkflowCount: 4,
+		VerifiedWorkflowCount:   2,
+		SkippedWorkflowCount:    2,
+	}, status)
+}
+
+func TestMigrateWorkflowsWorkflow_TerminateSourceWorkflows(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	executions := []*commonpb.WorkflowExecution{{WorkflowId: "wf1", RunId: "run1"}}
+
+	var a *activities
+	// the query matches all executions of the namespace
+	env.OnActivity(a.CountWorkflow, mock.Anything, mock.Anything).Return(&countWorkflowResponse{WorkflowCount: 1}, nil).Times(2)
+	env.OnActivity(a.GetMetadata, mock.Anything, mock.Anything).Return(&metadataResponse{NamespaceID: uuid.New(), ActiveClusterName: "source"}, nil)
+	env.OnActivity(a.ListWorkflows, mock.Anything, mock.Anything).Return(&listWorkflowsResponse{Executions: executions}, nil).Times(1)
+	env.OnActivity(a.GenerateReplicationTasks, mock.Anything, mock.Anything).Return(nil).Times(1)
+	env.OnActivity(a.VerifyMigratedWorkflows, mock.Anything, mock.Anything).Return(verifyMigratedWorkflowsResponse{VerifiedWorkflowCount: 1}, nil).Times(1)
+	// the executions are only terminated after the handover, on the next run
+	env.RegisterWorkflowWithOptions(NamespaceHandoverWorkflow, workflow.RegisterOptions{Name: namespaceHandoverWorkflowName})
+	env.OnWorkflow(namespaceHandoverWorkflowName, mock.Anything, mock.Anything).Return(func(ctx workflow.Context, params NamespaceHandoverParams) error {
+		require.Equal(t, "test-ns", params.Namespace)
+		require.Equal(t, "target", params.RemoteCluster)
+		return nil
+	}).Times(1)
+
+	env.ExecuteWorkflow(MigrateWorkflowsWorkflow, MigrateWorkflowsParams{
+		Namespace:                "test-ns",
+		Query:                    "WorkflowType = 'test'",
+		TargetClusterName:        "target",
+		TotalWorkflowCount:       1,
+		TerminateSourceWorkflows: true,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	var canErr *workflow.ContinueAsNewError
+	require.ErrorAs(t, env.GetWorkflowError(), &canErr)
+	var next MigrateWorkflowsParams
+	require.NoError(t, converter.GetDefaultDataConverter().FromPayloads(canErr.Input, &next))
+	require.True(t, next.TerminatingSourceWorkflows)
+	require.Nil(t, next.NextPageToken)
+	require.Equal(t, int64(1), next.VerifiedWorkflowCount)
+	env.AssertExpectations(t)
+}
+
+func TestMigrateWorkflowsWorkflow_TerminateSourceWorkflowsOfQuery(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	env.OnActivity(a.CountWorkflow, mock.Anything, mock.Anything).Return(func(ctx context.Context, request *workflowservice.CountWorkflowExecutionsRequest) (*countWorkflowResponse, error) {
+		if request.Query == "" {
+			return &countWorkflowResponse{WorkflowCount: 3}, nil
+		}
+		return &countWorkflowResponse{WorkflowCount: 2}, nil
+	})
+
+	// nothing is replicated when the handover would move executions not matching the query
+	env.ExecuteWorkflow(MigrateWorkflowsWorkflow, MigrateWorkflowsParams{
+		Namespace:                "test-ns",
+		Query:                    "WorkflowType = 'test'",
+		TargetClusterName:        "target",
+		TerminateSourceWorkflows: true,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.ErrorContains(t, env.GetWorkflowError(), "matches 2 of 3")
+	env.AssertNotCalled(t, "ListWorkflows", mock.Anything, mock.Anything)
+}
+
+func TestMigrateWorkflowsWorkflow_TerminatingSourceWorkflows(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+	executions := []*commonpb.WorkflowExecution{
+		{WorkflowId: "wf1", RunId: "run1"},
+		{WorkflowId: "wf2", RunId: "run2"},
+	}
+
+	var a *activities
+	env.OnActivity(a.GetMetadata, mock.Anything, mock.Anything).Return(&metadataResponse{NamespaceID: uuid.New(), ActiveClusterName: "target"}, nil)
+	env.OnActivity(a.ListWorkflows, mock.Anything, mock.Anything).Return(&listWorkflowsResponse{Executions: executions}, nil).Times(1)
+	env.OnActivity(a.TerminateMigratedWorkflows, mock.Anything, mock.Anything).Return(func(ctx context.Context, request *terminateMigratedWorkflowsRequest) (terminateMigratedWorkflowsResponse, error) {
+		require.Equal(t, executions, request.Executions)
+		require.Equal(t, "target", request.TargetClusterName)
+		return terminateMigratedWorkflowsResponse{TerminatedWorkflowCount: 2}, nil
+	}).Times(1)
+
+	env.ExecuteWorkflow(MigrateWorkflowsWorkflow, MigrateWorkflowsParams{
+		Namespace:                  "test-ns",
+		Query:                      "WorkflowType = 'test'",
+		TargetClusterName:          "target",
+		TotalWorkflowCount:         2,
+		TerminateSourceWorkflows:   true,
+		TerminatingSourceWorkflows: true,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.NoError(t, env.GetWorkflowError())
+	env.AssertExpectations(t)
+
+	envValue, err := env.QueryWorkflow(migrateWorkflowsStatusQueryType)
+	require.NoError(t, err)
+	var status MigrateWorkflowsStatus
+	require.NoError(t, envValue.Get(&status))
+	require.Equal(t, int64(2), status.TerminatedWorkflowCount)
+}
+
+func TestMigrateWorkflowsWorkflow_ContinueAsNew(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	var a *activities
+	env.OnActivity(a.GetMetadata, mock.Anything, mock.Anything).Return(&metadataResponse{NamespaceID: uuid.New()}, nil)
+	env.OnActivity(a.ListWorkflows, mock.Anything, mock.Anything).Return(&listWorkflowsResponse{NextPageToken: []byte("token")}, nil).Times(1)
+
+	env.ExecuteWorkflow(MigrateWorkflowsWorkflow, MigrateWorkflowsParams{
+		Namespace:             "test-ns",
+		Query:                 "WorkflowType = 'test'",
+		TargetClusterName:     "target",
+		PageCountPerExecution: 1,
+		TotalWorkflowCount:    10,
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	err := env.GetWorkflowError()
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "continue as new")
+	env.AssertExpectations(t)
+}
+
+func TestMigrateWorkflowsWorkflow_InvalidParams(t *testing.T) {
+	testSuite := &testsuite.WorkflowTestSuite{}
+	env := testSuite.NewTestWorkflowEnvironment()
+
+	env.ExecuteWorkflow(MigrateWorkflowsWorkflow, MigrateWorkflowsParams{
+		Namespace: "test-ns",
+		Query:     "WorkflowType = 'test'",
+	})
+
+	require.True(t, env.IsWorkflowCompleted())
+	require.ErrorContains(t, env.GetWorkflowError(), "TargetClusterName is required")
+}
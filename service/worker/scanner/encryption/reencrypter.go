@@ -0,0 +1,530 @@
+package encryption
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	commonpb "go.temporal.io/api/common/v1"
+	"go.temporal.io/api/serviceerror"
+	"go.temporal.io/sdk/activity"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common"
+	"go.temporal.io/server/common/collection"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/log/tag"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	persistenceencryption "go.temporal.io/server/common/persistence/encryption"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/quotas"
+)
+
+type (
+	// ReencrypterHeartbeatDetails is the heartbeat detail for HistoryReencryptionActivity
+	ReencrypterHeartbeatDetails struct {
+		SuccessCount       int
+		ErrorCount         int
+		RewrittenNodeCount int
+		CurrentPage        int
+
+		NextPageToken []byte
+
+		// The executions are re-encrypted once all history branches are.
+		HistoryBranchesDone        bool
+		RewrittenMutableStateCount int
+		RefreshedVisibilityCount   int
+		ExecutionsShardID          int32
+		ExecutionsNextPageToken    []byte
+	}
+
+	// Reencrypter is the type that holds the state for the history re-encryption daemon
+	Reencrypter struct {
+		numShards         int32
+		db                persistence.ExecutionManager
+		store             persistenceencryption.Reencrypter
+		shardStore        persistence.ShardStore
+		visibilityManager manager.VisibilityManager
+		historyClient     historyservice.HistoryServiceClient
+		namespaceRegistry namespace.Registry
+		rateLimiter       quotas.RateLimiter
+		logger            log.Logger
+		isInTest          bool
+
+		sync.WaitGroup
+		sync.Mutex
+		hbd ReencrypterHeartbeatDetails
+	}
+
+	taskDetail struct {
+		shardID     int32
+		branchToken []byte
+		branchInfo  persistence.HistoryBranchDetail
+	}
+
+	executionTaskDetail struct {
+		shardID     int32
+		rangeID     int64
+		namespaceID string
+		workflowID  string
+		runID       string
+	}
+)
+
+const (
+	pageSize  = 100
+	numWorker = 10
+)
+
+// NewReencrypter returns an instance of the history re-encryption daemon.
+// Calling the Run() method on the returned object results in one complete iteration
+// over all of the history branches in the system, followed by one over all of the executions.
+// The history nodes of each branch that are not encrypted with the active key are rewritten
+// with the active key. So are the mutable state of each execution, and its visibility record,
+// which is re-recorded by the history service.
+func NewReencrypter(
+	numShards int32,
+	db persistence.ExecutionManager,
+	store persistenceencryption.Reencrypter,
+	shardStore persistence.ShardStore,
+	visibilityManager manager.VisibilityManager,
+	historyClient historyservice.HistoryServiceClient,
+	namespaceRegistry namespace.Registry,
+	rps int,
+	hbd ReencrypterHeartbeatDetails,
+	logger log.Logger,
+) *Reencrypter {
+	return &Reencrypter{
+		numShards:         numShards,
+		db:                db,
+		store:             store,
+		shardStore:        shardStore,
+		visibilityManager: visibilityManager,
+		historyClient:     historyClient,
+		namespaceRegistry: namespaceRegistry,
+		rateLimiter: quotas.NewDefaultOutgoingRateLimiter(
+			func() float64 { return float64(rps) },
+		),
+		logger: logger,
+
+		hbd: hbd,
+	}
+}
+
+// Run runs the re-encrypter
+func (r *Reencrypter) Run(ctx context.Context) (ReencrypterHeartbeatDetails, error) {
+	r.Lock()
+	historyBranchesDone := r.hbd.HistoryBranchesDone
+	r.Unlock()
+
+	if !historyBranchesDone {
+		if err := runTasks(ctx, r, r.loadTasks, r.reencryptHistoryBranch); err != nil {
+			return r.details(), err
+		}
+		r.Lock()
+		r.hbd.HistoryBranchesDone = true
+		r.Unlock()
+	}
+
+	err := runTasks(ctx, r, r.loadExecutionTasks, r.reencryptExecution)
+	return r.details(), err
+}
+
+// runTasks processes the tasks loaded by load with numWorker workers.
+func runTasks[T any](
+	ctx context.Context,
+	r *Reencrypter,
+	load func(ctx context.Context, reqCh chan T) error,
+	process func(ctx context.Context, task T),
+) error {
+	reqCh := make(chan T, pageSize)
+
+	var loadErr error
+	r.WaitGroup.Add(1)
+	go func() {
+		defer r.WaitGroup.Done()
+		loadErr = load(ctx, reqCh)
+	}()
+	for i := 0; i < numWorker; i++ {
+		r.WaitGroup.Add(1)
+		go taskWorker(ctx, r, reqCh, process)
+	}
+
+	r.WaitGroup.Wait()
+	return loadErr
+}
+
+func (r *Reencrypter) loadTasks(
+	ctx context.Context,
+	reqCh chan taskDetail,
+) error {
+
+	defer close(reqCh)
+
+	iter := collection.NewPagingIteratorWithToken(r.getPaginationFn(ctx), r.hbd.NextPageToken)
+	for iter.HasNext() {
+		item, err := iter.Next()
+		if err != nil {
+			return err
+		}
+
+		r.heartbeat(ctx)
+
+		task := r.filterTask(item)
+		if task == nil {
+			continue
+		}
+
+		select {
+		case reqCh <- *task:
+			// noop
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+func (r *Reencrypter) loadExecutionTasks(
+	ctx context.Context,
+	reqCh chan executionTaskDetail,
+) error {
+
+	defer close(reqCh)
+
+	r.Lock()
+	// shard IDs start at 1
+	startShardID := max(r.hbd.ExecutionsShardID, 1)
+	startPageToken := r.hbd.ExecutionsNextPageToken
+	r.Unlock()
+
+	for shardID := startShardID; shardID <= r.numShards; shardID++ {
+		var pageToken []byte
+		if shardID == startShardID {
+			pageToken = startPageToken
+		}
+		if err := r.loadShardExecutionTasks(ctx, shardID, pageToken, reqCh); err != nil {
+			return err
+		}
+
+		r.Lock()
+		r.hbd.ExecutionsShardID = shardID + 1
+		r.hbd.ExecutionsNextPageToken = nil
+		r.Unlock()
+	}
+
+	return nil
+}
+
+func (r *Reencrypter) loadShardExecutionTasks(
+	ctx context.Context,
+	shardID int32,
+	pageToken []byte,
+	reqCh chan executionTaskDetail,
+) error {
+
+	rangeID, err := r.getRangeID(ctx, shardID)
+	if common.IsNotFoundError(err) {
+		// the shard was never loaded, so it has no executions
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	iter := collection.NewPagingIteratorWithToken(r.getExecutionsPaginationFn(ctx, shardID), pageToken)
+	for iter.HasNext() {
+		item, err := iter.Next()
+		if err != nil {
+			return err
+		}
+
+		r.heartbeat(ctx)
+
+		select {
+		case reqCh <- executionTaskDetail{
+			shardID:     shardID,
+			rangeID:     rangeID,
+			namespaceID: item.GetExecutionInfo().GetNamespaceId(),
+			workflowID:  item.GetExecutionInfo().GetWorkflowId(),
+			runID:       item.GetExecutionState().GetRunId(),
+		}:
+			// noop
+
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+func taskWorker[T any](
+	ctx context.Context,
+	r *Reencrypter,
+	taskCh chan T,
+	process func(ctx context.Context, task T),
+) {
+
+	defer r.WaitGroup.Done()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case task, ok := <-taskCh:
+			if !ok {
+				return
+			}
+
+			if err := r.rateLimiter.Wait(ctx); err != nil {
+				// context done
+				return
+			}
+			r.heartbeat(ctx)
+			process(ctx, task)
+		}
+	}
+}
+
+func (r *Reencrypter) reencryptHistoryBranch(
+	ctx context.Context,
+	task taskDetail,
+) {
+	count, err := r.store.ReencryptHistoryBranch(ctx, task.shardID, task.branchToken, task.branchInfo.BranchInfo)
+	if err != nil {
+		r.logger.Error("unable to re-encrypt history branch",
+			tag.DetailInfo(task.branchInfo.Info),
+			tag.WorkflowBranchToken(task.branchToken),
+			tag.Error(err),
+		)
+	}
+	r.handleResult(count, err)
+}
+
+// reencryptExecution has the history service re-record the visibility record of the execution
+// if its memo is not encrypted with the active key, and then rewrites the mutable state of the
+// execution if it's not encrypted with the active key. The mutable state is rewritten last, as
+// its rewrite makes the next write of the history service to it fail once on a conflict.
+func (r *Reencrypter) reencryptExecution(
+	ctx context.Context,
+	task executionTaskDetail,
+) {
+	refreshed, err := r.refreshVisibility(ctx, task)
+	if err != nil {
+		r.logger.Error("unable to re-encrypt visibility record",
+			tag.ShardID(task.shardID),
+			tag.WorkflowNamespaceID(task.namespaceID),
+			tag.WorkflowID(task.workflowID),
+			tag.WorkflowRunID(task.runID),
+			tag.Error(err),
+		)
+		r.handleExecutionResult(false, false, err)
+		return
+	}
+
+	rewritten, err := r.store.ReencryptMutableState(ctx, task.shardID, task.rangeID, task.namespaceID, task.workflowID, task.runID)
+	if common.IsNotFoundError(err) {
+		// deleted since it was listed
+		err = nil
+	}
+	if err != nil {
+		r.logger.Error("unable to re-encrypt mutable state",
+			tag.ShardID(task.shardID),
+			tag.WorkflowNamespaceID(task.namespaceID),
+			tag.WorkflowID(task.workflowID),
+			tag.WorkflowRunID(task.runID),
+			tag.Error(err),
+		)
+	}
+	r.handleExecutionResult(rewritten, refreshed, err)
+}
+
+func (r *Reencrypter) refreshVisibility(
+	ctx context.Context,
+	task executionTaskDetail,
+) (bool, error) {
+	namespaceName, err := r.namespaceRegistry.GetNamespaceName(namespace.ID(task.namespaceID))
+	var namespaceNotFound *serviceerror.NamespaceNotFound
+	if errors.As(err, &namespaceNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	response, err := r.visibilityManager.GetWorkflowExecution(ctx, &manager.GetWorkflowExecutionRequest{
+		NamespaceID: namespace.ID(task.namespaceID),
+		Namespace:   namespaceName,
+		RunID:       task.runID,
+		WorkflowID:  task.workflowID,
+	})
+	if common.IsNotFoundError(err) {
+		// not recorded yet, it will be recorded with the active key
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	if len(response.Execution.GetMemo().GetFields()) == 0 || response.MemoEncryptionKeyID == r.store.ActiveKeyID() {
+		return false, nil
+	}
+
+	// Regenerating the tasks of the execution includes its visibility tasks.
+	_, err = r.historyClient.RefreshWorkflowTasks(ctx, &historyservice.RefreshWorkflowTasksRequest{
+		NamespaceId: task.namespaceID,
+		Request: &adminservice.RefreshWorkflowTasksRequest{
+			NamespaceId: task.namespaceID,
+			Execution: &commonpb.WorkflowExecution{
+				WorkflowId: task.workflowID,
+				RunId:      task.runID,
+			},
+		},
+	})
+	if common.IsNotFoundError(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (r *Reencrypter) heartbeat(ctx context.Context) {
+	r.Lock()
+	defer r.Unlock()
+
+	if !r.isInTest {
+		activity.RecordHeartbeat(ctx, r.hbd)
+	}
+}
+
+func (r *Reencrypter) details() ReencrypterHeartbeatDetails {
+	r.Lock()
+	defer r.Unlock()
+	return r.hbd
+}
+
+func (r *Reencrypter) filterTask(
+	branch persistence.HistoryBranchDetail,
+) *taskDetail {
+	namespaceID, workflowID, _, err := persistence.SplitHistoryGarbageCleanupInfo(branch.Info)
+	if err != nil {
+		r.logger.Error("unable to parse the history cleanup info", tag.DetailInfo(branch.Info), tag.Error(err))
+		r.handleResult(0, err)
+		return nil
+	}
+
+	branchToken, err := serialization.HistoryBranchToBlob(branch.BranchInfo)
+	if err != nil {
+		r.logger.Error("unable to serialize the history branch token", tag.DetailInfo(branch.Info), tag.Error(err))
+		r.handleResult(0, err)
+		return nil
+	}
+
+	return &taskDetail{
+		shardID:     common.WorkflowIDToHistoryShard(namespaceID, workflowID, r.numShards),
+		branchToken: branchToken.Data,
+		branchInfo:  branch,
+	}
+}
+
+func (r *Reencrypter) handleResult(
+	rewrittenNodeCount int,
+	err error,
+) {
+	r.Lock()
+	defer r.Unlock()
+	r.hbd.RewrittenNodeCount += rewrittenNodeCount
+	if err != nil {
+		r.hbd.ErrorCount++
+		return
+	}
+	r.hbd.SuccessCount++
+}
+
+func (r *Reencrypter) handleExecutionResult(
+	mutableStateRewritten bool,
+	visibilityRefreshed bool,
+	err error,
+) {
+	r.Lock()
+	defer r.Unlock()
+	if mutableStateRewritten {
+		r.hbd.RewrittenMutableStateCount++
+	}
+	if visibilityRefreshed {
+		r.hbd.RefreshedVisibilityCount++
+	}
+	if err != nil {
+		r.hbd.ErrorCount++
+		return
+	}
+	r.hbd.SuccessCount++
+}
+
+// getRangeID returns the range ID of a shard, which the mutable state is rewritten with.
+func (r *Reencrypter) getRangeID(
+	ctx context.Context,
+	shardID int32,
+) (int64, error) {
+	// without CreateShardInfo the shard is not created if it doesn't exist
+	resp, err := r.shardStore.GetOrCreateShard(ctx, &persistence.InternalGetOrCreateShardRequest{
+		ShardID: shardID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	shardInfo, err := serialization.NewSerializer().ShardInfoFromBlob(resp.ShardInfo)
+	if err != nil {
+		return 0, err
+	}
+	return shardInfo.GetRangeId(), nil
+}
+
+func (r *Reencrypter) getPaginationFn(
+	ctx context.Context,
+) collection.PaginationFn[persistence.HistoryBranchDetail] {
+	return func(paginationToken []byte) ([]persistence.HistoryBranchDetail, []byte, error) {
+		resp, err := r.db.GetAllHistoryTreeBranches(ctx, &persistence.GetAllHistoryTreeBranchesRequest{
+			PageSize:      pageSize,
+			NextPageToken: paginationToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		r.Lock()
+		r.hbd.CurrentPage++
+		r.hbd.NextPageToken = resp.NextPageToken
+		r.Unlock()
+
+		return resp.Branches, resp.NextPageToken, nil
+	}
+}
+
+func (r *Reencrypter) getExecutionsPaginationFn(
+	ctx context.Context,
+	shardID int32,
+) collection.PaginationFn[*persistencespb.WorkflowMutableState] {
+	return func(paginationToken []byte) ([]*persistencespb.WorkflowMutableState, []byte, error) {
+		resp, err := r.db.ListConcreteExecutions(ctx, &persistence.ListConcreteExecutionsRequest{
+			ShardID:   shardID,
+			PageSize:  pageSize,
+			PageToken: paginationToken,
+		})
+		if err != nil {
+			return nil, nil, err
+		}
+
+		r.Lock()
+		r.hbd.ExecutionsShardID = shardID
+		r.hbd.ExecutionsNextPageToken = resp.PageToken
+		r.Unlock()
+
+		return resp.States, resp.PageToken, nil
+	}
+}
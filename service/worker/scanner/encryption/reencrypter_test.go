@@ -0,0 +1,125 @@
+package encryption
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/api/serviceerror"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	"go.temporal.io/server/api/historyservice/v1"
+	"go.temporal.io/server/api/historyservicemock/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/namespace"
+	"go.temporal.io/server/common/persistence"
+	"go.temporal.io/server/common/persistence/mock"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/visibility/manager"
+	"go.temporal.io/server/common/testing/protomock"
+	"go.uber.org/mock/gomock"
+)
+
+type fakeReencryptionStore struct {
+	sync.Mutex
+	outdated  map[string]bool
+	rewritten []string
+	rangeIDs  []int64
+}
+
+func (f *fakeReencryptionStore) ReencryptHistoryBranch(context.Context, int32, []byte, *persistencespb.HistoryBranch) (int, error) {
+	panic("history branches are already re-encrypted")
+}
+
+func (f *fakeReencryptionStore) ReencryptMutableState(_ context.Context, _ int32, rangeID int64, _ string, workflowID string, _ string) (bool, error) {
+	f.Lock()
+	defer f.Unlock()
+	f.rangeIDs = append(f.rangeIDs, rangeID)
+	if !f.outdated[workflowID] {
+		return false, nil
+	}
+	f.rewritten = append(f.rewritten, workflowID)
+	return true, nil
+}
+
+func (f *fakeReencryptionStore) ActiveKeyID() string {
+	return "key-2"
+}
+
+func TestReencrypter_Executions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	executionManager := persistence.NewMockExecutionManager(ctrl)
+	shardStore := mock.NewMockShardStore(ctrl)
+	visibilityManager := manager.NewMockVisibilityManager(ctrl)
+	historyClient := historyservicemock.NewMockHistoryServiceClient(ctrl)
+	registry := namespace.NewMockRegistry(ctrl)
+	store := &fakeReencryptionStore{outdated: map[string]bool{"wf-1": true}}
+
+	shardInfo, err := serialization.NewSerializer().ShardInfoToBlob(&persistencespb.ShardInfo{ShardId: 1, RangeId: 5}, enumspb.ENCODING_TYPE_PROTO3)
+	require.NoError(t, err)
+	shardStore.EXPECT().GetOrCreateShard(gomock.Any(), &persistence.InternalGetOrCreateShardRequest{ShardID: 1}).Return(
+		&persistence.InternalGetOrCreateShardResponse{ShardInfo: shardInfo}, nil)
+	// shard 2 was never loaded
+	shardStore.EXPECT().GetOrCreateShard(gomock.Any(), &persistence.InternalGetOrCreateShardRequest{ShardID: 2}).Return(
+		nil, serviceerror.NewNotFound("shard not found"))
+
+	newState := func(workflowID string) *persistencespb.WorkflowMutableState {
+		return &persistencespb.WorkflowMutableState{
+			ExecutionInfo:  &persistencespb.WorkflowExecutionInfo{NamespaceId: "namespace-id", WorkflowId: workflowID},
+			ExecutionState: &persistencespb.WorkflowExecutionState{RunId: "run-" + workflowID},
+		}
+	}
+	executionManager.EXPECT().ListConcreteExecutions(gomock.Any(), &persistence.ListConcreteExecutionsRequest{ShardID: 1, PageSize: pageSize}).Return(
+		&persistence.ListConcreteExecutionsResponse{States: []*persistencespb.WorkflowMutableState{newState("wf-1"), newState("wf-2")}}, nil)
+
+	registry.EXPECT().GetNamespaceName(namespace.ID("namespace-id")).Return(namespace.Name("namespace"), nil).Times(2)
+	memo := &commonpb.Memo{Fields: map[string]*commonpb.Payload{"key": {Data: []byte("value")}}}
+	visibilityManager.EXPECT().GetWorkflowExecution(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, request *manager.GetWorkflowExecutionRequest) (*manager.GetWorkflowExecutionResponse, error) {
+			require.Equal(t, namespace.Name("namespace"), request.Namespace)
+			keyID := "key-2"
+			if request.WorkflowID == "wf-1" {
+				keyID = "key-1"
+			}
+			return &manager.GetWorkflowExecutionResponse{
+				Execution:           &workflowpb.WorkflowExecutionInfo{Memo: memo},
+				MemoEncryptionKeyID: keyID,
+			}, nil
+		}).Times(2)
+	// only the visibility record with the outdated key is re-recorded
+	historyClient.EXPECT().RefreshWorkflowTasks(gomock.Any(), protomock.Eq(&historyservice.RefreshWorkflowTasksRequest{
+		NamespaceId: "namespace-id",
+		Request: &adminservice.RefreshWorkflowTasksRequest{
+			NamespaceId: "namespace-id",
+			Execution:   &commonpb.WorkflowExecution{WorkflowId: "wf-1", RunId: "run-wf-1"},
+		},
+	})).Return(&historyservice.RefreshWorkflowTasksResponse{}, nil)
+
+	reencrypter := NewReencrypter(
+		2,
+		executionManager,
+		store,
+		shardStore,
+		visibilityManager,
+		historyClient,
+		registry,
+		1000,
+		ReencrypterHeartbeatDetails{HistoryBranchesDone: true},
+		log.NewTestLogger(),
+	)
+	reencrypter.isInTest = true
+	hbd, err := reencrypter.Run(context.Background())
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"wf-1"}, store.rewritten)
+	require.Equal(t, []int64{5, 5}, store.rangeIDs)
+	require.Equal(t, 2, hbd.SuccessCount)
+	require.Equal(t, 0, hbd.ErrorCount)
+	require.Equal(t, 1, hbd.RewrittenMutableStateCount)
+	require.Equal(t, 1, hbd.RefreshedVisibilityCount)
+	require.Equal(t, int32(3), hbd.ExecutionsShardID)
+}
@@ -49,6 +49,8 @@ type (
 		HistoryScannerEnabled dynamicconfig.BoolPropertyFn
 		// ExecutionsScannerEnabled indicates if executions scanner should be started as part of scanner
 		ExecutionsScannerEnabled dynamicconfig.BoolPropertyFn
+		// HistoryReencryptionScannerEnabled indicates if history re-encryption scanner should be started as part of scanner
+		HistoryReencryptionScannerEnabled dynamicconfig.BoolPropertyFn
 		// HistoryScannerDataMinAge indicates the cleanup threshold of history branch data
 		// Only clean up history branches that older than this threshold
 		HistoryScannerDataMinAge dynamicconfig.DurationPropertyFn
@@ -80,6 +82,7 @@ type (
 		sdkClientFactory   sdk.ClientFactory
 		metricsHandler     metrics.Handler
 		executionManager   persistence.ExecutionManager
+		dataStoreFactory   persistence.DataStoreFactory
 		taskManager        persistence.TaskManager
 		visibilityManager  manager.VisibilityManager
 		metadataManager    persistence.MetadataManager
@@ -112,6 +115,7 @@ func New(
 	sdkClientFactory sdk.ClientFactory,
 	metricsHandler metrics.Handler,
 	executionManager persistence.ExecutionManager,
+	dataStoreFactory persistence.DataStoreFactory,
 	metadataManager persistence.MetadataManager,
 	visibilityManager manager.VisibilityManager,
 	taskManager persistence.TaskManager,
@@ -129,6 +133,7 @@ func New(
 			logger:             logger,
 			metricsHandler:     metricsHandler,
 			executionManager:   executionManager,
+			dataStoreFactory:   dataStoreFactory,
 			taskManager:        taskManager,
 			visibilityManager:  visibilityManager,
 			metadataManager:    metadataManager,
@@ -177,6 +182,12 @@ func (s *Scanner) Start() error {
 		workerTaskQueueNames = append(workerTaskQueueNames, historyScannerTaskQueueName)
 	}
 
+	if s.context.cfg.HistoryReencryptionScannerEnabled() {
+		s.wg.Add(1)
+		go s.startWorkflowWithRetry(ctx, historyReencryptionScannerWFStartOptions, historyReencryptionScannerWFTypeName)
+		workerTaskQueueNames = append(workerTaskQueueNames, historyReencryptionScannerTaskQueueName)
+	}
+
 	if s.context.cfg.BuildIdScavengerEnabled() {
 		s.wg.Add(1)
 		go s.startWorkflowWithRetry(ctx, build_ids.BuildIdScavengerWFStartOptions, build_ids.BuildIdScavangerWorkflowName)
@@ -210,9 +221,11 @@ func (s *Scanner) Start() error {
 		work.RegisterWorkflowWithOptions(TaskQueueScannerWorkflow, workflow.RegisterOptions{Name: tqScannerWFTypeName})
 		work.RegisterWorkflowWithOptions(HistoryScannerWorkflow, workflow.RegisterOptions{Name: historyScannerWFTypeName})
 		work.RegisterWorkflowWithOptions(ExecutionsScannerWorkflow, workflow.RegisterOptions{Name: executionsScannerWFTypeName})
+		work.RegisterWorkflowWithOptions(HistoryReencryptionScannerWorkflow, workflow.RegisterOptions{Name: historyReencryptionScannerWFTypeName})
 		work.RegisterActivityWithOptions(TaskQueueScavengerActivity, activity.RegisterOptions{Name: taskQueueScavengerActivityName})
 		work.RegisterActivityWithOptions(HistoryScavengerActivity, activity.RegisterOptions{Name: historyScavengerActivityName})
 		work.RegisterActivityWithOptions(ExecutionsScavengerActivity, activity.RegisterOptions{Name: executionsScavengerActivityName})
+		work.RegisterActivityWithOptions(HistoryReencryptionActivity, activity.RegisterOptions{Name: historyReencryptionActivityName})
 
 		// TODO: Nothing is gracefully stopping these workers or listening for fatal errors.
 		if err := work.Start(); err != nil {
@@ -52,15 +52,20 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 		WFTypeName:    build_ids.BuildIdScavangerWorkflowName,
 		TaskQueueName: build_ids.BuildIdScavengerTaskQueueName,
 	}
+	historyReencryptionScanner := expectedScanner{
+		WFTypeName:    historyReencryptionScannerWFTypeName,
+		TaskQueueName: historyReencryptionScannerTaskQueueName,
+	}
 
 	type testCase struct {
-		Name                     string
-		ExecutionsScannerEnabled bool
-		TaskQueueScannerEnabled  bool
-		HistoryScannerEnabled    bool
-		BuildIdScavengerEnabled  bool
-		DefaultStore             string
-		ExpectedScanners         []expectedScanner
+		Name                              string
+		ExecutionsScannerEnabled          bool
+		TaskQueueScannerEnabled           bool
+		HistoryScannerEnabled             bool
+		BuildIdScavengerEnabled           bool
+		HistoryReencryptionScannerEnabled bool
+		DefaultStore                      string
+		ExpectedScanners                  []expectedScanner
 	}
 
 	for _, c := range []testCase{
@@ -154,6 +159,12 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 			DefaultStore:             config.StoreTypeSQL,
 			ExpectedScanners:         []expectedScanner{buildIdScavenger},
 		},
+		{
+			Name:                              "HistoryReencryptionScanner",
+			HistoryReencryptionScannerEnabled: true,
+			DefaultStore:                      config.StoreTypeNoSQL,
+			ExpectedScanners:                  []expectedScanner{historyReencryptionScanner},
+		},
 		{
 			Name:                     "AllScannersSQL",
 			ExecutionsScannerEnabled: true,
@@ -181,6 +192,7 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 					BuildIdScavengerEnabled:                dynamicconfig.GetBoolPropertyFn(c.BuildIdScavengerEnabled),
 					ExecutionsScannerEnabled:               dynamicconfig.GetBoolPropertyFn(c.ExecutionsScannerEnabled),
 					TaskQueueScannerEnabled:                dynamicconfig.GetBoolPropertyFn(c.TaskQueueScannerEnabled),
+					HistoryReencryptionScannerEnabled:      dynamicconfig.GetBoolPropertyFn(c.HistoryReencryptionScannerEnabled),
 					Persistence: &config.Persistence{
 						DefaultStore: c.DefaultStore,
 						DataStores: map[string]config.DataStore{
@@ -194,6 +206,7 @@ func (s *scannerTestSuite) TestScannerEnabled() {
 				mockSdkClientFactory,
 				metrics.NoopMetricsHandler,
 				p.NewMockExecutionManager(ctrl),
+				nil,
 				// These nils are irrelevant since they're only used by the build ID scavenger which is not tested here.
 				nil,
 				nil,
@@ -260,6 +273,7 @@ func (s *scannerTestSuite) TestScannerShutdown() {
 			ExecutionsScannerEnabled:               dynamicconfig.GetBoolPropertyFn(false),
 			TaskQueueScannerEnabled:                dynamicconfig.GetBoolPropertyFn(false),
 			BuildIdScavengerEnabled:                dynamicconfig.GetBoolPropertyFn(false),
+			HistoryReencryptionScannerEnabled:      dynamicconfig.GetBoolPropertyFn(false),
 			Persistence: &config.Persistence{
 				DefaultStore: config.StoreTypeNoSQL,
 				DataStores: map[string]config.DataStore{
@@ -270,6 +284,7 @@ func (s *scannerTestSuite) TestScannerShutdown() {
 		mockSdkClientFactory,
 		metrics.NoopMetricsHandler,
 		p.NewMockExecutionManager(ctrl),
+		nil,
 		// These nils are irrelevant since they're only used by the build ID scavenger which is not tested here.
 		nil,
 		nil,
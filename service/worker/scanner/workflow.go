@@ -10,6 +10,8 @@ import (
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
 	"go.temporal.io/server/common/log/tag"
+	persistenceencryption "go.temporal.io/server/common/persistence/encryption"
+	"go.temporal.io/server/service/worker/scanner/encryption"
 	"go.temporal.io/server/service/worker/scanner/executions"
 	"go.temporal.io/server/service/worker/scanner/history"
 	"go.temporal.io/server/service/worker/scanner/taskqueue"
@@ -32,6 +34,11 @@ const (
 	executionsScannerWFTypeName     = "temporal-sys-executions-scanner-workflow"
 	executionsScannerTaskQueueName  = "temporal-sys-executions-scanner-taskqueue-0"
 	executionsScavengerActivityName = "temporal-sys-executions-scanner-scvg-activity"
+
+	historyReencryptionScannerWFID          = "temporal-sys-history-reencryption-scanner"
+	historyReencryptionScannerWFTypeName    = "temporal-sys-history-reencryption-scanner-workflow"
+	historyReencryptionScannerTaskQueueName = "temporal-sys-history-reencryption-scanner-taskqueue-0"
+	historyReencryptionActivityName         = "temporal-sys-history-reencryption-scanner-activity"
 )
 
 type (
@@ -73,6 +80,12 @@ var (
 		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
 		CronSchedule:          "0 */12 * * *",
 	}
+	historyReencryptionScannerWFStartOptions = client.StartWorkflowOptions{
+		ID:                    historyReencryptionScannerWFID,
+		TaskQueue:             historyReencryptionScannerTaskQueueName,
+		WorkflowIDReusePolicy: enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+		CronSchedule:          "0 */12 * * *",
+	}
 )
 
 // TaskQueueScannerWorkflow is the workflow that runs the task queue scanner background daemon
@@ -104,6 +117,14 @@ func ExecutionsScannerWorkflow(
 	return future.Get(ctx, nil)
 }
 
+// HistoryReencryptionScannerWorkflow is the workflow that runs the history re-encryption scanner background daemon
+func HistoryReencryptionScannerWorkflow(
+	ctx workflow.Context,
+) error {
+	future := workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, activityOptions), historyReencryptionActivityName)
+	return future.Get(ctx, nil)
+}
+
 // HistoryScavengerActivity is the activity that runs history scavenger
 func HistoryScavengerActivity(
 	activityCtx context.Context,
@@ -137,6 +158,48 @@ func HistoryScavengerActivity(
 	return scavenger.Run(activityCtx)
 }
 
+// HistoryReencryptionActivity is the activity that runs the re-encrypter of history, mutable state
+// and visibility memos
+func HistoryReencryptionActivity(
+	activityCtx context.Context,
+) (encryption.ReencrypterHeartbeatDetails, error) {
+	ctx := activityCtx.Value(scannerContextKey).(scannerContext)
+
+	hbd := encryption.ReencrypterHeartbeatDetails{}
+	if activity.HasHeartbeatDetails(activityCtx) {
+		if err := activity.GetHeartbeatDetails(activityCtx, &hbd); err != nil {
+			ctx.logger.Error("Failed to recover from last heartbeat, start over from beginning", tag.Error(err))
+		}
+	}
+
+	store, err := ctx.dataStoreFactory.NewExecutionStore()
+	if err != nil {
+		return hbd, err
+	}
+	reencrypter, ok := store.(persistenceencryption.Reencrypter)
+	if !ok {
+		ctx.logger.Info("Persistence encryption is not configured, skipping history re-encryption")
+		return hbd, nil
+	}
+	shardStore, err := ctx.dataStoreFactory.NewShardStore()
+	if err != nil {
+		return hbd, err
+	}
+
+	return encryption.NewReencrypter(
+		ctx.cfg.Persistence.NumHistoryShards,
+		ctx.executionManager,
+		reencrypter,
+		shardStore,
+		ctx.visibilityManager,
+		ctx.historyClient,
+		ctx.namespaceRegistry,
+		ctx.cfg.PersistenceMaxQPS(),
+		hbd,
+		ctx.logger,
+	).Run(activityCtx)
+}
+
 // TaskQueueScavengerActivity is the activity that runs task queue scavenger
 func TaskQueueScavengerActivity(
 	activityCtx context.Context,
@@ -0,0 +1,185 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"maps"
+
+	"github.com/nexus-rpc/sdk-go/nexus"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common/metrics"
+	"go.temporal.io/server/common/namespace"
+	commonnexus "go.temporal.io/server/common/nexus"
+	"go.temporal.io/server/common/payload"
+	"go.temporal.io/server/components/nexusoperations"
+)
+
+// ActionExtensionHeaderKey is the key in the header of a schedule's start_workflow action
+// under which a ScheduleActionExtension is encoded. A schedule with an extension signals a
+// workflow or starts a Nexus operation instead of starting a workflow. The key is removed
+// from the header before it's passed on to a started workflow.
+const ActionExtensionHeaderKey = "temporal-schedule-action"
+
+// GetActionExtension returns the action extension carried by the start workflow action of a
+// schedule, or nil if it doesn't have one.
+func GetActionExtension(startWorkflow *workflowpb.NewWorkflowExecutionInfo) (*schedulespb.ScheduleActionExtension, error) {
+	p, ok := startWorkflow.GetHeader().GetFields()[ActionExtensionHeaderKey]
+	if !ok {
+		return nil, nil
+	}
+	var ext schedulespb.ScheduleActionExtension
+	if err := payload.Decode(p, &ext); err != nil {
+		return nil, err
+	}
+	return &ext, nil
+}
+
+// ValidateActionExtension returns an error if the action extension is malformed.
+func ValidateActionExtension(startWorkflow *workflowpb.NewWorkflowExecutionInfo, ext *schedulespb.ScheduleActionExtension) error {
+	switch action := ext.GetAction().(type) {
+	case *schedulespb.ScheduleActionExtension_SignalWorkflow:
+		if action.SignalWorkflow.GetSignalName() == "" {
+			return errors.New("signal name is not set")
+		} else if startWorkflow.GetWorkflowId() == "" {
+			return errors.New("workflow id is not set")
+		}
+	case *schedulespb.ScheduleActionExtension_NexusOperation:
+		if action.NexusOperation.GetEndpoint() == "" {
+			return errors.New("nexus endpoint is not set")
+		} else if action.NexusOperation.GetService() == "" {
+			return errors.New("nexus service is not set")
+		} else if action.NexusOperation.GetOperation() == "" {
+			return errors.New("nexus operation is not set")
+		}
+	default:
+		return errors.New("action is not set")
+	}
+	return nil
+}
+
+// ActionTypeTag returns the value of the schedule action type metric tag for an action.
+func ActionTypeTag(ext *schedulespb.ScheduleActionExtension) string {
+	switch ext.GetAction().(type) {
+	case *schedulespb.ScheduleActionExtension_SignalWorkflow:
+		return metrics.ScheduleActionSignalWorkflow
+	case *schedulespb.ScheduleActionExtension_NexusOperation:
+		return metrics.ScheduleActionNexusOperation
+	default:
+		return metrics.ScheduleActionStartWorkflow
+	}
+}
+
+// withoutActionExtension returns the header without the action extension.
+func withoutActionExtension(header *commonpb.Header) *commonpb.Header {
+	if _, ok := header.GetFields()[ActionExtensionHeaderKey]; !ok {
+		return header
+	}
+	fields := maps.Clone(header.Fields)
+	delete(fields, ActionExtensionHeaderKey)
+	return &commonpb.Header{Fields: fields}
+}
+
+// NewSignalWorkflowRequest builds the request for a signal workflow action. The workflow to
+// signal is identified by the workflow id of the start workflow action, without a
+// timestamp: the target is a long-lived workflow rather than one started per action.
+func NewSignalWorkflowRequest(
+	namespaceName string,
+	identity string,
+	requestID string,
+	newWorkflow *workflowpb.NewWorkflowExecutionInfo,
+	searchAttributes *commonpb.SearchAttributes,
+	signal *schedulespb.SignalWorkflowAction,
+) *schedulespb.SignalWorkflowRequest {
+	if !signal.GetStartIfNotRunning() {
+		return &schedulespb.SignalWorkflowRequest{
+			SignalRequest: &workflowservice.SignalWorkflowExecutionRequest{
+				Namespace:         namespaceName,
+				WorkflowExecution: &commonpb.WorkflowExecution{WorkflowId: newWorkflow.GetWorkflowId()},
+				SignalName:        signal.GetSignalName(),
+				Input:             signal.GetInput(),
+				Identity:          identity,
+				RequestId:         requestID,
+			},
+		}
+	}
+	return &schedulespb.SignalWorkflowRequest{
+		SignalWithStartRequest: &workflowservice.SignalWithStartWorkflowExecutionRequest{
+			Namespace:                namespaceName,
+			WorkflowId:               newWorkflow.GetWorkflowId(),
+			WorkflowType:             newWorkflow.GetWorkflowType(),
+			TaskQueue:                newWorkflow.GetTaskQueue(),
+			Input:                    newWorkflow.GetInput(),
+			WorkflowExecutionTimeout: newWorkflow.GetWorkflowExecutionTimeout(),
+			WorkflowRunTimeout:       newWorkflow.GetWorkflowRunTimeout(),
+			WorkflowTaskTimeout:      newWorkflow.GetWorkflowTaskTimeout(),
+			Identity:                 identity,
+			RequestId:                requestID,
+			WorkflowIdReusePolicy:    enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE,
+			SignalName:               signal.GetSignalName(),
+			SignalInput:              signal.GetInput(),
+			RetryPolicy:              newWorkflow.GetRetryPolicy(),
+			Memo:                     newWorkflow.GetMemo(),
+			SearchAttributes:         searchAttributes,
+			Header:                   withoutActionExtension(newWorkflow.GetHeader()),
+			UserMetadata:             newWorkflow.GetUserMetadata(),
+			Priority:                 newWorkflow.GetPriority(),
+		},
+	}
+}
+
+// SignalWorkflow sends the signal of a signal workflow action. It returns the run id of the
+// signaled workflow if it's known.
+func SignalWorkflow(
+	ctx context.Context,
+	frontendClient workflowservice.WorkflowServiceClient,
+	req *schedulespb.SignalWorkflowRequest,
+) (string, error) {
+	if req.SignalWithStartRequest != nil {
+		res, err := frontendClient.SignalWithStartWorkflowExecution(ctx, req.SignalWithStartRequest)
+		if err != nil {
+			return "", err
+		}
+		return res.GetRunId(), nil
+	}
+	_, err := frontendClient.SignalWorkflowExecution(ctx, req.SignalRequest)
+	return "", err
+}
+
+// StartNexusOperation starts the operation of a Nexus operation action. Operations are
+// started without a completion callback: an asynchronous operation is recorded as started,
+// and the schedule doesn't wait for it to complete. The result of a synchronous operation is
+// discarded.
+func StartNexusOperation(
+	ctx context.Context,
+	endpointRegistry commonnexus.EndpointRegistry,
+	clientProvider nexusoperations.ClientProvider,
+	namespaceID namespace.ID,
+	req *schedulespb.StartNexusOperationRequest,
+) (string, error) {
+	op := req.GetOperation()
+	endpoint, err := endpointRegistry.GetByName(ctx, namespaceID, op.GetEndpoint())
+	if err != nil {
+		return "", err
+	}
+	client, err := clientProvider(ctx, namespaceID.String(), endpoint, op.GetService())
+	if err != nil {
+		return "", err
+	}
+	result, err := client.StartOperation(ctx, op.GetOperation(), op.GetInput(), nexus.StartOperationOptions{
+		Header:    nexus.Header(op.GetNexusHeader()),
+		RequestID: req.GetRequestId(),
+	})
+	if err != nil {
+		return "", err
+	}
+	if result.Pending != nil {
+		return result.Pending.Token, nil
+	}
+	// consume the result to release the underlying connection
+	var discard *commonpb.Payload
+	return "", result.Successful.Consume(&discard)
+}
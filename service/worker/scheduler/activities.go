@@ -32,8 +32,9 @@ type (
 		activityDeps
 		namespace   namespace.Name
 		namespaceID namespace.ID
-		// Rate limiter for start workflow requests. Note that the scope is all schedules in
-		// this namespace on this worker.
+		// Rate limiter for start workflow requests, and the signals and Nexus operations of
+		// extended actions. Note that the scope is all schedules in this namespace on this
+		// worker.
 		startWorkflowRateLimiter quotas.RateLimiter
 		maxBlobSize              dynamicconfig.IntPropertyFn
 		localActivitySleepLimit  dynamicconfig.DurationPropertyFn
@@ -64,7 +65,7 @@ var (
 func (e errFollow) Error() string { return string(e) }
 
 func (a *activities) StartWorkflow(ctx context.Context, req *schedulespb.StartWorkflowRequest) (*schedulespb.StartWorkflowResponse, error) {
-	if err := a.waitForRateLimiterPermission(req); err != nil {
+	if err := a.waitForRateLimiterPermission(req.CompletedRateLimitSleep, "StartWorkflowExecution"); err != nil {
 		return nil, err
 	}
 
@@ -85,13 +86,51 @@ func (a *activities) StartWorkflow(ctx context.Context, req *schedulespb.StartWo
 	}, nil
 }
 
-func (a *activities) waitForRateLimiterPermission(req *schedulespb.StartWorkflowRequest) error {
-	if req.CompletedRateLimitSleep {
+func (a *activities) SignalWorkflow(ctx context.Context, req *schedulespb.SignalWorkflowRequest) (*schedulespb.SignalWorkflowResponse, error) {
+	if err := a.waitForRateLimiterPermission(req.CompletedRateLimitSleep, "SignalWorkflowExecution"); err != nil {
+		return nil, err
+	}
+
+	if req.SignalWithStartRequest != nil {
+		req.SignalWithStartRequest.Namespace = a.namespace.String()
+	} else {
+		req.SignalRequest.Namespace = a.namespace.String()
+	}
+
+	runID, err := SignalWorkflow(ctx, a.FrontendClient, req)
+	if err != nil {
+		return nil, translateError(err, "SignalWorkflowExecution")
+	}
+
+	return &schedulespb.SignalWorkflowResponse{
+		RunId:    runID,
+		RealTime: timestamppb.Now(),
+	}, nil
+}
+
+func (a *activities) StartNexusOperation(ctx context.Context, req *schedulespb.StartNexusOperationRequest) (*schedulespb.StartNexusOperationResponse, error) {
+	if err := a.waitForRateLimiterPermission(req.CompletedRateLimitSleep, "StartNexusOperation"); err != nil {
+		return nil, err
+	}
+
+	token, err := StartNexusOperation(ctx, a.EndpointRegistry, a.NexusClientProvider, a.namespaceID, req)
+	if err != nil {
+		return nil, translateError(err, "StartNexusOperation")
+	}
+
+	return &schedulespb.StartNexusOperationResponse{
+		OperationToken: token,
+		RealTime:       timestamppb.Now(),
+	}, nil
+}
+
+func (a *activities) waitForRateLimiterPermission(completedSleep bool, operation string) error {
+	if completedSleep {
 		return nil
 	}
 	reservation := a.startWorkflowRateLimiter.Reserve()
 	if !reservation.OK() {
-		return translateError(errBlocked, "StartWorkflowExecution")
+		return translateError(errBlocked, operation)
 	}
 	delay := reservation.Delay()
 	if delay > a.localActivitySleepLimit() {
@@ -257,6 +296,35 @@ func (a *activities) TerminateWorkflow(ctx context.Context, req *schedulespb.Ter
 	return translateError(err, "TerminateWorkflowExecution")
 }
 
+func (a *activities) GetExclusionCalendars(_ context.Context, req *schedulespb.GetExclusionCalendarsRequest) (*schedulespb.GetExclusionCalendarsResponse, error) {
+	ns, err := a.NamespaceRegistry.GetNamespaceByID(a.namespaceID)
+	if err != nil {
+		return nil, translateError(err, "GetNamespaceByID")
+	}
+
+	res := &schedulespb.GetExclusionCalendarsResponse{}
+	for _, name := range req.Names {
+		if cal, ok := ns.GetScheduleExclusionCalendar(name); ok {
+			res.Calendars = append(res.Calendars, cal)
+		}
+	}
+	return res, nil
+}
+
+// MigrateSchedule asks history to migrate the calling scheduler workflow's schedule to or
+// from the state machine scheduler, see the historyservice MigrateSchedule API.
+func (a *activities) MigrateSchedule(ctx context.Context, req *historyservice.MigrateScheduleRequest) (*historyservice.MigrateScheduleResponse, error) {
+	info := activity.GetInfo(ctx)
+	req = common.CloneProto(req)
+	req.NamespaceId = a.namespaceID.String()
+	req.Execution = &commonpb.WorkflowExecution{
+		WorkflowId: info.WorkflowExecution.ID,
+		RunId:      info.WorkflowExecution.RunID,
+	}
+	res, err := a.HistoryClient.MigrateSchedule(ctx, req)
+	return res, translateError(err, "MigrateSchedule")
+}
+
 func translateError(err error, msgPrefix string) error {
 	if err == nil {
 		return nil
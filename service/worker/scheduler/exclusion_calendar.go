@@ -0,0 +1,198 @@
+package scheduler
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	schedulepb "go.temporal.io/api/schedule/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common"
+)
+
+type (
+	// compiledExclusionCalendar excludes whole days, evaluated in the time zone of the spec.
+	compiledExclusionCalendar struct {
+		tz           *time.Location
+		dates        map[calendarDate]struct{}
+		weekend      [7]bool
+		businessDays []int32
+	}
+
+	calendarDate struct {
+		year  int
+		month time.Month
+		day   int
+	}
+)
+
+const (
+	// ExclusionCalendarPrefix marks an exclude calendar in the spec of a CreateSchedule or
+	// UpdateSchedule request as a reference to a named exclusion calendar registered on the
+	// namespace: an exclude calendar with the comment "calendar:us-holidays" excludes the days
+	// of the "us-holidays" calendar. The frontend takes references out of the spec and passes
+	// the names to the scheduler workflow separately, so the spec of a running schedule never
+	// contains them, and exclude calendars whose comment happens to start with the prefix are
+	// not references there.
+	ExclusionCalendarPrefix = "calendar:"
+
+	// No month has more than 23 weekdays.
+	maxBusinessDaysPerMonth = 23
+)
+
+// ExclusionCalendarNames returns the sorted names of the exclusion calendars referenced by
+// the spec of a CreateSchedule or UpdateSchedule request.
+func ExclusionCalendarNames(spec *schedulepb.ScheduleSpec) []string {
+	var names []string
+	for _, cal := range spec.GetExcludeCalendar() {
+		if name, ok := exclusionCalendarName(cal.GetComment()); ok {
+			names = append(names, name)
+		}
+	}
+	for _, cal := range spec.GetExcludeStructuredCalendar() {
+		if name, ok := exclusionCalendarName(cal.GetComment()); ok {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return slices.Compact(names)
+}
+
+// WithoutExclusionCalendarReferences returns the spec without the exclude calendars that
+// reference named exclusion calendars. The spec is not modified; if it has references, a
+// copy is returned.
+func WithoutExclusionCalendarReferences(spec *schedulepb.ScheduleSpec) *schedulepb.ScheduleSpec {
+	isReference := func(comment string) bool {
+		_, ok := exclusionCalendarName(comment)
+		return ok
+	}
+	if !slices.ContainsFunc(spec.GetExcludeCalendar(), func(cal *schedulepb.CalendarSpec) bool { return isReference(cal.GetComment()) }) &&
+		!slices.ContainsFunc(spec.GetExcludeStructuredCalendar(), func(cal *schedulepb.StructuredCalendarSpec) bool { return isReference(cal.GetComment()) }) {
+		return spec
+	}
+	spec = common.CloneProto(spec)
+	spec.ExcludeCalendar = slices.DeleteFunc(spec.ExcludeCalendar, func(cal *schedulepb.CalendarSpec) bool { return isReference(cal.GetComment()) })
+	spec.ExcludeStructuredCalendar = slices.DeleteFunc(spec.ExcludeStructuredCalendar, func(cal *schedulepb.StructuredCalendarSpec) bool { return isReference(cal.GetComment()) })
+	return spec
+}
+
+// resolveExclusionCalendars returns the calendars with the given names, in the order of names.
+// It returns an error if a name is missing from calendars.
+func resolveExclusionCalendars(names []string, calendars []*schedulespb.ExclusionCalendar) ([]*schedulespb.ExclusionCalendar, error) {
+	resolved := make([]*schedulespb.ExclusionCalendar, 0, len(names))
+	for _, name := range names {
+		idx := slices.IndexFunc(calendars, func(c *schedulespb.ExclusionCalendar) bool { return c.GetName() == name })
+		if idx < 0 {
+			return nil, fmt.Errorf("exclusion calendar %q not found", name)
+		}
+		resolved = append(resolved, calendars[idx])
+	}
+	return resolved, nil
+}
+
+func exclusionCalendarName(comment string) (string, bool) {
+	name, ok := strings.CutPrefix(comment, ExclusionCalendarPrefix)
+	return strings.TrimSpace(name), ok
+}
+
+// ValidateExclusionCalendar returns an error if the calendar is malformed.
+func ValidateExclusionCalendar(cal *schedulespb.ExclusionCalendar) error {
+	if cal.GetName() == "" {
+		return errors.New("name is not set")
+	} else if strings.TrimSpace(cal.GetName()) != cal.GetName() {
+		return errors.New("name has leading or trailing whitespace")
+	}
+	for _, d := range cal.GetDates() {
+		if d.GetYear() < minCalendarYear || d.GetYear() > maxCalendarYear {
+			return fmt.Errorf("date %d-%d-%d: year is out of range", d.GetYear(), d.GetMonth(), d.GetDay())
+		} else if d.GetMonth() < 1 || d.GetMonth() > 12 {
+			return fmt.Errorf("date %d-%d-%d: month is out of range", d.GetYear(), d.GetMonth(), d.GetDay())
+		} else if d.GetDay() < 1 || int(d.GetDay()) > daysInMonth(time.Month(d.GetMonth()), int(d.GetYear())) {
+			return fmt.Errorf("date %d-%d-%d: day is out of range", d.GetYear(), d.GetMonth(), d.GetDay())
+		}
+	}
+	for _, bd := range cal.GetBusinessDaysOfMonth() {
+		if bd == 0 || bd > maxBusinessDaysPerMonth || bd < -maxBusinessDaysPerMonth {
+			return fmt.Errorf("business day of month %d is out of range", bd)
+		}
+	}
+	for _, wd := range cal.GetWeekendDays() {
+		if wd < 0 || wd > 6 {
+			return fmt.Errorf("weekend day %d is out of range", wd)
+		}
+	}
+	return nil
+}
+
+func newCompiledExclusionCalendar(cal *schedulespb.ExclusionCalendar, tz *time.Location) *compiledExclusionCalendar {
+	cc := &compiledExclusionCalendar{
+		tz:           tz,
+		dates:        make(map[calendarDate]struct{}, len(cal.GetDates())),
+		businessDays: cal.GetBusinessDaysOfMonth(),
+	}
+	for _, d := range cal.GetDates() {
+		cc.dates[calendarDate{year: int(d.GetYear()), month: time.Month(d.GetMonth()), day: int(d.GetDay())}] = struct{}{}
+	}
+	if len(cal.GetWeekendDays()) == 0 {
+		cc.weekend[time.Saturday] = true
+		cc.weekend[time.Sunday] = true
+	}
+	for _, wd := range cal.GetWeekendDays() {
+		cc.weekend[wd] = true
+	}
+	return cc
+}
+
+// excludes returns true if the calendar excludes the day of ts.
+func (cc *compiledExclusionCalendar) excludes(ts time.Time) bool {
+	year, month, day := ts.In(cc.tz).Date()
+	date := calendarDate{year: year, month: month, day: day}
+	if _, ok := cc.dates[date]; ok {
+		return true
+	}
+	if len(cc.businessDays) == 0 {
+		return false
+	}
+	return !cc.allowedBusinessDay(date)
+}
+
+func (cc *compiledExclusionCalendar) isBusinessDay(date calendarDate) bool {
+	if _, ok := cc.dates[date]; ok {
+		return false
+	}
+	// weekday of a date doesn't depend on the time zone
+	weekday := time.Date(date.year, date.month, date.day, 12, 0, 0, 0, time.UTC).Weekday()
+	return !cc.weekend[weekday]
+}
+
+// allowedBusinessDay returns true if date is one of the calendar's business days of its month.
+func (cc *compiledExclusionCalendar) allowedBusinessDay(date calendarDate) bool {
+	if !cc.isBusinessDay(date) {
+		return false
+	}
+	// count business days of the month up to and including date, and in total
+	fromStart, total := 0, 0
+	for day := 1; day <= daysInMonth(date.month, date.year); day++ {
+		if cc.isBusinessDay(calendarDate{year: date.year, month: date.month, day: day}) {
+			total++
+			if day <= date.day {
+				fromStart++
+			}
+		}
+	}
+	fromEnd := total - fromStart + 1
+	for _, bd := range cc.businessDays {
+		if (bd > 0 && int(bd) == fromStart) || (bd < 0 && int(-bd) == fromEnd) {
+			return true
+		}
+	}
+	return false
+}
+
+// endOfDay returns the last second of the day of ts.
+func (cc *compiledExclusionCalendar) endOfDay(ts time.Time) time.Time {
+	year, month, day := ts.In(cc.tz).Date()
+	return time.Date(year, month, day+1, 0, 0, 0, 0, cc.tz).Add(-time.Second)
+}
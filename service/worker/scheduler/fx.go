@@ -14,9 +14,11 @@ import (
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/metrics"
 	"go.temporal.io/server/common/namespace"
+	commonnexus "go.temporal.io/server/common/nexus"
 	"go.temporal.io/server/common/quotas"
 	"go.temporal.io/server/common/resource"
 	"go.temporal.io/server/common/searchattribute"
+	"go.temporal.io/server/components/nexusoperations"
 	workercommon "go.temporal.io/server/service/worker/common"
 	"go.uber.org/fx"
 )
@@ -46,14 +48,18 @@ type (
 		globalNSStartWorkflowRPS dynamicconfig.TypedSubscribableWithNamespaceFilter[float64]
 		maxBlobSize              dynamicconfig.IntPropertyFnWithNamespaceFilter
 		localActivitySleepLimit  dynamicconfig.DurationPropertyFnWithNamespaceFilter
+		migrationMode            dynamicconfig.StringPropertyFnWithNamespaceFilter
 	}
 
 	activityDeps struct {
 		fx.In
-		MetricsHandler metrics.Handler
-		Logger         log.Logger
-		HistoryClient  resource.HistoryClient
-		FrontendClient workflowservice.WorkflowServiceClient
+		MetricsHandler      metrics.Handler
+		Logger              log.Logger
+		HistoryClient       resource.HistoryClient
+		FrontendClient      workflowservice.WorkflowServiceClient
+		NamespaceRegistry   namespace.Registry
+		EndpointRegistry    commonnexus.EndpointRegistry
+		NexusClientProvider nexusoperations.ClientProvider
 	}
 
 	fxResult struct {
@@ -80,6 +86,7 @@ func NewResult(
 			globalNSStartWorkflowRPS: dynamicconfig.SchedulerNamespaceStartWorkflowRPS.Subscribe(dc),
 			maxBlobSize:              dynamicconfig.BlobSizeLimitError.Get(dc),
 			localActivitySleepLimit:  dynamicconfig.SchedulerLocalActivitySleepLimit.Get(dc),
+			migrationMode:            dynamicconfig.SchedulerMigrationMode.Get(dc),
 		},
 	}
 }
@@ -91,8 +98,12 @@ func (s *workerComponent) DedicatedWorkerOptions(ns *namespace.Namespace) *worke
 }
 
 func (s *workerComponent) Register(registry sdkworker.Registry, ns *namespace.Namespace, details workercommon.RegistrationDetails) func() {
+	migrationEnabled := func() bool {
+		// History decides what to do in each of the other modes.
+		return s.migrationMode(ns.Name().String()) != "disabled"
+	}
 	wfFunc := func(ctx workflow.Context, args *schedulespb.StartScheduleArgs) error {
-		return schedulerWorkflowWithSpecBuilder(ctx, args, s.specBuilder)
+		return schedulerWorkflowWithSpecBuilder(ctx, args, s.specBuilder, migrationEnabled)
 	}
 	registry.RegisterWorkflowWithOptions(wfFunc, workflow.RegisterOptions{Name: WorkflowType})
 
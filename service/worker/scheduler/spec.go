@@ -9,6 +9,7 @@ import (
 
 	"github.com/dgryski/go-farm"
 	schedulepb "go.temporal.io/api/schedule/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/cache"
 	"go.temporal.io/server/common/primitives/timestamp"
@@ -21,6 +22,8 @@ type (
 		tz       *time.Location
 		calendar []*compiledCalendar
 		excludes []*compiledCalendar
+
+		exclusionCalendars []*compiledExclusionCalendar
 	}
 
 	GetNextTimeResult struct {
@@ -54,6 +57,15 @@ func NewSpecBuilder() *SpecBuilder {
 }
 
 func (b *SpecBuilder) NewCompiledSpec(spec *schedulepb.ScheduleSpec) (*CompiledSpec, error) {
+	return b.NewCompiledSpecWithCalendars(spec, nil)
+}
+
+// NewCompiledSpecWithCalendars is like NewCompiledSpec but also excludes the days excluded by
+// any of the given calendars.
+func (b *SpecBuilder) NewCompiledSpecWithCalendars(
+	spec *schedulepb.ScheduleSpec,
+	calendars []*schedulespb.ExclusionCalendar,
+) (*CompiledSpec, error) {
 	spec, err := canonicalizeSpec(spec)
 	if err != nil {
 		return nil, err
@@ -76,12 +88,17 @@ func (b *SpecBuilder) NewCompiledSpec(spec *schedulepb.ScheduleSpec) (*CompiledS
 	for i, excal := range spec.ExcludeStructuredCalendar {
 		excludes[i] = newCompiledCalendar(excal, tz)
 	}
+	exclusionCalendars := make([]*compiledExclusionCalendar, len(calendars))
+	for i, cal := range calendars {
+		exclusionCalendars[i] = newCompiledExclusionCalendar(cal, tz)
+	}
 
 	cspec := &CompiledSpec{
-		spec:     spec,
-		tz:       tz,
-		calendar: ccs,
-		excludes: excludes,
+		spec:               spec,
+		tz:                 tz,
+		calendar:           ccs,
+		excludes:           excludes,
+		exclusionCalendars: exclusionCalendars,
 	}
 
 	return cspec, nil
@@ -280,8 +297,10 @@ func (cs *CompiledSpec) GetNextTime(jitterSeed string, after time.Time) GetNextT
 	}
 	var nominal time.Time
 	for nominal.IsZero() || cs.excluded(nominal) {
+		if !nominal.IsZero() {
+			after = cs.skipExcludedDay(nominal)
+		}
 		nominal = cs.rawNextTime(after)
-		after = nominal
 
 		if nominal.IsZero() || pastEndTime(nominal) {
 			return GetNextTimeResult{}
@@ -345,9 +364,25 @@ func (cs *CompiledSpec) excluded(nominal time.Time) bool {
 			return true
 		}
 	}
+	for _, ec := range cs.exclusionCalendars {
+		if ec.excludes(nominal) {
+			return true
+		}
+	}
 	return false
 }
 
+// Exclusion calendars exclude whole days, so if one excludes nominal, this returns the last
+// second of its day to continue searching from. Otherwise it returns nominal.
+func (cs *CompiledSpec) skipExcludedDay(nominal time.Time) time.Time {
+	for _, ec := range cs.exclusionCalendars {
+		if ec.excludes(nominal) {
+			return util.MaxTime(nominal, ec.endOfDay(nominal))
+		}
+	}
+	return nominal
+}
+
 // Adds jitter to a nominal time, deterministically (by hashing the given time and a seed).
 func (cs *CompiledSpec) addJitter(seed string, nominal time.Time, maxJitter time.Duration) time.Time {
 	if maxJitter < 0 {
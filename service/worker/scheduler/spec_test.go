@@ -6,6 +6,8 @@ import (
 
 	"github.com/stretchr/testify/suite"
 	schedulepb "go.temporal.io/api/schedule/v1"
+	schedulespb "go.temporal.io/server/api/schedule/v1"
+	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/testing/protorequire"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -481,3 +483,107 @@ func (s *specSuite) TestSpecJitterSeed() {
 		time.Date(2022, 3, 24, 0, 39, 16, 922000000, time.UTC),
 	)
 }
+
+func (s *specSuite) TestSpecExclusionCalendar() {
+	spec := &schedulepb.ScheduleSpec{
+		Calendar: []*schedulepb.CalendarSpec{
+			{Hour: "9"},
+		},
+		ExcludeStructuredCalendar: []*schedulepb.StructuredCalendarSpec{
+			{Comment: ExclusionCalendarPrefix + "us-holidays"},
+		},
+		TimezoneName: "America/New_York",
+	}
+	s.Equal([]string{"us-holidays"}, ExclusionCalendarNames(spec))
+
+	withoutReferences := WithoutExclusionCalendarReferences(spec)
+	s.Empty(withoutReferences.ExcludeStructuredCalendar)
+	s.Len(spec.ExcludeStructuredCalendar, 1, "spec must not be modified")
+	s.Same(withoutReferences, WithoutExclusionCalendarReferences(withoutReferences))
+
+	cs, err := s.specBuilder.NewCompiledSpecWithCalendars(withoutReferences, []*schedulespb.ExclusionCalendar{{
+		Name: "us-holidays",
+		Dates: []*schedulespb.ExclusionCalendarDate{
+			{Year: 2024, Month: 7, Day: 4},
+			{Year: 2024, Month: 7, Day: 5},
+		},
+	}})
+	s.NoError(err)
+	// days are excluded in the spec's time zone
+	s.Equal(time.Date(2024, 7, 3, 13, 0, 0, 0, time.UTC), cs.GetNextTime("", time.Date(2024, 7, 3, 3, 0, 0, 0, time.UTC)).Next)
+	s.Equal(time.Date(2024, 7, 6, 13, 0, 0, 0, time.UTC), cs.GetNextTime("", time.Date(2024, 7, 3, 14, 0, 0, 0, time.UTC)).Next)
+}
+
+func (s *specSuite) TestSpecExclusionCalendarCommentIsNotReference() {
+	// Compiled specs don't interpret comments, so an exclude calendar whose comment starts
+	// with the prefix is an ordinary exclude calendar.
+	cs, err := s.specBuilder.NewCompiledSpec(&schedulepb.ScheduleSpec{
+		Calendar: []*schedulepb.CalendarSpec{
+			{Hour: "0,12"},
+		},
+		ExcludeCalendar: []*schedulepb.CalendarSpec{
+			{Hour: "0", Comment: ExclusionCalendarPrefix + "not a reference"},
+		},
+	})
+	s.NoError(err)
+	s.Equal(time.Date(2024, 7, 3, 12, 0, 0, 0, time.UTC), cs.GetNextTime("", time.Date(2024, 7, 3, 1, 0, 0, 0, time.UTC)).Next)
+	s.Equal(time.Date(2024, 7, 4, 12, 0, 0, 0, time.UTC), cs.GetNextTime("", time.Date(2024, 7, 3, 12, 0, 0, 0, time.UTC)).Next)
+}
+
+func (s *specSuite) TestSpecExclusionCalendarBusinessDays() {
+	spec := &schedulepb.ScheduleSpec{
+		Interval: []*schedulepb.IntervalSpec{
+			{Interval: durationpb.New(1 * time.Hour)},
+		},
+		ExcludeCalendar: []*schedulepb.CalendarSpec{
+			{Hour: "0-8,10-23"},
+		},
+	}
+	cs, err := s.specBuilder.NewCompiledSpecWithCalendars(spec, []*schedulespb.ExclusionCalendar{{
+		Name: "month-end",
+		// first and last business day of each month
+		BusinessDaysOfMonth: []int32{1, -1},
+		Dates: []*schedulespb.ExclusionCalendarDate{
+			{Year: 2024, Month: 1, Day: 1},
+		},
+	}})
+	s.NoError(err)
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, exp := range []time.Time{
+		time.Date(2024, 1, 2, 9, 0, 0, 0, time.UTC),  // Jan 1 is a holiday
+		time.Date(2024, 1, 31, 9, 0, 0, 0, time.UTC), // Wednesday
+		time.Date(2024, 2, 1, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 2, 29, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 1, 9, 0, 0, 0, time.UTC),
+		time.Date(2024, 3, 29, 9, 0, 0, 0, time.UTC), // Mar 30 and 31 are a weekend
+	} {
+		next := cs.GetNextTime("", start).Next
+		s.Equal(exp, next)
+		start = next
+	}
+}
+
+func (s *specSuite) TestValidateExclusionCalendar() {
+	valid := &schedulespb.ExclusionCalendar{
+		Name:                "cal",
+		Dates:               []*schedulespb.ExclusionCalendarDate{{Year: 2024, Month: 2, Day: 29}},
+		BusinessDaysOfMonth: []int32{1, -23},
+		WeekendDays:         []int32{5, 6},
+	}
+	s.NoError(ValidateExclusionCalendar(valid))
+
+	for _, mutate := range []func(*schedulespb.ExclusionCalendar){
+		func(c *schedulespb.ExclusionCalendar) { c.Name = "" },
+		func(c *schedulespb.ExclusionCalendar) { c.Name = " cal" },
+		func(c *schedulespb.ExclusionCalendar) { c.Dates[0].Year = 2023 },
+		func(c *schedulespb.ExclusionCalendar) { c.Dates[0].Month = 13 },
+		func(c *schedulespb.ExclusionCalendar) { c.BusinessDaysOfMonth = []int32{0} },
+		func(c *schedulespb.ExclusionCalendar) { c.BusinessDaysOfMonth = []int32{24} },
+		func(c *schedulespb.ExclusionCalendar) { c.WeekendDays = []int32{7} },
+	} {
+		cal := common.CloneProto(valid)
+		mutate(cal)
+		s.Error(ValidateExclusionCalendar(cal))
+	}
+}
@@ -18,6 +18,7 @@ import (
 	sdklog "go.temporal.io/sdk/log"
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/api/historyservice/v1"
 	schedulespb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common"
 	"go.temporal.io/server/common/metrics"
@@ -62,6 +63,16 @@ const (
 	ActionResultIncludesStatus = 10
 	// limit the ScheduleSpec specs and exclusions to only 10 entries
 	LimitMemoSpecSize = 11
+	// load named exclusion calendars referenced by the spec with a local activity
+	ExclusionCalendars = 12
+	// signal a workflow or start a Nexus operation if the start workflow action carries an
+	// action extension
+	ActionExtensions = 13
+	// periodically ask history whether to migrate the schedule to the state machine scheduler
+	StateMachineMigration = 14
+	// while migrated, keep the schedule's info, memo and search attributes in sync with the
+	// state machine scheduler
+	SyncMigratedState = 15
 )
 
 const (
@@ -113,6 +124,10 @@ type (
 
 		tweakables TweakablePolicies
 
+		// Whether the namespace's schedules may be migrated to the state machine
+		// scheduler. Only read inside updateTweakables.
+		migrationEnabled func() bool
+
 		currentTimer         workflow.Future
 		currentTimerDeadline time.Time
 
@@ -127,6 +142,11 @@ type (
 
 		uuidBatch []string
 
+		// Next time to check whether to migrate to the state machine scheduler.
+		nextMigrationCheck time.Time
+		// Whether the schedule is run by the state machine scheduler.
+		migrated bool
+
 		// This cache is used to store time results after batching getNextTime queries
 		// in a single SideEffect
 		nextTimeCacheV1 map[time.Time]GetNextTimeResult
@@ -151,6 +171,8 @@ type (
 		ReuseTimer                        bool                     // Whether to reuse timer. Used for workflow compatibility.
 		NextTimeCacheV2Size               int                      // Size of next time cache (v2)
 		SpecFieldLengthLimit              int                      // item limit per spec field on the ScheduleInfo memo
+		ExclusionCalendarRefreshInterval  time.Duration            // How often to reload named exclusion calendars referenced by the spec
+		MigrationCheckInterval            time.Duration            // How often to check whether to migrate to or from the state machine scheduler, zero to not check
 		Version                           SchedulerWorkflowVersion // Used to keep track of schedules version to release new features and for backward compatibility
 		// version 0 corresponds to the schedule version that comes before introducing the Version parameter
 
@@ -201,7 +223,9 @@ var (
 		ReuseTimer:                        true,
 		NextTimeCacheV2Size:               14, // see note below
 		SpecFieldLengthLimit:              10,
-		Version:                           ActionResultIncludesStatus,
+		ExclusionCalendarRefreshInterval:  1 * time.Hour,
+		MigrationCheckInterval:            1 * time.Hour,
+		Version:                           SyncMigratedState,
 	}
 
 	// Note on NextTimeCacheV2Size: This value must be > FutureActionCountForList. Each
@@ -215,10 +239,15 @@ var (
 )
 
 func SchedulerWorkflow(ctx workflow.Context, args *schedulespb.StartScheduleArgs) error {
-	return schedulerWorkflowWithSpecBuilder(ctx, args, NewSpecBuilder())
+	return schedulerWorkflowWithSpecBuilder(ctx, args, NewSpecBuilder(), func() bool { return true })
 }
 
-func schedulerWorkflowWithSpecBuilder(ctx workflow.Context, args *schedulespb.StartScheduleArgs, specBuilder *SpecBuilder) error {
+func schedulerWorkflowWithSpecBuilder(
+	ctx workflow.Context,
+	args *schedulespb.StartScheduleArgs,
+	specBuilder *SpecBuilder,
+	migrationEnabled func() bool,
+) error {
 	scheduler := &scheduler{
 		StartScheduleArgs: args,
 		ctx:               ctx,
@@ -226,6 +255,7 @@ func schedulerWorkflowWithSpecBuilder(ctx workflow.Context, args *schedulespb.St
 		logger:            sdklog.With(workflow.GetLogger(ctx), "wf-namespace", args.State.Namespace, "schedule-id", args.State.ScheduleId),
 		metrics:           workflow.GetMetricsHandler(ctx).WithTags(map[string]string{"namespace": args.State.Namespace}),
 		specBuilder:       specBuilder,
+		migrationEnabled:  migrationEnabled,
 	}
 	return scheduler.run()
 }
@@ -233,6 +263,7 @@ func schedulerWorkflowWithSpecBuilder(ctx workflow.Context, args *schedulespb.St
 func (s *scheduler) run() error {
 	s.updateTweakables()
 	s.ensureFields()
+	s.refreshExclusionCalendars(false)
 	s.compileSpec()
 
 	if err := workflow.SetQueryHandler(s.ctx, QueryNameDescribe, s.handleDescribeQuery); err != nil {
@@ -272,6 +303,10 @@ func (s *scheduler) run() error {
 			break
 		}
 
+		if s.refreshExclusionCalendars(false) {
+			s.compileSpec()
+		}
+
 		t1 := timestamp.TimeValue(s.State.LastProcessedTime)
 		t2 := s.now()
 		if t2.Before(t1) {
@@ -311,6 +346,12 @@ func (s *scheduler) run() error {
 		}
 		s.updateMemoAndSearchAttributes()
 
+		if s.migrateToStateMachine() {
+			// The state machine scheduler ran the schedule until it was migrated back,
+			// start over from its state.
+			continue
+		}
+
 		// if schedule is not paused and out of actions or do not have anything scheduled, exit the schedule workflow after retention period has passed
 		if exp := s.getRetentionExpiration(nextWakeup); !exp.IsZero() && !exp.After(s.now()) {
 			return nil
@@ -371,7 +412,15 @@ func (s *scheduler) compileSpec() {
 	s.nextTimeCacheV1 = nil
 	s.nextTimeCacheV2 = nil
 
-	cspec, err := s.specBuilder.NewCompiledSpec(s.Schedule.Spec)
+	var calendars []*schedulespb.ExclusionCalendar
+	var err error
+	if s.hasMinVersion(ExclusionCalendars) {
+		calendars, err = resolveExclusionCalendars(s.State.ExclusionCalendarNames, s.State.ExclusionCalendars)
+	}
+	var cspec *CompiledSpec
+	if err == nil {
+		cspec, err = s.specBuilder.NewCompiledSpecWithCalendars(s.Schedule.Spec, calendars)
+	}
 	if err != nil {
 		if s.logger != nil {
 			s.logger.Error("Invalid schedule", "error", err)
@@ -384,6 +433,48 @@ func (s *scheduler) compileSpec() {
 	}
 }
 
+// refreshExclusionCalendars loads the exclusion calendars named in the state, unless
+// they were loaded less than ExclusionCalendarRefreshInterval ago and force is false. Returns
+// true if the calendars changed and the spec needs to be compiled again.
+func (s *scheduler) refreshExclusionCalendars(force bool) bool {
+	if !s.hasMinVersion(ExclusionCalendars) {
+		return false
+	}
+
+	names := s.State.ExclusionCalendarNames
+	if len(names) == 0 {
+		changed := len(s.State.ExclusionCalendars) > 0
+		s.State.ExclusionCalendars = nil
+		s.State.ExclusionCalendarsRefreshTime = nil
+		return changed
+	}
+
+	now := s.now()
+	lastRefresh := s.State.ExclusionCalendarsRefreshTime
+	if !force && lastRefresh != nil && now.Before(lastRefresh.AsTime().Add(s.tweakables.ExclusionCalendarRefreshInterval)) {
+		return false
+	}
+
+	ctx := workflow.WithLocalActivityOptions(s.ctx, defaultLocalActivityOptions)
+	req := &schedulespb.GetExclusionCalendarsRequest{Names: names}
+	var res schedulespb.GetExclusionCalendarsResponse
+	if err := workflow.ExecuteLocalActivity(ctx, s.a.GetExclusionCalendars, req).Get(s.ctx, &res); err != nil {
+		// keep using the calendars we have, and try again after the refresh interval
+		s.logger.Error("Failed to load exclusion calendars", "error", err)
+		s.State.ExclusionCalendarsRefreshTime = timestamppb.New(now)
+		return false
+	}
+	s.State.ExclusionCalendarsRefreshTime = timestamppb.New(now)
+
+	if slices.EqualFunc(res.Calendars, s.State.ExclusionCalendars, func(a, b *schedulespb.ExclusionCalendar) bool {
+		return proto.Equal(a, b)
+	}) {
+		return false
+	}
+	s.State.ExclusionCalendars = res.Calendars
+	return true
+}
+
 func (s *scheduler) now() time.Time {
 	// Notes:
 	// 1. The time returned here is actually the timestamp of the WorkflowTaskStarted
@@ -684,22 +775,7 @@ func (s *scheduler) canTakeScheduledAction(manual, decrement bool) bool {
 
 func (s *scheduler) sleep(nextWakeup time.Time) {
 	sel := workflow.NewSelector(s.ctx)
-
-	upCh := workflow.GetSignalChannel(s.ctx, SignalNameUpdate)
-	sel.AddReceive(upCh, func(ch workflow.ReceiveChannel, _ bool) {
-		ch.Receive(s.ctx, &s.pendingUpdate)
-	})
-
-	reqCh := workflow.GetSignalChannel(s.ctx, SignalNamePatch)
-	sel.AddReceive(reqCh, func(ch workflow.ReceiveChannel, _ bool) {
-		ch.Receive(s.ctx, &s.pendingPatch)
-	})
-
-	refreshCh := workflow.GetSignalChannel(s.ctx, SignalNameRefresh)
-	sel.AddReceive(refreshCh, s.handleRefreshSignal)
-
-	forceCAN := workflow.GetSignalChannel(s.ctx, SignalNameForceCAN)
-	sel.AddReceive(forceCAN, s.handleForceCANSignal)
+	s.addSignalReceivers(sel)
 
 	if s.hasMoreAllowAllBackfills() {
 		// if we have more allow-all backfills to do, do a short sleep and continue
@@ -750,6 +826,126 @@ func (s *scheduler) sleep(nextWakeup time.Time) {
 	}
 }
 
+func (s *scheduler) addSignalReceivers(sel workflow.Selector) {
+	upCh := workflow.GetSignalChannel(s.ctx, SignalNameUpdate)
+	sel.AddReceive(upCh, func(ch workflow.ReceiveChannel, _ bool) {
+		ch.Receive(s.ctx, &s.pendingUpdate)
+	})
+
+	reqCh := workflow.GetSignalChannel(s.ctx, SignalNamePatch)
+	sel.AddReceive(reqCh, func(ch workflow.ReceiveChannel, _ bool) {
+		ch.Receive(s.ctx, &s.pendingPatch)
+	})
+
+	refreshCh := workflow.GetSignalChannel(s.ctx, SignalNameRefresh)
+	sel.AddReceive(refreshCh, s.handleRefreshSignal)
+
+	forceCAN := workflow.GetSignalChannel(s.ctx, SignalNameForceCAN)
+	sel.AddReceive(forceCAN, s.handleForceCANSignal)
+}
+
+// migrateToStateMachine asks history to migrate the schedule to the state machine
+// scheduler, which history does according to the namespace's migration mode. The
+// state machine scheduler is hosted on this workflow's execution, so once migrated,
+// the workflow stays open without taking any actions until the schedule is migrated
+// back, and then resumes from the state machine scheduler's state. Returns true if
+// the schedule was migrated.
+func (s *scheduler) migrateToStateMachine() bool {
+	checkInterval := s.tweakables.MigrationCheckInterval
+	if !s.hasMinVersion(StateMachineMigration) || checkInterval <= 0 || s.now().Before(s.nextMigrationCheck) {
+		return false
+	}
+	if len(s.State.ExclusionCalendarNames) > 0 {
+		// The state machine scheduler doesn't support named exclusion calendars.
+		return false
+	}
+	s.nextMigrationCheck = s.now().Add(checkInterval)
+
+	res, err := s.migrateSchedule(&historyservice.MigrateScheduleRequest{
+		Args:              s.StartScheduleArgs,
+		FutureActionTimes: s.getFutureActionTimes(true, s.tweakables.FutureActionCount),
+	})
+	if err != nil || !res.Migrated {
+		return false
+	}
+	s.logger.Info("Schedule migrated to the state machine scheduler")
+	s.migrated = true
+
+	// The state machine scheduler tracks its own running workflows.
+	s.watchingFuture = nil
+	s.watchingWorkflowId = ""
+	s.currentTimer = nil
+
+	for {
+		ctx, cancel := workflow.WithCancel(s.ctx)
+		sel := workflow.NewSelector(ctx)
+		s.addSignalReceivers(sel)
+		sel.AddFuture(workflow.NewTimer(ctx, checkInterval), func(workflow.Future) {})
+		sel.Select(ctx)
+		for sel.HasPending() {
+			sel.Select(ctx)
+		}
+		cancel()
+
+		// Only the workflow applies schedule changes, and the workflow can't continue
+		// as new while it hosts the state machine scheduler, so both take the schedule
+		// back regardless of the migration mode.
+		force := s.pendingUpdate != nil || s.pendingPatch != nil || s.forceCAN ||
+			workflow.GetInfo(s.ctx).GetContinueAsNewSuggested()
+		res, err := s.migrateSchedule(&historyservice.MigrateScheduleRequest{Force: force})
+		if err != nil {
+			continue
+		}
+		if res.Migrated {
+			if res.Args != nil && s.hasMinVersion(SyncMigratedState) {
+				s.syncFromStateMachine(res.Args)
+			}
+			continue
+		}
+		s.logger.Info("Schedule migrated back from the state machine scheduler")
+		s.migrated = false
+		if res.Args != nil {
+			s.resumeFromStateMachine(res.Args)
+		}
+		return true
+	}
+}
+
+func (s *scheduler) migrateSchedule(req *historyservice.MigrateScheduleRequest) (*historyservice.MigrateScheduleResponse, error) {
+	ctx := workflow.WithLocalActivityOptions(s.ctx, defaultLocalActivityOptions)
+	var res historyservice.MigrateScheduleResponse
+	if err := workflow.ExecuteLocalActivity(ctx, s.a.MigrateSchedule, req).Get(s.ctx, &res); err != nil {
+		s.logger.Error("Failed to migrate schedule", "error", err)
+		return nil, err
+	}
+	return &res, nil
+}
+
+// syncFromStateMachine brings the schedule's info, memo and search attributes up to
+// date with the state machine scheduler while the schedule is migrated, so that
+// queries and visibility don't show the state from before the migration. The rest of
+// the state is taken over when the schedule is migrated back.
+func (s *scheduler) syncFromStateMachine(args *schedulespb.StartScheduleArgs) {
+	s.Schedule = args.Schedule
+	s.Info = args.Info
+	s.State.LastProcessedTime = args.State.GetLastProcessedTime()
+	s.State.ConflictToken = args.State.GetConflictToken()
+	s.ensureFields()
+	s.compileSpec()
+	s.updateMemoAndSearchAttributes()
+}
+
+// resumeFromStateMachine replaces the schedule's state with the state it was
+// migrated back with. Pending signals are kept and processed afterwards.
+func (s *scheduler) resumeFromStateMachine(args *schedulespb.StartScheduleArgs) {
+	s.Schedule = args.Schedule
+	s.Info = args.Info
+	s.State = args.State
+	s.ensureFields()
+	s.refreshExclusionCalendars(true)
+	s.compileSpec()
+}
+
 func (s *scheduler) processBackfills() {
 	limit := s.tweakables.BackfillsPerIteration
 
@@ -876,6 +1072,10 @@ func (s *scheduler) processUpdate(req *schedulespb.FullUpdateRequest) {
 	// don't touch Info
 
 	s.ensureFields()
+	if s.hasMinVersion(ExclusionCalendars) {
+		s.State.ExclusionCalendarNames = req.ExclusionCalendarNames
+	}
+	s.refreshExclusionCalendars(true)
 	s.compileSpec()
 
 	s.updateCustomSearchAttributes(req.SearchAttributes)
@@ -978,12 +1178,29 @@ func (s *scheduler) handleDescribeQuery() (*schedulespb.DescribeResponse, error)
 	infoCopy.BufferSize = int64(len(s.State.BufferedStarts))
 
 	return &schedulespb.DescribeResponse{
-		Schedule:      s.Schedule,
+		Schedule:      s.scheduleWithExclusionCalendarReferences(),
 		Info:          infoCopy,
 		ConflictToken: s.State.ConflictToken,
+		Migrated:      s.migrated,
 	}, nil
 }
 
+// scheduleWithExclusionCalendarReferences returns the schedule with references to the named
+// exclusion calendars put back into the spec, so that the schedule can be described and
+// updated again without losing them.
+func (s *scheduler) scheduleWithExclusionCalendarReferences() *schedulepb.Schedule {
+	if len(s.State.ExclusionCalendarNames) == 0 {
+		return s.Schedule
+	}
+	schedule := common.CloneProto(s.Schedule)
+	for _, name := range s.State.ExclusionCalendarNames {
+		schedule.Spec.ExcludeStructuredCalendar = append(schedule.Spec.ExcludeStructuredCalendar, &schedulepb.StructuredCalendarSpec{
+			Comment: ExclusionCalendarPrefix + name,
+		})
+	}
+	return schedule
+}
+
 func (s *scheduler) handleListMatchingTimesQuery(req *workflowservice.ListScheduleMatchingTimesRequest) (*workflowservice.ListScheduleMatchingTimesResponse, error) {
 	if req == nil || req.StartTime == nil || req.EndTime == nil {
 		return nil, errors.New("missing or invalid query")
@@ -1132,7 +1349,15 @@ func (s *scheduler) checkConflict(token int64) error {
 
 func (s *scheduler) updateTweakables() {
 	// Use MutableSideEffect so that we can change the defaults without breaking determinism.
-	get := func(ctx workflow.Context) interface{} { return CurrentTweakablePolicies }
+	get := func(ctx workflow.Context) interface{} {
+		tweakables := CurrentTweakablePolicies
+		// Don't check for migration in namespaces that don't migrate schedules, so
+		// their histories don't grow with the checks.
+		if !s.migrationEnabled() {
+			tweakables.MigrationCheckInterval = 0
+		}
+		return tweakables
+	}
 	eq := func(a, b interface{}) bool { return a.(TweakablePolicies) == b.(TweakablePolicies) }
 	if err := workflow.MutableSideEffect(s.ctx, "tweakables", get, eq).Get(&s.tweakables); err != nil {
 		panic("can't decode TweakablePolicies:" + err.Error())
@@ -1214,18 +1439,28 @@ func (s *scheduler) processBuffer() bool {
 	if action.NonOverlappingStart != nil {
 		allStarts = append(allStarts, action.NonOverlappingStart)
 	}
+	ext := s.getActionExtension(req)
+	metricsWithTag := s.metrics.WithTags(map[string]string{
+		metrics.ScheduleActionTypeTag: ActionTypeTag(ext),
+	})
 	for _, start := range allStarts {
 		if !s.canTakeScheduledAction(start.Manual, true) {
 			// try again to drain the buffer if paused or out of actions
 			tryAgain = true
 			continue
 		}
-		result, err := s.startWorkflow(start, req)
-		metricsWithTag := s.metrics.WithTags(map[string]string{
-			metrics.ScheduleActionTypeTag: metrics.ScheduleActionStartWorkflow,
-		})
+		var result *schedulepb.ScheduleActionResult
+		var err error
+		switch {
+		case ext.GetSignalWorkflow() != nil:
+			result, err = s.signalWorkflow(start, req, ext.GetSignalWorkflow())
+		case ext.GetNexusOperation() != nil:
+			result, err = s.startNexusOperation(start, ext.GetNexusOperation())
+		default:
+			result, err = s.startWorkflow(start, req)
+		}
 		if err != nil {
-			s.logger.Error("Failed to start workflow", "error", err)
+			s.logger.Error("Failed to take action", "action", ActionTypeTag(ext), "error", err)
 			if !isUserScheduleError(err) {
 				metricsWithTag.Counter(metrics.ScheduleActionErrors.Name()).Inc(1)
 			}
@@ -1234,7 +1469,10 @@ func (s *scheduler) processBuffer() bool {
 		}
 		metricsWithTag.Counter(metrics.ScheduleActionSuccess.Name()).Inc(1)
 		nonOverlapping := start == action.NonOverlappingStart
-		s.recordAction(result, nonOverlapping)
+		// Signals and Nexus operations don't leave anything running on behalf of the
+		// schedule, so they never overlap with later actions and are never canceled or
+		// terminated by the overlap policy.
+		s.recordAction(result, nonOverlapping, ext == nil)
 	}
 
 	// Terminate or cancel if required (terminate overrides cancel if both are present)
@@ -1263,11 +1501,11 @@ func (s *scheduler) processBuffer() bool {
 	return tryAgain
 }
 
-func (s *scheduler) recordAction(result *schedulepb.ScheduleActionResult, nonOverlapping bool) {
+func (s *scheduler) recordAction(result *schedulepb.ScheduleActionResult, nonOverlapping, startedWorkflow bool) {
 	s.Info.ActionCount++
 	s.Info.RecentActions = util.SliceTail(append(s.Info.RecentActions, result), s.tweakables.RecentActionCount)
 	canTrack := nonOverlapping || !s.hasMinVersion(DontTrackOverlapping)
-	if canTrack && result.StartWorkflowResult != nil {
+	if canTrack && startedWorkflow && result.StartWorkflowResult != nil {
 		s.Info.RunningWorkflows = append(s.Info.RunningWorkflows, result.StartWorkflowResult)
 	}
 }
@@ -1283,22 +1521,7 @@ func (s *scheduler) startWorkflow(
 		workflowID += "-" + nominalTimeSec.Format(time.RFC3339)
 	}
 
-	// Set scheduleToCloseTimeout based on catchup window, which is the latest time that it's
-	// acceptable to start this workflow. For manual starts (trigger immediately or backfill),
-	// catch up window doesn't apply, so just use 60s.
-	options := defaultLocalActivityOptions
-	if start.Manual {
-		options.ScheduleToCloseTimeout = 60 * time.Second
-	} else {
-		deadline := start.ActualTime.AsTime().Add(s.getCatchupWindow())
-		options.ScheduleToCloseTimeout = deadline.Sub(s.now())
-		if options.ScheduleToCloseTimeout < options.StartToCloseTimeout {
-			options.ScheduleToCloseTimeout = options.StartToCloseTimeout
-		} else if options.ScheduleToCloseTimeout > 1*time.Hour {
-			options.ScheduleToCloseTimeout = 1 * time.Hour
-		}
-	}
-	ctx := workflow.WithLocalActivityOptions(s.ctx, options)
+	ctx := s.actionContext(start)
 
 	lastCompletionResult, continuedFailure := s.State.LastCompletionResult, s.State.ContinuedFailure
 	if start.OverlapPolicy == enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL && s.hasMinVersion(DontTrackOverlapping) {
@@ -1329,42 +1552,151 @@ func (s *scheduler) startWorkflow(
 			Priority:                 newWorkflow.Priority,
 		},
 	}
+	var res schedulespb.StartWorkflowResponse
+	err := s.executeActionActivity(ctx, s.a.StartWorkflow, req, &res, func() {
+		req.CompletedRateLimitSleep = true
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.recordActionDelay(start, res.RealStartTime)
+
+	actionResult := &schedulepb.ScheduleActionResult{
+		ScheduleTime: start.ActualTime,
+		ActualTime:   res.RealStartTime,
+		StartWorkflowResult: &commonpb.WorkflowExecution{
+			WorkflowId: workflowID,
+			RunId:      res.RunId,
+		},
+	}
+
+	if s.hasMinVersion(ActionResultIncludesStatus) {
+		actionResult.StartWorkflowStatus = enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
+	}
+
+	return actionResult, nil
+}
+
+func (s *scheduler) signalWorkflow(
+	start *schedulespb.BufferedStart,
+	newWorkflow *workflowpb.NewWorkflowExecutionInfo,
+	signal *schedulespb.SignalWorkflowAction,
+) (*schedulepb.ScheduleActionResult, error) {
+	nominalTimeSec := start.NominalTime.AsTime().UTC().Truncate(time.Second)
+	ctx := s.actionContext(start)
+
+	req := NewSignalWorkflowRequest(
+		s.State.Namespace,
+		s.identity(),
+		s.newUUIDString(),
+		newWorkflow,
+		s.addSearchAttributes(newWorkflow.SearchAttributes, nominalTimeSec),
+		signal,
+	)
+	var res schedulespb.SignalWorkflowResponse
+	err := s.executeActionActivity(ctx, s.a.SignalWorkflow, req, &res, func() {
+		req.CompletedRateLimitSleep = true
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.recordActionDelay(start, res.RealTime)
+
+	// The status of the signaled workflow isn't tracked, so it's left unspecified.
+	return &schedulepb.ScheduleActionResult{
+		ScheduleTime: start.ActualTime,
+		ActualTime:   res.RealTime,
+		StartWorkflowResult: &commonpb.WorkflowExecution{
+			WorkflowId: newWorkflow.WorkflowId,
+			RunId:      res.RunId,
+		},
+	}, nil
+}
+
+func (s *scheduler) startNexusOperation(
+	start *schedulespb.BufferedStart,
+	op *schedulespb.NexusOperationAction,
+) (*schedulepb.ScheduleActionResult, error) {
+	ctx := s.actionContext(start)
+
+	req := &schedulespb.StartNexusOperationRequest{
+		Operation: op,
+		RequestId: s.newUUIDString(),
+	}
+	var res schedulespb.StartNexusOperationResponse
+	err := s.executeActionActivity(ctx, s.a.StartNexusOperation, req, &res, func() {
+		req.CompletedRateLimitSleep = true
+	})
+	if err != nil {
+		return nil, err
+	}
+	s.recordActionDelay(start, res.RealTime)
+
+	return &schedulepb.ScheduleActionResult{
+		ScheduleTime: start.ActualTime,
+		ActualTime:   res.RealTime,
+	}, nil
+}
+
+// actionContext returns a context with local activity options for taking the action of start.
+func (s *scheduler) actionContext(start *schedulespb.BufferedStart) workflow.Context {
+	// Set scheduleToCloseTimeout based on catchup window, which is the latest time that it's
+	// acceptable to start this workflow. For manual starts (trigger immediately or backfill),
+	// catch up window doesn't apply, so just use 60s.
+	options := defaultLocalActivityOptions
+	if start.Manual {
+		options.ScheduleToCloseTimeout = 60 * time.Second
+	} else {
+		deadline := start.ActualTime.AsTime().Add(s.getCatchupWindow())
+		options.ScheduleToCloseTimeout = deadline.Sub(s.now())
+		if options.ScheduleToCloseTimeout < options.StartToCloseTimeout {
+			options.ScheduleToCloseTimeout = options.StartToCloseTimeout
+		} else if options.ScheduleToCloseTimeout > 1*time.Hour {
+			options.ScheduleToCloseTimeout = 1 * time.Hour
+		}
+	}
+	return workflow.WithLocalActivityOptions(s.ctx, options)
+}
+
+// executeActionActivity runs the local activity that takes an action. If the activity is
+// rate limited for longer than it can sleep itself, the workflow sleeps and calls
+// completedSleep before trying again.
+func (s *scheduler) executeActionActivity(ctx workflow.Context, activity, req, res any, completedSleep func()) error {
 	for {
-		var res schedulespb.StartWorkflowResponse
-		err := workflow.ExecuteLocalActivity(ctx, s.a.StartWorkflow, req).Get(s.ctx, &res)
+		err := workflow.ExecuteLocalActivity(ctx, activity, req).Get(s.ctx, res)
 		var appErr *temporal.ApplicationError
 		var details rateLimitedDetails
 		if errors.As(err, &appErr) && appErr.Type() == rateLimitedErrorType && appErr.Details(&details) == nil {
 			s.metrics.Counter(metrics.ScheduleRateLimited.Name()).Inc(1)
 			workflow.Sleep(s.ctx, details.Delay)
-			req.CompletedRateLimitSleep = true // only use rate limiter once
+			completedSleep() // only use rate limiter once
 			continue
 		}
-		if err != nil {
-			return nil, err
-		}
-
-		if !start.Manual {
-			// record metric only for _scheduled_ actions, not trigger/backfill, otherwise it's not meaningful
-			desiredTime := cmp.Or(start.DesiredTime, start.ActualTime)
-			s.metrics.Timer(metrics.ScheduleActionDelay.Name()).Record(res.RealStartTime.AsTime().Sub(desiredTime.AsTime()))
-		}
-
-		actionResult := &schedulepb.ScheduleActionResult{
-			ScheduleTime: start.ActualTime,
-			ActualTime:   res.RealStartTime,
-			StartWorkflowResult: &commonpb.WorkflowExecution{
-				WorkflowId: workflowID,
-				RunId:      res.RunId,
-			},
-		}
+		return err
+	}
+}
 
-		if s.hasMinVersion(ActionResultIncludesStatus) {
-			actionResult.StartWorkflowStatus = enumspb.WORKFLOW_EXECUTION_STATUS_RUNNING
-		}
+func (s *scheduler) recordActionDelay(start *schedulespb.BufferedStart, realTime *timestamppb.Timestamp) {
+	if !start.Manual {
+		// record metric only for _scheduled_ actions, not trigger/backfill, otherwise it's not meaningful
+		desiredTime := cmp.Or(start.DesiredTime, start.ActualTime)
+		s.metrics.Timer(metrics.ScheduleActionDelay.Name()).Record(realTime.AsTime().Sub(desiredTime.AsTime()))
+	}
+}
 
-		return actionResult, nil
+// getActionExtension returns the action extension of the start workflow action, if it has
+// one and the workflow version supports it.
+func (s *scheduler) getActionExtension(newWorkflow *workflowpb.NewWorkflowExecutionInfo) *schedulespb.ScheduleActionExtension {
+	if !s.hasMinVersion(ActionExtensions) {
+		return nil
+	}
+	ext, err := GetActionExtension(newWorkflow)
+	if err != nil {
+		// the frontend validates the extension, so this shouldn't happen
+		s.logger.Error("Invalid schedule action extension", "error", err)
+		return nil
 	}
+	return ext
 }
 
 func (s *scheduler) identity() string {
@@ -1381,12 +1713,22 @@ func (s *scheduler) jitterSeed() string {
 func (s *scheduler) addSearchAttributes(
 	attributes *commonpb.SearchAttributes,
 	nominal time.Time,
+) *commonpb.SearchAttributes {
+	return AddScheduleSearchAttributes(attributes, s.State.ScheduleId, nominal)
+}
+
+// AddScheduleSearchAttributes returns a copy of attributes with the search attributes
+// the schedule sets on the workflows of its actions.
+func AddScheduleSearchAttributes(
+	attributes *commonpb.SearchAttributes,
+	scheduleID string,
+	nominal time.Time,
 ) *commonpb.SearchAttributes {
 	fields := util.CloneMapNonNil(attributes.GetIndexedFields())
 	if p, err := payload.Encode(nominal); err == nil {
 		fields[searchattribute.TemporalScheduledStartTime] = p
 	}
-	if p, err := payload.Encode(s.State.ScheduleId); err == nil {
+	if p, err := payload.Encode(scheduleID); err == nil {
 		fields[searchattribute.TemporalScheduledById] = p
 	}
 	return &commonpb.SearchAttributes{
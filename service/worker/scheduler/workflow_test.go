@@ -17,8 +17,10 @@ import (
 	sdkpb "go.temporal.io/api/sdk/v1"
 	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	workflowpb "go.temporal.io/api/workflow/v1"
+	"go.temporal.io/api/workflowservice/v1"
 	"go.temporal.io/sdk/testsuite"
 	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/api/historyservice/v1"
 	schedulespb "go.temporal.io/server/api/schedule/v1"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
@@ -33,6 +35,9 @@ type (
 		suite.Suite
 		testsuite.WorkflowTestSuite
 		env *testsuite.TestWorkflowEnvironment
+
+		// Handles MigrateSchedule calls, by default the schedule isn't migrated.
+		migrateSchedule func(req *historyservice.MigrateScheduleRequest) (*historyservice.MigrateScheduleResponse, error)
 	}
 )
 
@@ -45,7 +50,8 @@ func TestWorkflow(t *testing.T) {
 }
 
 func (s *workflowSuite) SetupTest() {
-	s.env = s.NewTestWorkflowEnvironment()
+	s.migrateSchedule = nil
+	s.newTestEnv()
 }
 
 func (s *workflowSuite) AfterTest(suiteName, testName string) {
@@ -54,6 +60,17 @@ func (s *workflowSuite) AfterTest(suiteName, testName string) {
 
 // test helpers
 
+func (s *workflowSuite) newTestEnv() {
+	s.env = s.NewTestWorkflowEnvironment()
+	s.env.OnActivity(new(activities).MigrateSchedule, mock.Anything, mock.Anything).Maybe().Return(
+		func(_ context.Context, req *historyservice.MigrateScheduleRequest) (*historyservice.MigrateScheduleResponse, error) {
+			if s.migrateSchedule == nil {
+				return &historyservice.MigrateScheduleResponse{}, nil
+			}
+			return s.migrateSchedule(req)
+		})
+}
+
 func (s *workflowSuite) now() time.Time {
 	return s.env.Now().UTC() // env.Now() returns local time by default, force to UTC
 }
@@ -81,6 +98,12 @@ func (s *workflowSuite) defaultAction(id string) *schedulepb.ScheduleAction {
 }
 
 func (s *workflowSuite) run(sched *schedulepb.Schedule, iterations int) {
+	s.runWithState(sched, iterations, &schedulespb.InternalState{})
+}
+
+// runWithState is like run but starts the schedule with the given state. The namespace,
+// schedule ID and conflict token are filled in.
+func (s *workflowSuite) runWithState(sched *schedulepb.Schedule, iterations int, state *schedulespb.InternalState) {
 	// test workflows will run until "completion", in our case that means until
 	// continue-as-new. we only need a small number of iterations to test, though.
 	CurrentTweakablePolicies.IterationsBeforeContinueAsNew = iterations
@@ -93,14 +116,13 @@ func (s *workflowSuite) run(sched *schedulepb.Schedule, iterations int) {
 		sched.Action = s.defaultAction("myid")
 	}
 
+	state.Namespace = "myns"
+	state.NamespaceId = "mynsid"
+	state.ScheduleId = "myschedule"
+	state.ConflictToken = InitialConflictToken
 	s.env.ExecuteWorkflow(SchedulerWorkflow, &schedulespb.StartScheduleArgs{
 		Schedule: sched,
-		State: &schedulespb.InternalState{
-			Namespace:     "myns",
-			NamespaceId:   "mynsid",
-			ScheduleId:    "myschedule",
-			ConflictToken: InitialConflictToken,
-		},
+		State:    state,
 	})
 }
 
@@ -275,7 +297,7 @@ func (s *workflowSuite) runAcrossContinue(
 			started: make(map[string]time.Time),
 		}
 		for {
-			s.env = s.NewTestWorkflowEnvironment()
+			s.newTestEnv()
 			s.env.SetStartTime(startTime)
 
 			s.setupMocksForWorkflows(runs, &state)
@@ -1129,6 +1151,212 @@ func (s *workflowSuite) TestCompileError() {
 	// doesn't end properly since it sleeps forever
 }
 
+func (s *workflowSuite) TestExclusionCalendar() {
+	// written using low-level mocks so we can check the calendar request
+
+	// the state machine scheduler doesn't support exclusion calendars
+	s.migrateSchedule = func(req *historyservice.MigrateScheduleRequest) (*historyservice.MigrateScheduleResponse, error) {
+		s.Nil(req.Args, "schedule with exclusion calendars migrated")
+		return &historyservice.MigrateScheduleResponse{}, nil
+	}
+
+	// calendars are loaded on start, and again when the schedule wakes up more than an hour later
+	s.env.OnActivity(new(activities).GetExclusionCalendars, mock.Anything, mock.Anything).Twice().Return(
+		func(_ context.Context, req *schedulespb.GetExclusionCalendarsRequest) (*schedulespb.GetExclusionCalendarsResponse, error) {
+			s.Equal([]string{"holidays"}, req.Names)
+			return &schedulespb.GetExclusionCalendarsResponse{
+				Calendars: []*schedulespb.ExclusionCalendar{{
+					Name: "holidays",
+					Dates: []*schedulespb.ExclusionCalendarDate{
+						{Year: 2022, Month: 6, Day: 2},
+						{Year: 2022, Month: 6, Day: 3},
+					},
+				}},
+			}, nil
+		})
+	s.expectStart(func(req *schedulespb.StartWorkflowRequest) (*schedulespb.StartWorkflowResponse, error) {
+		s.True(time.Date(2022, 6, 4, 0, 0, 0, 0, time.UTC).Equal(s.now()))
+		s.Equal("myid-2022-06-04T00:00:00Z", req.Request.WorkflowId)
+		return nil, nil
+	})
+	s.env.RegisterDelayedCallback(func() {
+		encoded, err := s.env.QueryWorkflow(QueryNameListMatchingTimes, &workflowservice.ListScheduleMatchingTimesRequest{
+			StartTime: timestamppb.New(baseStartTime),
+			EndTime:   timestamppb.New(baseStartTime.Add(5 * 24 * time.Hour)),
+		})
+		s.NoError(err)
+		var resp workflowservice.ListScheduleMatchingTimesResponse
+		s.NoError(encoded.Get(&resp))
+		protoassert.ProtoSliceEqual(s.T(), []*timestamppb.Timestamp{
+			timestamppb.New(time.Date(2022, 6, 4, 0, 0, 0, 0, time.UTC)),
+			timestamppb.New(time.Date(2022, 6, 5, 0, 0, 0, 0, time.UTC)),
+			timestamppb.New(time.Date(2022, 6, 6, 0, 0, 0, 0, time.UTC)),
+		}, resp.StartTime)
+	}, 1*time.Hour)
+
+	s.runWithState(&schedulepb.Schedule{
+		Spec: &schedulepb.ScheduleSpec{
+			Interval: []*schedulepb.IntervalSpec{{
+				Interval: durationpb.New(24 * time.Hour),
+			}},
+		},
+	}, 2, &schedulespb.InternalState{
+		ExclusionCalendarNames: []string{"holidays"},
+	})
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+	desc := s.describe()
+	s.Empty(desc.Info.InvalidScheduleError)
+	// the reference is put back into the described spec so that it survives an update
+	s.Equal([]string{"holidays"}, ExclusionCalendarNames(desc.Schedule.Spec))
+}
+
+func (s *workflowSuite) TestExclusionCalendarCommentBeforeVersion() {
+	// A schedule of a version before named exclusion calendars, with an exclude calendar whose
+	// comment happens to start with the reference prefix, keeps compiling and doesn't load
+	// any calendars.
+	prevTweakables := CurrentTweakablePolicies
+	CurrentTweakablePolicies.Version = LimitMemoSpecSize
+	defer func() { CurrentTweakablePolicies = prevTweakables }()
+
+	s.env.OnActivity(new(activities).GetExclusionCalendars, mock.Anything, mock.Anything).Never()
+	s.expectStart(func(req *schedulespb.StartWorkflowRequest) (*schedulespb.StartWorkflowResponse, error) {
+		s.True(time.Date(2022, 6, 2, 0, 0, 0, 0, time.UTC).Equal(s.now()))
+		s.Equal("myid-2022-06-02T00:00:00Z", req.Request.WorkflowId)
+		return nil, nil
+	})
+
+	s.run(&schedulepb.Schedule{
+		Spec: &schedulepb.ScheduleSpec{
+			Interval: []*schedulepb.IntervalSpec{{
+				Interval: durationpb.New(24 * time.Hour),
+			}},
+			ExcludeStructuredCalendar: []*schedulepb.StructuredCalendarSpec{{
+				Comment: ExclusionCalendarPrefix + "holidays",
+				// a real exclusion: never matches
+				Year: []*schedulepb.Range{{Start: 1999}},
+			}},
+		},
+	}, 2)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+	s.Empty(s.describe().Info.InvalidScheduleError)
+}
+
+func (s *workflowSuite) actionWithExtension(ext *schedulespb.ScheduleActionExtension) *schedulepb.ScheduleAction {
+	action := s.defaultAction("myid")
+	p, err := payload.Encode(ext)
+	s.NoError(err)
+	action.GetStartWorkflow().Header = &commonpb.Header{
+		Fields: map[string]*commonpb.Payload{
+			ActionExtensionHeaderKey: p,
+			"myheader":               payload.EncodeString("value"),
+		},
+	}
+	return action
+}
+
+func (s *workflowSuite) TestSignalWithStartAction() {
+	// written using low-level mocks so we can check the signal request
+
+	signals := 0
+	s.env.OnActivity(new(activities).SignalWorkflow, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, req *schedulespb.SignalWorkflowRequest) (*schedulespb.SignalWorkflowResponse, error) {
+			signals++
+			s.Nil(req.SignalRequest)
+			// the long-lived workflow is signaled without a timestamp in its id
+			s.Equal("myid", req.SignalWithStartRequest.WorkflowId)
+			s.Equal("mywf", req.SignalWithStartRequest.WorkflowType.Name)
+			s.Equal("tick", req.SignalWithStartRequest.SignalName)
+			s.Equal(enumspb.WORKFLOW_ID_REUSE_POLICY_ALLOW_DUPLICATE, req.SignalWithStartRequest.WorkflowIdReusePolicy)
+			s.NotContains(req.SignalWithStartRequest.Header.Fields, ActionExtensionHeaderKey)
+			s.Contains(req.SignalWithStartRequest.Header.Fields, "myheader")
+			return &schedulespb.SignalWorkflowResponse{
+				RunId:    "myrunid",
+				RealTime: timestamppb.New(s.env.Now()),
+			}, nil
+		})
+
+	s.run(&schedulepb.Schedule{
+		Spec: &schedulepb.ScheduleSpec{
+			Interval: []*schedulepb.IntervalSpec{{
+				Interval: durationpb.New(1 * time.Hour),
+			}},
+		},
+		Action: s.actionWithExtension(&schedulespb.ScheduleActionExtension{
+			Action: &schedulespb.ScheduleActionExtension_SignalWorkflow{
+				SignalWorkflow: &schedulespb.SignalWorkflowAction{
+					SignalName:        "tick",
+					StartIfNotRunning: true,
+				},
+			},
+		}),
+		Policies: &schedulepb.SchedulePolicies{
+			// signals don't leave anything running, so nothing is ever terminated
+			OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_TERMINATE_OTHER,
+		},
+	}, 3)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+
+	desc := s.describe()
+	s.Positive(signals)
+	s.Equal(int64(signals), desc.Info.ActionCount)
+	s.Len(desc.Info.RecentActions, signals)
+	for _, action := range desc.Info.RecentActions {
+		s.Equal("myid", action.StartWorkflowResult.WorkflowId)
+		s.Equal("myrunid", action.StartWorkflowResult.RunId)
+		s.Equal(enumspb.WORKFLOW_EXECUTION_STATUS_UNSPECIFIED, action.StartWorkflowStatus)
+	}
+	s.Empty(desc.Info.RunningWorkflows)
+}
+
+func (s *workflowSuite) TestNexusOperationAction() {
+	// written using low-level mocks so we can check the operation request
+
+	operations := 0
+	s.env.OnActivity(new(activities).StartNexusOperation, mock.Anything, mock.Anything).Return(
+		func(_ context.Context, req *schedulespb.StartNexusOperationRequest) (*schedulespb.StartNexusOperationResponse, error) {
+			operations++
+			s.Equal("myendpoint", req.Operation.Endpoint)
+			s.Equal("myservice", req.Operation.Service)
+			s.Equal("myoperation", req.Operation.Operation)
+			s.NotEmpty(req.RequestId)
+			return &schedulespb.StartNexusOperationResponse{
+				OperationToken: "mytoken",
+				RealTime:       timestamppb.New(s.env.Now()),
+			}, nil
+		})
+
+	s.run(&schedulepb.Schedule{
+		Spec: &schedulepb.ScheduleSpec{
+			Interval: []*schedulepb.IntervalSpec{{
+				Interval: durationpb.New(1 * time.Hour),
+			}},
+		},
+		Action: s.actionWithExtension(&schedulespb.ScheduleActionExtension{
+			Action: &schedulespb.ScheduleActionExtension_NexusOperation{
+				NexusOperation: &schedulespb.NexusOperationAction{
+					Endpoint:  "myendpoint",
+					Service:   "myservice",
+					Operation: "myoperation",
+				},
+			},
+		}),
+	}, 3)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+
+	desc := s.describe()
+	s.Positive(operations)
+	s.Len(desc.Info.RecentActions, operations)
+	for _, action := range desc.Info.RecentActions {
+		s.Nil(action.StartWorkflowResult)
+		s.NotNil(action.ActualTime)
+	}
+	s.Empty(desc.Info.RunningWorkflows)
+}
+
 func (s *workflowSuite) TestTriggerImmediate() {
 	s.runAcrossContinue(
 		[]workflowRun{
@@ -2257,3 +2485,167 @@ func (s *workflowSuite) TestCANBySignal() {
 	s.True(s.env.IsWorkflowCompleted())
 	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
 }
+
+func (s *workflowSuite) TestMigrateToStateMachine() {
+	// While migrated, the state machine scheduler takes the actions, and the workflow resumes
+	// from its state once it's migrated back.
+	migratedAt := baseStartTime
+	migratedBackAt := baseStartTime.Add(2 * time.Hour)
+	stateMachineArgs := func(actionCount int64, lastProcessedTime time.Time) *schedulespb.StartScheduleArgs {
+		return &schedulespb.StartScheduleArgs{
+			Schedule: &schedulepb.Schedule{
+				Spec: &schedulepb.ScheduleSpec{
+					Interval: []*schedulepb.IntervalSpec{{
+						Interval: durationpb.New(30 * time.Minute),
+					}},
+				},
+				Action: s.defaultAction("myid"),
+				Policies: &schedulepb.SchedulePolicies{
+					OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+				},
+			},
+			Info: &schedulepb.ScheduleInfo{ActionCount: actionCount},
+			State: &schedulespb.InternalState{
+				Namespace:         "myns",
+				NamespaceId:       "mynsid",
+				ScheduleId:        "myschedule",
+				ConflictToken:     InitialConflictToken,
+				LastProcessedTime: timestamppb.New(lastProcessedTime),
+				NeedRefresh:       true,
+			},
+		}
+	}
+	var migrations, rollbackChecks int
+	s.migrateSchedule = func(req *historyservice.MigrateScheduleRequest) (*historyservice.MigrateScheduleResponse, error) {
+		if req.Args != nil {
+			migrations++
+			if migrations > 1 {
+				return &historyservice.MigrateScheduleResponse{}, nil
+			}
+			s.True(migratedAt.Equal(s.now()))
+			s.Equal("myschedule", req.Args.State.ScheduleId)
+			s.Len(req.FutureActionTimes, CurrentTweakablePolicies.FutureActionCount)
+			s.True(baseStartTime.Add(30 * time.Minute).Equal(req.FutureActionTimes[0].AsTime()))
+			return &historyservice.MigrateScheduleResponse{Migrated: true}, nil
+		}
+
+		rollbackChecks++
+		s.False(req.Force)
+		if s.now().Before(migratedBackAt) {
+			// The schedule stays migrated, with the state machine scheduler's current state.
+			return &historyservice.MigrateScheduleResponse{
+				Migrated: true,
+				Args:     stateMachineArgs(2, s.now()),
+			}, nil
+		}
+		// The state machine scheduler took four actions while the schedule was migrated.
+		return &historyservice.MigrateScheduleResponse{
+			Args: stateMachineArgs(4, migratedBackAt),
+		}, nil
+	}
+
+	for _, t := range []time.Time{migratedBackAt.Add(30 * time.Minute), migratedBackAt.Add(time.Hour)} {
+		s.expectStart(func(req *schedulespb.StartWorkflowRequest) (*schedulespb.StartWorkflowResponse, error) {
+			s.Equal("myid-"+t.Format(time.RFC3339), req.Request.WorkflowId)
+			return nil, nil
+		})
+	}
+	s.expectStart(func(req *schedulespb.StartWorkflowRequest) (*schedulespb.StartWorkflowResponse, error) {
+		s.Fail("unexpected start", req.Request.WorkflowId)
+		return nil, nil
+	}).Times(0).Maybe()
+
+	// While migrated, the workflow describes the state machine scheduler's state as of its
+	// last check.
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.True(desc.Migrated)
+		s.Equal(int64(2), desc.Info.ActionCount)
+		s.True(baseStartTime.Add(90 * time.Minute).Equal(desc.Info.FutureActionTimes[0].AsTime()))
+	}, time.Hour+10*time.Minute)
+	s.env.RegisterDelayedCallback(func() {
+		desc := s.describe()
+		s.False(desc.Migrated)
+		s.Equal(int64(4), desc.Info.ActionCount)
+	}, 2*time.Hour+10*time.Minute)
+
+	s.run(&schedulepb.Schedule{
+		Spec: &schedulepb.ScheduleSpec{
+			Interval: []*schedulepb.IntervalSpec{{
+				Interval: durationpb.New(30 * time.Minute),
+			}},
+		},
+		Policies: &schedulepb.SchedulePolicies{
+			OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+		},
+	}, 4)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+	// Once migrated back, the workflow checks again right away, and then hourly.
+	s.Equal(3, migrations)
+	s.Equal(2, rollbackChecks)
+}
+
+func (s *workflowSuite) TestMigrateBackForUpdate() {
+	// Schedule updates are only applied by the workflow, so they take the schedule back
+	// regardless of the migration mode.
+	var migrated bool
+	s.migrateSchedule = func(req *historyservice.MigrateScheduleRequest) (*historyservice.MigrateScheduleResponse, error) {
+		if req.Args != nil {
+			if migrated {
+				return &historyservice.MigrateScheduleResponse{}, nil
+			}
+			migrated = true
+			return &historyservice.MigrateScheduleResponse{Migrated: true}, nil
+		}
+		s.True(req.Force)
+		s.True(baseStartTime.Add(10 * time.Minute).Equal(s.now()))
+		args := &schedulespb.StartScheduleArgs{
+			Schedule: &schedulepb.Schedule{
+				Spec: &schedulepb.ScheduleSpec{
+					Interval: []*schedulepb.IntervalSpec{{
+						Interval: durationpb.New(time.Hour),
+					}},
+				},
+				Action: s.defaultAction("myid"),
+			},
+			Info: &schedulepb.ScheduleInfo{},
+			State: &schedulespb.InternalState{
+				Namespace:         "myns",
+				NamespaceId:       "mynsid",
+				ScheduleId:        "myschedule",
+				ConflictToken:     InitialConflictToken,
+				LastProcessedTime: timestamppb.New(s.now()),
+			},
+		}
+		return &historyservice.MigrateScheduleResponse{Args: args}, nil
+	}
+
+	s.env.RegisterDelayedCallback(func() {
+		s.env.SignalWorkflow(SignalNameUpdate, &schedulespb.FullUpdateRequest{
+			Schedule: &schedulepb.Schedule{
+				Spec: &schedulepb.ScheduleSpec{
+					Interval: []*schedulepb.IntervalSpec{{
+						Interval: durationpb.New(20 * time.Minute),
+					}},
+				},
+				Action: s.defaultAction("newid"),
+			},
+		})
+	}, 10*time.Minute)
+
+	s.expectStart(func(req *schedulespb.StartWorkflowRequest) (*schedulespb.StartWorkflowResponse, error) {
+		s.Equal("newid-2022-06-01T00:20:00Z", req.Request.WorkflowId)
+		return nil, nil
+	})
+
+	s.run(&schedulepb.Schedule{
+		Spec: &schedulepb.ScheduleSpec{
+			Interval: []*schedulepb.IntervalSpec{{
+				Interval: durationpb.New(time.Hour),
+			}},
+		},
+	}, 3)
+	s.True(s.env.IsWorkflowCompleted())
+	s.True(workflow.IsContinueAsNewError(s.env.GetWorkflowError()))
+}
@@ -40,6 +40,7 @@ type (
 		membershipMonitor      membership.Monitor
 		hostInfo               membership.HostInfo
 		executionManager       persistence.ExecutionManager
+		dataStoreFactory       persistence.DataStoreFactory
 		taskManager            persistence.TaskManager
 		historyClient          resource.HistoryClient
 		namespaceRegistry      namespace.Registry
@@ -102,6 +103,7 @@ func NewService(
 	clusterMetadataManager persistence.ClusterMetadataManager,
 	namespaceRegistry namespace.Registry,
 	executionManager persistence.ExecutionManager,
+	dataStoreFactory persistence.DataStoreFactory,
 	membershipMonitor membership.Monitor,
 	hostInfoProvider membership.HostInfoProvider,
 	namespaceReplicationQueue persistence.NamespaceReplicationQueue,
@@ -130,6 +132,7 @@ func NewService(
 		clusterMetadataManager:    clusterMetadataManager,
 		namespaceRegistry:         namespaceRegistry,
 		executionManager:          executionManager,
+		dataStoreFactory:          dataStoreFactory,
 		workerServiceResolver:     workerServiceResolver,
 		membershipMonitor:         membershipMonitor,
 		hostInfo:                  hostInfoProvider.HostInfo(),
@@ -176,6 +179,7 @@ func NewConfig(
 			BuildIdScavengerEnabled:                 dynamicconfig.BuildIdScavengerEnabled.Get(dc),
 			HistoryScannerEnabled:                   dynamicconfig.HistoryScannerEnabled.Get(dc),
 			ExecutionsScannerEnabled:                dynamicconfig.ExecutionsScannerEnabled.Get(dc),
+			HistoryReencryptionScannerEnabled:       dynamicconfig.HistoryReencryptionScannerEnabled.Get(dc),
 			HistoryScannerDataMinAge:                dynamicconfig.HistoryScannerDataMinAge.Get(dc),
 			HistoryScannerVerifyRetention:           dynamicconfig.HistoryScannerVerifyRetention.Get(dc),
 			ExecutionScannerPerHostQPS:              dynamicconfig.ExecutionScannerPerHostQPS.Get(dc),
@@ -298,6 +302,7 @@ func (s *Service) initScanner() error {
 		s.sdkClientFactory,
 		s.metricsHandler,
 		s.executionManager,
+		s.dataStoreFactory,
 		s.metadataManager,
 		s.visibilityManager,
 		s.taskManager,
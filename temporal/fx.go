@@ -25,6 +25,7 @@ import (
 	"go.temporal.io/server/common/cluster"
 	"go.temporal.io/server/common/config"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/dynamicconfig/persistedconfig"
 	"go.temporal.io/server/common/headers"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/log/tag"
@@ -139,6 +140,8 @@ var (
 		pprof.Module,
 		TraceExportModule,
 		FxLogAdapter,
+		fx.Invoke(PersistedDynamicConfigLifetimeHooks),
+		fx.Invoke(AuthorizerLifetimeHooks),
 		fx.Invoke(ServerLifetimeHooks),
 	)
 )
@@ -209,6 +212,9 @@ func ServerOptionsProvider(opts []ServerOption) (serverOptionsProvider, error) {
 			dcClient = dynamicconfig.NewNoopClient()
 		}
 	}
+	if so.config.PersistedDynamicConfig != nil {
+		dcClient = persistedconfig.NewClient(dcClient, so.config.PersistedDynamicConfig.PollInterval, logger)
+	}
 
 	// TLSConfigProvider
 	tlsConfigProvider := so.tlsConfigProvider
@@ -578,26 +584,14 @@ func ApplyClusterMetadataConfigProvider(
 ) (*cluster.Config, config.Persistence, error) {
 	ctx := context.TODO()
 	logger = log.With(logger, tag.ComponentMetadataInitializer)
-	metricsHandler = metricsHandler.WithTags(metrics.ServiceNameTag(primitives.ServerService))
-	clusterName := persistenceClient.ClusterName(svc.ClusterMetadata.CurrentClusterName)
-	dataStoreFactory := persistenceClient.DataStoreFactoryProvider(
-		clusterName,
+	factory := serverPersistenceFactory(
+		logger,
+		svc,
 		persistenceServiceResolver,
-		&svc.Persistence,
+		persistenceFactoryProvider,
 		customDataStoreFactory,
-		logger,
 		metricsHandler,
-		telemetry.NoopTracerProvider,
 	)
-	factory := persistenceFactoryProvider(persistenceClient.NewFactoryParams{
-		DataStoreFactory:           dataStoreFactory,
-		Cfg:                        &svc.Persistence,
-		PersistenceMaxQPS:          nil,
-		PersistenceNamespaceMaxQPS: nil,
-		ClusterName:                persistenceClient.ClusterName(svc.ClusterMetadata.CurrentClusterName),
-		MetricsHandler:             metricsHandler,
-		Logger:                     logger,
-	})
 	defer factory.Close()
 
 	clusterMetadataManager, err := factory.NewClusterMetadataManager()
@@ -814,10 +808,98 @@ func overwriteCurrentClusterMetadataWithDBRecord(
 	}
 }
 
+// PersistedDynamicConfigLifetimeHooks starts the persisted dynamic config client, if one is
+// configured, before the services are started. It depends on the cluster metadata config so that
+// the record of the current cluster exists when the client starts.
+func PersistedDynamicConfigLifetimeHooks(
+	lc fx.Lifecycle,
+	logger log.Logger,
+	svc *config.Config,
+	_ *cluster.Config,
+	persistenceServiceResolver resolver.ServiceResolver,
+	persistenceFactoryProvider persistenceClient.FactoryProviderFn,
+	customDataStoreFactory persistenceClient.AbstractDataStoreFactory,
+	metricsHandler metrics.Handler,
+	dcClient dynamicconfig.Client,
+) {
+	client, ok := dcClient.(*persistedconfig.Client)
+	if !ok {
+		return
+	}
+	var factory persistenceClient.Factory
+	lc.Append(fx.Hook{
+		OnStart: func(context.Context) error {
+			factory = serverPersistenceFactory(
+				logger,
+				svc,
+				persistenceServiceResolver,
+				persistenceFactoryProvider,
+				customDataStoreFactory,
+				metricsHandler,
+			)
+			clusterMetadataManager, err := factory.NewClusterMetadataManager()
+			if err != nil {
+				return fmt.Errorf("error initializing cluster metadata manager: %w", err)
+			}
+			return client.Start(clusterMetadataManager, svc.ClusterMetadata.CurrentClusterName)
+		},
+		OnStop: func(context.Context) error {
+			client.Stop()
+			if factory != nil {
+				factory.Close()
+			}
+			return nil
+		},
+	})
+}
+
+// serverPersistenceFactory creates a persistence factory for components of the server that run
+// outside of the services.
+func serverPersistenceFactory(
+	logger log.Logger,
+	svc *config.Config,
+	persistenceServiceResolver resolver.ServiceResolver,
+	persistenceFactoryProvider persistenceClient.FactoryProviderFn,
+	customDataStoreFactory persistenceClient.AbstractDataStoreFactory,
+	metricsHandler metrics.Handler,
+) persistenceClient.Factory {
+	metricsHandler = metricsHandler.WithTags(metrics.ServiceNameTag(primitives.ServerService))
+	clusterName := persistenceClient.ClusterName(svc.ClusterMetadata.CurrentClusterName)
+	dataStoreFactory := persistenceClient.DataStoreFactoryProvider(
+		clusterName,
+		persistenceServiceResolver,
+		&svc.Persistence,
+		customDataStoreFactory,
+		logger,
+		metricsHandler,
+		telemetry.NoopTracerProvider,
+	)
+	return persistenceFactoryProvider(persistenceClient.NewFactoryParams{
+		DataStoreFactory:           dataStoreFactory,
+		Cfg:                        &svc.Persistence,
+		PersistenceMaxQPS:          nil,
+		PersistenceNamespaceMaxQPS: nil,
+		ClusterName:                clusterName,
+		MetricsHandler:             metricsHandler,
+		Logger:                     logger,
+	})
+}
+
 func PersistenceFactoryProvider() persistenceClient.FactoryProviderFn {
 	return persistenceClient.FactoryProvider
 }
 
+// AuthorizerLifetimeHooks closes the authorizer when the server stops, if it has background
+// work to stop, such as the policy authorizer reloading its policy file.
+func AuthorizerLifetimeHooks(
+	lc fx.Lifecycle,
+	authorizer authorization.Authorizer,
+) {
+	if closer, ok := authorizer.(interface{ Close() }); ok {
+		lc.Append(fx.StopHook(closer.Close))
+	}
+}
+
 func ServerLifetimeHooks(
 	lc fx.Lifecycle,
 	svr *ServerImpl,
@@ -18,7 +18,6 @@ import (
 	sdkclient "go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/temporal"
 	"go.temporal.io/sdk/workflow"
-	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
 	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/tests/testcore"
@@ -130,8 +129,6 @@ func (w *internalRulesTestWorkflow) ActivityFuncForPrePause() (string, error) {
 func (s *ActivityApiRulesClientTestSuite) SetupTest() {
 	s.FunctionalTestBase.SetupTest()
 
-	s.OverrideDynamicConfig(dynamicconfig.WorkflowRulesAPIsEnabled, true)
-
 	s.initialRetryInterval = 1 * time.Second
 	s.scheduleToCloseTimeout = 30 * time.Minute
 	s.startToCloseTimeout = 15 * time.Minute
@@ -272,6 +269,66 @@ func (s *ActivityApiRulesClientTestSuite) TestActivityRulesApi_CRUD() {
 	}, 5*time.Second, 200*time.Millisecond)
 }
 
+func (s *ActivityApiRulesClientTestSuite) TestActivityRulesApi_InvalidRule() {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	testCases := []struct {
+		name       string
+		updateSpec func(spec *rulespb.WorkflowRuleSpec)
+	}{
+		{
+			name: "invalid visibility query",
+			updateSpec: func(spec *rulespb.WorkflowRuleSpec) {
+				spec.VisibilityQuery = "WorkflowType = 'my-workflow' AND StartTime > 'not a time'"
+			},
+		},
+		{
+			name: "unsupported visibility query field",
+			updateSpec: func(spec *rulespb.WorkflowRuleSpec) {
+				spec.VisibilityQuery = "CustomKeywordField = 'value'"
+			},
+		},
+		{
+			name: "invalid activity predicate",
+			updateSpec: func(spec *rulespb.WorkflowRuleSpec) {
+				spec.GetActivityStart().Predicate = "ActivityType = 'my-activity' AND Attempts > 'ten'"
+			},
+		},
+		{
+			name: "missing trigger",
+			updateSpec: func(spec *rulespb.WorkflowRuleSpec) {
+				spec.Trigger = nil
+			},
+		},
+		{
+			name: "missing actions",
+			updateSpec: func(spec *rulespb.WorkflowRuleSpec) {
+				spec.Actions = nil
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			createRuleRequest := s.createPauseRuleRequest("ActivityFunc", "invalid-rule")
+			tc.updateSpec(createRuleRequest.Spec)
+
+			createRuleResponse, err := s.FrontendClient().CreateWorkflowRule(ctx, createRuleRequest)
+			var invalidArgument *serviceerror.InvalidArgument
+			s.ErrorAs(err, &invalidArgument)
+			s.Nil(createRuleResponse)
+		})
+	}
+
+	// invalid rules are not stored
+	nsResp, err := s.FrontendClient().ListWorkflowRules(ctx, &workflowservice.ListWorkflowRulesRequest{
+		Namespace: s.Namespace().String(),
+	})
+	s.NoError(err)
+	s.Empty(nsResp.Rules)
+}
+
 func (s *ActivityApiRulesClientTestSuite) TestActivityRulesApi_RetryActivity() {
 	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
@@ -574,7 +631,7 @@ func (s *ActivityApiRulesClientTestSuite) TestActivityRulesApi_PrePause() {
 	// 4. Start workflow
 	workflowRun := s.createWorkflow(ctx, testRetryTaskWorkflow.WorkflowFuncForPrePause)
 
-	// 5. Wait for activity to be paused by rule. This should happen in the recording activity task started
+	// 5. Wait for activity to be paused by rule. This should happen when the activity is scheduled
 	s.EventuallyWithT(func(t *assert.CollectT) {
 		description, err := s.SdkClient().DescribeWorkflowExecution(ctx, workflowRun.GetID(), workflowRun.GetRunID())
 		require.NoError(t, err)
@@ -4,16 +4,23 @@ import (
 	"context"
 	"fmt"
 	"math/rand"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 	commandpb "go.temporal.io/api/command/v1"
 	commonpb "go.temporal.io/api/common/v1"
 	enumspb "go.temporal.io/api/enums/v1"
+	taskqueuepb "go.temporal.io/api/taskqueue/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	taskqueuespb "go.temporal.io/server/api/taskqueue/v1"
 	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/testing/taskpoller"
 	"go.temporal.io/server/common/testing/testvars"
@@ -121,6 +128,87 @@ func (s *PriorityFairnessSuite) TestPriority_Activity_Basic() {
 	s.Less(w, 0.15)
 }
 
+func (s *PriorityFairnessSuite) TestFairness_Workflow_Weighted() {
+	const N = 30
+
+	tv := testvars.New(s.T())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	s.OverrideDynamicConfig(dynamicconfig.FairnessKeySearchAttribute, "CustomKeywordField")
+	s.OverrideDynamicConfig(dynamicconfig.MatchingFairnessKeyWeights, map[string]float64{"a": 2})
+	s.OverrideDynamicConfig(dynamicconfig.MatchingNumTaskqueueReadPartitions, 1)
+	s.OverrideDynamicConfig(dynamicconfig.MatchingNumTaskqueueWritePartitions, 1)
+	// load the whole backlog so dispatch order isn't limited by what's loaded
+	s.OverrideDynamicConfig(dynamicconfig.MatchingGetTasksBatchSize, 2*N)
+
+	// all of a's tasks are created before b's, so without fairness a would be dispatched first
+	for _, key := range []string{"a", "b"} {
+		for i := range N {
+			_, err := s.FrontendClient().StartWorkflowExecution(ctx, &workflowservice.StartWorkflowExecutionRequest{
+				Namespace:    s.Namespace().String(),
+				WorkflowId:   fmt.Sprintf("%s-%d", key, i),
+				WorkflowType: tv.WorkflowType(),
+				TaskQueue:    tv.TaskQueue(),
+				SearchAttributes: &commonpb.SearchAttributes{IndexedFields: map[string]*commonpb.Payload{
+					"CustomKeywordField": payload.EncodeString(key),
+				}},
+			})
+			s.NoError(err)
+		}
+	}
+
+	// per-key state is reported once the backlog is loaded
+	s.EventuallyWithT(func(t *assert.CollectT) {
+		resp, err := s.AdminClient().DescribeTaskQueuePartition(ctx, &adminservice.DescribeTaskQueuePartitionRequest{
+			Namespace: s.Namespace().String(),
+			TaskQueuePartition: &taskqueuespb.TaskQueuePartition{
+				TaskQueue:     tv.TaskQueue().GetName(),
+				TaskQueueType: enumspb.TASK_QUEUE_TYPE_WORKFLOW,
+			},
+			BuildIds: &taskqueuepb.TaskQueueVersionSelection{Unversioned: true},
+		})
+		require.NoError(t, err)
+		status := resp.GetVersionsInfoInternal()[""].GetPhysicalTaskQueueInfo().GetFairnessKeyStatus()
+		require.Len(t, status, 2)
+		require.Equal(t, "a", status[0].GetKey())
+		require.Equal(t, 2.0, status[0].GetWeight())
+		require.EqualValues(t, N, status[0].GetLoadedTasks())
+		require.Equal(t, "b", status[1].GetKey())
+		require.Equal(t, 1.0, status[1].GetWeight())
+		require.EqualValues(t, N, status[1].GetLoadedTasks())
+	}, 10*time.Second, 100*time.Millisecond)
+
+	var keys []string
+	for range 2 * N {
+		_, err := s.TaskPoller().PollAndHandleWorkflowTask(
+			tv,
+			func(task *workflowservice.PollWorkflowTaskQueueResponse) (*workflowservice.RespondWorkflowTaskCompletedRequest, error) {
+				keys = append(keys, task.WorkflowExecution.WorkflowId[:1])
+				return &workflowservice.RespondWorkflowTaskCompletedRequest{}, nil
+			},
+			taskpoller.WithContext(ctx),
+		)
+		s.NoError(err)
+	}
+	s.T().Log("dispatch order:", strings.Join(keys, ""))
+
+	// a has twice the weight of b, so b gets one of every three dispatches until a runs out
+	s.InDelta(N/2, countKey(keys[:3*N/2], "b"), 2)
+	s.Equal(N, countKey(keys, "b"))
+}
+
+func countKey(keys []string, key string) int {
+	n := 0
+	for _, k := range keys {
+		if k == key {
+			n++
+		}
+	}
+	return n
+}
+
 func (s *PriorityFairnessSuite) TestSubqueue_Migration() {
 	tv := testvars.New(s.T())
 
@@ -22,11 +22,13 @@ import (
 	"go.temporal.io/sdk/converter"
 	"go.temporal.io/sdk/worker"
 	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/payload"
 	"go.temporal.io/server/common/payloads"
 	"go.temporal.io/server/common/searchattribute"
 	"go.temporal.io/server/common/testing/protorequire"
+	schedulerhsm "go.temporal.io/server/components/scheduler"
 	"go.temporal.io/server/service/worker/scheduler"
 	"go.temporal.io/server/tests/testcore"
 	"google.golang.org/protobuf/proto"
@@ -1112,6 +1114,113 @@ func (s *ScheduleFunctionalSuite) TestNextTimeCache() {
 	s.Equal(expectedRefills, nextTimeSideEffects)
 }
 
+func (s *ScheduleFunctionalSuite) TestMigrateToStateMachine() {
+	sid := "sched-test-migrate"
+	wid := "sched-test-migrate-wf"
+	wt := "sched-test-migrate-wt"
+
+	s.OverrideDynamicConfig(dynamicconfig.SchedulerMigrationMode, []dynamicconfig.ConstrainedValue{{
+		Constraints: dynamicconfig.Constraints{Namespace: s.Namespace().String()},
+		Value:       string(schedulerhsm.MigrationModeEnabled),
+	}})
+
+	schedule := &schedulepb.Schedule{
+		Spec: &schedulepb.ScheduleSpec{
+			Interval: []*schedulepb.IntervalSpec{
+				{Interval: durationpb.New(3 * time.Second)},
+			},
+		},
+		// The state machine scheduler doesn't track workflow completions yet.
+		Policies: &schedulepb.SchedulePolicies{
+			OverlapPolicy: enumspb.SCHEDULE_OVERLAP_POLICY_ALLOW_ALL,
+		},
+		Action: &schedulepb.ScheduleAction{
+			Action: &schedulepb.ScheduleAction_StartWorkflow{
+				StartWorkflow: &workflowpb.NewWorkflowExecutionInfo{
+					WorkflowId:   wid,
+					WorkflowType: &commonpb.WorkflowType{Name: wt},
+					TaskQueue:    &taskqueuepb.TaskQueue{Name: s.taskQueue, Kind: enumspb.TASK_QUEUE_KIND_NORMAL},
+				},
+			},
+		},
+	}
+	req := &workflowservice.CreateScheduleRequest{
+		Namespace:  s.Namespace().String(),
+		ScheduleId: sid,
+		Schedule:   schedule,
+		Identity:   "test",
+		RequestId:  uuid.New(),
+	}
+
+	var runs int32
+	workflowFn := func(ctx workflow.Context) error {
+		workflow.SideEffect(ctx, func(ctx workflow.Context) any {
+			atomic.AddInt32(&runs, 1)
+			return 0
+		})
+		return nil
+	}
+	s.worker.RegisterWorkflowWithOptions(workflowFn, workflow.RegisterOptions{Name: wt})
+
+	_, err := s.FrontendClient().CreateSchedule(testcore.NewContext(), req)
+	s.NoError(err)
+	s.cleanup(sid)
+
+	hasStateMachineScheduler := func() bool {
+		resp, err := s.AdminClient().DescribeMutableState(testcore.NewContext(), &adminservice.DescribeMutableStateRequest{
+			Namespace: s.Namespace().String(),
+			Execution: &commonpb.WorkflowExecution{WorkflowId: scheduler.WorkflowIDPrefix + sid},
+		})
+		s.NoError(err)
+		machines := resp.GetDatabaseMutableState().GetExecutionInfo().GetSubStateMachinesByType()
+		return len(machines[schedulerhsm.SchedulerMachineType].GetMachinesById()) > 0
+	}
+
+	// The scheduler workflow migrates on its first iteration, and the state machine
+	// scheduler takes the actions from then on.
+	s.Eventually(hasStateMachineScheduler, 10*time.Second, 200*time.Millisecond)
+	s.Eventually(func() bool { return atomic.LoadInt32(&runs) >= 2 }, 15*time.Second, 200*time.Millisecond)
+
+	// While migrated, the schedule is described from the state machine scheduler, which
+	// took the actions, rather than from the workflow's state before the migration.
+	var describeResp *workflowservice.DescribeScheduleResponse
+	s.Eventually(func() bool {
+		describeResp, err = s.FrontendClient().DescribeSchedule(testcore.NewContext(), &workflowservice.DescribeScheduleRequest{
+			Namespace:  s.Namespace().String(),
+			ScheduleId: sid,
+		})
+		s.NoError(err)
+		return describeResp.Info.ActionCount >= 2
+	}, 10*time.Second, 200*time.Millisecond)
+	s.True(hasStateMachineScheduler())
+	s.NotEmpty(describeResp.Info.RecentActions)
+	s.NotEmpty(describeResp.Info.FutureActionTimes)
+	s.Equal(3*time.Second, describeResp.Schedule.Spec.Interval[0].Interval.AsDuration())
+
+	// Updates are only applied by the workflow, so they migrate the schedule back.
+	schedule.Spec.Interval[0].Interval = durationpb.New(time.Hour)
+	_, err = s.FrontendClient().UpdateSchedule(testcore.NewContext(), &workflowservice.UpdateScheduleRequest{
+		Namespace:  s.Namespace().String(),
+		ScheduleId: sid,
+		Schedule:   schedule,
+		Identity:   "test",
+		RequestId:  uuid.New(),
+	})
+	s.NoError(err)
+	s.Eventually(func() bool { return !hasStateMachineScheduler() }, 10*time.Second, 200*time.Millisecond)
+
+	// The workflow resumed with the state machine scheduler's state and applied the update.
+	s.Eventually(func() bool {
+		describeResp, err := s.FrontendClient().DescribeSchedule(testcore.NewContext(), &workflowservice.DescribeScheduleRequest{
+			Namespace:  s.Namespace().String(),
+			ScheduleId: sid,
+		})
+		s.NoError(err)
+		return describeResp.Schedule.Spec.Interval[0].Interval.AsDuration() == time.Hour &&
+			describeResp.Info.ActionCount >= 2
+	}, 10*time.Second, 200*time.Millisecond)
+}
+
 // getScheduleEntryFomVisibility polls visibility using ListSchedules until it finds a schedule
 // with the given id and for which the optional predicate function returns true.
 func (s *ScheduleFunctionalSuite) getScheduleEntryFomVisibility(sid string, predicate func(*schedulepb.ScheduleListEntry) bool) *schedulepb.ScheduleListEntry {
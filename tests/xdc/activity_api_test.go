@@ -3,6 +3,7 @@ package xdc
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -12,13 +13,17 @@ import (
 	"github.com/stretchr/testify/suite"
 	activitypb "go.temporal.io/api/activity/v1"
 	commonpb "go.temporal.io/api/common/v1"
+	rulespb "go.temporal.io/api/rules/v1"
 	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/sdk/activity"
 	sdkclient "go.temporal.io/sdk/client"
 	"go.temporal.io/sdk/temporal"
 	sdkworker "go.temporal.io/sdk/worker"
 	"go.temporal.io/sdk/workflow"
+	"go.temporal.io/server/api/adminservice/v1"
 	"go.temporal.io/server/common/dynamicconfig"
 	"go.temporal.io/server/common/log"
+	"go.temporal.io/server/common/util"
 	"go.temporal.io/server/tests/testcore"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/fieldmaskpb"
@@ -245,6 +250,129 @@ func (s *ActivityApiStateReplicationSuite) TestPauseActivityFailover() {
 	s.NoError(err)
 }
 
+func (s *ActivityApiStateReplicationSuite) TestActivityPausedByRuleReplication() {
+	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	defer cancel()
+
+	activityType := "rule-paused-activity"
+	var startedActivityCount atomic.Int32
+	activityFunction := func() (string, error) {
+		startedActivityCount.Add(1)
+		return "done!", nil
+	}
+	workflowFn := func(ctx workflow.Context) error {
+		var ret string
+		return workflow.ExecuteActivity(workflow.WithActivityOptions(ctx, workflow.ActivityOptions{
+			ActivityID:            "activity-id",
+			DisableEagerExecution: true,
+			StartToCloseTimeout:   time.Minute,
+		}), activityType).Get(ctx, &ret)
+	}
+
+	ns := s.createGlobalNamespace()
+	activeSDKClient, err := sdkclient.Dial(sdkclient.Options{
+		HostPort:  s.clusters[0].Host().FrontendGRPCAddress(),
+		Namespace: ns,
+		Logger:    log.NewSdkLogger(s.logger),
+	})
+	s.NoError(err)
+	standbySDKClient, err := sdkclient.Dial(sdkclient.Options{
+		HostPort:  s.clusters[1].Host().FrontendGRPCAddress(),
+		Namespace: ns,
+		Logger:    log.NewSdkLogger(s.logger),
+	})
+	s.NoError(err)
+
+	// create a rule that pauses the activity when it's scheduled
+	ruleID := "pause-activity"
+	_, err = s.clusters[0].Host().FrontendClient().CreateWorkflowRule(ctx, &workflowservice.CreateWorkflowRuleRequest{
+		Namespace: ns,
+		Spec: &rulespb.WorkflowRuleSpec{
+			Id: ruleID,
+			Trigger: &rulespb.WorkflowRuleSpec_ActivityStart{
+				ActivityStart: &rulespb.WorkflowRuleSpec_ActivityStartingTrigger{
+					Predicate: fmt.Sprintf("ActivityType = \"%s\"", activityType),
+				},
+			},
+			Actions: []*rulespb.WorkflowRuleAction{
+				{
+					Variant: &rulespb.WorkflowRuleAction_ActivityPause{
+						ActivityPause: &rulespb.WorkflowRuleAction_ActionActivityPause{},
+					},
+				},
+			},
+		},
+	})
+	s.NoError(err)
+	// There is no good way to check if the namespace config has propagated to the history service
+	s.NoError(util.InterruptibleSleep(ctx, 2*time.Second))
+
+	taskQueue := testcore.RandomizeStr("tq")
+	worker1 := sdkworker.New(activeSDKClient, taskQueue, sdkworker.Options{})
+	worker1.RegisterWorkflow(workflowFn)
+	worker1.RegisterActivityWithOptions(activityFunction, activity.RegisterOptions{Name: activityType})
+	s.NoError(worker1.Start())
+	defer worker1.Stop()
+
+	workflowRun, err := activeSDKClient.ExecuteWorkflow(ctx, sdkclient.StartWorkflowOptions{
+		ID:        testcore.RandomizeStr("wfid-" + s.T().Name()),
+		TaskQueue: taskQueue,
+	}, workflowFn)
+	s.NoError(err)
+
+	requirePausedByRule := func(t *assert.CollectT, client sdkclient.Client) {
+		description, err := client.DescribeWorkflowExecution(ctx, workflowRun.GetID(), workflowRun.GetRunID())
+		require.NoError(t, err)
+		require.Len(t, description.GetPendingActivities(), 1)
+		require.True(t, description.PendingActivities[0].GetPaused())
+		require.Equal(t, ruleID, description.PendingActivities[0].GetPauseInfo().GetRule().GetRuleId())
+	}
+
+	// the activity is paused when it's scheduled, and the pause is replicated to cluster1
+	s.EventuallyWithT(func(t *assert.CollectT) {
+		requirePausedByRule(t, activeSDKClient)
+	}, 5*time.Second, 200*time.Millisecond)
+	s.EventuallyWithT(func(t *assert.CollectT) {
+		requirePausedByRule(t, standbySDKClient)
+	}, 10*time.Second, 200*time.Millisecond)
+
+	// rebuilding the mutable state replays the history, which doesn't record the pause, and
+	// dispatches the activity again. The rule pauses it again before it starts.
+	_, err = s.clusters[0].Host().AdminClient().RebuildMutableState(ctx, &adminservice.RebuildMutableStateRequest{
+		Namespace: ns,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowRun.GetID(),
+			RunId:      workflowRun.GetRunID(),
+		},
+	})
+	s.NoError(err)
+	s.EventuallyWithT(func(t *assert.CollectT) {
+		requirePausedByRule(t, activeSDKClient)
+	}, 10*time.Second, 200*time.Millisecond)
+	s.Equal(int32(0), startedActivityCount.Load())
+
+	// remove the rule, so it doesn't pause the activity again
+	_, err = s.clusters[0].Host().FrontendClient().DeleteWorkflowRule(ctx, &workflowservice.DeleteWorkflowRuleRequest{
+		Namespace: ns,
+		RuleId:    ruleID,
+	})
+	s.NoError(err)
+	s.NoError(util.InterruptibleSleep(ctx, 2*time.Second))
+
+	// unpausing the activity dispatches it
+	_, err = s.clusters[0].Host().FrontendClient().UnpauseActivity(ctx, &workflowservice.UnpauseActivityRequest{
+		Namespace: ns,
+		Execution: &commonpb.WorkflowExecution{
+			WorkflowId: workflowRun.GetID(),
+		},
+		Activity: &workflowservice.UnpauseActivityRequest_Id{Id: "activity-id"},
+	})
+	s.NoError(err)
+
+	s.NoError(workflowRun.Get(ctx, nil))
+	s.Equal(int32(1), startedActivityCount.Load())
+}
+
 func (s *ActivityApiStateReplicationSuite) makeWorkflowFunc(activityFunction ActivityFunctions) WorkflowFunction {
 	initialRetryInterval := 1 * time.Second
 	scheduleToCloseTimeout := 30 * time.Minute
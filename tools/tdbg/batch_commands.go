@@ -0,0 +1,62 @@
+package tdbg
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/urfave/cli/v2"
+	"go.temporal.io/server/api/adminservice/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// AdminListBatchOperationResults writes the per-workflow results of a batch operation, one
+// JSON object per line, so that the workflows a batch failed for can be downloaded and retried.
+func AdminListBatchOperationResults(c *cli.Context, clientFactory ClientFactory) error {
+	jobID, err := getRequiredOption(c, FlagJobID)
+	if err != nil {
+		return err
+	}
+	writer := c.App.Writer
+	if c.IsSet(FlagOutputFilename) {
+		file, err := os.Create(c.String(FlagOutputFilename))
+		if err != nil {
+			return fmt.Errorf("unable to create output file: %w", err)
+		}
+		defer func() { _ = file.Close() }()
+		writer = file
+	}
+	adminClient := clientFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	req := &adminservice.ListBatchOperationResultsRequest{
+		Namespace:    c.String(FlagNamespace),
+		JobId:        jobID,
+		FailuresOnly: c.Bool(FlagFailuresOnly),
+	}
+	var resp *adminservice.ListBatchOperationResultsResponse
+	for {
+		resp, err = adminClient.ListBatchOperationResults(ctx, req)
+		if err != nil {
+			return fmt.Errorf("unable to list batch operation results: %w", err)
+		}
+		for _, item := range resp.GetItems() {
+			data, err := protojson.Marshal(item)
+			if err != nil {
+				return fmt.Errorf("unable to encode batch operation result: %w", err)
+			}
+			if _, err := fmt.Fprintln(writer, string(data)); err != nil {
+				return fmt.Errorf("unable to write batch operation result: %w", err)
+			}
+		}
+		if len(resp.GetNextPageToken()) == 0 {
+			break
+		}
+		req.NextPageToken = resp.GetNextPageToken()
+	}
+	if resp.GetDroppedSuccesses() > 0 || resp.GetDroppedFailures() > 0 {
+		fmt.Fprintf(c.App.ErrWriter, "Warning: the batch operation didn't keep %d successful and %d failed results\n",
+			resp.GetDroppedSuccesses(), resp.GetDroppedFailures())
+	}
+	return nil
+}
@@ -1,9 +1,13 @@
 package tdbg
 
 import (
+	"bufio"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -30,8 +34,24 @@ import (
 )
 
 const (
-	historyImportBlobSize = 16
-	historyImportPageSize = 256 * 1024 // 256K
+	historyImportBlobSize = 256 * 1024 // 256K
+	historyImportPageSize = 16
+
+	// historyExportFormat identifies files written by AdminExportWorkflow
+	historyExportFormat = "temporal-history-export/v1"
+)
+
+type (
+	// historyExportHeader is the first line of a file written by AdminExportWorkflow. It is
+	// followed by one line per history batch, each a JSON encoded DataBlob with the encoding
+	// of the source cluster.
+	historyExportHeader struct {
+		Format         string          `json:"format"`
+		Namespace      string          `json:"namespace"`
+		WorkflowID     string          `json:"workflowId"`
+		RunID          string          `json:"runId"`
+		VersionHistory json.RawMessage `json:"versionHistory"`
+	}
 )
 
 // AdminShowWorkflow shows history
@@ -135,33 +155,197 @@ func AdminShowWorkflow(c *cli.Context, clientFactory ClientFactory) error {
 	return nil
 }
 
-// AdminImportWorkflow imports history
-func AdminImportWorkflow(c *cli.Context, clientFactory ClientFactory) error {
-	nsName, err := getRequiredOption(c, FlagNamespace)
+// AdminExportWorkflow exports the history of the current branch of a workflow execution to a
+// file that can be imported with AdminImportWorkflow
+func AdminExportWorkflow(c *cli.Context, clientFactory ClientFactory) error {
+	outputFileName, err := getRequiredOption(c, FlagOutputFilename)
 	if err != nil {
 		return err
 	}
-	wid, err := getRequiredOption(c, FlagWorkflowID)
+	msResp, err := describeMutableState(c, clientFactory)
 	if err != nil {
 		return err
 	}
-	rid, err := getRequiredOption(c, FlagRunID)
+	ms := msResp.GetDatabaseMutableState()
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: ms.GetExecutionInfo().GetWorkflowId(),
+		RunId:      ms.GetExecutionState().GetRunId(),
+	}
+	currentVersionHistory, err := versionhistory.GetCurrentVersionHistory(ms.GetExecutionInfo().GetVersionHistories())
 	if err != nil {
-		return err
+		return fmt.Errorf("unable to get current version history: %s", err)
+	}
+	lastItem, err := versionhistory.GetLastVersionHistoryItem(currentVersionHistory)
+	if err != nil {
+		return fmt.Errorf("unable to get current version history: %s", err)
 	}
-	inputFileName := c.String(FlagInputFilename)
+	// branch token is specific to the source cluster
+	versionHistory := versionhistory.NewVersionHistory(nil, versionhistory.CopyVersionHistory(currentVersionHistory).GetItems())
 
-	client := clientFactory.AdminClient(c)
+	encoder := codec.NewJSONPBEncoder()
+	versionHistoryData, err := encoder.Encode(versionHistory)
+	if err != nil {
+		return fmt.Errorf("unable to serialize version history: %s", err)
+	}
+	header, err := json.Marshal(historyExportHeader{
+		Format:         historyExportFormat,
+		Namespace:      c.String(FlagNamespace),
+		WorkflowID:     execution.GetWorkflowId(),
+		RunID:          execution.GetRunId(),
+		VersionHistory: versionHistoryData,
+	})
+	if err != nil {
+		return fmt.Errorf("unable to serialize export header: %s", err)
+	}
 
-	serializer := serialization.NewSerializer()
+	// write to a temporary file so that a failed export doesn't leave a partial history behind
+	file, err := os.CreateTemp(filepath.Dir(outputFileName), filepath.Base(outputFileName)+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("unable to create History data file: %s", err)
+	}
+	exported := false
+	defer func() {
+		_ = file.Close()
+		if !exported {
+			_ = os.Remove(file.Name())
+		}
+	}()
+	writer := bufio.NewWriter(file)
+	if _, err := writer.Write(append(header, '\n')); err != nil {
+		return fmt.Errorf("unable to write History data file: %s", err)
+	}
 
+	client := clientFactory.AdminClient(c)
+	serializer := serialization.NewSerializer()
 	ctx, cancel := newContext(c)
 	defer cancel()
 
-	data, err := os.ReadFile(inputFileName)
+	var lastEvent *historypb.HistoryEvent
+	batchCount := 0
+	var token []byte
+	for doContinue := true; doContinue; doContinue = len(token) != 0 {
+		resp, err := client.GetWorkflowExecutionRawHistoryV2(ctx, &adminservice.GetWorkflowExecutionRawHistoryV2Request{
+			NamespaceId: ms.GetExecutionInfo().GetNamespaceId(),
+			Execution:   execution,
+			// the API is exclusive-exclusive
+			EndEventId:      lastItem.GetEventId() + 1,
+			EndEventVersion: lastItem.GetVersion(),
+			MaximumPageSize: 100,
+			NextPageToken:   token,
+		})
+		if err != nil {
+			return fmt.Errorf("unable to recv History Branch: %s", err)
+		}
+		for _, blob := range resp.HistoryBatches {
+			events, err := serializer.DeserializeEvents(blob)
+			if err != nil {
+				return fmt.Errorf("unable to deserialize Events: %s", err)
+			}
+			if len(events) > 0 {
+				lastEvent = events[len(events)-1]
+			}
+			data, err := encoder.Encode(blob)
+			if err != nil {
+				return fmt.Errorf("unable to serialize History data: %s", err)
+			}
+			if _, err := writer.Write(append(data, '\n')); err != nil {
+				return fmt.Errorf("unable to write History data file: %s", err)
+			}
+			batchCount++
+		}
+		token = resp.NextPageToken
+	}
+	// for local namespaces the end event can't be pinned by version, so events written after the
+	// mutable state was read may be returned
+	if lastEvent.GetEventId() != lastItem.GetEventId() || lastEvent.GetVersion() != lastItem.GetVersion() {
+		return fmt.Errorf("workflow history changed during export, last event %v version %v, expected event %v version %v",
+			lastEvent.GetEventId(), lastEvent.GetVersion(), lastItem.GetEventId(), lastItem.GetVersion())
+	}
+	if err := writer.Flush(); err != nil {
+		return fmt.Errorf("unable to write History data file: %s", err)
+	}
+	if err := file.Close(); err != nil {
+		return fmt.Errorf("unable to write History data file: %s", err)
+	}
+	if err := os.Rename(file.Name(), outputFileName); err != nil {
+		return fmt.Errorf("unable to create History data file: %s", err)
+	}
+	exported = true
+	fmt.Fprintf(c.App.Writer, "Exported %v history batches of workflow %v run %v.\n", batchCount, execution.GetWorkflowId(), execution.GetRunId())
+	return nil
+}
+
+// AdminImportWorkflow imports history from a file written by AdminExportWorkflow, or from a
+// JSON encoded list of histories
+func AdminImportWorkflow(c *cli.Context, clientFactory ClientFactory) error {
+	inputFileName, err := getRequiredOption(c, FlagInputFilename)
+	if err != nil {
+		return err
+	}
+	file, err := os.Open(inputFileName)
 	if err != nil {
 		return fmt.Errorf("unable to read History data file: %s", err)
 	}
+	defer func() { _ = file.Close() }()
+
+	decoder := json.NewDecoder(bufio.NewReader(file))
+	var first json.RawMessage
+	if err := decoder.Decode(&first); err != nil {
+		return fmt.Errorf("unable to deserialize History data: %s", err)
+	}
+	var header historyExportHeader
+	if json.Unmarshal(first, &header) != nil || header.Format != historyExportFormat {
+		return importWorkflowHistories(c, clientFactory, first)
+	}
+
+	encoder := codec.NewJSONPBEncoder()
+	versionHistory := &historyspb.VersionHistory{}
+	if err := encoder.Decode(header.VersionHistory, versionHistory); err != nil {
+		return fmt.Errorf("unable to deserialize version history: %s", err)
+	}
+	nsName := header.Namespace
+	if c.IsSet(FlagNamespace) || nsName == "" {
+		nsName = c.String(FlagNamespace)
+	}
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: header.WorkflowID,
+		RunId:      header.RunID,
+	}
+	if c.IsSet(FlagWorkflowID) {
+		execution.WorkflowId = c.String(FlagWorkflowID)
+	}
+	if c.IsSet(FlagRunID) {
+		execution.RunId = c.String(FlagRunID)
+	}
+
+	return importWorkflowHistory(c, clientFactory, nsName, execution, versionHistory, func() (*commonpb.DataBlob, error) {
+		var data json.RawMessage
+		if err := decoder.Decode(&data); err != nil {
+			return nil, err
+		}
+		blob := &commonpb.DataBlob{}
+		if err := encoder.Decode(data, blob); err != nil {
+			return nil, err
+		}
+		return blob, nil
+	})
+}
+
+// importWorkflowHistories imports a JSON encoded list of histories, as written by AdminShowWorkflow
+func importWorkflowHistories(c *cli.Context, clientFactory ClientFactory, data []byte) error {
+	nsName, err := getRequiredOption(c, FlagNamespace)
+	if err != nil {
+		return err
+	}
+	wid, err := getRequiredOption(c, FlagWorkflowID)
+	if err != nil {
+		return err
+	}
+	rid, err := getRequiredOption(c, FlagRunID)
+	if err != nil {
+		return err
+	}
+
 	encoder := codec.NewJSONPBEncoder()
 	historyBatches, err := encoder.DecodeHistories(data)
 	if err != nil {
@@ -178,59 +362,86 @@ func AdminImportWorkflow(c *cli.Context, clientFactory ClientFactory) error {
 		}
 	}
 
+	serializer := serialization.NewSerializer()
+	execution := &commonpb.WorkflowExecution{
+		WorkflowId: wid,
+		RunId:      rid,
+	}
+	return importWorkflowHistory(c, clientFactory, nsName, execution, versionHistory, func() (*commonpb.DataBlob, error) {
+		if len(historyBatches) == 0 {
+			return nil, io.EOF
+		}
+		historyBatch := historyBatches[0]
+		historyBatches = historyBatches[1:]
+		blob, err := serializer.SerializeEvents(historyBatch.Events, enumspb.ENCODING_TYPE_PROTO3)
+		if err != nil {
+			return nil, fmt.Errorf("unable to serialize Events: %s", err)
+		}
+		return blob, nil
+	})
+}
+
+// importWorkflowHistory sends the history batches returned by nextBlob, until it returns io.EOF,
+// to ImportWorkflowExecution and commits the import.
+func importWorkflowHistory(
+	c *cli.Context,
+	clientFactory ClientFactory,
+	nsName string,
+	execution *commonpb.WorkflowExecution,
+	versionHistory *historyspb.VersionHistory,
+	nextBlob func() (*commonpb.DataBlob, error),
+) error {
+	client := clientFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+
 	var token []byte
+	send := func(blobs []*commonpb.DataBlob) error {
+		resp, err := client.ImportWorkflowExecution(ctx, &adminservice.ImportWorkflowExecutionRequest{
+			Namespace:      nsName,
+			Execution:      execution,
+			HistoryBatches: blobs,
+			VersionHistory: versionHistory,
+			Token:          token,
+		})
+		if err != nil {
+			return err
+		}
+		token = resp.Token
+		return nil
+	}
+
 	var blobs []*commonpb.DataBlob
 	blobSize := 0
-	for i := 0; i < len(historyBatches)+1; i++ {
-		if i < len(historyBatches) {
-			historyBatch := historyBatches[i]
-			blob, err := serializer.SerializeEvents(historyBatch.Events, enumspb.ENCODING_TYPE_PROTO3)
-			if err != nil {
-				return fmt.Errorf("unable to deserialize Events: %s", err)
-			}
-			blobSize += len(blob.Data)
-			blobs = append(blobs, blob)
+	for {
+		blob, err := nextBlob()
+		if errors.Is(err, io.EOF) {
+			break
 		}
-		if blobSize >= historyImportBlobSize ||
-			len(blobs) >= historyImportPageSize ||
-			(i == len(historyBatches) && len(blobs) > 0) {
-			resp, err := client.ImportWorkflowExecution(ctx, &adminservice.ImportWorkflowExecutionRequest{
-				Namespace: nsName,
-				Execution: &commonpb.WorkflowExecution{
-					WorkflowId: wid,
-					RunId:      rid,
-				},
-				HistoryBatches: blobs,
-				VersionHistory: versionHistory,
-				Token:          token,
-			})
-			if err != nil {
+		if err != nil {
+			return fmt.Errorf("unable to read History data: %s", err)
+		}
+		blobSize += len(blob.Data)
+		blobs = append(blobs, blob)
+		if blobSize >= historyImportBlobSize || len(blobs) >= historyImportPageSize {
+			if err := send(blobs); err != nil {
 				return fmt.Errorf("unable to send History Branch: %s", err)
 			}
-			token = resp.Token
-
-			blobs = []*commonpb.DataBlob{}
+			blobs = nil
 			blobSize = 0
 		}
 	}
-	if len(blobs) != 0 {
-		return errors.New("unable to import workflow events, some events are not sent")
+	if len(blobs) > 0 {
+		if err := send(blobs); err != nil {
+			return fmt.Errorf("unable to send History Branch: %s", err)
+		}
 	}
 	// call with empty history to commit
-	resp, err := client.ImportWorkflowExecution(ctx, &adminservice.ImportWorkflowExecutionRequest{
-		Namespace: nsName,
-		Execution: &commonpb.WorkflowExecution{
-			WorkflowId: wid,
-			RunId:      rid,
-		},
-		HistoryBatches: []*commonpb.DataBlob{},
-		VersionHistory: versionHistory,
-		Token:          token,
-	})
-	if err != nil {
+	if err := send([]*commonpb.DataBlob{}); err != nil {
 		return fmt.Errorf("unable to import workflow events: %s", err)
 	}
-	if len(resp.Token) != 0 {
+	if len(token) != 0 {
 		return errors.New("unable to import workflow events, not committed")
 	}
 	return nil
@@ -1,10 +1,26 @@
 package tdbg
 
 import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/urfave/cli/v2"
+	commonpb "go.temporal.io/api/common/v1"
+	enumspb "go.temporal.io/api/enums/v1"
+	historypb "go.temporal.io/api/history/v1"
+	"go.temporal.io/api/workflowservice/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	historyspb "go.temporal.io/server/api/history/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/persistence/serialization"
+	"go.temporal.io/server/common/persistence/versionhistory"
+	"go.temporal.io/server/common/testing/protorequire"
 	"go.temporal.io/server/service/history/tasks"
+	"google.golang.org/grpc"
 )
 
 func TestGetCategory(t *testing.T) {
@@ -49,3 +65,133 @@ func TestGetCategory(t *testing.T) {
 		})
 	}
 }
+
+type historyTestClient struct {
+	adminservice.AdminServiceClient
+	blobs           []*commonpb.DataBlob
+	versionHistory  *historyspb.VersionHistory
+	importRequests  []*adminservice.ImportWorkflowExecutionRequest
+	rawHistoryCalls int
+}
+
+func (t *historyTestClient) AdminClient(*cli.Context) adminservice.AdminServiceClient {
+	return t
+}
+
+func (t *historyTestClient) WorkflowClient(*cli.Context) workflowservice.WorkflowServiceClient {
+	panic("unimplemented")
+}
+
+func (t *historyTestClient) DescribeMutableState(_ context.Context, request *adminservice.DescribeMutableStateRequest, _ ...grpc.CallOption) (*adminservice.DescribeMutableStateResponse, error) {
+	return &adminservice.DescribeMutableStateResponse{
+		DatabaseMutableState: &persistencespb.WorkflowMutableState{
+			ExecutionInfo: &persistencespb.WorkflowExecutionInfo{
+				NamespaceId:      "namespace-id",
+				WorkflowId:       request.GetExecution().GetWorkflowId(),
+				VersionHistories: versionhistory.NewVersionHistories(t.versionHistory),
+			},
+			ExecutionState: &persistencespb.WorkflowExecutionState{RunId: "run-id"},
+		},
+	}, nil
+}
+
+func (t *historyTestClient) GetWorkflowExecutionRawHistoryV2(_ context.Context, request *adminservice.GetWorkflowExecutionRawHistoryV2Request, _ ...grpc.CallOption) (*adminservice.GetWorkflowExecutionRawHistoryV2Response, error) {
+	t.rawHistoryCalls++
+	if request.GetNamespaceId() != "namespace-id" || request.GetExecution().GetRunId() != "run-id" {
+		return nil, errors.New("unexpected request")
+	}
+	// one batch per page
+	page := 0
+	if len(request.NextPageToken) > 0 {
+		page = int(request.NextPageToken[0])
+	}
+	resp := &adminservice.GetWorkflowExecutionRawHistoryV2Response{
+		HistoryBatches: t.blobs[page : page+1],
+	}
+	if page+1 < len(t.blobs) {
+		resp.NextPageToken = []byte{byte(page + 1)}
+	}
+	return resp, nil
+}
+
+func (t *historyTestClient) ImportWorkflowExecution(_ context.Context, request *adminservice.ImportWorkflowExecutionRequest, _ ...grpc.CallOption) (*adminservice.ImportWorkflowExecutionResponse, error) {
+	t.importRequests = append(t.importRequests, request)
+	if len(request.HistoryBatches) == 0 {
+		return &adminservice.ImportWorkflowExecutionResponse{}, nil
+	}
+	return &adminservice.ImportWorkflowExecutionResponse{Token: []byte("token")}, nil
+}
+
+func newHistoryTestClient(t *testing.T, versions ...int64) *historyTestClient {
+	serializer := serialization.NewSerializer()
+	client := &historyTestClient{versionHistory: versionhistory.NewVersionHistory([]byte("branch-token"), nil)}
+	for i, version := range versions {
+		event := &historypb.HistoryEvent{EventId: int64(i + 1), Version: version}
+		blob, err := serializer.SerializeEvents([]*historypb.HistoryEvent{event}, enumspb.ENCODING_TYPE_PROTO3)
+		require.NoError(t, err)
+		client.blobs = append(client.blobs, blob)
+		require.NoError(t, versionhistory.AddOrUpdateVersionHistoryItem(client.versionHistory, versionhistory.NewVersionHistoryItem(event.EventId, version)))
+	}
+	return client
+}
+
+func TestExportImportWorkflow(t *testing.T) {
+	s := require.New(t)
+	source := newHistoryTestClient(t, 1, 1, 11)
+	fileName := filepath.Join(t.TempDir(), "history.jsonl")
+
+	app := NewCliApp(func(params *Params) { params.ClientFactory = source })
+	s.NoError(app.Run([]string{"tdbg", "--namespace", "source-ns", "workflow", "export", "--workflow-id", "wid", "--output-filename", fileName}))
+	s.Equal(3, source.rawHistoryCalls)
+
+	target := &historyTestClient{}
+	app = NewCliApp(func(params *Params) { params.ClientFactory = target })
+	s.NoError(app.Run([]string{"tdbg", "workflow", "import", "--input-filename", fileName}))
+
+	s.Len(target.importRequests, 2)
+	request := target.importRequests[0]
+	s.Equal("source-ns", request.GetNamespace())
+	s.Equal("wid", request.GetExecution().GetWorkflowId())
+	s.Equal("run-id", request.GetExecution().GetRunId())
+	s.Empty(request.GetVersionHistory().GetBranchToken())
+	protorequire.ProtoSliceEqual(t, source.versionHistory.GetItems(), request.GetVersionHistory().GetItems())
+	s.Len(request.GetHistoryBatches(), 3)
+	for i, blob := range request.GetHistoryBatches() {
+		s.Equal(source.blobs[i].GetData(), blob.GetData())
+		s.Equal(source.blobs[i].GetEncodingType(), blob.GetEncodingType())
+	}
+	commit := target.importRequests[1]
+	s.Empty(commit.GetHistoryBatches())
+	s.Equal([]byte("token"), commit.GetToken())
+
+	// namespace, workflow ID and run ID can be overridden
+	target = &historyTestClient{}
+	app = NewCliApp(func(params *Params) { params.ClientFactory = target })
+	s.NoError(app.Run([]string{"tdbg", "--namespace", "target-ns", "workflow", "import", "--input-filename", fileName, "--run-id", "new-run-id"}))
+	s.Equal("target-ns", target.importRequests[0].GetNamespace())
+	s.Equal("wid", target.importRequests[0].GetExecution().GetWorkflowId())
+	s.Equal("new-run-id", target.importRequests[0].GetExecution().GetRunId())
+}
+
+func TestExportWorkflow_HistoryChanged(t *testing.T) {
+	s := require.New(t)
+	client := newHistoryTestClient(t, 0, 0, 0)
+	// an event was added after the mutable state was read
+	client.versionHistory.Items[0].EventId = 2
+	dir := t.TempDir()
+	fileName := filepath.Join(dir, "history.jsonl")
+	s.NoError(os.WriteFile(fileName, []byte("previous export"), 0666))
+
+	app := NewCliApp(func(params *Params) { params.ClientFactory = client })
+	app.ExitErrHandler = func(*cli.Context, error) {}
+	err := app.Run([]string{"tdbg", "workflow", "export", "--workflow-id", "wid", "--output-filename", fileName})
+	s.ErrorContains(err, "workflow history changed during export")
+
+	// a failed export leaves the existing file as is and no partial history behind
+	data, err := os.ReadFile(fileName)
+	s.NoError(err)
+	s.Equal("previous export", string(data))
+	entries, err := os.ReadDir(dir)
+	s.NoError(err)
+	s.Len(entries, 1)
+}
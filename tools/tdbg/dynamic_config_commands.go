@@ -0,0 +1,180 @@
+package tdbg
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/urfave/cli/v2"
+	enumspb "go.temporal.io/api/enums/v1"
+	"go.temporal.io/server/api/adminservice/v1"
+	enumsspb "go.temporal.io/server/api/enums/v1"
+	persistencespb "go.temporal.io/server/api/persistence/v1"
+	"go.temporal.io/server/common/dynamicconfig"
+	"go.temporal.io/server/common/dynamicconfig/persistedconfig"
+)
+
+// AdminListDynamicConfig displays all persisted dynamic config values and recent changes
+func AdminListDynamicConfig(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.ListDynamicConfig(ctx, &adminservice.ListDynamicConfigRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to list dynamic config: %w", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminGetDynamicConfig displays the persisted values and recent changes of a dynamic config key
+func AdminGetDynamicConfig(c *cli.Context, clientFactory ClientFactory) error {
+	key, err := getRequiredOption(c, FlagKey)
+	if err != nil {
+		return err
+	}
+	adminClient := clientFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.GetDynamicConfig(ctx, &adminservice.GetDynamicConfigRequest{Key: key})
+	if err != nil {
+		return fmt.Errorf("unable to get dynamic config: %w", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminSetDynamicConfig replaces the persisted values of a dynamic config key
+func AdminSetDynamicConfig(c *cli.Context, clientFactory ClientFactory, prompter *Prompter) error {
+	key, err := getRequiredOption(c, FlagKey)
+	if err != nil {
+		return err
+	}
+	var contents []byte
+	switch {
+	case c.IsSet(FlagValue) && c.IsSet(FlagInputFilename):
+		return fmt.Errorf("only one of %s and %s can be set", FlagValue, FlagInputFilename)
+	case c.IsSet(FlagValue):
+		contents = []byte(c.String(FlagValue))
+	case c.IsSet(FlagInputFilename):
+		contents, err = os.ReadFile(c.String(FlagInputFilename))
+		if err != nil {
+			return fmt.Errorf("unable to read input file: %w", err)
+		}
+	default:
+		return fmt.Errorf("one of %s and %s is required", FlagValue, FlagInputFilename)
+	}
+
+	cvs, lr := dynamicconfig.LoadValues(key, contents)
+	if len(lr.Errors) > 0 {
+		return fmt.Errorf("invalid dynamic config values: %w", errors.Join(lr.Errors...))
+	}
+	if len(cvs) == 0 {
+		return fmt.Errorf("no dynamic config values, use the remove command to remove a key")
+	}
+	for _, warning := range lr.Warnings {
+		fmt.Fprintf(c.App.ErrWriter, "Warning: %v\n", warning)
+	}
+	values, err := persistedconfig.ConstrainedValuesToProto(cvs)
+	if err != nil {
+		return err
+	}
+
+	prompter.Prompt(fmt.Sprintf("Key: %s Values: %s\nReplace persisted values of above dynamic config key?", key, contents))
+	return setDynamicConfig(c, clientFactory, &adminservice.SetDynamicConfigRequest{
+		Key:    key,
+		Values: values,
+	})
+}
+
+// AdminRemoveDynamicConfig removes the persisted values of a dynamic config key
+func AdminRemoveDynamicConfig(c *cli.Context, clientFactory ClientFactory, prompter *Prompter) error {
+	key, err := getRequiredOption(c, FlagKey)
+	if err != nil {
+		return err
+	}
+
+	prompter.Prompt(fmt.Sprintf("Key: %s\nRemove persisted values of above dynamic config key?", key))
+	return setDynamicConfig(c, clientFactory, &adminservice.SetDynamicConfigRequest{
+		Key: key,
+	})
+}
+
+func setDynamicConfig(c *cli.Context, clientFactory ClientFactory, request *adminservice.SetDynamicConfigRequest) error {
+	request.Identity = c.String(FlagIdentity)
+	if request.Identity == "" {
+		request.Identity = defaultIdentity()
+	}
+	request.Reason = c.String(FlagReason)
+
+	adminClient := clientFactory.AdminClient(c)
+	ctx, cancel := newContext(c)
+	defer cancel()
+	if _, err := adminClient.SetDynamicConfig(ctx, request); err != nil {
+		return fmt.Errorf("unable to set dynamic config: %w", err)
+	}
+	fmt.Fprintln(c.App.Writer, "Dynamic config updated.")
+	return nil
+}
+
+func defaultIdentity() string {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "unknown"
+	}
+	return "tdbg@" + hostname
+}
+
+// AdminExplainDynamicConfig displays how the value of a dynamic config key is determined
+func AdminExplainDynamicConfig(c *cli.Context, clientFactory ClientFactory) error {
+	key, err := getRequiredOption(c, FlagKey)
+	if err != nil {
+		return err
+	}
+	taskQueueType, err := StringToEnum(c.String(FlagTaskQueueType), enumspb.TaskQueueType_value)
+	if err != nil {
+		return fmt.Errorf("invalid task queue type: %w", err)
+	}
+	taskType, err := StringToEnum(c.String(FlagTaskType), enumsspb.TaskType_value)
+	if err != nil {
+		return fmt.Errorf("invalid task type: %w", err)
+	}
+	adminClient := clientFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.ExplainDynamicConfig(ctx, &adminservice.ExplainDynamicConfigRequest{
+		Key: key,
+		Constraints: &persistencespb.DynamicConfigConstraints{
+			Namespace:     c.String(FlagNamespace),
+			NamespaceId:   c.String(FlagNamespaceID),
+			TaskQueueName: c.String(FlagTaskQueue),
+			TaskQueueType: enumspb.TaskQueueType(taskQueueType),
+			ShardId:       int32(c.Int(FlagShardID)),
+			TaskType:      enumsspb.TaskType(taskType),
+			Destination:   c.String(FlagDestination),
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to explain dynamic config: %w", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
+
+// AdminListDynamicConfigDeviations displays the dynamic config keys with values that differ
+// from their defaults
+func AdminListDynamicConfigDeviations(c *cli.Context, clientFactory ClientFactory) error {
+	adminClient := clientFactory.AdminClient(c)
+
+	ctx, cancel := newContext(c)
+	defer cancel()
+	resp, err := adminClient.ListDynamicConfigDeviations(ctx, &adminservice.ListDynamicConfigDeviationsRequest{})
+	if err != nil {
+		return fmt.Errorf("unable to list dynamic config deviations: %w", err)
+	}
+	prettyPrintJSONObject(c, resp)
+	return nil
+}
@@ -68,4 +68,11 @@ var (
 	FlagBuildIDs                   = "select-build-id"
 	FlagUnversioned                = "select-unversioned"
 	FlagAllActive                  = "select-all-active"
+	FlagKey                        = "key"
+	FlagValue                      = "value"
+	FlagIdentity                   = "identity"
+	FlagTaskType                   = "task-type"
+	FlagDestination                = "destination"
+	FlagJobID                      = "job-id"
+	FlagFailuresOnly               = "failures-only"
 )
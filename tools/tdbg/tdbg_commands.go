@@ -63,6 +63,17 @@ func getCommands(
 				},
 			},
 		},
+		{
+			Name:        "dynamic-config",
+			Aliases:     []string{"dc"},
+			Usage:       "Run admin operation on persisted dynamic config",
+			Subcommands: newAdminDynamicConfigCommands(clientFactory, prompterFactory),
+		},
+		{
+			Name:        "batch",
+			Usage:       "Run admin operation on batch operations",
+			Subcommands: newAdminBatchCommands(clientFactory),
+		},
 		{
 			Name:        "decode",
 			Usage:       "Decode payload",
@@ -80,21 +91,43 @@ func newAdminWorkflowCommands(clientFactory ClientFactory, prompterFactory Promp
 				&cli.StringFlag{
 					Name:    FlagWorkflowID,
 					Aliases: FlagWorkflowIDAlias,
-					Usage:   "Workflow ID",
+					Usage:   "Workflow ID, defaults to the workflow ID of an exported file",
 				},
 				&cli.StringFlag{
 					Name:    FlagRunID,
 					Aliases: FlagRunIDAlias,
-					Usage:   "Run ID",
+					Usage:   "Run ID, defaults to the run ID of an exported file",
 				},
 				&cli.StringFlag{
 					Name:  FlagInputFilename,
-					Usage: "input file",
+					Usage: "input file, written by the export command or by the show command with --" + FlagOutputFilename,
 				}},
 			Action: func(c *cli.Context) error {
 				return AdminImportWorkflow(c, clientFactory)
 			},
 		},
+		{
+			Name:  "export",
+			Usage: "export the history of the current branch of a workflow to a file",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    FlagWorkflowID,
+					Aliases: FlagWorkflowIDAlias,
+					Usage:   "Workflow ID",
+				},
+				&cli.StringFlag{
+					Name:    FlagRunID,
+					Aliases: FlagRunIDAlias,
+					Usage:   "Run ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagOutputFilename,
+					Usage: "output file",
+				}},
+			Action: func(c *cli.Context) error {
+				return AdminExportWorkflow(c, clientFactory)
+			},
+		},
 		{
 			Name:  "show",
 			Usage: "show workflow history from database",
@@ -867,3 +900,159 @@ func newDecodeCommands(
 		},
 	}
 }
+
+func newAdminDynamicConfigCommands(clientFactory ClientFactory, prompterFactory PrompterFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "list",
+			Usage: "List persisted dynamic config values and recent changes",
+			Action: func(c *cli.Context) error {
+				return AdminListDynamicConfig(c, clientFactory)
+			},
+		},
+		{
+			Name:  "get",
+			Usage: "Get persisted values and recent changes of a dynamic config key",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagKey,
+					Usage:    "Dynamic config key",
+					Required: true,
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminGetDynamicConfig(c, clientFactory)
+			},
+		},
+		{
+			Name:  "set",
+			Usage: "Replace persisted values of a dynamic config key",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagKey,
+					Usage:    "Dynamic config key",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name: FlagValue,
+					Usage: "Values of the key in the format of the dynamic config file, " +
+						"e.g. '[{constraints: {namespace: my-namespace}, value: 100}]'",
+				},
+				&cli.StringFlag{
+					Name:  FlagInputFilename,
+					Usage: "File with the values of the key in the format of the dynamic config file",
+				},
+				&cli.StringFlag{
+					Name:  FlagIdentity,
+					Usage: "Identity of the operator making the change",
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for the change",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminSetDynamicConfig(c, clientFactory, prompterFactory(c))
+			},
+		},
+		{
+			Name:  "remove",
+			Usage: "Remove persisted values of a dynamic config key",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagKey,
+					Usage:    "Dynamic config key",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:  FlagIdentity,
+					Usage: "Identity of the operator making the change",
+				},
+				&cli.StringFlag{
+					Name:  FlagReason,
+					Usage: "Reason for the change",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminRemoveDynamicConfig(c, clientFactory, prompterFactory(c))
+			},
+		},
+		{
+			Name:  "explain",
+			Usage: "Show how the value of a dynamic config key is determined for a namespace, task queue, shard, etc.",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagKey,
+					Usage:    "Dynamic config key",
+					Required: true,
+				},
+				&cli.StringFlag{
+					Name:    FlagNamespace,
+					Aliases: FlagNamespaceAlias,
+					Usage:   "Namespace",
+				},
+				&cli.StringFlag{
+					Name:  FlagNamespaceID,
+					Usage: "Namespace ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueue,
+					Usage: "Task Queue name",
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskQueueType,
+					Usage: "Task Queue type, e.g. TASK_QUEUE_TYPE_WORKFLOW",
+				},
+				&cli.IntFlag{
+					Name:  FlagShardID,
+					Usage: "Shard ID",
+				},
+				&cli.StringFlag{
+					Name:  FlagTaskType,
+					Usage: "History task type, e.g. TASK_TYPE_TRANSFER_ACTIVITY_TASK",
+				},
+				&cli.StringFlag{
+					Name:  FlagDestination,
+					Usage: "Destination of outbound tasks",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminExplainDynamicConfig(c, clientFactory)
+			},
+		},
+		{
+			Name:  "deviations",
+			Usage: "List dynamic config keys with values that differ from their defaults",
+			Action: func(c *cli.Context) error {
+				return AdminListDynamicConfigDeviations(c, clientFactory)
+			},
+		},
+	}
+}
+
+func newAdminBatchCommands(clientFactory ClientFactory) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "results",
+			Usage: "List the per-workflow results of an update or signal-with-start batch operation",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:     FlagJobID,
+					Usage:    "Job ID of the batch operation",
+					Required: true,
+				},
+				&cli.BoolFlag{
+					Name:  FlagFailuresOnly,
+					Usage: "Only list the workflows the operation failed for",
+				},
+				&cli.StringFlag{
+					Name:  FlagOutputFilename,
+					Usage: "File to write the results to, one JSON object per line",
+				},
+			},
+			Action: func(c *cli.Context) error {
+				return AdminListBatchOperationResults(c, clientFactory)
+			},
+		},
+	}
+}